@@ -45,18 +45,28 @@ type modTimeFunc func(ctx context.Context, path string) (time.Time, error)
 
 var zeroTime = time.Time{}
 
+// Prefix is stripped from the path before calling these functions. HTTP
+// functions need the full URL, so they add it back.
 var prefixToReadfunc = map[string]readFunc{
-	"gs://":    readFileFromGCS,
-	"s3://":    readFileFromS3,
-	"http://":  readFileFromHTTP,
-	"https://": readFileFromHTTP,
+	"gs://": readFileFromGCS,
+	"s3://": readFileFromS3,
+	"http://": func(ctx context.Context, path string) ([]byte, error) {
+		return readFileFromHTTP(ctx, "http://"+path)
+	},
+	"https://": func(ctx context.Context, path string) ([]byte, error) {
+		return readFileFromHTTP(ctx, "https://"+path)
+	},
 }
 
 var prefixToModTimeFunc = map[string]modTimeFunc{
-	"gs://":    gcsModTime,
-	"s3://":    s3ModTime,
-	"http://":  httpModTime,
-	"https://": httpModTime,
+	"gs://": gcsModTime,
+	"s3://": s3ModTime,
+	"http://": func(ctx context.Context, path string) (time.Time, error) {
+		return httpModTime(ctx, "http://"+path)
+	},
+	"https://": func(ctx context.Context, path string) (time.Time, error) {
+		return httpModTime(ctx, "https://"+path)
+	},
 }
 
 func parseObjectURL(objectPath string) (bucket, object string, err error) {
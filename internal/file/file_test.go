@@ -16,6 +16,8 @@ package file
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -71,6 +73,30 @@ func TestReadFile(t *testing.T) {
 	}
 }
 
+func TestReadFileHTTP(t *testing.T) {
+	lastModified := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/data.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		w.Write([]byte("http-content"))
+	}))
+	defer ts.Close()
+
+	b, err := ReadFile(context.Background(), ts.URL+"/data.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "http-content", string(b))
+
+	modTime, err := ModTime(context.Background(), ts.URL+"/data.txt")
+	assert.NoError(t, err)
+	assert.True(t, lastModified.Equal(modTime), "got mod time: %v, want: %v", modTime, lastModified)
+
+	_, err = ReadFile(context.Background(), ts.URL+"/missing.txt")
+	assert.Error(t, err)
+}
+
 func TestReadWithCache(t *testing.T) {
 	f, err := os.CreateTemp("", "")
 	if err != nil {
@@ -0,0 +1,119 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// FailureDetailMetricName is the name of the metric that counts validation
+// failures by validator, reason and value labels.
+const FailureDetailMetricName = "validation_failure_detail"
+
+// Captured values are bounded to keep the label cardinality in check: only
+// the first maxValuesPerReason distinct values are recorded for each
+// validator and reason, further values are recorded as otherValue. Values
+// are also truncated to maxValueLen bytes.
+const (
+	maxValuesPerReason = 10
+	maxValueLen        = 64
+	otherValue         = "other"
+)
+
+type detailKey struct {
+	validator, reason, value string
+}
+
+// FailureDetails counts the validation failures by validator, reason and
+// captured value, for exporting them as labeled metrics.
+type FailureDetails struct {
+	mu     sync.Mutex
+	counts map[detailKey]int64
+	// Number of distinct values, per validator and reason.
+	numValues map[[2]string]int
+}
+
+// NewFailureDetails returns a new FailureDetails.
+func NewFailureDetails() *FailureDetails {
+	return &FailureDetails{
+		counts:    make(map[detailKey]int64),
+		numValues: make(map[[2]string]int),
+	}
+}
+
+func (fd *FailureDetails) record(validator string, d *FailureDetail) {
+	if fd == nil {
+		return
+	}
+	if d == nil {
+		d = &FailureDetail{Reason: ReasonFailed}
+	}
+
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+
+	value := d.Value
+	if len(value) > maxValueLen {
+		value = value[:maxValueLen]
+	}
+	key := detailKey{validator, d.Reason, value}
+
+	if _, ok := fd.counts[key]; !ok {
+		reasonKey := [2]string{validator, d.Reason}
+		if fd.numValues[reasonKey] >= maxValuesPerReason {
+			key.value = otherValue
+		} else {
+			fd.numValues[reasonKey]++
+		}
+	}
+	fd.counts[key]++
+}
+
+// EventMetrics returns one EventMetrics for each recorded combination of
+// validator, reason and value, with the failure count as the
+// validation_failure_detail metric. EventMetrics are returned in a stable
+// order.
+func (fd *FailureDetails) EventMetrics(ts time.Time) []*metrics.EventMetrics {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+
+	keys := make([]detailKey, 0, len(fd.counts))
+	for k := range fd.counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].validator != keys[j].validator {
+			return keys[i].validator < keys[j].validator
+		}
+		if keys[i].reason != keys[j].reason {
+			return keys[i].reason < keys[j].reason
+		}
+		return keys[i].value < keys[j].value
+	})
+
+	var ems []*metrics.EventMetrics
+	for _, k := range keys {
+		ems = append(ems, metrics.NewEventMetrics(ts).
+			AddMetric(FailureDetailMetricName, metrics.NewInt(fd.counts[k])).
+			AddLabel("validator", k.validator).
+			AddLabel("reason", k.reason).
+			AddLabel("value", k.value))
+	}
+	return ems
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunValidatorsWithDetails(t *testing.T) {
+	vs := append([]*Validator{
+		{
+			Name: "test-v3",
+			ValidateDetail: func(input *Input) (bool, *FailureDetail, error) {
+				return false, &FailureDetail{Reason: "status_code", Value: "404"}, nil
+			},
+		},
+	}, testValidators...)
+
+	vfMap := ValidationFailureMap(vs)
+	details := NewFailureDetails()
+	for i := 0; i < 2; i++ {
+		failures := RunValidatorsWithDetails(vs, &Input{}, vfMap, details, nil)
+		assert.Equal(t, []string{"test-v3", "test-v2"}, failures)
+	}
+
+	var got [][4]string
+	for _, em := range details.EventMetrics(time.Now()) {
+		got = append(got, [4]string{em.Label("validator"), em.Label("reason"), em.Label("value"), em.Metric(FailureDetailMetricName).String()})
+	}
+	assert.Equal(t, [][4]string{
+		{"test-v2", ReasonFailed, "", "2"},
+		{"test-v3", "status_code", "404", "2"},
+	}, got)
+}
+
+func TestFailureDetailsBounds(t *testing.T) {
+	fd := NewFailureDetails()
+	for i := 0; i < maxValuesPerReason+5; i++ {
+		fd.record("v", &FailureDetail{Reason: "r", Value: strconv.Itoa(i)})
+	}
+	fd.record("v", &FailureDetail{Reason: "r", Value: "0"})
+	fd.record("v", &FailureDetail{Reason: "long", Value: strings.Repeat("x", 100)})
+
+	values := make(map[string]string)
+	for _, em := range fd.EventMetrics(time.Now()) {
+		values[em.Label("reason")+"/"+em.Label("value")] = em.Metric(FailureDetailMetricName).String()
+	}
+	assert.Len(t, values, maxValuesPerReason+2)
+	assert.Equal(t, "2", values["r/0"])
+	assert.Equal(t, "5", values["r/"+otherValue])
+	assert.Equal(t, "1", values["long/"+strings.Repeat("x", maxValueLen)])
+
+	// Recording into a nil FailureDetails is a no-op.
+	var nilFD *FailureDetails
+	nilFD.record("v", &FailureDetail{Reason: "r"})
+}
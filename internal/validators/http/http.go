@@ -198,41 +198,60 @@ func (v *Validator) Init(config interface{}, l *logger.Logger) error {
 	return v.initHeaderValidators(c)
 }
 
+// Failure reasons returned by ValidateDetail.
+const (
+	ReasonFailureStatusCode = "failure_status_code"
+	ReasonFailureHeader     = "failure_header"
+	ReasonStatusCode        = "status_code"
+	ReasonMissingHeader     = "missing_header"
+	ReasonBadLastModified   = "bad_last_modified"
+	ReasonStaleLastModified = "stale_last_modified"
+)
+
 // Validate the provided input and return true if input is valid. Validate
 // expects the input to be of the type: *http.Response. Note that it doesn't
 // use the string input, it's part of the function signature to satisfy
 // Validator interface.
 func (v *Validator) Validate(input interface{}, unused []byte) (bool, error) {
+	ok, _, _, err := v.ValidateDetail(input)
+	return ok, err
+}
+
+// ValidateDetail validates the provided input like Validate, and in case of
+// validation failure, also returns the reason (one of the Reason* constants)
+// and the value that caused the failure, e.g. the status code.
+func (v *Validator) ValidateDetail(input interface{}) (ok bool, reason, value string, err error) {
 	res, ok := input.(*nethttp.Response)
 	if !ok {
-		return false, fmt.Errorf("input %v is not of type http.Response", input)
+		return false, "", "", fmt.Errorf("input %v is not of type http.Response", input)
 	}
+	statusCode := strconv.Itoa(res.StatusCode)
 
 	if v.c.GetFailureStatusCodes() != "" {
 		if lookupStatusCode(res.StatusCode, v.failureStatusCodeRanges) {
 			v.l.Warningf("HTTP validation failure: status code %d in failure status codes: %s, status: %s", res.StatusCode, v.c.GetFailureStatusCodes(), res.Status)
-			return false, nil
+			return false, ReasonFailureStatusCode, statusCode, nil
 		}
 	}
 
 	if failureHeader := v.c.GetFailureHeader(); failureHeader != nil {
 		if lookupHTTPHeader(res.Header, failureHeader.GetName(), v.failureHeaderRegexp) {
 			v.l.Warningf("HTTP validation failure: got unexpected header %s", failureHeader.GetName())
-			return false, nil
+			return false, ReasonFailureHeader, res.Header.Get(failureHeader.GetName()), nil
 		}
 	}
 
 	if v.c.GetSuccessStatusCodes() != "" {
 		if !lookupStatusCode(res.StatusCode, v.successStatusCodeRanges) {
 			v.l.Warningf("HTTP validation failure: status code %d not in success status codes: %s, status: %s, ", res.StatusCode, v.c.GetSuccessStatusCodes(), res.Status)
-			return false, nil
+			return false, ReasonStatusCode, statusCode, nil
 		}
 	}
 
 	if successHeader := v.c.GetSuccessHeader(); successHeader != nil {
 		if !lookupHTTPHeader(res.Header, successHeader.GetName(), v.successHeaderRegexp) {
 			v.l.Warningf("HTTP validation failure: header %s not found", successHeader.GetName())
-			return false, nil
+			return false, ReasonMissingHeader, res.Header.Get(successHeader.GetName()), nil
 		}
 	}
 
@@ -240,14 +259,14 @@ func (v *Validator) Validate(input interface{}, unused []byte) (bool, error) {
 		lastModified, err := time.Parse(time.RFC1123, res.Header.Get("Last-Modified"))
 		if err != nil {
 			v.l.Warningf("HTTP validation failure: Error parsing Last-Modified header: %v", err)
-			return false, nil
+			return false, ReasonBadLastModified, "", nil
 		}
 
 		if time.Since(lastModified) > v.maxLastModifiedDiff {
 			v.l.Warningf("HTTP validation failure: Last-Modified header is too old: %v", lastModified)
-			return false, nil
+			return false, ReasonStaleLastModified, "", nil
 		}
 	}
 
-	return true, nil
+	return true, "", "", nil
 }
@@ -407,3 +407,43 @@ func TestValidateLastModifiedHeaderMissing(t *testing.T) {
 		t.Errorf("Error running validate, got: %v, want: %v", ok, false)
 	}
 }
+
+func TestValidateDetail(t *testing.T) {
+	v := &Validator{}
+	err := v.Init(&configpb.Validator{
+		SuccessStatusCodes: proto.String("200-299"),
+		FailureStatusCodes: proto.String("503"),
+		SuccessHeader: &configpb.Validator_Header{
+			Name:       proto.String("X-Success"),
+			ValueRegex: proto.String("^ok$"),
+		},
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Init(): err: %v", err)
+	}
+
+	tests := []struct {
+		code       int
+		header     http.Header
+		wantOK     bool
+		wantReason string
+		wantValue  string
+	}{
+		{code: 200, header: http.Header{"X-Success": {"ok"}}, wantOK: true},
+		{code: 503, wantReason: ReasonFailureStatusCode, wantValue: "503"},
+		{code: 404, wantReason: ReasonStatusCode, wantValue: "404"},
+		{code: 200, header: http.Header{"X-Success": {"bad"}}, wantReason: ReasonMissingHeader, wantValue: "bad"},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d_%v", test.code, test.header), func(t *testing.T) {
+			ok, reason, value, err := v.ValidateDetail(&http.Response{StatusCode: test.code, Header: test.header})
+			if err != nil {
+				t.Fatalf("ValidateDetail(): err: %v", err)
+			}
+			if ok != test.wantOK || reason != test.wantReason || value != test.wantValue {
+				t.Errorf("ValidateDetail()=%v, %q, %q, want: %v, %q, %q", ok, reason, value, test.wantOK, test.wantReason, test.wantValue)
+			}
+		})
+	}
+}
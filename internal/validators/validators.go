@@ -36,6 +36,38 @@ import (
 type Validator struct {
 	Name     string
 	Validate func(input *Input) (bool, error)
+
+	// ValidateDetail, if set, is used instead of Validate. In case of
+	// validation failure, it also returns the failure detail.
+	ValidateDetail func(input *Input) (bool, *FailureDetail, error)
+}
+
+// FailureDetail describes a validation failure. Reason comes from a small,
+// fixed set of reasons for each validator type, and Value is the captured
+// value that caused the failure, e.g. the HTTP status code, if any.
+type FailureDetail struct {
+	Reason string
+	Value  string
+}
+
+// Failure reasons for the validators that have only one way to fail.
+const (
+	ReasonFailed          = "failed"
+	ReasonNoMatch         = "no_match"
+	ReasonJQFalse         = "jq_false"
+	ReasonPatternMismatch = "pattern_mismatch"
+)
+
+// withReason returns a ValidateDetail function that wraps the given validate
+// function, and reports the given reason on failures.
+func withReason(validate func(input *Input) (bool, error), reason string) func(input *Input) (bool, *FailureDetail, error) {
+	return func(input *Input) (bool, *FailureDetail, error) {
+		ok, err := validate(input)
+		if ok || err != nil {
+			return ok, nil, err
+		}
+		return false, &FailureDetail{Reason: reason}, nil
+	}
 }
 
 // Init initializes the validators defined in the config.
@@ -76,6 +108,13 @@ func initValidator(validatorConf *configpb.Validator, l *logger.Logger) (validat
 		validator.Validate = func(input *Input) (bool, error) {
 			return v.Validate(input.Response, input.ResponseBody)
 		}
+		validator.ValidateDetail = func(input *Input) (bool, *FailureDetail, error) {
+			ok, reason, value, err := v.ValidateDetail(input.Response)
+			if ok || err != nil {
+				return ok, nil, err
+			}
+			return false, &FailureDetail{Reason: reason, Value: value}, nil
+		}
 		return
 
 	case *configpb.Validator_IntegrityValidator:
@@ -86,6 +125,7 @@ func initValidator(validatorConf *configpb.Validator, l *logger.Logger) (validat
 		validator.Validate = func(input *Input) (bool, error) {
 			return v.Validate(input.ResponseBody)
 		}
+		validator.ValidateDetail = withReason(validator.Validate, ReasonPatternMismatch)
 		return
 
 	case *configpb.Validator_JsonValidator:
@@ -96,6 +136,7 @@ func initValidator(validatorConf *configpb.Validator, l *logger.Logger) (validat
 		validator.Validate = func(input *Input) (bool, error) {
 			return v.Validate(input.ResponseBody)
 		}
+		validator.ValidateDetail = withReason(validator.Validate, ReasonJQFalse)
 		return
 
 	case *configpb.Validator_Regex:
@@ -106,6 +147,7 @@ func initValidator(validatorConf *configpb.Validator, l *logger.Logger) (validat
 		validator.Validate = func(input *Input) (bool, error) {
 			return v.Validate(input.ResponseBody)
 		}
+		validator.ValidateDetail = withReason(validator.Validate, ReasonNoMatch)
 		return
 	default:
 		err = fmt.Errorf("unknown validator type: %v", validatorConf.Type)
@@ -123,16 +165,28 @@ type Input struct {
 // responseBody, updates the given validationFailure map and returns the list
 // of failures.
 func RunValidators(vs []*Validator, input *Input, validationFailure *metrics.Map[int64], l *logger.Logger) []string {
+	return RunValidatorsWithDetails(vs, input, validationFailure, nil, l)
+}
+
+// RunValidatorsWithDetails is like RunValidators, but it also records the
+// failure details in the given FailureDetails, if it's not nil.
+func RunValidatorsWithDetails(vs []*Validator, input *Input, validationFailure *metrics.Map[int64], details *FailureDetails, l *logger.Logger) []string {
 	var failures []string
 
 	for _, v := range vs {
-		success, err := v.Validate(input)
+		validateDetail := v.ValidateDetail
+		if validateDetail == nil {
+			validateDetail = withReason(v.Validate, ReasonFailed)
+		}
+
+		success, detail, err := validateDetail(input)
 		if err != nil {
 			l.Error("Error while running the validator ", v.Name, ": ", err.Error())
 			continue
 		}
 		if !success {
 			validationFailure.IncKey(v.Name)
+			details.record(v.Name, detail)
 			failures = append(failures, v.Name)
 		}
 	}
@@ -332,6 +332,71 @@ func (d *Distribution) StackdriverTypedValue() *monitoring.TypedValue {
 	}
 }
 
+// MergeBuckets returns a new distribution with every factor adjacent buckets
+// of the receiver merged into one bucket. The underflow bucket (the one with
+// -Inf lower bound) is never merged with other buckets. Last merged bucket
+// may contain fewer buckets if number of buckets is not a multiple of the
+// factor. If factor is less than 2, it returns a copy of the receiver.
+func (d *Distribution) MergeBuckets(factor int) *Distribution {
+	if factor < 2 {
+		return d.CloneDist()
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var lowerBounds []float64
+	for i := 1; i < len(d.lowerBounds); i += factor {
+		lowerBounds = append(lowerBounds, d.lowerBounds[i])
+	}
+
+	newD := NewDistribution(lowerBounds)
+	newD.sum = d.sum
+	newD.count = d.count
+	newD.bucketCounts[0] = d.bucketCounts[0]
+	for i := 1; i < len(d.bucketCounts); i++ {
+		newD.bucketCounts[1+(i-1)/factor] += d.bucketCounts[i]
+	}
+	return newD
+}
+
+// Percentile returns the p-th percentile (0 < p <= 100) of the samples,
+// estimated from the bucket counts. Since the samples' exact values are not
+// known, the percentile is approximated by interpolating linearly within the
+// bucket it falls in. For the underflow bucket, its upper bound is returned,
+// and for the last bucket, its lower bound. It returns NaN if there are no
+// samples.
+func (d *Distribution) Percentile(p float64) float64 {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var total int64
+	for _, count := range d.bucketCounts {
+		total += count
+	}
+	if total == 0 {
+		return math.NaN()
+	}
+
+	rank := p / 100 * float64(total)
+	var cumCount int64
+	for i, count := range d.bucketCounts {
+		if count == 0 || float64(cumCount+count) < rank {
+			cumCount += count
+			continue
+		}
+		if i == len(d.bucketCounts)-1 {
+			return d.lowerBounds[i]
+		}
+		if i == 0 {
+			return d.lowerBounds[1]
+		}
+		lower, upper := d.lowerBounds[i], d.lowerBounds[i+1]
+		return lower + (upper-lower)*(rank-float64(cumCount))/float64(count)
+	}
+	return d.lowerBounds[len(d.lowerBounds)-1]
+}
+
 // Clone returns a copy of the receiver distribution.
 func (d *Distribution) CloneDist() *Distribution {
 	d.mu.RLock()
@@ -17,6 +17,7 @@ package metrics
 import (
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -218,6 +219,118 @@ func TestDistString(t *testing.T) {
 	}
 }
 
+func TestDistMergeBuckets(t *testing.T) {
+	d := NewDistribution([]float64{1, 2, 4, 8, 16, 32, 64})
+	for _, s := range []float64{0.5, 1.5, 3, 5, 6, 10, 20, 40, 100, 200} {
+		d.AddSample(s)
+	}
+
+	tests := []struct {
+		factor int
+		want   string
+	}{
+		{
+			factor: 0,
+			want:   "dist:sum:386|count:10|lb:-Inf,1,2,4,8,16,32,64|bc:1,1,1,2,1,1,1,2",
+		},
+		{
+			factor: 1,
+			want:   "dist:sum:386|count:10|lb:-Inf,1,2,4,8,16,32,64|bc:1,1,1,2,1,1,1,2",
+		},
+		{
+			factor: 2,
+			want:   "dist:sum:386|count:10|lb:-Inf,1,4,16,64|bc:1,2,3,2,2",
+		},
+		{
+			factor: 3,
+			want:   "dist:sum:386|count:10|lb:-Inf,1,8,64|bc:1,4,3,2",
+		},
+		{
+			factor: 7,
+			want:   "dist:sum:386|count:10|lb:-Inf,1|bc:1,9",
+		},
+		{
+			factor: 10,
+			want:   "dist:sum:386|count:10|lb:-Inf,1|bc:1,9",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(strconv.Itoa(test.factor), func(t *testing.T) {
+			assert.Equal(t, test.want, d.MergeBuckets(test.factor).String())
+		})
+	}
+
+	// Verify that receiver is not modified.
+	assert.Equal(t, "dist:sum:386|count:10|lb:-Inf,1,2,4,8,16,32,64|bc:1,1,1,2,1,1,1,2", d.String())
+}
+
+func TestDistPercentile(t *testing.T) {
+	tests := []struct {
+		name        string
+		lowerBounds []float64
+		samples     []float64
+		percentiles map[float64]float64
+	}{
+		{
+			// 10 samples in each of the buckets [0,10), [10,20), [20,40).
+			name:        "interpolated",
+			lowerBounds: []float64{0, 10, 20, 40},
+			samples:     repeatSamples(map[float64]int{5: 10, 15: 10, 30: 10}),
+			percentiles: map[float64]float64{
+				10:  3,
+				50:  15,
+				90:  34,
+				100: 40,
+			},
+		},
+		{
+			name:        "underflow_and_overflow",
+			lowerBounds: []float64{0, 10},
+			samples:     repeatSamples(map[float64]int{-5: 1, 5: 8, 50: 1}),
+			percentiles: map[float64]float64{
+				5:  0,
+				50: 5,
+				99: 10,
+			},
+		},
+		{
+			name:        "single_bucket",
+			lowerBounds: []float64{1, 2, 4, 8},
+			samples:     repeatSamples(map[float64]int{3: 4}),
+			percentiles: map[float64]float64{
+				25: 2.5,
+				50: 3,
+				99: 3.98,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDistribution(test.lowerBounds)
+			for _, s := range test.samples {
+				d.AddSample(s)
+			}
+			for p, want := range test.percentiles {
+				assert.InDelta(t, want, d.Percentile(p), 1e-9, "p%v", p)
+			}
+		})
+	}
+
+	assert.True(t, math.IsNaN(NewDistribution([]float64{0, 10}).Percentile(50)), "no samples")
+}
+
+func repeatSamples(counts map[float64]int) []float64 {
+	var samples []float64
+	for s, n := range counts {
+		for i := 0; i < n; i++ {
+			samples = append(samples, s)
+		}
+	}
+	return samples
+}
+
 func TestVerify(t *testing.T) {
 	d := &Distribution{}
 	if d.Verify() == nil {
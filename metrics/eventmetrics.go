@@ -52,6 +52,13 @@ type EventMetrics struct {
 	labelsKeys []string
 
 	LatencyUnit time.Duration
+
+	// MetricUnits optionally specifies the units of the metrics, keyed by
+	// metric name, e.g. {"resp_bytes": "By"}. Units should be UCUM codes, as
+	// used by OpenTelemetry. Surfacers that support units infer them for the
+	// metrics without one. Like LatencyUnit, it should not be modified after
+	// the EventMetrics is shared.
+	MetricUnits map[string]string
 }
 
 // NewEventMetrics return a new EventMetrics object with internals maps initialized.
@@ -143,10 +150,17 @@ func (em *EventMetrics) Clone() *EventMetrics {
 	em.mu.RLock()
 	defer em.mu.RUnlock()
 	newEM := &EventMetrics{
-		Timestamp: em.Timestamp,
-		Kind:      em.Kind,
-		metrics:   make(map[string]Value),
-		labels:    make(map[string]string),
+		Timestamp:   em.Timestamp,
+		Kind:        em.Kind,
+		LatencyUnit: em.LatencyUnit,
+		metrics:     make(map[string]Value),
+		labels:      make(map[string]string),
+	}
+	if em.MetricUnits != nil {
+		newEM.MetricUnits = make(map[string]string, len(em.MetricUnits))
+		for k, v := range em.MetricUnits {
+			newEM.MetricUnits[k] = v
+		}
 	}
 	for _, lk := range em.labelsKeys {
 		newEM.labels[lk] = em.labels[lk]
@@ -195,11 +195,28 @@ func (pr *Prober) Init(ctx context.Context, cfg *configpb.ProberConfig, l *logge
 		return err
 	}
 
+	if err := surfacers.SetFilterBundles(pr.c.GetSurfacerFilterBundle()); err != nil {
+		return err
+	}
+
 	pr.Surfacers, err = surfacers.Init(ctx, pr.c.GetSurfacer())
 	if err != nil {
 		return err
 	}
 
+	if surfacers.SchemaManifestsEnabled(pr.Surfacers) {
+		var schemaEMs []*metrics.EventMetrics
+		for _, pi := range pr.Probes {
+			if pi == nil {
+				continue
+			}
+			schemaEMs = append(schemaEMs, pi.SchemaMetrics()...)
+		}
+		if err := surfacers.WriteSchemaManifests(pr.Surfacers, schemaEMs); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -225,6 +242,9 @@ func (pr *Prober) Start(ctx context.Context) {
 	// Start a goroutine to export system variables
 	go sysvars.Start(ctx, pr.dataChan, time.Millisecond*time.Duration(pr.c.GetSysvarsIntervalMsec()), pr.c.GetSysvarsEnvVar())
 
+	// Export surfacers' filter stats along with the system variables.
+	surfacers.StartFilterStats(ctx, pr.Surfacers, pr.dataChan)
+
 	// Start servers, each in its own goroutine
 	for _, s := range pr.Servers {
 		go s.Start(ctx, pr.dataChan)
@@ -15,13 +15,17 @@
 package prober
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	configpb "github.com/cloudprober/cloudprober/config/proto"
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/probes"
 	probespb "github.com/cloudprober/cloudprober/probes/proto"
 	"github.com/stretchr/testify/assert"
@@ -146,3 +150,42 @@ probe: {
 		})
 	}
 }
+
+func TestInitSchemaManifest(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+	manifestFile := filepath.Join(t.TempDir(), "schema.json")
+
+	cfg := &configpb.ProberConfig{}
+	assert.NoError(t, prototext.Unmarshal([]byte(fmt.Sprintf(`
+	probe {
+	  name: "dns-probe"
+	  type: DNS
+	  targets { host_names: "localhost" }
+	}
+	probe {
+	  name: "elsewhere"
+	  type: HTTP
+	  run_on: "^no-such-host$"
+	  targets { host_names: "localhost" }
+	}
+	surfacer {
+	  type: FILE
+	  schema_manifest_file: %q
+	}`, manifestFile)), cfg))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pr := &Prober{}
+	if err := pr.Init(ctx, cfg, logger.New()); err != nil {
+		t.Fatalf("Init() error: %v", err)
+	}
+	assert.NotContains(t, pr.Probes, "elsewhere")
+
+	b, err := os.ReadFile(manifestFile)
+	if err != nil {
+		t.Fatalf("error reading schema manifest: %v", err)
+	}
+	assert.Contains(t, string(b), `"timeouts"`)
+	assert.NotContains(t, string(b), `"resp-code"`)
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoscale implements a probe type that measures how long it takes
+// for a Kubernetes workload to scale up after a scaling event.
+package autoscale
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/autoscale/proto"
+	"github.com/cloudprober/cloudprober/probes/common/kubeclient"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+// restoreTimeout is the timeout for restoring the original replicas. Restore
+// uses its own timeout, as probe's context has usually expired by then.
+const restoreTimeout = 30 * time.Second
+
+// Probe holds aggregate information about all probe runs.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	client     workloadClient
+	loadClient *http.Client
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	apiErrors      int64
+	scaleTimeouts  int64
+	alreadyScaled  int64
+	restoreErrors  int64
+
+	// Ready replicas at the end of the last run, valid only if
+	// replicasKnown is true.
+	readyReplicas int32
+	replicasKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("api_errors", metrics.NewInt(result.apiErrors)).
+		AddMetric("scale_timeouts", metrics.NewInt(result.scaleTimeouts)).
+		AddMetric("already_scaled", metrics.NewInt(result.alreadyScaled)).
+		AddMetric("restore_errors", metrics.NewInt(result.restoreErrors)).
+		AddLabel("ptype", "autoscale")
+}
+
+// GaugeMetrics returns the ready replicas at the end of the last run.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.replicasKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("ready_replicas", metrics.NewInt(int64(result.readyReplicas))).
+		AddLabel("ptype", "autoscale")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not autoscale probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetName() == "" {
+		return fmt.Errorf("workload name is required")
+	}
+	if p.c.GetTargetReplicas() <= 0 {
+		return fmt.Errorf("target_replicas should be positive, got %d", p.c.GetTargetReplicas())
+	}
+	if p.c.GetPollIntervalMsec() <= 0 {
+		return fmt.Errorf("poll_interval_msec should be positive, got %d", p.c.GetPollIntervalMsec())
+	}
+
+	if load := p.c.GetLoad(); load != nil {
+		if load.GetConcurrency() <= 0 {
+			return fmt.Errorf("load: concurrency should be positive, got %d", load.GetConcurrency())
+		}
+		p.loadClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				MaxIdleConnsPerHost: int(load.GetConcurrency()),
+			},
+		}
+	}
+
+	if p.client == nil {
+		kc, err := kubeclient.New(p.c.GetApiServerAddress(), p.c.GetTlsConfig(), p.l)
+		if err != nil {
+			return err
+		}
+		p.client = &apiWorkloadClient{c: kc}
+	}
+
+	return nil
+}
+
+// generateLoad sends requests to the load URL, using the configured number
+// of concurrent workers, until the context is canceled.
+func (p *Probe) generateLoad(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < int(p.c.GetLoad().GetConcurrency()); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.c.GetLoad().GetUrl(), nil)
+				if err != nil {
+					p.l.Errorf("Error creating load request: %v", err)
+					return
+				}
+				resp, err := p.loadClient.Do(req)
+				if err != nil {
+					if ctx.Err() == nil {
+						p.l.Debugf("Load request failed: %v", err)
+					}
+					continue
+				}
+				io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// waitForReplicas waits for the workload to have target_replicas ready
+// replicas. It returns the last seen ready replicas, and whether the target
+// was reached before the context was canceled.
+func (p *Probe) waitForReplicas(ctx context.Context) (int32, bool) {
+	ticker := time.NewTicker(time.Duration(p.c.GetPollIntervalMsec()) * time.Millisecond)
+	defer ticker.Stop()
+
+	var ready int32
+	for {
+		w, err := p.client.getWorkload(ctx, p.c.GetKind(), p.c.GetNamespace(), p.c.GetName())
+		if err != nil {
+			// API errors may be transient, keep trying until timeout.
+			if ctx.Err() == nil {
+				p.l.Warningf("Error getting workload %s/%s: %v", p.c.GetNamespace(), p.c.GetName(), err)
+			}
+		} else {
+			ready = w.Status.ReadyReplicas
+			if ready >= p.c.GetTargetReplicas() {
+				return ready, true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ready, false
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Probe) restore(replicas int32, result *probeResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), restoreTimeout)
+	defer cancel()
+
+	if err := p.client.setReplicas(ctx, p.c.GetKind(), p.c.GetNamespace(), p.c.GetName(), replicas); err != nil {
+		p.l.Errorf("Error restoring replicas of %s/%s to %d: %v", p.c.GetNamespace(), p.c.GetName(), replicas, err)
+		result.restoreErrors++
+	}
+}
+
+func (p *Probe) runProbe(ctx context.Context, _ endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	namespace, name := p.c.GetNamespace(), p.c.GetName()
+
+	w, err := p.client.getWorkload(ctx, p.c.GetKind(), namespace, name)
+	if err != nil {
+		p.l.Warningf("Error getting workload %s/%s: %v", namespace, name, err)
+		result.apiErrors++
+		return
+	}
+	result.readyReplicas, result.replicasKnown = w.Status.ReadyReplicas, true
+
+	if w.Status.ReadyReplicas >= p.c.GetTargetReplicas() {
+		p.l.Warningf("Workload %s/%s already has %d ready replicas (target: %d), skipping the run", namespace, name, w.Status.ReadyReplicas, p.c.GetTargetReplicas())
+		result.alreadyScaled++
+		return
+	}
+
+	start := time.Now()
+	if p.c.GetLoad() != nil {
+		loadCtx, stopLoad := context.WithCancel(ctx)
+		defer stopLoad()
+		go p.generateLoad(loadCtx)
+	} else {
+		if err := p.client.setReplicas(ctx, p.c.GetKind(), namespace, name, p.c.GetTargetReplicas()); err != nil {
+			p.l.Warningf("Error scaling workload %s/%s to %d replicas: %v", namespace, name, p.c.GetTargetReplicas(), err)
+			result.apiErrors++
+			return
+		}
+		defer p.restore(w.Spec.Replicas, result)
+	}
+
+	ready, ok := p.waitForReplicas(ctx)
+	result.readyReplicas = ready
+	if !ok {
+		p.l.Warningf("Workload %s/%s didn't scale to %d ready replicas before timeout, ready replicas: %d", namespace, name, p.c.GetTargetReplicas(), ready)
+		result.scaleTimeouts++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
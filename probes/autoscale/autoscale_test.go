@@ -0,0 +1,240 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/autoscale/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeWorkloadClient simulates a workload that is scaled up one replica per
+// getWorkload call, until it has the desired replicas. If underLoad is set,
+// it simulates an autoscaler too: desired replicas go up to loadTarget once
+// there is load.
+type fakeWorkloadClient struct {
+	mu         sync.Mutex
+	desired    int32
+	ready      int32
+	setCalls   []int32
+	getErr     error
+	setErr     error
+	underLoad  func() bool
+	loadTarget int32
+}
+
+func (fc *fakeWorkloadClient) getWorkload(_ context.Context, kind configpb.ProbeConf_Kind, namespace, name string) (*workload, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.getErr != nil {
+		return nil, fc.getErr
+	}
+
+	if fc.underLoad != nil && fc.underLoad() {
+		fc.desired = fc.loadTarget
+	}
+	w := &workload{}
+	w.Spec.Replicas = fc.desired
+	w.Status.Replicas = fc.desired
+	w.Status.ReadyReplicas = fc.ready
+	if fc.ready < fc.desired {
+		fc.ready++
+	}
+	return w, nil
+}
+
+func (fc *fakeWorkloadClient) setReplicas(_ context.Context, kind configpb.ProbeConf_Kind, namespace, name string, replicas int32) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.setErr != nil {
+		return fc.setErr
+	}
+	fc.setCalls = append(fc.setCalls, replicas)
+	fc.desired = replicas
+	if fc.ready > replicas {
+		fc.ready = replicas
+	}
+	return nil
+}
+
+func testProbe(t *testing.T, fc *fakeWorkloadClient, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	c.Name = proto.String("web")
+	if c.TargetReplicas == nil {
+		c.TargetReplicas = proto.Int32(4)
+	}
+	c.PollIntervalMsec = proto.Int32(10)
+
+	opts := options.DefaultOptions()
+	opts.Timeout = 500 * time.Millisecond
+	opts.ProbeConf = c
+
+	p := &Probe{client: fc}
+	require.NoError(t, p.Init("test-autoscale", opts))
+	return p
+}
+
+func TestRunProbeScaleAPI(t *testing.T) {
+	tests := []struct {
+		name          string
+		fc            *fakeWorkloadClient
+		target        int32
+		wantSuccess   int64
+		wantTimeouts  int64
+		wantAPIErrors int64
+		wantAlready   int64
+		wantSetCalls  []int32
+		wantReady     int32
+	}{
+		{
+			name:         "scale_up",
+			fc:           &fakeWorkloadClient{desired: 1, ready: 1},
+			wantSuccess:  1,
+			wantSetCalls: []int32{4, 1},
+			wantReady:    4,
+		},
+		{
+			name:         "scale_timeout",
+			fc:           &fakeWorkloadClient{desired: 1, ready: 1},
+			target:       1000,
+			wantTimeouts: 1,
+			wantSetCalls: []int32{1000, 1},
+		},
+		{
+			name:        "already_scaled",
+			fc:          &fakeWorkloadClient{desired: 5, ready: 5},
+			wantAlready: 1,
+			wantReady:   5,
+		},
+		{
+			name:          "get_error",
+			fc:            &fakeWorkloadClient{getErr: errors.New("forbidden")},
+			wantAPIErrors: 1,
+		},
+		{
+			name:          "scale_error",
+			fc:            &fakeWorkloadClient{desired: 1, ready: 1, setErr: errors.New("conflict")},
+			wantAPIErrors: 1,
+			wantReady:     1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &configpb.ProbeConf{}
+			if test.target != 0 {
+				c.TargetReplicas = proto.Int32(test.target)
+			}
+			p := testProbe(t, test.fc, c)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantTimeouts, result.scaleTimeouts, "scale_timeouts")
+			assert.Equal(t, test.wantAPIErrors, result.apiErrors, "api_errors")
+			assert.Equal(t, test.wantAlready, result.alreadyScaled, "already_scaled")
+			assert.Equal(t, int64(0), result.restoreErrors, "restore_errors")
+			assert.Equal(t, test.wantSetCalls, test.fc.setCalls, "setReplicas calls")
+
+			em := result.GaugeMetrics(time.Now(), p.opts)
+			if test.name == "get_error" {
+				assert.Nil(t, em, "gauge metrics")
+			}
+			if test.wantReady != 0 {
+				require.NotNil(t, em, "gauge metrics")
+				assert.Equal(t, int64(test.wantReady), em.Metric("ready_replicas").(metrics.NumValue).Int64())
+			}
+
+			if test.wantSuccess == 1 {
+				latency := time.Duration(result.latency.(*metrics.Float).Float64()) * p.opts.LatencyUnit
+				// One replica per poll, from 1 to 4.
+				assert.GreaterOrEqual(t, latency, 30*time.Millisecond, "scale latency")
+			}
+		})
+	}
+}
+
+func TestRunProbeLoad(t *testing.T) {
+	var requests atomic.Int64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer ts.Close()
+
+	// Autoscaler scales the workload to 6 replicas once it sees load.
+	fc := &fakeWorkloadClient{
+		desired:    2,
+		ready:      2,
+		underLoad:  func() bool { return requests.Load() > 0 },
+		loadTarget: 6,
+	}
+	p := testProbe(t, fc, &configpb.ProbeConf{
+		TargetReplicas: proto.Int32(6),
+		Load: &configpb.LoadGenerator{
+			Url:         proto.String(ts.URL),
+			Concurrency: proto.Int32(2),
+		},
+	})
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), endpoint.Endpoint{}, result)
+
+	assert.Equal(t, int64(1), result.success, "success")
+	assert.Equal(t, int32(6), result.readyReplicas, "ready replicas")
+	assert.Greater(t, requests.Load(), int64(0), "load requests")
+	assert.Empty(t, fc.setCalls, "workload should not be scaled through the API")
+
+	// Load should stop with the run.
+	time.Sleep(50 * time.Millisecond)
+	n := requests.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, n, requests.Load(), "load requests after the run")
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"no_name":         {TargetReplicas: proto.Int32(2)},
+		"zero_target":     {Name: proto.String("web"), TargetReplicas: proto.Int32(0)},
+		"bad_poll":        {Name: proto.String("web"), TargetReplicas: proto.Int32(2), PollIntervalMsec: proto.Int32(0)},
+		"bad_concurrency": {Name: proto.String("web"), TargetReplicas: proto.Int32(2), Load: &configpb.LoadGenerator{Url: proto.String("http://web"), Concurrency: proto.Int32(0)}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{client: &fakeWorkloadClient{}}).Init("test-autoscale", opts))
+		})
+	}
+}
+
+func TestWorkloadPath(t *testing.T) {
+	assert.Equal(t, "/apis/apps/v1/namespaces/prod/deployments/web", workloadPath(configpb.ProbeConf_DEPLOYMENT, "prod", "web"))
+	assert.Equal(t, "/apis/apps/v1/namespaces/prod/statefulsets/db", workloadPath(configpb.ProbeConf_STATEFULSET, "prod", "db"))
+}
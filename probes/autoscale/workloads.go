@@ -0,0 +1,97 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoscale
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	configpb "github.com/cloudprober/cloudprober/probes/autoscale/proto"
+	"github.com/cloudprober/cloudprober/probes/common/kubeclient"
+)
+
+// workload is a minimal subset of the Kubernetes Deployment and StatefulSet
+// APIs.
+type workload struct {
+	Spec struct {
+		Replicas int32 `json:"replicas"`
+	} `json:"spec"`
+	Status struct {
+		Replicas      int32 `json:"replicas"`
+		ReadyReplicas int32 `json:"readyReplicas"`
+	} `json:"status"`
+}
+
+// workloadClient is the interface to read and scale the workloads. It's
+// implemented by apiWorkloadClient for the real API server, and by a fake
+// for tests.
+type workloadClient interface {
+	getWorkload(ctx context.Context, kind configpb.ProbeConf_Kind, namespace, name string) (*workload, error)
+	setReplicas(ctx context.Context, kind configpb.ProbeConf_Kind, namespace, name string, replicas int32) error
+}
+
+type apiWorkloadClient struct {
+	c *kubeclient.Client
+}
+
+func workloadPath(kind configpb.ProbeConf_Kind, namespace, name string) string {
+	resource := "deployments"
+	if kind == configpb.ProbeConf_STATEFULSET {
+		resource = "statefulsets"
+	}
+	return fmt.Sprintf("/apis/apps/v1/namespaces/%s/%s/%s", namespace, resource, name)
+}
+
+func (ac *apiWorkloadClient) getWorkload(ctx context.Context, kind configpb.ProbeConf_Kind, namespace, name string) (*workload, error) {
+	b, err := ac.c.Do(ctx, http.MethodGet, workloadPath(kind, namespace, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	w := &workload{}
+	if err := json.Unmarshal(b, w); err != nil {
+		return nil, fmt.Errorf("error parsing workload %s/%s: %v", namespace, name, err)
+	}
+	return w, nil
+}
+
+// setReplicas updates the workload's scale subresource. Scale object is read
+// first and written back with the new replicas, so that the update fails if
+// the object changes in between.
+func (ac *apiWorkloadClient) setReplicas(ctx context.Context, kind configpb.ProbeConf_Kind, namespace, name string, replicas int32) error {
+	path := workloadPath(kind, namespace, name) + "/scale"
+
+	b, err := ac.c.Do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	var scale map[string]interface{}
+	if err := json.Unmarshal(b, &scale); err != nil {
+		return fmt.Errorf("error parsing scale of %s/%s: %v", namespace, name, err)
+	}
+	spec, _ := scale["spec"].(map[string]interface{})
+	if spec == nil {
+		spec = make(map[string]interface{})
+		scale["spec"] = spec
+	}
+	spec["replicas"] = replicas
+
+	if b, err = json.Marshal(scale); err != nil {
+		return err
+	}
+	_, err = ac.c.Do(ctx, http.MethodPut, path, b)
+	return err
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup implements a probe type that verifies that a backup job
+// completed successfully within the expected window.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/file"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/backup/proto"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+const targetPlaceholder = "@target@"
+
+var defaultSuccessStatus = []string{"SUCCEEDED"}
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	jqQuery       *gojq.Query
+	successStatus map[string]bool
+	maxAge        time.Duration
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	apiErrors      int64
+	backupMissing  int64
+
+	// Last successful backup's age, valid only if ageKnown is true.
+	ageSec   float64
+	ageKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("api_errors", metrics.NewInt(result.apiErrors)).
+		AddMetric("backup_missing", metrics.NewInt(result.backupMissing)).
+		AddLabel("ptype", "backup")
+}
+
+// GaugeMetrics returns the last successful backup's age.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.ageKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("last_backup_age_seconds", metrics.NewFloat(result.ageSec)).
+		AddLabel("ptype", "backup")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not backup probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetStatusUrl() == "" {
+		return errors.New("status_url is required")
+	}
+	if p.c.GetMaxAgeSec() <= 0 {
+		return fmt.Errorf("max_age_sec should be positive, got %d", p.c.GetMaxAgeSec())
+	}
+	p.maxAge = time.Duration(p.c.GetMaxAgeSec()) * time.Second
+
+	q, err := gojq.Parse(p.c.GetBackupsJqFilter())
+	if err != nil {
+		return fmt.Errorf("error parsing backups_jq_filter (%s): %v", p.c.GetBackupsJqFilter(), err)
+	}
+	p.jqQuery = q
+
+	successStatus := p.c.GetSuccessStatus()
+	if len(successStatus) == 0 {
+		successStatus = defaultSuccessStatus
+	}
+	p.successStatus = make(map[string]bool)
+	for _, s := range successStatus {
+		p.successStatus[strings.ToUpper(s)] = true
+	}
+
+	if p.now == nil {
+		p.now = time.Now
+	}
+
+	return nil
+}
+
+// parseTimestamp parses the timestamp as per the configured format.
+func (p *Probe) parseTimestamp(v interface{}) (time.Time, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		s = strconv.Itoa(v)
+	default:
+		return time.Time{}, fmt.Errorf("unexpected timestamp type %T: %v", v, v)
+	}
+
+	switch format := p.c.GetTimestampFormat(); format {
+	case "rfc3339":
+		return time.Parse(time.RFC3339Nano, s)
+	case "unix", "unix_ms":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s timestamp: %s", format, s)
+		}
+		if format == "unix_ms" {
+			f /= 1000
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	default:
+		return time.Parse(format, s)
+	}
+}
+
+// lastSuccess returns the completion time of the latest successful backup
+// in the status. It returns a zero time if there is no successful backup.
+// Records that are not successful are skipped without looking at their
+// completion time, as it's usually not set for the failed or running backups.
+func (p *Probe) lastSuccess(status []byte) (time.Time, error) {
+	var input interface{}
+	if err := json.Unmarshal(status, &input); err != nil {
+		return time.Time{}, fmt.Errorf("backup status is not a valid JSON: %v", err)
+	}
+
+	var last time.Time
+	iter := p.jqQuery.Run(input)
+	for {
+		item, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := item.(error); ok {
+			return time.Time{}, err
+		}
+
+		record, ok := item.(map[string]interface{})
+		if !ok {
+			return time.Time{}, fmt.Errorf("backup record is not an object: %v", item)
+		}
+		status, _ := record[p.c.GetStatusField()].(string)
+		if !p.successStatus[strings.ToUpper(status)] {
+			continue
+		}
+
+		ts, err := p.parseTimestamp(record[p.c.GetCompletionTimeField()])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("error parsing %s of the backup record: %v", p.c.GetCompletionTimeField(), err)
+		}
+		if ts.After(last) {
+			last = ts
+		}
+	}
+	return last, nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.ageKnown = false
+
+	statusURL := strings.ReplaceAll(p.c.GetStatusUrl(), targetPlaceholder, target.Name)
+
+	start := time.Now()
+	status, err := file.ReadFile(ctx, statusURL)
+	latency := time.Since(start)
+	if err != nil {
+		p.l.Warningf("Target: %s, error fetching backup status %s: %v", target.Name, statusURL, err)
+		result.apiErrors++
+		return
+	}
+
+	last, err := p.lastSuccess(status)
+	if err != nil {
+		p.l.Warningf("Target: %s, error decoding backup status %s: %v", target.Name, statusURL, err)
+		result.apiErrors++
+		return
+	}
+
+	if last.IsZero() {
+		p.l.Warningf("Target: %s, no successful backup found in %s", target.Name, statusURL)
+		result.backupMissing++
+		return
+	}
+
+	age := p.now().Sub(last)
+	result.ageSec, result.ageKnown = age.Seconds(), true
+
+	if age > p.maxAge {
+		p.l.Warningf("Target: %s, no successful backup in the last %s, latest successful backup: %s, age: %s", target.Name, p.maxAge, last.Format(time.RFC3339), age.Round(time.Second))
+		result.backupMissing++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
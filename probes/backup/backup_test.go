@@ -0,0 +1,182 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/backup/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var testNow = time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+func testProbe(t *testing.T, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	if c.MaxAgeSec == nil {
+		c.MaxAgeSec = proto.Int32(86400)
+	}
+	opts := options.DefaultOptions()
+	opts.ProbeConf = c
+
+	p := &Probe{now: func() time.Time { return testNow }}
+	require.NoError(t, p.Init("test-backup", opts))
+	return p
+}
+
+// startStubStatusAPI starts a backup status API that serves the given status
+// for each job at /jobs/<job>/runs.
+func startStubStatusAPI(t *testing.T, jobs map[string]string) string {
+	t.Helper()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for job, status := range jobs {
+			if r.URL.Path == "/jobs/"+job+"/runs" {
+				w.Write([]byte(status))
+				return
+			}
+		}
+		if r.URL.Path == "/jobs/broken/runs" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+func TestRunProbe(t *testing.T) {
+	jobs := map[string]string{
+		// Latest backup failed, but the previous one is recent enough.
+		"orders": `{"backups": [
+			{"status": "SUCCEEDED", "end_time": "2026-10-13T14:00:00Z"},
+			{"status": "FAILED"},
+			{"status": "succeeded", "end_time": "2026-10-14T02:00:00Z"},
+			{"status": "FAILED", "end_time": "2026-10-14T11:00:00Z"}
+		]}`,
+		"users": `{"backups": [
+			{"status": "SUCCEEDED", "end_time": "2026-10-12T02:00:00Z"},
+			{"status": "RUNNING"}
+		]}`,
+		"events":  `{"backups": [{"status": "FAILED", "end_time": "2026-10-14T02:00:00Z"}]}`,
+		"invalid": `{"backups": [{"status": "SUCCEEDED", "end_time": "yesterday"}]}`,
+		"garbage": `<html>oops</html>`,
+	}
+	baseURL := startStubStatusAPI(t, jobs)
+
+	tests := []struct {
+		target      string
+		wantSuccess int64
+		wantMissing int64
+		wantErrors  int64
+		wantAge     float64
+		wantAgeNA   bool
+	}{
+		{target: "orders", wantSuccess: 1, wantAge: 36000},
+		{target: "users", wantMissing: 1, wantAge: 208800},
+		{target: "events", wantMissing: 1, wantAgeNA: true},
+		{target: "invalid", wantErrors: 1, wantAgeNA: true},
+		{target: "garbage", wantErrors: 1, wantAgeNA: true},
+		{target: "broken", wantErrors: 1, wantAgeNA: true},
+	}
+
+	p := testProbe(t, &configpb.ProbeConf{
+		StatusUrl: proto.String(baseURL + "/jobs/@target@/runs"),
+	})
+
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: test.target}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantMissing, result.backupMissing, "backup_missing")
+			assert.Equal(t, test.wantErrors, result.apiErrors, "api_errors")
+
+			em := result.GaugeMetrics(time.Now(), p.opts)
+			if test.wantAgeNA {
+				assert.Nil(t, em, "gauge metrics")
+				return
+			}
+			require.NotNil(t, em, "gauge metrics")
+			assert.Equal(t, test.wantAge, em.Metric("last_backup_age_seconds").(metrics.NumValue).Float64())
+		})
+	}
+}
+
+func TestRunProbeCustomFields(t *testing.T) {
+	baseURL := startStubStatusAPI(t, map[string]string{
+		"db": `{"items": [
+			{"state": "Completed", "kind": "full", "finished": 1791957600},
+			{"state": "Completed", "kind": "incremental", "finished": 1791975600},
+			{"state": "PartiallyFailed", "kind": "full", "finished": 1791979000}
+		]}`,
+	})
+
+	p := testProbe(t, &configpb.ProbeConf{
+		StatusUrl:           proto.String(baseURL + "/jobs/@target@/runs"),
+		BackupsJqFilter:     proto.String(`.items[] | select(.kind == "full")`),
+		StatusField:         proto.String("state"),
+		SuccessStatus:       []string{"COMPLETED"},
+		CompletionTimeField: proto.String("finished"),
+		TimestampFormat:     proto.String("unix"),
+	})
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), endpoint.Endpoint{Name: "db"}, result)
+
+	assert.Equal(t, int64(1), result.success, "success")
+	assert.Equal(t, 21600.0, result.ageSec, "age of the latest full backup")
+}
+
+func TestInitErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		conf *configpb.ProbeConf
+	}{
+		{
+			name: "no_status_url",
+			conf: &configpb.ProbeConf{MaxAgeSec: proto.Int32(60)},
+		},
+		{
+			name: "bad_max_age",
+			conf: &configpb.ProbeConf{StatusUrl: proto.String("/tmp/status.json"), MaxAgeSec: proto.Int32(0)},
+		},
+		{
+			name: "bad_jq_filter",
+			conf: &configpb.ProbeConf{StatusUrl: proto.String("/tmp/status.json"), MaxAgeSec: proto.Int32(60), BackupsJqFilter: proto.String(".[")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = test.conf
+			assert.Error(t, (&Probe{}).Init("test-backup", opts))
+		})
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package callback implements a probe type that triggers an action on the
+// target and verifies that the target calls back within the timeout.
+package callback
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/callback/proto"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+const (
+	noncePlaceholder       = "@nonce@"
+	callbackURLPlaceholder = "@callback_url@"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	client      *http.Client
+	callbackURL string
+	ln          net.Listener
+	srv         *http.Server
+
+	// Pending callbacks, keyed by nonce.
+	mu      sync.Mutex
+	pending map[string]chan time.Time
+}
+
+type probeResult struct {
+	total, success  int64
+	triggerFailures int64
+	timeouts        int64
+	latency         metrics.LatencyValue
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("trigger_failures", metrics.NewInt(result.triggerFailures)).
+		AddMetric("callback_timeouts", metrics.NewInt(result.timeouts)).
+		AddLabel("ptype", "callback")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not callback probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if s := p.c.GetScheme(); s != "http" && s != "https" {
+		return fmt.Errorf("invalid scheme: %s, should be http or https", s)
+	}
+	if !strings.HasPrefix(p.c.GetCallbackPath(), "/") {
+		return fmt.Errorf("callback_path should start with '/', got: %s", p.c.GetCallbackPath())
+	}
+	if p.c.GetNonceParam() == "" {
+		return fmt.Errorf("nonce_param cannot be empty")
+	}
+
+	p.pending = make(map[string]chan time.Time)
+	if p.client == nil {
+		p.client = &http.Client{}
+	}
+
+	ln, err := net.Listen("tcp", ":"+strconv.Itoa(int(p.c.GetCallbackPort())))
+	if err != nil {
+		return fmt.Errorf("error starting callback listener: %v", err)
+	}
+	p.ln = ln
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(p.c.GetCallbackPath(), p.handleCallback)
+	p.srv = &http.Server{Handler: mux}
+
+	p.callbackURL = p.c.GetCallbackUrl()
+	if p.callbackURL == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			ln.Close()
+			return fmt.Errorf("error getting hostname for callback URL: %v", err)
+		}
+		port := ln.Addr().(*net.TCPAddr).Port
+		p.callbackURL = "http://" + net.JoinHostPort(hostname, strconv.Itoa(port)) + p.c.GetCallbackPath()
+	}
+
+	return nil
+}
+
+// handleCallback handles callbacks from the targets.
+func (p *Probe) handleCallback(w http.ResponseWriter, r *http.Request) {
+	rcvdTime := time.Now()
+
+	nonce := r.FormValue(p.c.GetNonceParam())
+	if nonce == "" {
+		p.l.Warningf("Callback from %s without nonce", r.RemoteAddr)
+		http.Error(w, "missing "+p.c.GetNonceParam(), http.StatusBadRequest)
+		return
+	}
+
+	p.mu.Lock()
+	ch, ok := p.pending[nonce]
+	delete(p.pending, nonce)
+	p.mu.Unlock()
+
+	if !ok {
+		p.l.Warningf("Callback from %s with unknown or expired nonce: %s", r.RemoteAddr, nonce)
+		http.Error(w, "unknown "+p.c.GetNonceParam(), http.StatusNotFound)
+		return
+	}
+
+	// Channel is buffered and we are the only writer.
+	ch <- rcvdTime
+}
+
+// serveCallbacks serves callbacks until the context is canceled.
+func (p *Probe) serveCallbacks(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		p.srv.Close()
+	}()
+
+	if err := p.srv.Serve(p.ln); err != nil && err != http.ErrServerClosed {
+		p.l.Errorf("Callback server stopped: %v", err)
+	}
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (p *Probe) triggerRequest(ctx context.Context, target endpoint.Endpoint, nonce string) (*http.Request, error) {
+	host := target.Name
+	if target.IP != nil {
+		host = target.IP.String()
+	}
+
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	if port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+
+	q := url.Values{}
+	q.Set(p.c.GetNonceParam(), nonce)
+	q.Set("callback_url", p.callbackURL)
+	u := &url.URL{
+		Scheme:   p.c.GetScheme(),
+		Host:     host,
+		Path:     p.c.GetTriggerPath(),
+		RawQuery: q.Encode(),
+	}
+
+	r := strings.NewReplacer(noncePlaceholder, nonce, callbackURLPlaceholder, p.callbackURL)
+
+	var body io.Reader
+	if p.c.GetTriggerBody() != "" {
+		body = strings.NewReader(r.Replace(p.c.GetTriggerBody()))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, p.c.GetTriggerMethod(), u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range p.c.GetTriggerHeader() {
+		req.Header.Set(k, r.Replace(v))
+	}
+	if target.Name != host {
+		req.Host = target.Name
+	}
+
+	return req, nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	ipLabel := ""
+	if target.IP != nil {
+		ipLabel = target.IP.String()
+	}
+	for _, al := range p.opts.AdditionalLabels {
+		al.UpdateForTarget(target, ipLabel, int(p.c.GetPort()))
+	}
+
+	nonce, err := newNonce()
+	if err != nil {
+		p.l.Errorf("Target: %s, error generating nonce: %v", target.Name, err)
+		return
+	}
+
+	req, err := p.triggerRequest(ctx, target, nonce)
+	if err != nil {
+		p.l.Errorf("Target: %s, error creating trigger request: %v", target.Name, err)
+		return
+	}
+
+	// Register nonce before sending the trigger request, as the callback may
+	// arrive before we get the trigger response.
+	ch := make(chan time.Time, 1)
+	p.mu.Lock()
+	p.pending[nonce] = ch
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.pending, nonce)
+		p.mu.Unlock()
+	}()
+
+	start := time.Now()
+	resp, err := p.client.Do(req)
+	if err != nil {
+		p.l.Warningf("Target: %s, trigger request error: %v", target.Name, err)
+		result.triggerFailures++
+		return
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		p.l.Warningf("Target: %s, trigger request failed with status: %s", target.Name, resp.Status)
+		result.triggerFailures++
+		return
+	}
+
+	select {
+	case rcvdTime := <-ch:
+		result.success++
+		result.latency.AddFloat64(rcvdTime.Sub(start).Seconds() / p.opts.LatencyUnit.Seconds())
+	case <-ctx.Done():
+		p.l.Warningf("Target: %s, timed out waiting for callback, nonce: %s", target.Name, nonce)
+		result.timeouts++
+	}
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	go p.serveCallbacks(ctx)
+
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
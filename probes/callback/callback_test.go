@@ -0,0 +1,254 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/callback/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubTarget is a target that calls back on the callback URL it receives in
+// the trigger request.
+type stubTarget struct {
+	status int
+	// Callback delay, negative value means no callback.
+	delay time.Duration
+	// If set, callback is sent with this nonce instead of the received one.
+	nonceOverride string
+	// If set, nonce and callback URL are read from the JSON request body.
+	fromBody bool
+}
+
+func (st *stubTarget) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	nonce, callbackURL := r.URL.Query().Get("nonce"), r.URL.Query().Get("callback_url")
+	if st.fromBody {
+		var body struct {
+			ID     string `json:"id"`
+			Notify string `json:"notify"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		nonce, callbackURL = body.ID, body.Notify
+	}
+	if st.nonceOverride != "" {
+		nonce = st.nonceOverride
+	}
+
+	if st.status != 0 {
+		w.WriteHeader(st.status)
+		return
+	}
+
+	if st.delay >= 0 {
+		go func() {
+			time.Sleep(st.delay)
+			// Late callbacks may fail as probe's listener is closed by then.
+			if resp, err := http.PostForm(callbackURL, url.Values{"nonce": {nonce}}); err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func testProbe(t *testing.T, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	opts := options.DefaultOptions()
+	opts.Timeout = 500 * time.Millisecond
+	opts.ProbeConf = c
+
+	p := &Probe{}
+	require.NoError(t, p.Init("test-callback", opts))
+
+	// Default callback URL uses the hostname, which may not be resolvable in
+	// tests.
+	if c.GetCallbackUrl() == "" {
+		port := p.ln.Addr().(*net.TCPAddr).Port
+		p.callbackURL = "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(port)) + p.c.GetCallbackPath()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go p.serveCallbacks(ctx)
+
+	return p
+}
+
+func targetForServer(t *testing.T, ts *httptest.Server) endpoint.Endpoint {
+	t.Helper()
+
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	return endpoint.Endpoint{Name: u.Hostname(), Port: port}
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name                string
+		conf                *configpb.ProbeConf
+		target              *stubTarget
+		wantSuccess         int64
+		wantTriggerFailures int64
+		wantTimeouts        int64
+	}{
+		{
+			name:        "success",
+			target:      &stubTarget{},
+			wantSuccess: 1,
+		},
+		{
+			name:        "success_delayed_callback",
+			target:      &stubTarget{delay: 100 * time.Millisecond},
+			wantSuccess: 1,
+		},
+		{
+			name: "success_nonce_in_body",
+			conf: &configpb.ProbeConf{
+				TriggerBody:   proto.String(`{"id": "@nonce@", "notify": "@callback_url@"}`),
+				TriggerHeader: map[string]string{"Content-Type": "application/json"},
+			},
+			target:      &stubTarget{fromBody: true},
+			wantSuccess: 1,
+		},
+		{
+			name:         "no_callback",
+			target:       &stubTarget{delay: -1},
+			wantTimeouts: 1,
+		},
+		{
+			name:         "late_callback",
+			target:       &stubTarget{delay: time.Second},
+			wantTimeouts: 1,
+		},
+		{
+			name:         "wrong_nonce",
+			target:       &stubTarget{nonceOverride: "deadbeef"},
+			wantTimeouts: 1,
+		},
+		{
+			name:                "trigger_failure",
+			target:              &stubTarget{status: http.StatusInternalServerError},
+			wantTriggerFailures: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewServer(test.target)
+			defer ts.Close()
+
+			conf := test.conf
+			if conf == nil {
+				conf = &configpb.ProbeConf{}
+			}
+			p := testProbe(t, conf)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), targetForServer(t, ts), result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantTriggerFailures, result.triggerFailures, "trigger_failures")
+			assert.Equal(t, test.wantTimeouts, result.timeouts, "callback_timeouts")
+			assert.Empty(t, p.pending, "pending callbacks")
+		})
+	}
+}
+
+func TestTriggerRequest(t *testing.T) {
+	p := testProbe(t, &configpb.ProbeConf{
+		Scheme:        proto.String("https"),
+		Port:          proto.Int32(8443),
+		TriggerPath:   proto.String("/jobs"),
+		TriggerMethod: proto.String("PUT"),
+		TriggerBody:   proto.String("run @nonce@"),
+		TriggerHeader: map[string]string{"X-Callback": "@callback_url@"},
+		CallbackUrl:   proto.String("https://prober.example.com/cb"),
+		NonceParam:    proto.String("id"),
+	})
+
+	req, err := p.triggerRequest(context.Background(), endpoint.Endpoint{Name: "api.example.com", Port: 80}, "n123")
+	require.NoError(t, err)
+
+	assert.Equal(t, "PUT", req.Method)
+	assert.Equal(t, "https://api.example.com:8443/jobs?callback_url=https%3A%2F%2Fprober.example.com%2Fcb&id=n123", req.URL.String())
+	assert.Equal(t, "https://prober.example.com/cb", req.Header.Get("X-Callback"))
+
+	body, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "run n123", string(body))
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, c := range map[string]*configpb.ProbeConf{
+		"bad_scheme":        {Scheme: proto.String("ftp")},
+		"bad_callback_path": {CallbackPath: proto.String("callback")},
+		"empty_nonce_param": {NonceParam: proto.String("")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = c
+			assert.Error(t, (&Probe{}).Init("test-callback", opts))
+		})
+	}
+}
+
+func TestHandleCallback(t *testing.T) {
+	p := testProbe(t, &configpb.ProbeConf{})
+
+	ch := make(chan time.Time, 1)
+	p.pending["n1"] = ch
+
+	for _, test := range []struct {
+		query      string
+		wantStatus int
+	}{
+		{query: "", wantStatus: http.StatusBadRequest},
+		{query: "?nonce=unknown", wantStatus: http.StatusNotFound},
+		{query: "?nonce=n1", wantStatus: http.StatusOK},
+		// Duplicate callback.
+		{query: "?nonce=n1", wantStatus: http.StatusNotFound},
+	} {
+		w := httptest.NewRecorder()
+		p.handleCallback(w, httptest.NewRequest("GET", "/callback"+test.query, nil))
+		assert.Equal(t, test.wantStatus, w.Code, "query: %s", test.query)
+	}
+
+	select {
+	case <-ch:
+	default:
+		t.Errorf("callback not delivered for nonce n1")
+	}
+}
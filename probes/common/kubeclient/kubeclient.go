@@ -0,0 +1,167 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeclient implements a minimal Kubernetes API client for the
+// probes that talk to the Kubernetes API server. Similar to the kubernetes
+// RDS provider, it uses the REST API directly instead of client-go, to keep
+// the dependencies small.
+package kubeclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/internal/oauth"
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	tlsconfigpb "github.com/cloudprober/cloudprober/internal/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	"golang.org/x/oauth2"
+)
+
+// Variables defined by Kubernetes spec to find out local CA cert.
+var (
+	LocalCACert = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// maxResponseSize limits the size of the API responses that we read.
+const maxResponseSize = 10 << 20
+
+// Client is a Kubernetes API client.
+type Client struct {
+	httpC   *http.Client
+	apiHost string
+	l       *logger.Logger
+}
+
+// StatusError is returned by Do if API server responds with a non-2xx status.
+type StatusError struct {
+	Code int
+	Body string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("kubernetes API error, status code: %d, body: %s", e.Code, e.Body)
+}
+
+func apiHost(addr string) (string, error) {
+	if addr != "" {
+		return addr, nil
+	}
+
+	// If API server address is not given, assume in-cluster operation and try to
+	// get it from environment variables set by pod.
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if len(host) == 0 || len(port) == 0 {
+		return "", fmt.Errorf("not running in cluster: KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT environment variables not set")
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+func httpTransport(tlsConfig *tlsconfigpb.TLSConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{}
+
+	if tlsConfig != nil {
+		if err := tlsconfig.UpdateTLSConfig(transport.TLSClientConfig, tlsConfig); err != nil {
+			return nil, err
+		}
+		return transport, nil
+	}
+
+	// If TLS config is not provided, assume in-cluster.
+	certs, err := os.ReadFile(LocalCACert)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading local ca.crt file (%s): %v", LocalCACert, err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(certs)
+	transport.TLSClientConfig.RootCAs = caCertPool
+
+	return transport, nil
+}
+
+// New returns a new Kubernetes API client. If apiServerAddress is empty,
+// client assumes that it's running inside the cluster and uses the
+// environment to find the API server. If tlsConfig is nil, local service
+// account's CA cert is used to verify the API server. Requests are
+// authenticated using the local service account's token.
+func New(apiServerAddress string, tlsConfig *tlsconfigpb.TLSConfig, l *logger.Logger) (*Client, error) {
+	host, err := apiHost(apiServerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := httpTransport(tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := oauth.K8STokenSource(l)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating token source from k8s token file: %v", err)
+	}
+
+	return &Client{
+		httpC: &http.Client{
+			Transport: &oauth2.Transport{Source: ts, Base: transport},
+		},
+		apiHost: host,
+		l:       l,
+	}, nil
+}
+
+// Do sends a request to the API server and returns the response body. For
+// non-2xx responses, it returns a *StatusError.
+func (c *Client) Do(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	url := fmt.Sprintf("https://%s/%s", c.apiHost, strings.TrimPrefix(path, "/"))
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	c.l.Debugf("kubeclient: %s %s", method, url)
+	resp, err := c.httpC.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &StatusError{Code: resp.StatusCode, Body: string(respBody)}
+	}
+	return respBody, nil
+}
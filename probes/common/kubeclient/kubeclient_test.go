@@ -0,0 +1,76 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/pods":
+			b, _ := io.ReadAll(r.Body)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write(b)
+		default:
+			http.Error(w, `{"reason": "NotFound"}`, http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c := &Client{
+		httpC:   ts.Client(),
+		apiHost: strings.TrimPrefix(ts.URL, "https://"),
+	}
+
+	got, err := c.Do(context.Background(), http.MethodPost, "/api/v1/namespaces/default/pods", []byte(`{"kind": "Pod"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"kind": "Pod"}`, string(got))
+
+	_, err = c.Do(context.Background(), http.MethodGet, "api/v1/namespaces/default/pods/p1", nil)
+	var statusErr *StatusError
+	if assert.True(t, errors.As(err, &statusErr), "error: %v", err) {
+		assert.Equal(t, http.StatusNotFound, statusErr.Code)
+	}
+}
+
+func TestAPIHost(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	host, err := apiHost("k8s.example.com:443")
+	assert.NoError(t, err)
+	assert.Equal(t, "k8s.example.com:443", host)
+
+	_, err = apiHost("")
+	assert.Error(t, err)
+
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+	host, err = apiHost("")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:443", host)
+}
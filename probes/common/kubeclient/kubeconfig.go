@@ -0,0 +1,201 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/internal/oauth"
+	oauthpb "github.com/cloudprober/cloudprober/internal/oauth/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	"golang.org/x/oauth2"
+	"sigs.k8s.io/yaml"
+)
+
+// kubeconfig is the subset of the kubeconfig file format that we support.
+// Byte slice fields hold base64 encoded data in the file.
+type kubeconfig struct {
+	CurrentContext string `json:"current-context"`
+	Clusters       []struct {
+		Name    string `json:"name"`
+		Cluster struct {
+			Server                   string `json:"server"`
+			CertificateAuthority     string `json:"certificate-authority"`
+			CertificateAuthorityData []byte `json:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify"`
+		} `json:"cluster"`
+	} `json:"clusters"`
+	Users []struct {
+		Name string `json:"name"`
+		User struct {
+			Token                 string `json:"token"`
+			TokenFile             string `json:"tokenFile"`
+			ClientCertificate     string `json:"client-certificate"`
+			ClientCertificateData []byte `json:"client-certificate-data"`
+			ClientKey             string `json:"client-key"`
+			ClientKeyData         []byte `json:"client-key-data"`
+			Exec                  any    `json:"exec"`
+			AuthProvider          any    `json:"auth-provider"`
+		} `json:"user"`
+	} `json:"users"`
+	Contexts []struct {
+		Name    string `json:"name"`
+		Context struct {
+			Cluster string `json:"cluster"`
+			User    string `json:"user"`
+		} `json:"context"`
+	} `json:"contexts"`
+}
+
+// fileOrData returns data if it's not empty, otherwise it reads the file.
+// Relative file paths are resolved relative to the kubeconfig directory.
+func fileOrData(data []byte, file, dir string) ([]byte, error) {
+	if len(data) != 0 || file == "" {
+		return data, nil
+	}
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(dir, file)
+	}
+	return os.ReadFile(file)
+}
+
+// NewFromKubeconfig returns a new Kubernetes API client configured from the
+// given kubeconfig file. If kubeContext is empty, kubeconfig's current
+// context is used. Only static credentials (token, token file and client
+// certificates) are supported; exec and auth-provider plugins are not.
+func NewFromKubeconfig(path, kubeContext string, l *logger.Logger) (*Client, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading kubeconfig file (%s): %v", path, err)
+	}
+	kc := &kubeconfig{}
+	if err := yaml.Unmarshal(b, kc); err != nil {
+		return nil, fmt.Errorf("error parsing kubeconfig file (%s): %v", path, err)
+	}
+	dir := filepath.Dir(path)
+
+	if kubeContext == "" {
+		kubeContext = kc.CurrentContext
+	}
+	if kubeContext == "" {
+		return nil, errors.New("kubeconfig: no context specified and current-context is not set")
+	}
+
+	var clusterName, userName string
+	found := false
+	for _, c := range kc.Contexts {
+		if c.Name == kubeContext {
+			clusterName, userName, found = c.Context.Cluster, c.Context.User, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("kubeconfig: context %s not found", kubeContext)
+	}
+
+	tlsConfig := &tls.Config{}
+	var server string
+	for _, c := range kc.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		server = c.Cluster.Server
+		tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+
+		ca, err := fileOrData(c.Cluster.CertificateAuthorityData, c.Cluster.CertificateAuthority, dir)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig: error reading certificate authority for cluster %s: %v", clusterName, err)
+		}
+		if len(ca) != 0 {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("kubeconfig: no valid certificates in certificate authority for cluster %s", clusterName)
+			}
+		}
+	}
+	if server == "" {
+		return nil, fmt.Errorf("kubeconfig: server for cluster %s not found", clusterName)
+	}
+
+	u, err := url.Parse(server)
+	if err != nil || u.Scheme != "https" {
+		return nil, fmt.Errorf("kubeconfig: invalid server URL %s for cluster %s, only https is supported", server, clusterName)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	var rt http.RoundTripper = transport
+
+	for _, user := range kc.Users {
+		if user.Name != userName {
+			continue
+		}
+		uc := user.User
+		if uc.Exec != nil || uc.AuthProvider != nil {
+			return nil, fmt.Errorf("kubeconfig: user %s uses exec or auth-provider plugin, which is not supported", userName)
+		}
+
+		cert, err := fileOrData(uc.ClientCertificateData, uc.ClientCertificate, dir)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig: error reading client certificate for user %s: %v", userName, err)
+		}
+		key, err := fileOrData(uc.ClientKeyData, uc.ClientKey, dir)
+		if err != nil {
+			return nil, fmt.Errorf("kubeconfig: error reading client key for user %s: %v", userName, err)
+		}
+		if len(cert) != 0 {
+			keyPair, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, fmt.Errorf("kubeconfig: invalid client certificate for user %s: %v", userName, err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{keyPair}
+		}
+
+		switch {
+		case uc.Token != "":
+			rt = &oauth2.Transport{Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: uc.Token}), Base: transport}
+		case uc.TokenFile != "":
+			tokenFile := uc.TokenFile
+			if !filepath.IsAbs(tokenFile) {
+				tokenFile = filepath.Join(dir, tokenFile)
+			}
+			ts, err := oauth.TokenSourceFromConfig(&oauthpb.Config{
+				Type: &oauthpb.Config_BearerToken{
+					BearerToken: &oauthpb.BearerToken{
+						Source: &oauthpb.BearerToken_File{File: tokenFile},
+					},
+				},
+			}, l)
+			if err != nil {
+				return nil, fmt.Errorf("kubeconfig: error creating token source for user %s: %v", userName, err)
+			}
+			rt = &oauth2.Transport{Source: ts, Base: transport}
+		}
+	}
+
+	return &Client{
+		httpC:   &http.Client{Transport: rt},
+		apiHost: u.Host + strings.TrimSuffix(u.Path, "/"),
+		l:       l,
+	}, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeclient
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFromKubeconfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer file-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, r.URL.Path)
+	}))
+	defer ts.Close()
+
+	// CA cert and token files are relative to the kubeconfig directory.
+	dir := t.TempDir()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.crt"), ca, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte("file-token"), 0600))
+
+	kubeconfig := fmt.Sprintf(`
+current-context: other
+clusters:
+- name: c1
+  cluster:
+    server: %s/prefix/
+    certificate-authority: ca.crt
+contexts:
+- name: other
+  context:
+    cluster: missing
+- name: test
+  context:
+    cluster: c1
+    user: u1
+- name: exec
+  context:
+    cluster: c1
+    user: u2
+users:
+- name: u1
+  user:
+    tokenFile: token
+- name: u2
+  user:
+    exec:
+      command: get-token
+`, ts.URL)
+	path := filepath.Join(dir, "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0600))
+
+	c, err := NewFromKubeconfig(path, "test", nil)
+	require.NoError(t, err)
+	got, err := c.Do(context.Background(), http.MethodGet, "/api/v1/namespaces", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "/prefix/api/v1/namespaces", string(got))
+
+	for _, kubeContext := range []string{"", "exec", "missing"} {
+		t.Run("error_context_"+kubeContext, func(t *testing.T) {
+			_, err := NewFromKubeconfig(path, kubeContext, nil)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err = NewFromKubeconfig(filepath.Join(dir, "missing"), "", nil)
+	assert.Error(t, err)
+}
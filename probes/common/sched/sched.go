@@ -46,6 +46,17 @@ type ProbeResult interface {
 	Metrics(time.Time, *options.Options) *metrics.EventMetrics
 }
 
+// GaugeProbeResult is implemented by the ProbeResults that also have GAUGE
+// metrics. These metrics are exported in a separate EventMetrics, as an
+// EventMetrics can't mix metric kinds.
+type GaugeProbeResult interface {
+	ProbeResult
+
+	// GaugeMetrics returns GAUGE metrics as a metrics.EventMetrics object. It
+	// returns nil if there are no metrics to export.
+	GaugeMetrics(time.Time, *options.Options) *metrics.EventMetrics
+}
+
 type Scheduler struct {
 	ProbeName              string
 	DataChan               chan *metrics.EventMetrics
@@ -121,6 +132,14 @@ func (s *Scheduler) startForTarget(ctx context.Context, target endpoint.Endpoint
 				AddLabel("dst", target.Dst())
 
 			s.Opts.RecordMetrics(target, em, s.DataChan)
+
+			if gr, ok := result.(GaugeProbeResult); ok {
+				if em := gr.GaugeMetrics(ts, s.Opts); em != nil {
+					em.Kind = metrics.GAUGE
+					em.AddLabel("probe", s.ProbeName).AddLabel("dst", target.Dst())
+					s.Opts.RecordMetrics(target, em, s.DataChan, options.WithNoAlert())
+				}
+			}
 		}
 	}
 }
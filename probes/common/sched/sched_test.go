@@ -109,3 +109,63 @@ func TestUpdateTargetsAndStartProbes(t *testing.T) {
 	cancelF()
 	s.Wait()
 }
+
+type testGaugeProbeResult struct {
+	testProbeResult
+	last int
+}
+
+func (tpr *testGaugeProbeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if tpr.total%2 == 0 {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).AddMetric("last", metrics.NewInt(int64(tpr.last)))
+}
+
+func TestGaugeProbeResult(t *testing.T) {
+	opts := &options.Options{
+		Targets:             targets.StaticTargets("test1.com"),
+		Interval:            10 * time.Millisecond,
+		StatsExportInterval: 10 * time.Millisecond,
+		LogMetrics:          func(_ *metrics.EventMetrics) {},
+		Logger:              &logger.Logger{},
+	}
+
+	s := &Scheduler{
+		ProbeName: "test-probe",
+		Opts:      opts,
+		DataChan:  make(chan *metrics.EventMetrics, 100),
+		NewResult: func() ProbeResult { return &testGaugeProbeResult{} },
+		RunProbeForTarget: func(ctx context.Context, ep endpoint.Endpoint, r ProbeResult) {
+			r.(*testGaugeProbeResult).total++
+			r.(*testGaugeProbeResult).last = 5
+		},
+	}
+	s.init()
+
+	ctx, cancelF := context.WithCancel(context.Background())
+	s.refreshTargets(ctx)
+	ems, _ := testutils.MetricsFromChannel(s.DataChan, 6, time.Second)
+	cancelF()
+	s.Wait()
+
+	var numCumulative, numGauge int
+	for _, em := range ems {
+		if em.Label("probe") != "test-probe" || em.Label("dst") != "test1.com" {
+			t.Errorf("Unexpected labels in EventMetrics: %s", em.String())
+		}
+		if em.Kind == metrics.GAUGE {
+			numGauge++
+			if em.Metric("last") == nil || em.Metric("total") != nil {
+				t.Errorf("Unexpected metrics in gauge EventMetrics: %s", em.String())
+			}
+			continue
+		}
+		numCumulative++
+	}
+
+	// Gauge metrics are exported only for every other run.
+	if numCumulative != 4 || numGauge != 2 {
+		t.Errorf("Got %d cumulative and %d gauge EventMetrics, want 4 and 2", numCumulative, numGauge)
+	}
+}
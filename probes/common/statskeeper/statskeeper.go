@@ -37,6 +37,18 @@ type ProbeResult interface {
 	Target() string
 }
 
+// GaugeProbeResult is implemented by the ProbeResults that also have GAUGE
+// metrics. Unlike the cumulative metrics, GAUGE metrics are not aggregated
+// across runs: StatsKeeper keeps the latest ones for each target and exports
+// them in a separate EventMetrics, as an EventMetrics can't mix metric kinds.
+type GaugeProbeResult interface {
+	ProbeResult
+
+	// GaugeMetrics returns GAUGE metrics as a metrics.EventMetrics object. It
+	// returns nil if there are no metrics to export.
+	GaugeMetrics() *metrics.EventMetrics
+}
+
 // StatsKeeper manages and outputs probe results.
 //
 // Typical StatsKeeper usage pattern is that the probes start a StatsKeeper
@@ -59,6 +71,7 @@ type ProbeResult interface {
 // updated on a regular basis.
 func StatsKeeper(ctx context.Context, ptype, name string, opts *options.Options, targetsFunc func() []endpoint.Endpoint, resultsChan <-chan ProbeResult, dataChan chan<- *metrics.EventMetrics) {
 	targetMetrics := make(map[string]*metrics.EventMetrics)
+	gaugeMetrics := make(map[string]*metrics.EventMetrics)
 	exportTicker := time.NewTicker(opts.StatsExportInterval)
 	defer exportTicker.Stop()
 
@@ -67,6 +80,11 @@ func StatsKeeper(ctx context.Context, ptype, name string, opts *options.Options,
 		case result := <-resultsChan:
 			// result is a ProbeResult
 			t := result.Target()
+			if gr, ok := result.(GaugeProbeResult); ok {
+				if em := gr.GaugeMetrics(); em != nil {
+					gaugeMetrics[t] = em
+				}
+			}
 			if targetMetrics[t] == nil {
 				targetMetrics[t] = result.Metrics()
 				continue
@@ -86,6 +104,16 @@ func StatsKeeper(ctx context.Context, ptype, name string, opts *options.Options,
 
 					opts.RecordMetrics(t, em.Clone(), dataChan)
 				}
+
+				if em := gaugeMetrics[t.Name]; em != nil {
+					em.Kind = metrics.GAUGE
+					em.AddLabel("ptype", ptype)
+					em.AddLabel("probe", name)
+					em.AddLabel("dst", t.Name)
+					em.Timestamp = ts
+
+					opts.RecordMetrics(t, em.Clone(), dataChan, options.WithNoAlert())
+				}
 			}
 		case <-ctx.Done():
 			return
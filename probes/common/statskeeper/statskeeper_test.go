@@ -111,3 +111,61 @@ func TestStatsKeeper(t *testing.T) {
 		}
 	}
 }
+
+// gaugeProbeRunResult is a probeRunResult that also has a GAUGE metric.
+type gaugeProbeRunResult struct {
+	probeRunResult
+	depth int64
+}
+
+func (prr gaugeProbeRunResult) GaugeMetrics() *metrics.EventMetrics {
+	return metrics.NewEventMetrics(time.Now()).
+		AddMetric("depth", metrics.NewInt(prr.depth))
+}
+
+func TestStatsKeeperGaugeMetrics(t *testing.T) {
+	targets := []endpoint.Endpoint{{Name: "target1"}}
+
+	resultsChan := make(chan ProbeResult, 2)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	opts := options.DefaultOptions()
+	opts.StatsExportInterval = 100 * time.Millisecond
+
+	// Gauges are not aggregated across runs, only the latest one is exported.
+	for _, depth := range []int64{5, 2} {
+		prr := gaugeProbeRunResult{probeRunResult: newProbeRunResult("target1"), depth: depth}
+		prr.sent.Inc()
+		resultsChan <- prr
+	}
+	go StatsKeeper(ctx, "test", "testProbe", opts, func() []endpoint.Endpoint { return targets }, resultsChan, dataChan)
+
+	var cumulative, gauge *metrics.EventMetrics
+	for cumulative == nil || gauge == nil {
+		select {
+		case em := <-dataChan:
+			if em.Kind == metrics.GAUGE {
+				gauge = em
+			} else {
+				cumulative = em
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for the metrics")
+		}
+	}
+
+	if got := cumulative.Metric("sent").(metrics.NumValue).Int64(); got != 2 {
+		t.Errorf("sent=%d, want=2", got)
+	}
+	if cumulative.Metric("depth") != nil {
+		t.Error("depth unexpectedly found in the cumulative metrics")
+	}
+	if got := gauge.Metric("depth").(metrics.NumValue).Int64(); got != 2 {
+		t.Errorf("depth=%d, want=2", got)
+	}
+	if gauge.Label("dst") != "target1" || gauge.Label("ptype") != "test" {
+		t.Errorf("unexpected gauge labels: %s", gauge.String())
+	}
+}
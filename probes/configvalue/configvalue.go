@@ -0,0 +1,265 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package configvalue implements a probe type that fetches a key from a
+// config or feature-flag service, over HTTP or gRPC, and verifies that the
+// returned value matches the expectation.
+package configvalue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/configvalue/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+// errMissingKey is returned by fetchers if config service reports that the
+// key doesn't exist.
+var errMissingKey = errors.New("key not found")
+
+// fetcher fetches the raw value of the key from a target.
+type fetcher interface {
+	fetch(ctx context.Context, target endpoint.Endpoint, key string) ([]byte, error)
+}
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	fetcher    fetcher
+	jqQuery    *gojq.Query
+	expectedRe *regexp.Regexp
+}
+
+type probeResult struct {
+	total, success   int64
+	mismatch         int64
+	missingKey       int64
+	latency          metrics.LatencyValue
+	lastValue        string
+	lastNumericValue *float64
+
+	exportValueAsLabel bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		exportValueAsLabel: p.c.GetExportValueAsLabel(),
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("mismatch", metrics.NewInt(result.mismatch)).
+		AddMetric("missing_key", metrics.NewInt(result.missingKey)).
+		AddLabel("ptype", "configvalue")
+
+	if result.lastNumericValue != nil {
+		em.AddMetric("value", metrics.NewFloat(*result.lastNumericValue))
+	}
+
+	if result.exportValueAsLabel {
+		em.AddLabel("value", result.lastValue)
+	}
+
+	return em
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not configvalue probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetKey() == "" {
+		return errors.New("key is required")
+	}
+
+	var err error
+	switch p.c.Source.(type) {
+	case *configpb.ProbeConf_HttpSource:
+		p.fetcher, err = newHTTPFetcher(p.c.GetHttpSource(), p.opts)
+	case *configpb.ProbeConf_GrpcSource:
+		p.fetcher, err = newGRPCFetcher(p.c.GetGrpcSource(), p.opts)
+	default:
+		err = errors.New("one of http_source or grpc_source is required")
+	}
+	if err != nil {
+		return err
+	}
+
+	if jqf := p.c.GetValueJqFilter(); jqf != "" {
+		if p.jqQuery, err = gojq.Parse(jqf); err != nil {
+			return fmt.Errorf("error parsing value_jq_filter (%s): %v", jqf, err)
+		}
+	}
+
+	if _, ok := p.c.Expectation.(*configpb.ProbeConf_ExpectedValueRegex); ok {
+		if p.expectedRe, err = regexp.Compile(p.c.GetExpectedValueRegex()); err != nil {
+			return fmt.Errorf("invalid expected_value_regex (%s): %v", p.c.GetExpectedValueRegex(), err)
+		}
+	}
+
+	return nil
+}
+
+// extractValue extracts the value from the response using the jq filter, if
+// configured. It returns errMissingKey if the filter produces null.
+func (p *Probe) extractValue(resp []byte) (string, error) {
+	if p.jqQuery == nil {
+		return strings.TrimSpace(string(resp)), nil
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(resp, &input); err != nil {
+		return "", fmt.Errorf("response is not a valid JSON: %v", err)
+	}
+
+	var lastItem interface{}
+	iter := p.jqQuery.Run(input)
+	for {
+		item, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := item.(error); ok {
+			return "", err
+		}
+		lastItem = item
+	}
+
+	switch v := lastItem.(type) {
+	case nil:
+		return "", errMissingKey
+	case string:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+}
+
+func (p *Probe) matchValue(value string) bool {
+	if p.expectedRe != nil {
+		return p.expectedRe.MatchString(value)
+	}
+	if _, ok := p.c.Expectation.(*configpb.ProbeConf_ExpectedValue); ok {
+		return value == p.c.GetExpectedValue()
+	}
+	return true
+}
+
+// numericValue returns value's numeric representation, if it has one.
+func numericValue(value string) *float64 {
+	switch value {
+	case "true":
+		f := 1.0
+		return &f
+	case "false":
+		f := 0.0
+		return &f
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return &f
+	}
+	return nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	start := time.Now()
+	resp, err := p.fetcher.fetch(ctx, target, p.c.GetKey())
+	latency := time.Since(start)
+
+	var value string
+	if err == nil {
+		value, err = p.extractValue(resp)
+	}
+
+	if err != nil {
+		if errors.Is(err, errMissingKey) {
+			p.l.Warningf("Target: %s, key %s not found", target.Name, p.c.GetKey())
+			result.missingKey++
+			return
+		}
+		p.l.Warningf("Target: %s, error fetching key %s: %v", target.Name, p.c.GetKey(), err)
+		return
+	}
+
+	result.lastValue = value
+	result.lastNumericValue = numericValue(value)
+
+	if !p.matchValue(value) {
+		p.l.Warningf("Target: %s, key %s value mismatch, got: %s", target.Name, p.c.GetKey(), value)
+		result.mismatch++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,264 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configvalue
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/configvalue/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
+)
+
+// testFlags are served by the stub config services.
+var testFlags = map[string]string{
+	"new_ui":      `{"enabled": true, "rollout": 25}`,
+	"banner_text": `{"enabled": true, "text": "Hello"}`,
+}
+
+func startHTTPConfigService(t *testing.T) endpoint.Endpoint {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v, ok := testFlags[strings.TrimPrefix(r.URL.Path, "/flags/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, v)
+	}))
+	t.Cleanup(ts.Close)
+
+	return endpoint.Endpoint{Name: "127.0.0.1", Port: ts.Listener.Addr().(*net.TCPAddr).Port}
+}
+
+// startGRPCConfigService uses the gRPC health service as a stand-in for a
+// gRPC config service: service names are keys and serving status is value.
+func startGRPCConfigService(t *testing.T) endpoint.Endpoint {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs := health.NewServer()
+	hs.SetServingStatus("frontend", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("backend", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	reflection.Register(srv)
+	go srv.Serve(ln)
+	t.Cleanup(srv.Stop)
+
+	return endpoint.Endpoint{Name: "127.0.0.1", Port: ln.Addr().(*net.TCPAddr).Port}
+}
+
+func TestRunProbe(t *testing.T) {
+	httpSource := &configpb.ProbeConf_HttpSource{
+		HttpSource: &configpb.HTTPSource{Path: proto.String("/flags/@key@")},
+	}
+	grpcSource := &configpb.ProbeConf_GrpcSource{
+		GrpcSource: &configpb.GRPCSource{
+			Method:  proto.String("grpc.health.v1.Health.Check"),
+			Request: proto.String(`{"service": "@key@"}`),
+		},
+	}
+
+	tests := []struct {
+		desc           string
+		conf           *configpb.ProbeConf
+		wantSuccess    int64
+		wantMismatch   int64
+		wantMissingKey int64
+		wantValue      string
+		wantNumeric    *float64
+	}{
+		{
+			desc: "http-exact-match",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("new_ui"),
+				Source:        httpSource,
+				ValueJqFilter: proto.String(".enabled"),
+				Expectation:   &configpb.ProbeConf_ExpectedValue{ExpectedValue: "true"},
+			},
+			wantSuccess: 1,
+			wantValue:   "true",
+			wantNumeric: proto.Float64(1),
+		},
+		{
+			desc: "http-numeric-value",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("new_ui"),
+				Source:        httpSource,
+				ValueJqFilter: proto.String(".rollout"),
+			},
+			wantSuccess: 1,
+			wantValue:   "25",
+			wantNumeric: proto.Float64(25),
+		},
+		{
+			desc: "http-regex-mismatch",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("banner_text"),
+				Source:        httpSource,
+				ValueJqFilter: proto.String(".text"),
+				Expectation:   &configpb.ProbeConf_ExpectedValueRegex{ExpectedValueRegex: "^Welcome"},
+			},
+			wantMismatch: 1,
+			wantValue:    "Hello",
+		},
+		{
+			desc: "http-missing-key",
+			conf: &configpb.ProbeConf{
+				Key:    proto.String("old_ui"),
+				Source: httpSource,
+			},
+			wantMissingKey: 1,
+		},
+		{
+			desc: "http-missing-field",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("new_ui"),
+				Source:        httpSource,
+				ValueJqFilter: proto.String(".text"),
+			},
+			wantMissingKey: 1,
+		},
+		{
+			desc: "grpc-match",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("frontend"),
+				Source:        grpcSource,
+				ValueJqFilter: proto.String(".status"),
+				Expectation:   &configpb.ProbeConf_ExpectedValue{ExpectedValue: "SERVING"},
+			},
+			wantSuccess: 1,
+			wantValue:   "SERVING",
+		},
+		{
+			desc: "grpc-mismatch",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("backend"),
+				Source:        grpcSource,
+				ValueJqFilter: proto.String(".status"),
+				Expectation:   &configpb.ProbeConf_ExpectedValue{ExpectedValue: "SERVING"},
+			},
+			wantMismatch: 1,
+			wantValue:    "NOT_SERVING",
+		},
+		{
+			desc: "grpc-missing-key",
+			conf: &configpb.ProbeConf{
+				Key:           proto.String("unknown"),
+				Source:        grpcSource,
+				ValueJqFilter: proto.String(".status"),
+			},
+			wantMissingKey: 1,
+		},
+	}
+
+	httpTarget := startHTTPConfigService(t)
+	grpcTarget := startGRPCConfigService(t)
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.Timeout = 2 * time.Second
+			opts.ProbeConf = test.conf
+
+			p := &Probe{}
+			if err := p.Init("configvalue-test", opts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			target := httpTarget
+			if test.conf.GetGrpcSource() != nil {
+				target = grpcTarget
+			}
+
+			res := p.newResult()
+			p.runProbe(context.Background(), target, res)
+			result := res.(*probeResult)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantMismatch, result.mismatch, "mismatch")
+			assert.Equal(t, test.wantMissingKey, result.missingKey, "missing_key")
+			assert.Equal(t, test.wantValue, result.lastValue, "value")
+			assert.Equal(t, test.wantNumeric, result.lastNumericValue, "numeric value")
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{
+		Key:                proto.String("new_ui"),
+		Source:             &configpb.ProbeConf_HttpSource{HttpSource: &configpb.HTTPSource{}},
+		ExportValueAsLabel: proto.Bool(true),
+	}
+
+	p := &Probe{}
+	if err := p.Init("configvalue-test", opts); err != nil {
+		t.Fatalf("Error initializing probe: %v", err)
+	}
+
+	result := p.newResult().(*probeResult)
+	result.total, result.success = 2, 1
+	result.lastValue, result.lastNumericValue = "25", proto.Float64(25)
+
+	em := result.Metrics(time.Now(), opts)
+	assert.Equal(t, "25", em.Label("value"))
+	assert.Equal(t, "25.000", em.Metric("value").String())
+	assert.Equal(t, "configvalue", em.Label("ptype"))
+}
+
+func TestInitErrors(t *testing.T) {
+	for _, conf := range []*configpb.ProbeConf{
+		{
+			Key: proto.String("new_ui"),
+		},
+		{
+			Source: &configpb.ProbeConf_HttpSource{HttpSource: &configpb.HTTPSource{}},
+		},
+		{
+			Key:           proto.String("new_ui"),
+			Source:        &configpb.ProbeConf_HttpSource{HttpSource: &configpb.HTTPSource{}},
+			ValueJqFilter: proto.String(".["),
+		},
+		{
+			Key:         proto.String("new_ui"),
+			Source:      &configpb.ProbeConf_HttpSource{HttpSource: &configpb.HTTPSource{}},
+			Expectation: &configpb.ProbeConf_ExpectedValueRegex{ExpectedValueRegex: "(abc"},
+		},
+	} {
+		opts := options.DefaultOptions()
+		opts.ProbeConf = conf
+		assert.Error(t, (&Probe{}).Init("configvalue-test", opts), "conf: %v", conf)
+	}
+}
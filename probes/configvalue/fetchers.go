@@ -0,0 +1,194 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configvalue
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	configpb "github.com/cloudprober/cloudprober/probes/configvalue/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/fullstorydev/grpcurl"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const keyPlaceholder = "@key@"
+
+// maxResponseSize limits the size of the config service response we read.
+const maxResponseSize = 1 << 20
+
+type httpFetcher struct {
+	c      *configpb.HTTPSource
+	client *http.Client
+}
+
+func newHTTPFetcher(c *configpb.HTTPSource, opts *options.Options) (*httpFetcher, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.GetTlsConfig() != nil {
+		transport.TLSClientConfig = &tls.Config{}
+		if err := tlsconfig.UpdateTLSConfig(transport.TLSClientConfig, c.GetTlsConfig()); err != nil {
+			return nil, fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+	if opts.SourceIP != nil {
+		transport.DialContext = (&net.Dialer{
+			LocalAddr: &net.TCPAddr{IP: opts.SourceIP},
+		}).DialContext
+	}
+
+	return &httpFetcher{
+		c:      c,
+		client: &http.Client{Transport: transport, Timeout: opts.Timeout},
+	}, nil
+}
+
+func (hf *httpFetcher) url(target endpoint.Endpoint, key string) string {
+	scheme := strings.ToLower(hf.c.GetScheme().String())
+
+	host := target.Name
+	port := int(hf.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	if port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+
+	path := strings.ReplaceAll(hf.c.GetPath(), keyPlaceholder, key)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return scheme + "://" + host + path
+}
+
+func (hf *httpFetcher) fetch(ctx context.Context, target endpoint.Endpoint, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hf.url(target, key), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range hf.c.GetHeader() {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := hf.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errMissingKey
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
+
+type grpcFetcher struct {
+	c        *configpb.GRPCSource
+	dialOpts []grpc.DialOption
+	descSrc  grpcurl.DescriptorSource
+}
+
+func newGRPCFetcher(c *configpb.GRPCSource, opts *options.Options) (*grpcFetcher, error) {
+	gf := &grpcFetcher{c: c}
+
+	creds := insecure.NewCredentials()
+	if c.GetTlsConfig() != nil {
+		tlsCfg := &tls.Config{}
+		if err := tlsconfig.UpdateTLSConfig(tlsCfg, c.GetTlsConfig()); err != nil {
+			return nil, fmt.Errorf("tls_config error: %v", err)
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	gf.dialOpts = append(gf.dialOpts, grpc.WithTransportCredentials(creds))
+
+	if opts.SourceIP != nil {
+		dialer := &net.Dialer{LocalAddr: &net.TCPAddr{IP: opts.SourceIP}}
+		gf.dialOpts = append(gf.dialOpts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", addr)
+		}))
+	}
+
+	if c.GetProtosetFile() != "" {
+		descSrc, err := grpcurl.DescriptorSourceFromProtoSets(c.GetProtosetFile())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing protoset file: %v", err)
+		}
+		if _, err = descSrc.FindSymbol(c.GetMethod()); err != nil {
+			return nil, fmt.Errorf("error finding symbol (%s) in protoset file: %v", c.GetMethod(), err)
+		}
+		gf.descSrc = descSrc
+	}
+
+	return gf, nil
+}
+
+func (gf *grpcFetcher) fetch(ctx context.Context, target endpoint.Endpoint, key string) ([]byte, error) {
+	port := int(gf.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	addr := net.JoinHostPort(target.Name, strconv.Itoa(port))
+
+	conn, err := grpc.NewClient(addr, gf.dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	descSrc := gf.descSrc
+	if descSrc == nil {
+		refClient := grpcreflect.NewClientAuto(ctx, conn)
+		defer refClient.Reset()
+		descSrc = grpcurl.DescriptorSourceFromServer(ctx, refClient)
+	}
+
+	in := strings.NewReader(strings.ReplaceAll(gf.c.GetRequest(), keyPlaceholder, key))
+	rf, formatter, err := grpcurl.RequestParserAndFormatter(grpcurl.FormatJSON, descSrc, in, grpcurl.FormatOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct parser and formatter: %v", err)
+	}
+
+	var out bytes.Buffer
+	h := &grpcurl.DefaultEventHandler{Out: &out, Formatter: formatter}
+	if err := grpcurl.InvokeRPC(ctx, descSrc, conn, gf.c.GetMethod(), nil, h, rf.Next); err != nil {
+		return nil, fmt.Errorf("error invoking gRPC: %v", err)
+	}
+
+	switch h.Status.Code() {
+	case codes.OK:
+		return out.Bytes(), nil
+	case codes.NotFound:
+		return nil, errMissingKey
+	default:
+		return nil, fmt.Errorf("gRPC call failed: %s: %s", h.Status.Code(), h.Status.Message())
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package counterrate implements a probe type that verifies that a counter
+// is increasing at the expected rate.
+package counterrate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/file"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/counterrate/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+const targetPlaceholder = "@target@"
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	jqQuery        *gojq.Query
+	sampleInterval time.Duration
+
+	// Used by tests to feed the counter values and to control time.
+	readFile func(ctx context.Context, fileName string) ([]byte, error)
+	now      func() time.Time
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	fetchErrors    int64
+	counterResets  int64
+	rateOutOfRange int64
+
+	// Rate computed in the last run, valid only if rateKnown is true.
+	rate      float64
+	rateKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("fetch_errors", metrics.NewInt(result.fetchErrors)).
+		AddMetric("counter_resets", metrics.NewInt(result.counterResets)).
+		AddMetric("rate_out_of_range", metrics.NewInt(result.rateOutOfRange)).
+		AddLabel("ptype", "counter_rate")
+}
+
+// GaugeMetrics returns the rate computed in the last run.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.rateKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("counter_rate", metrics.NewFloat(result.rate)).
+		AddLabel("ptype", "counter_rate")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not counter_rate probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetUrl() == "" {
+		return errors.New("url is required")
+	}
+
+	p.sampleInterval = time.Duration(p.c.GetSampleIntervalMsec()) * time.Millisecond
+	if p.sampleInterval <= 0 || p.sampleInterval >= p.opts.Timeout {
+		return fmt.Errorf("sample_interval_msec (%d) should be positive and less than the probe timeout (%s)", p.c.GetSampleIntervalMsec(), p.opts.Timeout)
+	}
+
+	if p.c.MinRate != nil && p.c.MaxRate != nil && p.c.GetMinRate() > p.c.GetMaxRate() {
+		return fmt.Errorf("min_rate (%v) is greater than max_rate (%v)", p.c.GetMinRate(), p.c.GetMaxRate())
+	}
+
+	if filter := p.c.GetValueJqFilter(); filter != "" {
+		q, err := gojq.Parse(filter)
+		if err != nil {
+			return fmt.Errorf("error parsing value_jq_filter (%s): %v", filter, err)
+		}
+		p.jqQuery = q
+	}
+
+	if p.readFile == nil {
+		p.readFile = file.ReadFile
+	}
+	if p.now == nil {
+		p.now = time.Now
+	}
+
+	return nil
+}
+
+// parseValue extracts the counter value from the response.
+func (p *Probe) parseValue(b []byte) (float64, error) {
+	if p.jqQuery == nil {
+		return strconv.ParseFloat(strings.TrimSpace(string(b)), 64)
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(b, &input); err != nil {
+		return 0, fmt.Errorf("response is not a valid JSON: %v", err)
+	}
+	item, _ := p.jqQuery.Run(input).Next()
+	switch v := item.(type) {
+	case error:
+		return 0, v
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unexpected value_jq_filter result type %T: %v", v, v)
+	}
+}
+
+// sample reads the counter. It returns the counter value, the time it was
+// read at, and the time spent reading it.
+func (p *Probe) sample(ctx context.Context, counterURL string) (float64, time.Time, time.Duration, error) {
+	start := time.Now()
+	b, err := p.readFile(ctx, counterURL)
+	if err != nil {
+		return 0, time.Time{}, 0, err
+	}
+	ts, latency := p.now(), time.Since(start)
+
+	v, err := p.parseValue(b)
+	if err != nil {
+		return 0, time.Time{}, 0, fmt.Errorf("error parsing counter value: %v", err)
+	}
+	return v, ts, latency, nil
+}
+
+// computeRate returns the rate of increase per second between the two
+// samples, and whether the counter was reset in between.
+func computeRate(v1, v2 float64, elapsed time.Duration) (float64, bool) {
+	increase, reset := v2-v1, false
+	if v2 < v1 {
+		// Counter has restarted from 0.
+		increase, reset = v2, true
+	}
+	return increase / elapsed.Seconds(), reset
+}
+
+// formatBound formats the optional rate bound for logging.
+func formatBound(b *float64) string {
+	if b == nil {
+		return "none"
+	}
+	return strconv.FormatFloat(*b, 'g', -1, 64)
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.rateKnown = false
+
+	counterURL := strings.ReplaceAll(p.c.GetUrl(), targetPlaceholder, target.Name)
+
+	v1, ts1, latency1, err := p.sample(ctx, counterURL)
+	if err != nil {
+		p.l.Warningf("Target: %s, error reading counter from %s: %v", target.Name, counterURL, err)
+		result.fetchErrors++
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+		p.l.Warningf("Target: %s, probe timed out before the second sample", target.Name)
+		result.fetchErrors++
+		return
+	case <-time.After(p.sampleInterval):
+	}
+
+	v2, ts2, latency2, err := p.sample(ctx, counterURL)
+	if err != nil {
+		p.l.Warningf("Target: %s, error reading counter from %s: %v", target.Name, counterURL, err)
+		result.fetchErrors++
+		return
+	}
+
+	elapsed := ts2.Sub(ts1)
+	if elapsed <= 0 {
+		p.l.Warningf("Target: %s, no time elapsed between the samples", target.Name)
+		result.fetchErrors++
+		return
+	}
+
+	rate, reset := computeRate(v1, v2, elapsed)
+	if reset {
+		p.l.Infof("Target: %s, counter reset detected (%v -> %v)", target.Name, v1, v2)
+		result.counterResets++
+	}
+	result.rate, result.rateKnown = rate, true
+
+	if (p.c.MinRate != nil && rate < p.c.GetMinRate()) || (p.c.MaxRate != nil && rate > p.c.GetMaxRate()) {
+		p.l.Warningf("Target: %s, counter rate %.3f/s is outside the allowed range (min_rate: %s, max_rate: %s)", target.Name, rate, formatBound(p.c.MinRate), formatBound(p.c.MaxRate))
+		result.rateOutOfRange++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64((latency1 + latency2).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package counterrate
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/counterrate/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeCounter returns the given responses in order, and a clock that moves
+// by a second on every read.
+type fakeCounter struct {
+	responses []string
+	readErr   error
+	urls      []string
+	now       time.Time
+}
+
+func (fc *fakeCounter) readFile(_ context.Context, fileName string) ([]byte, error) {
+	fc.urls = append(fc.urls, fileName)
+	if fc.readErr != nil {
+		return nil, fc.readErr
+	}
+	resp := fc.responses[0]
+	fc.responses = fc.responses[1:]
+	fc.now = fc.now.Add(time.Second)
+	return []byte(resp), nil
+}
+
+func testProbe(t *testing.T, fc *fakeCounter, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	c.Url = proto.String("http://@target@/stats")
+	c.SampleIntervalMsec = proto.Int32(10)
+
+	opts := options.DefaultOptions()
+	opts.ProbeConf = c
+
+	p := &Probe{readFile: fc.readFile, now: func() time.Time { return fc.now }}
+	require.NoError(t, p.Init("test-counter-rate", opts))
+	return p
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name           string
+		responses      []string
+		readErr        error
+		conf           *configpb.ProbeConf
+		wantSuccess    int64
+		wantErrors     int64
+		wantResets     int64
+		wantOutOfRange int64
+		wantRate       float64
+		wantNoRate     bool
+	}{
+		{
+			name:        "in_range",
+			responses:   []string{"100", "150\n"},
+			conf:        &configpb.ProbeConf{MinRate: proto.Float64(10), MaxRate: proto.Float64(100)},
+			wantSuccess: 1,
+			wantRate:    50,
+		},
+		{
+			name:           "below_min",
+			responses:      []string{"100", "105"},
+			conf:           &configpb.ProbeConf{MinRate: proto.Float64(10)},
+			wantOutOfRange: 1,
+			wantRate:       5,
+		},
+		{
+			name:           "above_max",
+			responses:      []string{"100", "1100"},
+			conf:           &configpb.ProbeConf{MaxRate: proto.Float64(100)},
+			wantOutOfRange: 1,
+			wantRate:       1000,
+		},
+		{
+			name:           "stalled",
+			responses:      []string{"100", "100"},
+			conf:           &configpb.ProbeConf{MinRate: proto.Float64(0.1)},
+			wantOutOfRange: 1,
+			wantRate:       0,
+		},
+		{
+			name:        "reset",
+			responses:   []string{"5000", "30"},
+			conf:        &configpb.ProbeConf{MinRate: proto.Float64(10)},
+			wantSuccess: 1,
+			wantResets:  1,
+			wantRate:    30,
+		},
+		{
+			name:        "no_bounds",
+			responses:   []string{"0", "2"},
+			conf:        &configpb.ProbeConf{},
+			wantSuccess: 1,
+			wantRate:    2,
+		},
+		{
+			name:        "jq_filter",
+			responses:   []string{`{"stats": {"events": 10}}`, `{"stats": {"events": "30"}}`},
+			conf:        &configpb.ProbeConf{ValueJqFilter: proto.String(".stats.events")},
+			wantSuccess: 1,
+			wantRate:    20,
+		},
+		{
+			name:       "read_error",
+			readErr:    errors.New("connection refused"),
+			conf:       &configpb.ProbeConf{},
+			wantErrors: 1,
+			wantNoRate: true,
+		},
+		{
+			name:       "bad_value",
+			responses:  []string{"100", "n/a"},
+			conf:       &configpb.ProbeConf{},
+			wantErrors: 1,
+			wantNoRate: true,
+		},
+		{
+			name:       "jq_filter_no_value",
+			responses:  []string{`{"stats": {}}`},
+			conf:       &configpb.ProbeConf{ValueJqFilter: proto.String(".stats.events")},
+			wantErrors: 1,
+			wantNoRate: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fc := &fakeCounter{responses: test.responses, readErr: test.readErr, now: time.Now()}
+			p := testProbe(t, fc, test.conf)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: "ingest-1"}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantErrors, result.fetchErrors, "fetch_errors")
+			assert.Equal(t, test.wantResets, result.counterResets, "counter_resets")
+			assert.Equal(t, test.wantOutOfRange, result.rateOutOfRange, "rate_out_of_range")
+			assert.Equal(t, "http://ingest-1/stats", fc.urls[0], "counter URL")
+
+			em := result.GaugeMetrics(time.Now(), p.opts)
+			if test.wantNoRate {
+				assert.Nil(t, em, "gauge metrics")
+				return
+			}
+			require.NotNil(t, em, "gauge metrics")
+			assert.Equal(t, test.wantRate, em.Metric("counter_rate").(*metrics.Float).Float64())
+		})
+	}
+}
+
+func TestRunProbeSequence(t *testing.T) {
+	// Counter keeps increasing at 10/s across runs, except for a restart in
+	// the third run.
+	fc := &fakeCounter{
+		responses: []string{"0", "10", "20", "30", "40", "5", "15", "25"},
+		now:       time.Now(),
+	}
+	p := testProbe(t, fc, &configpb.ProbeConf{MinRate: proto.Float64(5)})
+
+	result := p.newResult().(*probeResult)
+	var rates []float64
+	for i := 0; i < 4; i++ {
+		p.runProbe(context.Background(), endpoint.Endpoint{Name: "ingest-1"}, result)
+		rates = append(rates, result.rate)
+	}
+
+	assert.Equal(t, []float64{10, 10, 5, 10}, rates)
+	assert.Equal(t, int64(4), result.success, "success")
+	assert.Equal(t, int64(1), result.counterResets, "counter_resets")
+}
+
+func TestRunProbeFile(t *testing.T) {
+	// Real file reads and clock: counter doesn't change, rate is 0.
+	counterFile := filepath.Join(t.TempDir(), "counter")
+	require.NoError(t, os.WriteFile(counterFile, []byte("42"), 0644))
+
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{
+		Url:                proto.String(counterFile),
+		SampleIntervalMsec: proto.Int32(10),
+	}
+	p := &Probe{}
+	require.NoError(t, p.Init("test-counter-rate", opts))
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), endpoint.Endpoint{Name: "local"}, result)
+	assert.Equal(t, int64(1), result.success, "success")
+	assert.True(t, result.rateKnown, "rate known")
+	assert.Equal(t, 0.0, result.rate, "rate")
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"no_url":               {},
+		"zero_sample_interval": {Url: proto.String("/stats"), SampleIntervalMsec: proto.Int32(0)},
+		"sample_above_timeout": {Url: proto.String("/stats"), SampleIntervalMsec: proto.Int32(60000)},
+		"min_above_max":        {Url: proto.String("/stats"), MinRate: proto.Float64(10), MaxRate: proto.Float64(1)},
+		"bad_jq_filter":        {Url: proto.String("/stats"), ValueJqFilter: proto.String(".[")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("test-counter-rate", opts))
+		})
+	}
+}
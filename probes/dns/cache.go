@@ -0,0 +1,69 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/miekg/dns"
+)
+
+// coldLabelPrefix is prepended to the random labels of the cold queries, to
+// make them easy to spot in the resolver logs.
+const coldLabelPrefix = "cp-"
+
+// cacheChecker queries random subdomains of a domain to measure the cold
+// resolution latency.
+type cacheChecker struct {
+	coldDomain string
+}
+
+func newCacheChecker(c *configpb.CacheCheck, resolvedDomain string) *cacheChecker {
+	domain := c.GetColdDomain()
+	if domain == "" {
+		domain = resolvedDomain
+	}
+	return &cacheChecker{coldDomain: dns.Fqdn(domain)}
+}
+
+// coldName returns a new random subdomain of the cold domain.
+func (cc *cacheChecker) coldName() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return coldLabelPrefix + hex.EncodeToString(b) + "." + cc.coldDomain
+}
+
+// coldQuery queries a random subdomain and returns the latency, and whether
+// the resolver answered it. NXDOMAIN is expected for the random names and is
+// considered an answer.
+func (p *Probe) coldQuery(target string) (time.Duration, bool) {
+	msg := new(dns.Msg)
+	name := p.cacheCheck.coldName()
+	msg.SetQuestion(name, p.queryType)
+
+	resp, latency, err := p.client.Exchange(msg, target)
+	if err != nil {
+		p.l.Warningf("Target(%s): cache check: cold query for %s failed: %v", target, name, err)
+		return 0, false
+	}
+	if resp == nil || (resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError) {
+		p.l.Warningf("Target(%s): cache check: unexpected response for cold query %s: %v", target, name, resp)
+		return 0, false
+	}
+	return latency, true
+}
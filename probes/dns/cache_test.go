@@ -0,0 +1,145 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/statskeeper"
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// cacheMockClient simulates a caching resolver: names it has seen before are
+// answered in 1ms, others take 50ms and return coldRcode.
+type cacheMockClient struct {
+	coldRcode int
+
+	mu    sync.Mutex
+	seen  map[string]bool
+	names []string
+}
+
+func (mc *cacheMockClient) Exchange(in *dns.Msg, fullTarget string) (*dns.Msg, time.Duration, error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	name := in.Question[0].Name
+	mc.names = append(mc.names, name)
+
+	out := &dns.Msg{}
+	out.SetReply(in)
+	if mc.seen[name] {
+		return out, time.Millisecond, nil
+	}
+	mc.seen[name] = true
+	if name != "www.example.com." {
+		out.Rcode = mc.coldRcode
+	}
+	return out, 50 * time.Millisecond, nil
+}
+func (*cacheMockClient) setReadTimeout(time.Duration)  {}
+func (*cacheMockClient) setSourceIP(net.IP)            {}
+func (*cacheMockClient) setDNSProto(configpb.DNSProto) {}
+
+func TestCacheCheck(t *testing.T) {
+	tests := []struct {
+		name            string
+		coldDomain      string
+		coldRcode       int
+		wantColdDomain  string
+		wantColdSuccess int64
+	}{
+		{
+			name:            "nxdomain",
+			coldRcode:       dns.RcodeNameError,
+			wantColdDomain:  ".www.example.com.",
+			wantColdSuccess: 1,
+		},
+		{
+			name:            "noerror_cold_domain",
+			coldDomain:      "wildcard.example.com",
+			coldRcode:       dns.RcodeSuccess,
+			wantColdDomain:  ".wildcard.example.com.",
+			wantColdSuccess: 1,
+		},
+		{
+			name:           "servfail",
+			coldRcode:      dns.RcodeServerFailure,
+			wantColdDomain: ".www.example.com.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Probe{}
+			opts := &options.Options{
+				Targets:           targets.StaticTargets("8.8.8.8"),
+				Interval:          2 * time.Second,
+				Timeout:           time.Second,
+				LatencyUnit:       time.Millisecond,
+				LatencyMetricName: "latency",
+				ProbeConf: &configpb.ProbeConf{
+					ResolvedDomain: proto.String("www.example.com"),
+					QueryType:      configpb.QueryType_A.Enum(),
+					CacheCheck:     &configpb.CacheCheck{ColdDomain: proto.String(test.coldDomain)},
+				},
+			}
+			if err := p.Init("dns_cache_test", opts); err != nil {
+				t.Fatalf("Error creating probe: %v", err)
+			}
+			mc := &cacheMockClient{coldRcode: test.coldRcode, seen: map[string]bool{}}
+			p.client = mc
+			p.targets = p.opts.Targets.ListEndpoints()
+
+			// Run twice: the second run's warm query is served from cache,
+			// while the cold queries are always new names.
+			resultsChan := make(chan statskeeper.ProbeResult, 2)
+			p.runProbe(resultsChan)
+			<-resultsChan
+			cold1, warm1 := mc.names[0], mc.names[1]
+			p.runProbe(resultsChan)
+			result := (<-resultsChan).(probeRunResult)
+
+			assert.Len(t, mc.names, 4)
+			for _, cold := range []string{cold1, mc.names[2]} {
+				assert.True(t, strings.HasPrefix(cold, coldLabelPrefix), cold)
+				assert.True(t, strings.HasSuffix(cold, test.wantColdDomain), cold)
+			}
+			assert.NotEqual(t, cold1, mc.names[2], "cold names are random")
+			assert.Equal(t, "www.example.com.", warm1, "warm query follows the cold query")
+
+			// Results are per run.
+			assert.Equal(t, int64(1), result.success.Int64(), "success")
+			assert.Equal(t, int64(1), result.coldTotal.Int64(), "cold_total")
+			assert.Equal(t, test.wantColdSuccess, result.coldSuccess.Int64(), "cold_success")
+			assert.Equal(t, 1.0, result.latency.(*metrics.Float).Float64(), "warm latency")
+			assert.Equal(t, float64(test.wantColdSuccess)*50, result.coldLatency.(*metrics.Float).Float64(), "cold latency")
+
+			em := result.Metrics()
+			assert.NotNil(t, em.Metric("cold_latency"))
+			assert.NotNil(t, em.Metric("cold_success"))
+		})
+	}
+}
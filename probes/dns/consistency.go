@@ -0,0 +1,122 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/miekg/dns"
+)
+
+// consistencyChecker compares a target's answers with the answers from a set
+// of reference resolvers.
+type consistencyChecker struct {
+	resolvers []string
+	allowed   []*regexp.Regexp
+}
+
+func newConsistencyChecker(c *configpb.ConsistencyCheck) (*consistencyChecker, error) {
+	if len(c.GetResolver()) == 0 {
+		return nil, errors.New("consistency_check: at least one resolver is required")
+	}
+
+	cc := &consistencyChecker{}
+	for _, r := range c.GetResolver() {
+		if _, _, err := net.SplitHostPort(r); err != nil {
+			r = net.JoinHostPort(r, strconv.Itoa(defaultPort))
+		}
+		cc.resolvers = append(cc.resolvers, r)
+	}
+
+	for _, s := range c.GetAllowedVariance() {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("consistency_check: invalid allowed_variance regex (%s): %v", s, err)
+		}
+		cc.allowed = append(cc.allowed, re)
+	}
+	return cc, nil
+}
+
+// answerSet returns the sorted and de-duplicated answers of the response, in
+// presentation format without the name and TTL, e.g. "A 192.168.0.1".
+// Answers matching the allowed_variance regexes and signatures are skipped.
+func (cc *consistencyChecker) answerSet(resp *dns.Msg) []string {
+	var answers []string
+	for _, rr := range resp.Answer {
+		if _, ok := rr.(*dns.RRSIG); ok {
+			continue
+		}
+		hdr := rr.Header()
+		answer := dns.TypeToString[hdr.Rrtype] + " " + strings.TrimPrefix(rr.String(), hdr.String())
+
+		allowed := false
+		for _, re := range cc.allowed {
+			if re.MatchString(answer) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			answers = append(answers, answer)
+		}
+	}
+	slices.Sort(answers)
+	return slices.Compact(answers)
+}
+
+// checkConsistency queries all the consistency_check resolvers concurrently
+// and returns the ones whose answers differ from the target's response.
+// Resolvers that fail to answer are considered divergent as well.
+func (p *Probe) checkConsistency(resp *dns.Msg, target string) []string {
+	want := p.consistency.answerSet(resp)
+
+	divergent := make([]bool, len(p.consistency.resolvers))
+	var wg sync.WaitGroup
+	for i, resolver := range p.consistency.resolvers {
+		wg.Add(1)
+		go func(i int, resolver string) {
+			defer wg.Done()
+
+			got, _, err := p.client.Exchange(p.newQuery(), resolver)
+			if err != nil || got.Rcode != dns.RcodeSuccess {
+				p.l.Warningf("Target(%s): consistency check: error querying resolver %s, err: %v, resp: %v", target, resolver, err, got)
+				divergent[i] = true
+				return
+			}
+			if gotAnswers := p.consistency.answerSet(got); !slices.Equal(want, gotAnswers) {
+				p.l.Warningf("Target(%s): consistency check: answers from resolver %s diverge, got: %v, target's: %v", target, resolver, gotAnswers, want)
+				divergent[i] = true
+			}
+		}(i, resolver)
+	}
+	wg.Wait()
+
+	var out []string
+	for i, resolver := range p.consistency.resolvers {
+		if divergent[i] {
+			out = append(out, resolver)
+		}
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/probes/common/statskeeper"
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// consistencyMockClient answers with A records configured per resolver.
+// Resolvers that are not configured return an error.
+type consistencyMockClient struct {
+	answers map[string][]string
+}
+
+func (mc *consistencyMockClient) Exchange(in *dns.Msg, fullTarget string) (*dns.Msg, time.Duration, error) {
+	ips, ok := mc.answers[fullTarget]
+	if !ok {
+		return nil, 0, fmt.Errorf("no route to %s", fullTarget)
+	}
+
+	out := &dns.Msg{}
+	out.SetReply(in)
+	for i, ip := range ips {
+		// Vary TTL across resolvers, as caching resolvers do.
+		rr, err := dns.NewRR(fmt.Sprintf("%s %d IN A %s", in.Question[0].Name, 300+i*len(fullTarget), ip))
+		if err != nil {
+			return nil, 0, err
+		}
+		out.Answer = append(out.Answer, rr)
+	}
+	return out, time.Millisecond, nil
+}
+func (*consistencyMockClient) setReadTimeout(time.Duration)  {}
+func (*consistencyMockClient) setSourceIP(net.IP)            {}
+func (*consistencyMockClient) setDNSProto(configpb.DNSProto) {}
+
+func TestConsistencyCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		answers       map[string][]string
+		allowed       []string
+		wantSuccess   int64
+		wantDivergent []string
+	}{
+		{
+			name: "consistent",
+			answers: map[string][]string{
+				"8.8.8.8:53": {"192.168.0.1", "192.168.0.2"},
+				"1.1.1.1:53": {"192.168.0.2", "192.168.0.1"},
+				"9.9.9.9:53": {"192.168.0.1", "192.168.0.2", "192.168.0.1"},
+			},
+			wantSuccess: 1,
+		},
+		{
+			name: "divergent",
+			answers: map[string][]string{
+				"8.8.8.8:53": {"192.168.0.1", "192.168.0.2"},
+				"1.1.1.1:53": {"192.168.0.1"},
+				"9.9.9.9:53": {"192.168.0.1", "192.168.0.2"},
+			},
+			wantDivergent: []string{"1.1.1.1:53"},
+		},
+		{
+			name: "resolver_error",
+			answers: map[string][]string{
+				"8.8.8.8:53": {"192.168.0.1"},
+				"1.1.1.1:53": {"192.168.0.1"},
+			},
+			wantDivergent: []string{"9.9.9.9:53"},
+		},
+		{
+			name: "allowed_geo_variance",
+			answers: map[string][]string{
+				"8.8.8.8:53": {"192.168.0.1", "10.1.0.1"},
+				"1.1.1.1:53": {"192.168.0.1", "10.2.0.1"},
+				"9.9.9.9:53": {"192.168.0.1"},
+			},
+			allowed:     []string{`^A 10\.`},
+			wantSuccess: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Probe{}
+			opts := &options.Options{
+				Targets:  targets.StaticTargets("8.8.8.8"),
+				Interval: 2 * time.Second,
+				Timeout:  time.Second,
+				ProbeConf: &configpb.ProbeConf{
+					ResolvedDomain: proto.String("www.example.com"),
+					QueryType:      configpb.QueryType_A.Enum(),
+					ConsistencyCheck: &configpb.ConsistencyCheck{
+						Resolver:        []string{"1.1.1.1", "9.9.9.9:53"},
+						AllowedVariance: test.allowed,
+					},
+				},
+			}
+			if err := p.Init("dns_consistency_test", opts); err != nil {
+				t.Fatalf("Error creating probe: %v", err)
+			}
+			p.client = &consistencyMockClient{answers: test.answers}
+			p.targets = p.opts.Targets.ListEndpoints()
+
+			resultsChan := make(chan statskeeper.ProbeResult, len(p.targets))
+			p.runProbe(resultsChan)
+			result := (<-resultsChan).(probeRunResult)
+
+			assert.Equal(t, int64(1), result.total.Int64(), "total")
+			assert.Equal(t, test.wantSuccess, result.success.Int64(), "success")
+			assert.Equal(t, test.wantSuccess, result.consistent.Int64(), "dns_consistency")
+			assert.ElementsMatch(t, test.wantDivergent, result.divergentResolvers.Keys(), "dns_divergent_resolvers")
+
+			em := result.Metrics()
+			assert.NotNil(t, em.Metric("dns_consistency"))
+			assert.NotNil(t, em.Metric("dns_divergent_resolvers"))
+		})
+	}
+}
+
+func TestNewConsistencyCheckerErrors(t *testing.T) {
+	for name, c := range map[string]*configpb.ConsistencyCheck{
+		"no_resolvers": {},
+		"bad_regex":    {Resolver: []string{"1.1.1.1"}, AllowedVariance: []string{"("}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newConsistencyChecker(c)
+			assert.Error(t, err)
+		})
+	}
+}
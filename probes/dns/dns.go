@@ -95,6 +95,18 @@ type Probe struct {
 	queryType uint16
 	fqdn      string
 	client    Client
+
+	// Set if consistency_check is configured.
+	consistency *consistencyChecker
+
+	// Set if cache_check is configured.
+	cacheCheck *cacheChecker
+
+	// Set if propagation_check is configured.
+	propagation *propagationChecker
+
+	// Set if dnssec is enabled.
+	dnssecValidator *dnssecValidator
 }
 
 // probeRunResult captures the results of a single probe run. The way we work with
@@ -109,16 +121,71 @@ type probeRunResult struct {
 	timeouts          metrics.Int
 	validationFailure *metrics.Map[int64]
 	latencyMetricName string
+
+	// DNSSEC related metrics, exported only if DNSSEC is enabled.
+	dnssec         bool
+	dnssecValid    metrics.Int
+	dnssecUnsigned metrics.Int
+
+	// Consistency check metrics, exported only if consistency_check is
+	// configured.
+	consistencyCheck   bool
+	consistent         metrics.Int
+	divergentResolvers *metrics.Map[int64]
+
+	// Cache check metrics, exported only if cache_check is configured.
+	cacheCheck  bool
+	coldTotal   metrics.Int
+	coldSuccess metrics.Int
+	coldLatency metrics.LatencyValue
+
+	// Propagation check metrics, exported only if propagation_check is
+	// configured. propagation is nil if the check didn't run.
+	propagationCheck   bool
+	propagationOverdue *metrics.Map[int64]
+	propagation        *propagationResult
 }
 
 // Metrics converts probeRunResult into metrics.EventMetrics object
 func (prr probeRunResult) Metrics() *metrics.EventMetrics {
-	return metrics.NewEventMetrics(time.Now()).
+	em := metrics.NewEventMetrics(time.Now()).
 		AddMetric("total", &prr.total).
 		AddMetric("success", &prr.success).
 		AddMetric(prr.latencyMetricName, prr.latency.Clone()).
 		AddMetric("timeouts", &prr.timeouts).
 		AddMetric("validation_failure", prr.validationFailure)
+
+	if prr.dnssec {
+		em.AddMetric("dnssec_valid", &prr.dnssecValid).
+			AddMetric("dnssec_unsigned", &prr.dnssecUnsigned)
+	}
+	if prr.consistencyCheck {
+		em.AddMetric("dns_consistency", &prr.consistent).
+			AddMetric("dns_divergent_resolvers", prr.divergentResolvers)
+	}
+	if prr.cacheCheck {
+		em.AddMetric("cold_total", &prr.coldTotal).
+			AddMetric("cold_success", &prr.coldSuccess).
+			AddMetric("cold_"+prr.latencyMetricName, prr.coldLatency.Clone())
+	}
+	if prr.propagationCheck {
+		em.AddMetric("dns_propagation_overdue", prr.propagationOverdue)
+	}
+	return em
+}
+
+// GaugeMetrics returns the propagation status after the run.
+func (prr probeRunResult) GaugeMetrics() *metrics.EventMetrics {
+	if prr.propagation == nil {
+		return nil
+	}
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("dns_propagation_converged_fraction", metrics.NewFloat(prr.propagation.convergedFraction)).
+		AddMetric("dns_propagation_max_stale_ttl_seconds", metrics.NewFloat(prr.propagation.maxStaleTTL.Seconds()))
+	if prr.propagation.timeToFullKnown {
+		em.AddMetric("dns_time_to_full_propagation_seconds", metrics.NewFloat(prr.propagation.timeToFull.Seconds()))
+	}
+	return em
 }
 
 // Target returns the p.target.
@@ -161,6 +228,34 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 	p.queryType = uint16(queryType)
 	p.fqdn = dns.Fqdn(p.c.GetResolvedDomain())
 
+	if p.c.GetConsistencyCheck() != nil {
+		cc, err := newConsistencyChecker(p.c.GetConsistencyCheck())
+		if err != nil {
+			return fmt.Errorf("dns_probe(%v): %v", name, err)
+		}
+		p.consistency = cc
+	}
+
+	if p.c.GetDnssec() {
+		v, err := newDNSSECValidator(p.c.GetDnssecTrustAnchor())
+		if err != nil {
+			return fmt.Errorf("dns_probe(%v): %v", name, err)
+		}
+		p.dnssecValidator = v
+	}
+
+	if p.c.GetCacheCheck() != nil {
+		p.cacheCheck = newCacheChecker(p.c.GetCacheCheck(), p.fqdn)
+	}
+
+	if p.c.GetPropagationCheck() != nil {
+		pc, err := newPropagationChecker(p.c.GetPropagationCheck())
+		if err != nil {
+			return fmt.Errorf("dns_probe(%v): %v", name, err)
+		}
+		p.propagation = pc
+	}
+
 	// I believe the client is safe for concurrent use by multiple goroutines
 	// (although the documentation doesn't explicitly say so). It uses locks
 	// internally and the underlying net.Conn declares that multiple goroutines
@@ -221,18 +316,48 @@ func (p *Probe) validateResponse(resp *dns.Msg, target string, result *probeRunR
 	return true
 }
 
-func (p *Probe) doDNSRequest(target string, result *probeRunResult, resultMu *sync.Mutex) {
-	// Generate a new question for each probe so transaction IDs aren't repeated.
+// newQuery returns a new DNS query message. Generate a new question for each
+// request so transaction IDs aren't repeated.
+func (p *Probe) newQuery() *dns.Msg {
 	msg := new(dns.Msg)
 	msg.SetQuestion(p.fqdn, p.queryType)
+	if p.c.GetDnssec() {
+		msg.SetEdns0(dnssecUDPSize, true)
+	}
+	return msg
+}
 
-	resp, latency, err := p.client.Exchange(msg, target)
+func (p *Probe) doDNSRequest(target string, result *probeRunResult, resultMu *sync.Mutex) {
+	// Cold query goes first, so that the regular query measures the warm
+	// latency.
+	var coldLatency time.Duration
+	var coldOK bool
+	if p.cacheCheck != nil {
+		coldLatency, coldOK = p.coldQuery(target)
+	}
+
+	resp, latency, err := p.client.Exchange(p.newQuery(), target)
+
+	// Query the consistency check resolvers before locking the result, as
+	// that may take a while.
+	var divergent []string
+	if p.consistency != nil && err == nil && resp != nil && resp.Rcode == dns.RcodeSuccess {
+		divergent = p.checkConsistency(resp, target)
+	}
 
 	if resultMu != nil {
 		resultMu.Lock()
 		defer resultMu.Unlock()
 	}
 
+	if p.cacheCheck != nil {
+		result.coldTotal.Inc()
+		if coldOK {
+			result.coldSuccess.Inc()
+			result.coldLatency.AddFloat64(coldLatency.Seconds() / p.opts.LatencyUnit.Seconds())
+		}
+	}
+
 	if err != nil {
 		if isClientTimeout(err) {
 			p.l.Warningf("Target(%s): client.Exchange: Timeout error: %v", target, err)
@@ -241,6 +366,27 @@ func (p *Probe) doDNSRequest(target string, result *probeRunResult, resultMu *sy
 			p.l.Warningf("Target(%s): client.Exchange: %v", target, err)
 		}
 	} else if p.validateResponse(resp, target, result) {
+		if p.c.GetDnssec() {
+			if err := p.verifyDNSSEC(resp, target); err != nil {
+				if err == errUnsigned {
+					p.l.Warningf("Target(%s): DNSSEC: unsigned response for %s", target, p.fqdn)
+					result.dnssecUnsigned.Inc()
+				} else {
+					p.l.Warningf("Target(%s): DNSSEC validation failed: %v", target, err)
+				}
+				return
+			}
+			result.dnssecValid.Inc()
+		}
+		if p.consistency != nil {
+			if len(divergent) > 0 {
+				for _, resolver := range divergent {
+					result.divergentResolvers.IncKey(resolver)
+				}
+				return
+			}
+			result.consistent.Inc()
+		}
 		result.success.Inc()
 		result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
 	}
@@ -263,6 +409,16 @@ func (p *Probe) runProbe(resultsChan chan<- statskeeper.ProbeResult) {
 				target:            target.Name,
 				latencyMetricName: p.opts.LatencyMetricName,
 				validationFailure: validators.ValidationFailureMap(p.opts.Validators),
+				dnssec:            p.c.GetDnssec(),
+				consistencyCheck:  p.consistency != nil,
+				propagationCheck:  p.propagation != nil,
+			}
+
+			if p.consistency != nil {
+				result.divergentResolvers = metrics.NewMap("resolver")
+			}
+			if p.propagation != nil {
+				result.propagationOverdue = metrics.NewMap("resolver")
 			}
 
 			if p.opts.LatencyDist != nil {
@@ -271,6 +427,11 @@ func (p *Probe) runProbe(resultsChan chan<- statskeeper.ProbeResult) {
 				result.latency = metrics.NewFloat(0)
 			}
 
+			if p.cacheCheck != nil {
+				result.cacheCheck = true
+				result.coldLatency = result.latency.Clone().(metrics.LatencyValue)
+			}
+
 			port := defaultPort
 			if target.Port != 0 {
 				port = target.Port
@@ -301,6 +462,15 @@ func (p *Probe) runProbe(resultsChan chan<- statskeeper.ProbeResult) {
 				al.UpdateForTarget(target, ipLabel, port)
 			}
 
+			// Propagation is checked once per run, irrespective of the
+			// requests per probe.
+			if p.propagation != nil {
+				result.propagation = p.checkPropagation(fullTarget, target.Name)
+				for _, resolver := range result.propagation.overdue {
+					result.propagationOverdue.IncKey(resolver)
+				}
+			}
+
 			if p.c.GetRequestsPerProbe() == 1 {
 				p.doDNSRequest(fullTarget, &result, nil)
 				resultsChan <- result
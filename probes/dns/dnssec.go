@@ -0,0 +1,277 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// dnssecUDPSize is the EDNS0 UDP buffer size advertised in DNSSEC queries.
+// Signed responses are usually larger than 512 bytes.
+const dnssecUDPSize = 4096
+
+// rootTrustAnchor is the root zone's KSK-2017 DS record, used as the trust
+// anchor if dnssec_trust_anchor is not configured.
+const rootTrustAnchor = ". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+
+// errUnsigned is returned by verifyDNSSEC if the response doesn't carry any
+// signatures for the answer.
+var errUnsigned = errors.New("no RRSIG records in the answer")
+
+// splitSigned splits resource records into the RRset of the given type and
+// the signatures covering that type.
+func splitSigned(rrs []dns.RR, rrType uint16) (rrset []dns.RR, sigs []*dns.RRSIG) {
+	for _, rr := range rrs {
+		if sig, ok := rr.(*dns.RRSIG); ok {
+			if sig.TypeCovered == rrType {
+				sigs = append(sigs, sig)
+			}
+			continue
+		}
+		if rr.Header().Rrtype == rrType {
+			rrset = append(rrset, rr)
+		}
+	}
+	return
+}
+
+// verifyRRSet verifies that at least one of the signatures over the RRset is
+// valid and made by one of the given keys.
+func verifyRRSet(rrset []dns.RR, sigs []*dns.RRSIG, keys []dns.RR, now time.Time) error {
+	if len(sigs) == 0 {
+		return errors.New("no RRSIG records")
+	}
+
+	var lastErr error
+	for _, sig := range sigs {
+		if !sig.ValidityPeriod(now) {
+			lastErr = fmt.Errorf("RRSIG (key tag: %d) is outside its validity period", sig.KeyTag)
+			continue
+		}
+		for _, rr := range keys {
+			key, ok := rr.(*dns.DNSKEY)
+			if !ok || key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			if err := sig.Verify(key, rrset); err != nil {
+				lastErr = fmt.Errorf("RRSIG (key tag: %d) verification failed: %v", sig.KeyTag, err)
+				continue
+			}
+			return nil
+		}
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no DNSKEY found for RRSIG (key tag: %d, signer: %s)", sig.KeyTag, sig.SignerName)
+		}
+	}
+	return lastErr
+}
+
+// zoneKeys is a zone's validated DNSKEY RRset, cached until it expires.
+type zoneKeys struct {
+	keys    []dns.RR
+	expires time.Time
+}
+
+// dnssecValidator validates the DNSSEC chain of trust, and caches the
+// validated keys per zone.
+type dnssecValidator struct {
+	// Trust anchors, keyed by the zone name.
+	anchors map[string][]*dns.DS
+
+	mu       sync.Mutex
+	keyCache map[string]*zoneKeys
+}
+
+func newDNSSECValidator(trustAnchors []string) (*dnssecValidator, error) {
+	if len(trustAnchors) == 0 {
+		trustAnchors = []string{rootTrustAnchor}
+	}
+
+	v := &dnssecValidator{
+		anchors:  make(map[string][]*dns.DS),
+		keyCache: make(map[string]*zoneKeys),
+	}
+	for _, s := range trustAnchors {
+		rr, err := dns.NewRR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dnssec_trust_anchor (%s): %v", s, err)
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok {
+			return nil, fmt.Errorf("invalid dnssec_trust_anchor (%s): not a DS record", s)
+		}
+		zone := dns.CanonicalName(ds.Hdr.Name)
+		v.anchors[zone] = append(v.anchors[zone], ds)
+	}
+	return v, nil
+}
+
+func (v *dnssecValidator) cachedKeys(zone string, now time.Time) []dns.RR {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if zk := v.keyCache[zone]; zk != nil && now.Before(zk.expires) {
+		return zk.keys
+	}
+	return nil
+}
+
+func (v *dnssecValidator) cacheKeys(zone string, keys []dns.RR, ttl uint32, now time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keyCache[zone] = &zoneKeys{keys: keys, expires: now.Add(time.Duration(ttl) * time.Second)}
+}
+
+// minTTL returns the smallest TTL of the given records.
+func minTTL(rrs []dns.RR) uint32 {
+	var ttl uint32
+	for i, rr := range rrs {
+		if i == 0 || rr.Header().Ttl < ttl {
+			ttl = rr.Header().Ttl
+		}
+	}
+	return ttl
+}
+
+// matchingKeys returns the keys that match any of the given DS records.
+func matchingKeys(keys []dns.RR, dsRecords []*dns.DS) []dns.RR {
+	var result []dns.RR
+	for _, rr := range keys {
+		key, ok := rr.(*dns.DNSKEY)
+		if !ok {
+			continue
+		}
+		for _, ds := range dsRecords {
+			if key.KeyTag() != ds.KeyTag || key.Algorithm != ds.Algorithm {
+				continue
+			}
+			if keyDS := key.ToDS(ds.DigestType); keyDS != nil && strings.EqualFold(keyDS.Digest, ds.Digest) {
+				result = append(result, key)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// querySigned queries the target for the given name and type, with the
+// DNSSEC OK bit set, and returns the RRset and the signatures covering it.
+func (p *Probe) querySigned(name string, rrType uint16, target string) ([]dns.RR, []*dns.RRSIG, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(name, rrType)
+	msg.SetEdns0(dnssecUDPSize, true)
+
+	resp, _, err := p.client.Exchange(msg, target)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching %s for %s: %v", dns.TypeToString[rrType], name, err)
+	}
+	if resp == nil || resp.Rcode != dns.RcodeSuccess {
+		return nil, nil, fmt.Errorf("error in %s response for %s: %v", dns.TypeToString[rrType], name, resp)
+	}
+	rrset, sigs := splitSigned(resp.Answer, rrType)
+	return rrset, sigs, nil
+}
+
+// zoneKeys returns the validated DNSKEY RRset of the given zone. Zone's
+// DNSKEY RRset must be signed by a key that matches either the zone's trust
+// anchor, or the zone's DS records, which are in turn validated using the
+// parent zone's keys, recursively.
+func (p *Probe) zoneKeys(zone, target string, now time.Time) ([]dns.RR, error) {
+	v := p.dnssecValidator
+	zone = dns.CanonicalName(zone)
+	if keys := v.cachedKeys(zone, now); keys != nil {
+		return keys, nil
+	}
+
+	keys, keySigs, err := p.querySigned(zone, dns.TypeDNSKEY, target)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no DNSKEY records for zone %s", zone)
+	}
+	ttl := minTTL(keys)
+
+	dsRecords := v.anchors[zone]
+	if dsRecords == nil {
+		if zone == "." {
+			return nil, errors.New("no trust anchor for the root zone")
+		}
+		dsRRs, dsSigs, err := p.querySigned(zone, dns.TypeDS, target)
+		if err != nil {
+			return nil, err
+		}
+		if len(dsRRs) == 0 || len(dsSigs) == 0 {
+			return nil, fmt.Errorf("no signed DS records for zone %s, chain of trust is broken", zone)
+		}
+		parent := dns.CanonicalName(dsSigs[0].SignerName)
+		if parent == zone || !dns.IsSubDomain(parent, zone) {
+			return nil, fmt.Errorf("DS records for zone %s are signed by %s, not a parent zone", zone, parent)
+		}
+		parentKeys, err := p.zoneKeys(parent, target, now)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyRRSet(dsRRs, dsSigs, parentKeys, now); err != nil {
+			return nil, fmt.Errorf("DS RRset for %s: %v", zone, err)
+		}
+		for _, rr := range dsRRs {
+			if ds, ok := rr.(*dns.DS); ok {
+				dsRecords = append(dsRecords, ds)
+			}
+		}
+		if dsTTL := minTTL(dsRRs); dsTTL < ttl {
+			ttl = dsTTL
+		}
+	}
+
+	sepKeys := matchingKeys(keys, dsRecords)
+	if len(sepKeys) == 0 {
+		return nil, fmt.Errorf("no DNSKEY for zone %s matches its DS records or trust anchor", zone)
+	}
+	if err := verifyRRSet(keys, keySigs, sepKeys, now); err != nil {
+		return nil, fmt.Errorf("DNSKEY RRset for %s: %v", zone, err)
+	}
+
+	v.cacheKeys(zone, keys, ttl, now)
+	return keys, nil
+}
+
+// verifyDNSSEC verifies the DNSSEC signatures in the given response, using
+// the signer zone's keys validated along the chain of trust (see zoneKeys).
+func (p *Probe) verifyDNSSEC(resp *dns.Msg, target string) error {
+	rrset, sigs := splitSigned(resp.Answer, p.queryType)
+	if len(sigs) == 0 {
+		return errUnsigned
+	}
+	if len(rrset) == 0 {
+		return fmt.Errorf("found RRSIG records but no %s records in the answer", dns.TypeToString[p.queryType])
+	}
+
+	now := time.Now()
+	keys, err := p.zoneKeys(sigs[0].SignerName, target, now)
+	if err != nil {
+		return err
+	}
+	if err := verifyRRSet(rrset, sigs, keys, now); err != nil {
+		return fmt.Errorf("answer RRset: %v", err)
+	}
+	return nil
+}
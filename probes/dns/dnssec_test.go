@@ -0,0 +1,288 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"crypto"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/probes/common/statskeeper"
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testZone       = "example.com."
+	testParentZone = "com."
+)
+
+type signedZone struct {
+	name       string
+	key        *dns.DNSKEY
+	privateKey crypto.Signer
+}
+
+func newSignedZone(t *testing.T, name string) *signedZone {
+	t.Helper()
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     257,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	priv, err := key.Generate(256)
+	if err != nil {
+		t.Fatalf("Error generating DNSKEY: %v", err)
+	}
+	return &signedZone{name: name, key: key, privateKey: priv.(crypto.Signer)}
+}
+
+func (z *signedZone) sign(t *testing.T, rrset []dns.RR) *dns.RRSIG {
+	t.Helper()
+	sig := &dns.RRSIG{
+		Hdr:        dns.RR_Header{Name: rrset[0].Header().Name, Rrtype: dns.TypeRRSIG, Class: dns.ClassINET, Ttl: 3600},
+		Inception:  uint32(time.Now().Add(-time.Hour).Unix()),
+		Expiration: uint32(time.Now().Add(time.Hour).Unix()),
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.name,
+		Algorithm:  z.key.Algorithm,
+	}
+	if err := sig.Sign(z.privateKey, rrset); err != nil {
+		t.Fatalf("Error signing RRset: %v", err)
+	}
+	return sig
+}
+
+// signed returns the given RRset along with its signature.
+func (z *signedZone) signed(t *testing.T, rrset ...dns.RR) []dns.RR {
+	return append(rrset, z.sign(t, rrset))
+}
+
+// dnssecMockClient serves the records for testZone and its parent zone,
+// testParentZone, and counts the queries by name and type.
+type dnssecMockClient struct {
+	records map[string][]dns.RR
+
+	mu      sync.Mutex
+	queries map[string]int
+}
+
+func recordsKey(name string, rrType uint16) string {
+	return name + "/" + dns.TypeToString[rrType]
+}
+
+type dnssecMockOpts struct {
+	unsigned bool
+	badSig   bool
+	noDS     bool
+	badDS    bool
+}
+
+func newDNSSECMockClient(t *testing.T, parent *signedZone, mo dnssecMockOpts) *dnssecMockClient {
+	zone := newSignedZone(t, testZone)
+	aRR, _ := dns.NewRR("www." + testZone + " 3600 IN A 192.168.0.1")
+
+	mc := &dnssecMockClient{
+		records: make(map[string][]dns.RR),
+		queries: make(map[string]int),
+	}
+	if mo.unsigned {
+		mc.records[recordsKey("www."+testZone, dns.TypeA)] = []dns.RR{aRR}
+		return mc
+	}
+
+	mc.records[recordsKey("www."+testZone, dns.TypeA)] = zone.signed(t, aRR)
+	if mo.badSig {
+		// Signature over a different record, so that it doesn't match the
+		// answer.
+		otherRR, _ := dns.NewRR("www." + testZone + " 3600 IN A 192.168.0.2")
+		mc.records[recordsKey("www."+testZone, dns.TypeA)] = []dns.RR{aRR, zone.sign(t, []dns.RR{otherRR})}
+	}
+	mc.records[recordsKey(testZone, dns.TypeDNSKEY)] = zone.signed(t, zone.key)
+	mc.records[recordsKey(testParentZone, dns.TypeDNSKEY)] = parent.signed(t, parent.key)
+
+	ds := zone.key.ToDS(dns.SHA256)
+	if mo.badDS {
+		// DS record for a different key, i.e. zone's keys are only
+		// self-signed.
+		ds = newSignedZone(t, testZone).key.ToDS(dns.SHA256)
+	}
+	if !mo.noDS {
+		mc.records[recordsKey(testZone, dns.TypeDS)] = parent.signed(t, ds)
+	}
+	return mc
+}
+
+func (mc *dnssecMockClient) Exchange(in *dns.Msg, fullTarget string) (*dns.Msg, time.Duration, error) {
+	out := &dns.Msg{}
+	out.SetReply(in)
+
+	q := in.Question[0]
+	key := recordsKey(q.Name, q.Qtype)
+
+	mc.mu.Lock()
+	mc.queries[key]++
+	mc.mu.Unlock()
+
+	for _, rr := range mc.records[key] {
+		if _, ok := rr.(*dns.RRSIG); ok && !in.IsEdns0().Do() {
+			continue
+		}
+		out.Answer = append(out.Answer, rr)
+	}
+	return out, time.Millisecond, nil
+}
+
+func (mc *dnssecMockClient) queryCount(name string, rrType uint16) int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.queries[recordsKey(name, rrType)]
+}
+
+func (*dnssecMockClient) setReadTimeout(time.Duration)  {}
+func (*dnssecMockClient) setSourceIP(net.IP)            {}
+func (*dnssecMockClient) setDNSProto(configpb.DNSProto) {}
+
+func testDNSSECProbe(t *testing.T, trustAnchor string) *Probe {
+	t.Helper()
+	p := &Probe{}
+	opts := &options.Options{
+		Targets:  targets.StaticTargets("8.8.8.8"),
+		Interval: 2 * time.Second,
+		Timeout:  time.Second,
+		ProbeConf: &configpb.ProbeConf{
+			ResolvedDomain:    proto.String("www." + testZone),
+			QueryType:         configpb.QueryType_A.Enum(),
+			Dnssec:            proto.Bool(true),
+			DnssecTrustAnchor: []string{trustAnchor},
+		},
+	}
+	if err := p.Init("dns_dnssec_test", opts); err != nil {
+		t.Fatalf("Error creating probe: %v", err)
+	}
+	p.targets = p.opts.Targets.ListEndpoints()
+	return p
+}
+
+func runDNSSECProbe(p *Probe) probeRunResult {
+	resultsChan := make(chan statskeeper.ProbeResult, len(p.targets))
+	p.runProbe(resultsChan)
+	return (<-resultsChan).(probeRunResult)
+}
+
+func TestDNSSEC(t *testing.T) {
+	parent := newSignedZone(t, testParentZone)
+
+	tests := []struct {
+		name         string
+		mockOpts     dnssecMockOpts
+		anchor       string
+		wantSuccess  int64
+		wantValid    int64
+		wantUnsigned int64
+	}{
+		{
+			name:        "signed",
+			wantSuccess: 1,
+			wantValid:   1,
+		},
+		{
+			name:         "unsigned",
+			mockOpts:     dnssecMockOpts{unsigned: true},
+			wantUnsigned: 1,
+		},
+		{
+			name:     "bad_signature",
+			mockOpts: dnssecMockOpts{badSig: true},
+		},
+		{
+			name:     "no_ds",
+			mockOpts: dnssecMockOpts{noDS: true},
+		},
+		{
+			name:     "self_signed_only",
+			mockOpts: dnssecMockOpts{badDS: true},
+		},
+		{
+			name:   "untrusted_parent",
+			anchor: newSignedZone(t, testParentZone).key.ToDS(dns.SHA256).String(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			anchor := test.anchor
+			if anchor == "" {
+				anchor = parent.key.ToDS(dns.SHA256).String()
+			}
+			p := testDNSSECProbe(t, anchor)
+			p.client = newDNSSECMockClient(t, parent, test.mockOpts)
+
+			result := runDNSSECProbe(p)
+			assert.Equal(t, int64(1), result.total.Int64(), "total")
+			assert.Equal(t, test.wantSuccess, result.success.Int64(), "success")
+			assert.Equal(t, test.wantValid, result.dnssecValid.Int64(), "dnssec_valid")
+			assert.Equal(t, test.wantUnsigned, result.dnssecUnsigned.Int64(), "dnssec_unsigned")
+
+			em := result.Metrics()
+			assert.NotNil(t, em.Metric("dnssec_valid"))
+			assert.NotNil(t, em.Metric("dnssec_unsigned"))
+		})
+	}
+}
+
+func TestDNSSECKeyCache(t *testing.T) {
+	parent := newSignedZone(t, testParentZone)
+	p := testDNSSECProbe(t, parent.key.ToDS(dns.SHA256).String())
+	mc := newDNSSECMockClient(t, parent, dnssecMockOpts{})
+	p.client = mc
+
+	for i := 0; i < 3; i++ {
+		result := runDNSSECProbe(p)
+		assert.Equal(t, int64(1), result.dnssecValid.Int64(), "run #%d, dnssec_valid", i)
+	}
+
+	// Keys are fetched once, and reused until they expire.
+	assert.Equal(t, 3, mc.queryCount("www."+testZone, dns.TypeA))
+	assert.Equal(t, 1, mc.queryCount(testZone, dns.TypeDNSKEY))
+	assert.Equal(t, 1, mc.queryCount(testZone, dns.TypeDS))
+	assert.Equal(t, 1, mc.queryCount(testParentZone, dns.TypeDNSKEY))
+
+	// Expire the zone's keys, parent zone's keys are still cached.
+	p.dnssecValidator.keyCache[testZone].expires = time.Now()
+	result := runDNSSECProbe(p)
+	assert.Equal(t, int64(1), result.dnssecValid.Int64(), "dnssec_valid")
+	assert.Equal(t, 2, mc.queryCount(testZone, dns.TypeDNSKEY))
+	assert.Equal(t, 1, mc.queryCount(testParentZone, dns.TypeDNSKEY))
+}
+
+func TestNewDNSSECValidator(t *testing.T) {
+	v, err := newDNSSECValidator(nil)
+	assert.NoError(t, err)
+	assert.Len(t, v.anchors["."], 1)
+	assert.Equal(t, uint16(20326), v.anchors["."][0].KeyTag)
+
+	for _, anchor := range []string{"invalid", "example.com. 3600 IN A 192.168.0.1"} {
+		_, err := newDNSSECValidator([]string{anchor})
+		assert.Error(t, err, "anchor: %s", anchor)
+	}
+}
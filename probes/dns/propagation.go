@@ -0,0 +1,212 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"errors"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/miekg/dns"
+)
+
+// propagationChecker tracks the propagation of the expected answers across a
+// set of resolvers, per target.
+type propagationChecker struct {
+	resolvers []string
+	expected  []string
+	now       func() time.Time
+
+	mu    sync.Mutex
+	state map[string]*propagationState
+}
+
+// propagationState is the per-target propagation state, carried across runs.
+type propagationState struct {
+	seen       bool
+	propagated bool
+	started    time.Time
+
+	// Expiry of the stale answers, as reported by each resolver when first
+	// seen stale during the current propagation.
+	staleUntil map[string]time.Time
+
+	timeToFull      time.Duration
+	timeToFullKnown bool
+}
+
+// propagationResult captures the propagation status after a run.
+type propagationResult struct {
+	convergedFraction float64
+	maxStaleTTL       time.Duration
+	overdue           []string
+	timeToFull        time.Duration
+	timeToFullKnown   bool
+}
+
+func newPropagationChecker(c *configpb.PropagationCheck) (*propagationChecker, error) {
+	if len(c.GetExpectedAnswer()) == 0 {
+		return nil, errors.New("propagation_check: at least one expected_answer is required")
+	}
+
+	pc := &propagationChecker{
+		now:   time.Now,
+		state: make(map[string]*propagationState),
+	}
+	for _, r := range c.GetResolver() {
+		if _, _, err := net.SplitHostPort(r); err != nil {
+			r = net.JoinHostPort(r, strconv.Itoa(defaultPort))
+		}
+		pc.resolvers = append(pc.resolvers, r)
+	}
+
+	for _, a := range c.GetExpectedAnswer() {
+		pc.expected = append(pc.expected, strings.TrimSpace(a))
+	}
+	slices.Sort(pc.expected)
+	pc.expected = slices.Compact(pc.expected)
+	return pc, nil
+}
+
+// answers returns the sorted and de-duplicated answers of the given type in
+// the response, in presentation format without the name, TTL and type, along
+// with the TTL the response can be cached for. For the responses without any
+// answers, TTL is the negative caching TTL from the SOA record, if present.
+func answers(resp *dns.Msg, qtype uint16) ([]string, time.Duration) {
+	var out []string
+	var ttl uint32
+	ttlSet := false
+	for _, rr := range resp.Answer {
+		hdr := rr.Header()
+		if hdr.Rrtype == dns.TypeRRSIG {
+			continue
+		}
+		if !ttlSet || hdr.Ttl < ttl {
+			ttl, ttlSet = hdr.Ttl, true
+		}
+		if hdr.Rrtype == qtype {
+			out = append(out, strings.TrimPrefix(rr.String(), hdr.String()))
+		}
+	}
+
+	if !ttlSet {
+		for _, rr := range resp.Ns {
+			if soa, ok := rr.(*dns.SOA); ok {
+				ttl = min(soa.Hdr.Ttl, soa.Minttl)
+				break
+			}
+		}
+	}
+
+	slices.Sort(out)
+	return slices.Compact(out), time.Duration(ttl) * time.Second
+}
+
+// checkPropagation queries the target and the propagation_check resolvers
+// concurrently, and updates the target's propagation state.
+func (p *Probe) checkPropagation(fullTarget, target string) *propagationResult {
+	pc := p.propagation
+	resolvers := append([]string{fullTarget}, pc.resolvers...)
+
+	converged := make([]bool, len(resolvers))
+	staleTTL := make([]time.Duration, len(resolvers))
+	var wg sync.WaitGroup
+	for i, resolver := range resolvers {
+		wg.Add(1)
+		go func(i int, resolver string) {
+			defer wg.Done()
+
+			resp, _, err := p.client.Exchange(p.newQuery(), resolver)
+			if err != nil || (resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError) {
+				p.l.Warningf("Target(%s): propagation check: error querying resolver %s, err: %v, resp: %v", target, resolver, err, resp)
+				staleTTL[i] = -1
+				return
+			}
+			got, ttl := answers(resp, p.queryType)
+			if slices.Equal(got, pc.expected) {
+				converged[i] = true
+				return
+			}
+			p.l.Debugf("Target(%s): propagation check: resolver %s not converged yet, got: %v, ttl: %s", target, resolver, got, ttl)
+			staleTTL[i] = ttl
+		}(i, resolver)
+	}
+	wg.Wait()
+
+	now := pc.now()
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	st := pc.state[target]
+	if st == nil {
+		st = &propagationState{}
+		pc.state[target] = st
+	}
+
+	result := &propagationResult{}
+	numConverged := 0
+	for i := range resolvers {
+		if converged[i] {
+			numConverged++
+		} else if staleTTL[i] > result.maxStaleTTL {
+			result.maxStaleTTL = staleTTL[i]
+		}
+	}
+	result.convergedFraction = float64(numConverged) / float64(len(resolvers))
+
+	if !st.propagated {
+		switch {
+		case numConverged == 0:
+			// Change is not visible yet, or has been rolled back.
+			st.started, st.staleUntil = time.Time{}, nil
+		case st.started.IsZero():
+			st.started, st.staleUntil = now, make(map[string]time.Time)
+		}
+
+		if numConverged == len(resolvers) {
+			// If all resolvers have converged in the very first run, we
+			// didn't see the propagation.
+			if st.seen {
+				st.timeToFull, st.timeToFullKnown = now.Sub(st.started), true
+			}
+			st.propagated, st.staleUntil = true, nil
+		}
+
+		for i, resolver := range resolvers {
+			if converged[i] || staleTTL[i] < 0 || st.staleUntil == nil {
+				continue
+			}
+			expiry, ok := st.staleUntil[resolver]
+			if !ok {
+				st.staleUntil[resolver] = now.Add(staleTTL[i])
+				continue
+			}
+			if now.After(expiry) {
+				p.l.Warningf("Target(%s): propagation check: resolver %s still stale past its TTL (expired at %s)", target, resolver, expiry)
+				result.overdue = append(result.overdue, resolver)
+			}
+		}
+	}
+	st.seen = true
+
+	result.timeToFull, result.timeToFullKnown = st.timeToFull, st.timeToFullKnown
+	return result
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/statskeeper"
+	configpb "github.com/cloudprober/cloudprober/probes/dns/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	oldIP = "192.168.0.1"
+	newIP = "192.168.0.2"
+)
+
+// propagationMockClient answers with oldIP until the resolver's convergence
+// time, and with newIP after that. Resolvers that are not configured return
+// an error.
+type propagationMockClient struct {
+	now        *time.Time
+	convergeAt map[string]time.Time
+	staleTTL   uint32
+}
+
+func (mc *propagationMockClient) Exchange(in *dns.Msg, fullTarget string) (*dns.Msg, time.Duration, error) {
+	convergeAt, ok := mc.convergeAt[fullTarget]
+	if !ok {
+		return nil, 0, fmt.Errorf("no route to %s", fullTarget)
+	}
+
+	ip, ttl := oldIP, mc.staleTTL
+	if !mc.now.Before(convergeAt) {
+		ip, ttl = newIP, 300
+	}
+
+	out := &dns.Msg{}
+	out.SetReply(in)
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d IN A %s", in.Question[0].Name, ttl, ip))
+	if err != nil {
+		return nil, 0, err
+	}
+	out.Answer = []dns.RR{rr}
+	return out, time.Millisecond, nil
+}
+func (*propagationMockClient) setReadTimeout(time.Duration)  {}
+func (*propagationMockClient) setSourceIP(net.IP)            {}
+func (*propagationMockClient) setDNSProto(configpb.DNSProto) {}
+
+func TestPropagationCheck(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type step struct {
+		at                time.Duration
+		wantFraction      float64
+		wantMaxStaleTTL   time.Duration
+		wantOverdue       []string
+		wantTimeToFull    time.Duration
+		wantTimeToFullSet bool
+	}
+
+	tests := []struct {
+		name       string
+		convergeAt map[string]time.Duration
+		steps      []step
+	}{
+		{
+			name: "converge_at_different_times",
+			convergeAt: map[string]time.Duration{
+				"8.8.8.8:53": time.Minute,
+				"1.1.1.1:53": 2 * time.Minute,
+				"9.9.9.9:53": 5 * time.Minute,
+			},
+			steps: []step{
+				{at: 0, wantFraction: 0, wantMaxStaleTTL: 2 * time.Minute},
+				{at: time.Minute, wantFraction: 1.0 / 3, wantMaxStaleTTL: 2 * time.Minute},
+				{at: 2 * time.Minute, wantFraction: 2.0 / 3, wantMaxStaleTTL: 2 * time.Minute},
+				// Stale answers first seen at 1m expire at 3m.
+				{at: 3 * time.Minute, wantFraction: 2.0 / 3, wantMaxStaleTTL: 2 * time.Minute},
+				{at: 4 * time.Minute, wantFraction: 2.0 / 3, wantMaxStaleTTL: 2 * time.Minute, wantOverdue: []string{"9.9.9.9:53"}},
+				{at: 5 * time.Minute, wantFraction: 1, wantTimeToFull: 4 * time.Minute, wantTimeToFullSet: true},
+				// Time to full propagation stays put afterwards.
+				{at: 6 * time.Minute, wantFraction: 1, wantTimeToFull: 4 * time.Minute, wantTimeToFullSet: true},
+			},
+		},
+		{
+			name: "already_propagated",
+			convergeAt: map[string]time.Duration{
+				"8.8.8.8:53": 0,
+				"1.1.1.1:53": 0,
+				"9.9.9.9:53": 0,
+			},
+			steps: []step{
+				{at: 0, wantFraction: 1},
+				{at: time.Minute, wantFraction: 1},
+			},
+		},
+		{
+			name: "propagated_within_an_interval",
+			convergeAt: map[string]time.Duration{
+				"8.8.8.8:53": time.Minute,
+				"1.1.1.1:53": time.Minute,
+				"9.9.9.9:53": time.Minute,
+			},
+			steps: []step{
+				{at: 0, wantFraction: 0, wantMaxStaleTTL: 2 * time.Minute},
+				{at: time.Minute, wantFraction: 1, wantTimeToFullSet: true},
+			},
+		},
+		{
+			name: "resolver_error",
+			convergeAt: map[string]time.Duration{
+				"8.8.8.8:53": 0,
+				"1.1.1.1:53": 0,
+			},
+			steps: []step{
+				{at: 0, wantFraction: 2.0 / 3},
+				{at: 10 * time.Minute, wantFraction: 2.0 / 3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Probe{}
+			opts := &options.Options{
+				Targets:  targets.StaticTargets("8.8.8.8"),
+				Interval: 2 * time.Second,
+				Timeout:  time.Second,
+				ProbeConf: &configpb.ProbeConf{
+					ResolvedDomain: proto.String("www.example.com"),
+					QueryType:      configpb.QueryType_A.Enum(),
+					PropagationCheck: &configpb.PropagationCheck{
+						Resolver:       []string{"1.1.1.1", "9.9.9.9:53"},
+						ExpectedAnswer: []string{newIP},
+					},
+				},
+			}
+			if err := p.Init("dns_propagation_test", opts); err != nil {
+				t.Fatalf("Error creating probe: %v", err)
+			}
+
+			now := t0
+			mc := &propagationMockClient{
+				now:        &now,
+				convergeAt: make(map[string]time.Time),
+				staleTTL:   120,
+			}
+			for r, d := range test.convergeAt {
+				mc.convergeAt[r] = t0.Add(d)
+			}
+			p.client = mc
+			p.propagation.now = func() time.Time { return now }
+			p.targets = p.opts.Targets.ListEndpoints()
+
+			for _, s := range test.steps {
+				now = t0.Add(s.at)
+
+				resultsChan := make(chan statskeeper.ProbeResult, len(p.targets))
+				p.runProbe(resultsChan)
+				result := (<-resultsChan).(probeRunResult)
+
+				// Propagation doesn't affect the probe's success.
+				assert.Equal(t, int64(1), result.success.Int64(), "success at %s", s.at)
+				assert.ElementsMatch(t, s.wantOverdue, result.propagationOverdue.Keys(), "overdue at %s", s.at)
+				assert.NotNil(t, result.Metrics().Metric("dns_propagation_overdue"))
+
+				em := result.GaugeMetrics()
+				if em == nil {
+					t.Fatalf("no gauge metrics at %s", s.at)
+				}
+				assert.InDelta(t, s.wantFraction, em.Metric("dns_propagation_converged_fraction").(metrics.NumValue).Float64(), 1e-9, "fraction at %s", s.at)
+				assert.Equal(t, s.wantMaxStaleTTL.Seconds(), em.Metric("dns_propagation_max_stale_ttl_seconds").(metrics.NumValue).Float64(), "max stale TTL at %s", s.at)
+
+				ttf := em.Metric("dns_time_to_full_propagation_seconds")
+				if !s.wantTimeToFullSet {
+					assert.Nil(t, ttf, "time to full propagation at %s", s.at)
+					continue
+				}
+				if assert.NotNil(t, ttf, "time to full propagation at %s", s.at) {
+					assert.Equal(t, s.wantTimeToFull.Seconds(), ttf.(metrics.NumValue).Float64(), "time to full propagation at %s", s.at)
+				}
+			}
+		})
+	}
+}
+
+func TestPropagationAnswers(t *testing.T) {
+	newMsg := func(rrs ...string) *dns.Msg {
+		m := &dns.Msg{}
+		for _, s := range rrs {
+			rr, err := dns.NewRR(s)
+			if err != nil {
+				t.Fatalf("error parsing RR %q: %v", s, err)
+			}
+			if _, ok := rr.(*dns.SOA); ok {
+				m.Ns = append(m.Ns, rr)
+				continue
+			}
+			m.Answer = append(m.Answer, rr)
+		}
+		return m
+	}
+
+	tests := []struct {
+		name        string
+		msg         *dns.Msg
+		qtype       uint16
+		wantAnswers []string
+		wantTTL     time.Duration
+	}{
+		{
+			name:        "sorted_deduplicated",
+			msg:         newMsg("a.example.com. 300 IN A 10.0.0.2", "a.example.com. 60 IN A 10.0.0.1", "a.example.com. 300 IN A 10.0.0.2"),
+			qtype:       dns.TypeA,
+			wantAnswers: []string{"10.0.0.1", "10.0.0.2"},
+			wantTTL:     time.Minute,
+		},
+		{
+			name:        "cname_chain",
+			msg:         newMsg("a.example.com. 30 IN CNAME b.example.com.", "b.example.com. 300 IN A 10.0.0.1"),
+			qtype:       dns.TypeA,
+			wantAnswers: []string{"10.0.0.1"},
+			wantTTL:     30 * time.Second,
+		},
+		{
+			name:        "txt",
+			msg:         newMsg(`a.example.com. 300 IN TXT "v=spf1 -all"`),
+			qtype:       dns.TypeTXT,
+			wantAnswers: []string{`"v=spf1 -all"`},
+			wantTTL:     5 * time.Minute,
+		},
+		{
+			name:    "negative_caching",
+			msg:     newMsg("example.com. 3600 IN SOA ns.example.com. admin.example.com. 1 7200 3600 1209600 900"),
+			qtype:   dns.TypeA,
+			wantTTL: 15 * time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ttl := answers(test.msg, test.qtype)
+			assert.Equal(t, test.wantAnswers, got)
+			assert.Equal(t, test.wantTTL, ttl)
+		})
+	}
+}
+
+func TestNewPropagationCheckerErrors(t *testing.T) {
+	_, err := newPropagationChecker(&configpb.PropagationCheck{Resolver: []string{"1.1.1.1"}})
+	assert.Error(t, err)
+}
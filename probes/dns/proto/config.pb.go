@@ -250,6 +250,200 @@ func (DNSProto) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{1}
 }
 
+// ConsistencyCheck compares the answers from the probe's target with the
+// answers from other resolvers, e.g. to make sure that a globally-distributed
+// record is consistent across regions.
+type ConsistencyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resolvers to compare the target's answers with, in host[:port] format,
+	// e.g. "8.8.8.8" or "1.1.1.1:53".
+	Resolver []string `protobuf:"bytes,1,rep,name=resolver" json:"resolver,omitempty"`
+	// Regexes for the answers that are expected to vary across resolvers, e.g.
+	// geo-specific IPs. Answers are matched in their presentation format,
+	// without name and TTL, e.g. "A 192.168.0.1", and matching answers are left
+	// out of the comparison.
+	AllowedVariance []string `protobuf:"bytes,2,rep,name=allowed_variance,json=allowedVariance" json:"allowed_variance,omitempty"`
+}
+
+func (x *ConsistencyCheck) Reset() {
+	*x = ConsistencyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyCheck) ProtoMessage() {}
+
+func (x *ConsistencyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyCheck.ProtoReflect.Descriptor instead.
+func (*ConsistencyCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConsistencyCheck) GetResolver() []string {
+	if x != nil {
+		return x.Resolver
+	}
+	return nil
+}
+
+func (x *ConsistencyCheck) GetAllowedVariance() []string {
+	if x != nil {
+		return x.AllowedVariance
+	}
+	return nil
+}
+
+// CacheCheck measures the cold (uncached) resolution latency of a recursive
+// resolver, to compare it with the warm (cached) latency of resolved_domain.
+type CacheCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Domain under which random subdomains are queried for the cold latency.
+	// A new random label is prepended for each query, e.g.
+	// "cp-3f9a1c2e7b4d5a60.example.com.", so that the name is unlikely to be in
+	// the resolver's cache. Default is resolved_domain.
+	ColdDomain *string `protobuf:"bytes,1,opt,name=cold_domain,json=coldDomain" json:"cold_domain,omitempty"`
+}
+
+func (x *CacheCheck) Reset() {
+	*x = CacheCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheCheck) ProtoMessage() {}
+
+func (x *CacheCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheCheck.ProtoReflect.Descriptor instead.
+func (*CacheCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CacheCheck) GetColdDomain() string {
+	if x != nil && x.ColdDomain != nil {
+		return *x.ColdDomain
+	}
+	return ""
+}
+
+// PropagationCheck monitors the propagation of a DNS change across resolvers.
+// In every run, the target and the propagation_check resolvers are queried,
+// and a resolver is considered converged if its answers match the
+// expected_answer set exactly.
+//
+// Propagation is considered started when the expected answers are first seen
+// at some resolver, and complete once all resolvers have converged in a run.
+// The time between the two is exported as the
+// "dns_time_to_full_propagation_seconds" gauge. If all resolvers have already
+// converged when the probe starts, no propagation time is reported.
+//
+// Resolvers still serving the stale answers are reasonably expected to
+// converge once their cached answers expire. The largest remaining TTL of the
+// stale answers is exported as the "dns_propagation_max_stale_ttl_seconds"
+// gauge, and resolvers that are still stale past the TTL they reported when
+// first seen stale during the propagation are counted in the
+// "dns_propagation_overdue" map, keyed by resolver.
+type PropagationCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resolvers to check the propagation on, in host[:port] format, e.g.
+	// "8.8.8.8" or "1.1.1.1:53". The probe's target is always checked.
+	Resolver []string `protobuf:"bytes,1,rep,name=resolver" json:"resolver,omitempty"`
+	// Expected answers, in their presentation format without the name, TTL and
+	// type, e.g. "192.168.0.1" for A records, or "\"v=spf1 -all\"" for TXT
+	// records. Only the answers of the query_type are compared.
+	ExpectedAnswer []string `protobuf:"bytes,2,rep,name=expected_answer,json=expectedAnswer" json:"expected_answer,omitempty"`
+}
+
+func (x *PropagationCheck) Reset() {
+	*x = PropagationCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropagationCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropagationCheck) ProtoMessage() {}
+
+func (x *PropagationCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropagationCheck.ProtoReflect.Descriptor instead.
+func (*PropagationCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PropagationCheck) GetResolver() []string {
+	if x != nil {
+		return x.Resolver
+	}
+	return nil
+}
+
+func (x *PropagationCheck) GetExpectedAnswer() []string {
+	if x != nil {
+		return x.ExpectedAnswer
+	}
+	return nil
+}
+
 type ProbeConf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -268,6 +462,44 @@ type ProbeConf struct {
 	// default we resolve first if it's a discovered resource, e.g., a k8s
 	// endpoint.
 	ResolveFirst *bool `protobuf:"varint,5,opt,name=resolve_first,json=resolveFirst" json:"resolve_first,omitempty"`
+	// Whether to request and validate DNSSEC signatures. If enabled, queries are
+	// sent with the DNSSEC OK (DO) bit set, and the RRSIG records in the answer
+	// are validated along the chain of trust: signer zone's DNSKEY RRset must
+	// be signed by a key that matches the zone's DS records, DS records must be
+	// signed by the parent zone's keys, and so on, up to a zone that has a
+	// trust anchor (see dnssec_trust_anchor). DNSKEY and DS records are queried
+	// from the target, and validated keys are cached per zone for their TTL.
+	//
+	// Responses without any RRSIG records are considered unsigned, and are
+	// counted as failures in a separate "dnssec_unsigned" counter. Successfully
+	// validated responses are counted in the "dnssec_valid" counter.
+	Dnssec *bool `protobuf:"varint,6,opt,name=dnssec" json:"dnssec,omitempty"`
+	// DNSSEC trust anchors, as DS records in the presentation format. Chain of
+	// trust is followed up to the first zone that has a trust anchor. Default
+	// is the root zone's KSK-2017:
+	//   dnssec_trust_anchor: ". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+	DnssecTrustAnchor []string `protobuf:"bytes,10,rep,name=dnssec_trust_anchor,json=dnssecTrustAnchor" json:"dnssec_trust_anchor,omitempty"`
+	// If configured, answers from each target are compared with the answers
+	// from the consistency_check resolvers. Probe succeeds only if all answer
+	// sets match. Consistent runs are counted in the "dns_consistency" counter,
+	// and divergent resolvers (including the ones that fail to answer) are
+	// counted in the "dns_divergent_resolvers" map, keyed by resolver.
+	ConsistencyCheck *ConsistencyCheck `protobuf:"bytes,7,opt,name=consistency_check,json=consistencyCheck" json:"consistency_check,omitempty"`
+	// If configured, each request to resolved_domain is preceded by a query for
+	// a random, likely uncached, subdomain. Latency of the random subdomain
+	// queries is exported as the "cold_latency" metric (with "cold_" prefixed
+	// to the probe's latency metric name), while the regular latency metric
+	// reflects the warm (cached) resolution of resolved_domain. Both NOERROR and
+	// NXDOMAIN are expected responses for the random subdomains. Cold queries
+	// are counted in the "cold_total" and "cold_success" counters; they don't
+	// affect the probe's total and success.
+	CacheCheck *CacheCheck `protobuf:"bytes,8,opt,name=cache_check,json=cacheCheck" json:"cache_check,omitempty"`
+	// If configured, the probe also tracks the propagation of the
+	// expected_answer across the target and the propagation_check resolvers.
+	// Fraction of the converged resolvers is exported as the
+	// "dns_propagation_converged_fraction" gauge. Propagation doesn't affect the
+	// probe's success.
+	PropagationCheck *PropagationCheck `protobuf:"bytes,9,opt,name=propagation_check,json=propagationCheck" json:"propagation_check,omitempty"`
 	// Which DNS protocol is used for resolution.
 	DnsProto *DNSProto `protobuf:"varint,97,opt,name=dns_proto,json=dnsProto,enum=cloudprober.probes.dns.DNSProto,def=0" json:"dns_proto,omitempty"`
 	// Requests per probe.
@@ -299,7 +531,7 @@ const (
 func (x *ProbeConf) Reset() {
 	*x = ProbeConf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[0]
+		mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +544,7 @@ func (x *ProbeConf) String() string {
 func (*ProbeConf) ProtoMessage() {}
 
 func (x *ProbeConf) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[0]
+	mi := &file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +557,7 @@ func (x *ProbeConf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProbeConf.ProtoReflect.Descriptor instead.
 func (*ProbeConf) Descriptor() ([]byte, []int) {
-	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{0}
+	return file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ProbeConf) GetResolvedDomain() string {
@@ -356,6 +588,41 @@ func (x *ProbeConf) GetResolveFirst() bool {
 	return false
 }
 
+func (x *ProbeConf) GetDnssec() bool {
+	if x != nil && x.Dnssec != nil {
+		return *x.Dnssec
+	}
+	return false
+}
+
+func (x *ProbeConf) GetDnssecTrustAnchor() []string {
+	if x != nil {
+		return x.DnssecTrustAnchor
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetConsistencyCheck() *ConsistencyCheck {
+	if x != nil {
+		return x.ConsistencyCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetCacheCheck() *CacheCheck {
+	if x != nil {
+		return x.CacheCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetPropagationCheck() *PropagationCheck {
+	if x != nil {
+		return x.PropagationCheck
+	}
+	return nil
+}
+
 func (x *ProbeConf) GetDnsProto() DNSProto {
 	if x != nil && x.DnsProto != nil {
 		return *x.DnsProto
@@ -385,64 +652,98 @@ var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDes
 	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x16, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x22, 0x82, 0x03, 0x0a, 0x09, 0x50,
-	0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x38, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x3a, 0x0f, 0x77, 0x77, 0x77, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2e, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x02, 0x4d, 0x58, 0x52, 0x09, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f,
-	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x3a, 0x01, 0x30,
-	0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x72, 0x73,
-	0x74, 0x12, 0x42, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x61,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x44, 0x4e,
-	0x53, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x03, 0x55, 0x44, 0x50, 0x52, 0x08, 0x64, 0x6e, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2f, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x62, 0x20, 0x01, 0x28,
-	0x05, 0x3a, 0x01, 0x31, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x2a,
-	0xa4, 0x03, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a,
-	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x05, 0x0a, 0x01, 0x41, 0x10, 0x01, 0x12, 0x06,
-	0x0a, 0x02, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x10,
-	0x05, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x4f, 0x41, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x54,
-	0x52, 0x10, 0x0c, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x58, 0x10, 0x0f, 0x12, 0x07, 0x0a, 0x03, 0x54,
-	0x58, 0x54, 0x10, 0x10, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x50, 0x10, 0x11, 0x12, 0x09, 0x0a, 0x05,
-	0x41, 0x46, 0x53, 0x44, 0x42, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x49, 0x47, 0x10, 0x18,
-	0x12, 0x07, 0x0a, 0x03, 0x4b, 0x45, 0x59, 0x10, 0x19, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x41, 0x41,
-	0x41, 0x10, 0x1c, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x43, 0x10, 0x1d, 0x12, 0x07, 0x0a, 0x03,
-	0x53, 0x52, 0x56, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x41, 0x50, 0x54, 0x52, 0x10, 0x23,
-	0x12, 0x06, 0x0a, 0x02, 0x4b, 0x58, 0x10, 0x24, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x45, 0x52, 0x54,
-	0x10, 0x25, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x27, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x50, 0x4c, 0x10, 0x2a, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x53, 0x10, 0x2b, 0x12, 0x09,
-	0x0a, 0x05, 0x53, 0x53, 0x48, 0x46, 0x50, 0x10, 0x2c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x50, 0x53,
-	0x45, 0x43, 0x4b, 0x45, 0x59, 0x10, 0x2d, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x52, 0x53, 0x49, 0x47,
-	0x10, 0x2e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x53, 0x45, 0x43, 0x10, 0x2f, 0x12, 0x0a, 0x0a, 0x06,
-	0x44, 0x4e, 0x53, 0x4b, 0x45, 0x59, 0x10, 0x30, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x48, 0x43, 0x49,
-	0x44, 0x10, 0x31, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x53, 0x45, 0x43, 0x33, 0x10, 0x32, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4e, 0x53, 0x45, 0x43, 0x33, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x10, 0x33, 0x12, 0x08,
-	0x0a, 0x04, 0x54, 0x4c, 0x53, 0x41, 0x10, 0x34, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x49, 0x50, 0x10,
-	0x37, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x44, 0x53, 0x10, 0x3b, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x44,
-	0x4e, 0x53, 0x4b, 0x45, 0x59, 0x10, 0x3c, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x50,
-	0x47, 0x50, 0x4b, 0x45, 0x59, 0x10, 0x3d, 0x12, 0x09, 0x0a, 0x04, 0x54, 0x4b, 0x45, 0x59, 0x10,
-	0xf9, 0x01, 0x12, 0x09, 0x0a, 0x04, 0x54, 0x53, 0x49, 0x47, 0x10, 0xfa, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x55, 0x52, 0x49, 0x10, 0x80, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x43, 0x41, 0x41, 0x10, 0x81,
-	0x02, 0x12, 0x08, 0x0a, 0x02, 0x54, 0x41, 0x10, 0x80, 0x80, 0x02, 0x12, 0x09, 0x0a, 0x03, 0x44,
-	0x4c, 0x56, 0x10, 0x81, 0x80, 0x02, 0x2a, 0x29, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54,
-	0x43, 0x50, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x43, 0x50, 0x5f, 0x54, 0x4c, 0x53, 0x10,
-	0x02, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x64,
-	0x6e, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x64, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0x57, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0xbd, 0x05,
+	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x38, 0x0a, 0x0f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x3a, 0x0f, 0x77, 0x77, 0x77, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64,
+	0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x02, 0x4d, 0x58,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x6d,
+	0x69, 0x6e, 0x5f, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x3a, 0x01, 0x30, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x12, 0x2e, 0x0a, 0x13,
+	0x64, 0x6e, 0x73, 0x73, 0x65, 0x63, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6e, 0x73, 0x73, 0x65,
+	0x63, 0x54, 0x72, 0x75, 0x73, 0x74, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x11,
+	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e,
+	0x73, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x55, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70,
+	0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x42, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x18, 0x61, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x44, 0x4e, 0x53, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x03, 0x55, 0x44, 0x50, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x2f, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x62, 0x20, 0x01, 0x28, 0x05, 0x3a,
+	0x01, 0x31, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x63,
+	0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x2a, 0xa4, 0x03,
+	0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x05, 0x0a, 0x01, 0x41, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02,
+	0x4e, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12,
+	0x07, 0x0a, 0x03, 0x53, 0x4f, 0x41, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x54, 0x52, 0x10,
+	0x0c, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x58, 0x10, 0x0f, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x58, 0x54,
+	0x10, 0x10, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x50, 0x10, 0x11, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x46,
+	0x53, 0x44, 0x42, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x49, 0x47, 0x10, 0x18, 0x12, 0x07,
+	0x0a, 0x03, 0x4b, 0x45, 0x59, 0x10, 0x19, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x41, 0x41, 0x41, 0x10,
+	0x1c, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x43, 0x10, 0x1d, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x52,
+	0x56, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x41, 0x50, 0x54, 0x52, 0x10, 0x23, 0x12, 0x06,
+	0x0a, 0x02, 0x4b, 0x58, 0x10, 0x24, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x45, 0x52, 0x54, 0x10, 0x25,
+	0x12, 0x09, 0x0a, 0x05, 0x44, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x27, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x50, 0x4c, 0x10, 0x2a, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x53, 0x10, 0x2b, 0x12, 0x09, 0x0a, 0x05,
+	0x53, 0x53, 0x48, 0x46, 0x50, 0x10, 0x2c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x50, 0x53, 0x45, 0x43,
+	0x4b, 0x45, 0x59, 0x10, 0x2d, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x52, 0x53, 0x49, 0x47, 0x10, 0x2e,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x53, 0x45, 0x43, 0x10, 0x2f, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x4e,
+	0x53, 0x4b, 0x45, 0x59, 0x10, 0x30, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x48, 0x43, 0x49, 0x44, 0x10,
+	0x31, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x53, 0x45, 0x43, 0x33, 0x10, 0x32, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4e, 0x53, 0x45, 0x43, 0x33, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x10, 0x33, 0x12, 0x08, 0x0a, 0x04,
+	0x54, 0x4c, 0x53, 0x41, 0x10, 0x34, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x49, 0x50, 0x10, 0x37, 0x12,
+	0x07, 0x0a, 0x03, 0x43, 0x44, 0x53, 0x10, 0x3b, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x44, 0x4e, 0x53,
+	0x4b, 0x45, 0x59, 0x10, 0x3c, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x50, 0x47, 0x50,
+	0x4b, 0x45, 0x59, 0x10, 0x3d, 0x12, 0x09, 0x0a, 0x04, 0x54, 0x4b, 0x45, 0x59, 0x10, 0xf9, 0x01,
+	0x12, 0x09, 0x0a, 0x04, 0x54, 0x53, 0x49, 0x47, 0x10, 0xfa, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55,
+	0x52, 0x49, 0x10, 0x80, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x43, 0x41, 0x41, 0x10, 0x81, 0x02, 0x12,
+	0x08, 0x0a, 0x02, 0x54, 0x41, 0x10, 0x80, 0x80, 0x02, 0x12, 0x09, 0x0a, 0x03, 0x44, 0x4c, 0x56,
+	0x10, 0x81, 0x80, 0x02, 0x2a, 0x29, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x43, 0x50, 0x5f, 0x54, 0x4c, 0x53, 0x10, 0x02, 0x42,
+	0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x64, 0x6e, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -458,20 +759,26 @@ func file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDe
 }
 
 var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_goTypes = []any{
-	(QueryType)(0),    // 0: cloudprober.probes.dns.QueryType
-	(DNSProto)(0),     // 1: cloudprober.probes.dns.DNSProto
-	(*ProbeConf)(nil), // 2: cloudprober.probes.dns.ProbeConf
+	(QueryType)(0),           // 0: cloudprober.probes.dns.QueryType
+	(DNSProto)(0),            // 1: cloudprober.probes.dns.DNSProto
+	(*ConsistencyCheck)(nil), // 2: cloudprober.probes.dns.ConsistencyCheck
+	(*CacheCheck)(nil),       // 3: cloudprober.probes.dns.CacheCheck
+	(*PropagationCheck)(nil), // 4: cloudprober.probes.dns.PropagationCheck
+	(*ProbeConf)(nil),        // 5: cloudprober.probes.dns.ProbeConf
 }
 var file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_depIdxs = []int32{
 	0, // 0: cloudprober.probes.dns.ProbeConf.query_type:type_name -> cloudprober.probes.dns.QueryType
-	1, // 1: cloudprober.probes.dns.ProbeConf.dns_proto:type_name -> cloudprober.probes.dns.DNSProto
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 1: cloudprober.probes.dns.ProbeConf.consistency_check:type_name -> cloudprober.probes.dns.ConsistencyCheck
+	3, // 2: cloudprober.probes.dns.ProbeConf.cache_check:type_name -> cloudprober.probes.dns.CacheCheck
+	4, // 3: cloudprober.probes.dns.ProbeConf.propagation_check:type_name -> cloudprober.probes.dns.PropagationCheck
+	1, // 4: cloudprober.probes.dns.ProbeConf.dns_proto:type_name -> cloudprober.probes.dns.DNSProto
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_init() }
@@ -481,6 +788,42 @@ func file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_init(
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsistencyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CacheCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PropagationCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*ProbeConf); i {
 			case 0:
 				return &v.state
@@ -499,7 +842,7 @@ func file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_init(
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_probes_dns_proto_config_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
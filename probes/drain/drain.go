@@ -0,0 +1,244 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drain implements a probe type that verifies that a service drains
+// the in-flight requests on shutdown.
+package drain
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/drain/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	scheme    string
+	tlsConfig *tls.Config
+}
+
+type probeResult struct {
+	total, success     int64
+	latency            metrics.LatencyValue
+	inflightDropped    int64
+	newRequestAccepted int64
+	shutdownErrors     int64
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("inflight_dropped", metrics.NewInt(result.inflightDropped)).
+		AddMetric("new_request_accepted", metrics.NewInt(result.newRequestAccepted)).
+		AddMetric("shutdown_errors", metrics.NewInt(result.shutdownErrors)).
+		AddLabel("ptype", "drain")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not drain probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	for _, path := range []string{p.c.GetInflightPath(), p.c.GetShutdownPath(), p.c.GetNewRequestPath()} {
+		if path != "" && !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("request path should start with a /, got: %s", path)
+		}
+	}
+
+	if p.c.GetShutdownDelayMsec() < 0 || p.c.GetNewRequestDelayMsec() < 0 {
+		return fmt.Errorf("shutdown_delay_msec (%d) and new_request_delay_msec (%d) can't be negative", p.c.GetShutdownDelayMsec(), p.c.GetNewRequestDelayMsec())
+	}
+
+	p.scheme = strings.ToLower(p.c.GetScheme().String())
+
+	if p.c.GetTlsConfig() != nil {
+		p.tlsConfig = &tls.Config{}
+		if err := tlsconfig.UpdateTLSConfig(p.tlsConfig, p.c.GetTlsConfig()); err != nil {
+			return fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// newClient returns a new HTTP client that doesn't share connections with
+// the other clients, so that each request of the sequence is sent on its
+// own connection.
+func (p *Probe) newClient() *http.Client {
+	t := &http.Transport{
+		Proxy:             http.ProxyFromEnvironment,
+		DisableKeepAlives: true,
+		TLSClientConfig:   p.tlsConfig,
+	}
+	if p.opts.SourceIP != nil {
+		t.DialContext = (&net.Dialer{LocalAddr: &net.TCPAddr{IP: p.opts.SourceIP}}).DialContext
+	}
+	return &http.Client{Transport: t}
+}
+
+func (p *Probe) url(target endpoint.Endpoint, port int, path string) string {
+	if port == 0 {
+		port = int(p.c.GetPort())
+	}
+	if port == 0 {
+		port = target.Port
+	}
+	host := target.Name
+	if port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return p.scheme + "://" + host + path
+}
+
+// do sends the request and returns the status code, after reading the whole
+// response body.
+func do(ctx context.Context, client *http.Client, method, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return resp.StatusCode, err
+	}
+	return resp.StatusCode, nil
+}
+
+func isSuccess(code int) bool {
+	return code >= 200 && code < 300
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	type inflightResult struct {
+		code int
+		err  error
+		end  time.Time
+	}
+	inflightC := make(chan inflightResult, 1)
+	go func() {
+		code, err := do(ctx, p.newClient(), http.MethodGet, p.url(target, 0, p.c.GetInflightPath()))
+		inflightC <- inflightResult{code, err, time.Now()}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return
+	case r := <-inflightC:
+		// In-flight request should still be in-flight.
+		p.l.Warningf("Target: %s, in-flight request finished before the shutdown (code: %d, err: %v), increase its duration or decrease shutdown_delay_msec", target.Name, r.code, r.err)
+		return
+	case <-time.After(time.Duration(p.c.GetShutdownDelayMsec()) * time.Millisecond):
+	}
+
+	shutdownStart := time.Now()
+	code, err := do(ctx, p.newClient(), p.c.GetShutdownMethod(), p.url(target, int(p.c.GetAdminPort()), p.c.GetShutdownPath()))
+	if err != nil || !isSuccess(code) {
+		p.l.Warningf("Target: %s, shutdown request failed, code: %d, err: %v", target.Name, code, err)
+		result.shutdownErrors++
+		return
+	}
+
+	failed := false
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Duration(p.c.GetNewRequestDelayMsec()) * time.Millisecond):
+	}
+	code, err = do(ctx, p.newClient(), http.MethodGet, p.url(target, 0, p.c.GetNewRequestPath()))
+	if err == nil && code != http.StatusServiceUnavailable {
+		p.l.Warningf("Target: %s, new request was accepted after the shutdown, code: %d", target.Name, code)
+		result.newRequestAccepted++
+		failed = true
+	}
+
+	r := <-inflightC
+	if r.err != nil || !isSuccess(r.code) {
+		p.l.Warningf("Target: %s, in-flight request was dropped during the shutdown, code: %d, err: %v", target.Name, r.code, r.err)
+		result.inflightDropped++
+		return
+	}
+
+	if failed {
+		return
+	}
+	result.success++
+	result.latency.AddFloat64(r.end.Sub(shutdownStart).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/drain/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type shutdownMode int
+
+const (
+	// Graceful shutdown: stop accepting connections, drain in-flight requests.
+	graceful shutdownMode = iota
+	// Keep accepting connections, but answer new requests with a 503.
+	draining503
+	// Close all connections immediately.
+	abrupt
+	// Ignore the shutdown request.
+	ignore
+)
+
+// startStubService starts a service with a slow endpoint (/slow), and an
+// admin endpoint (/shutdown) that shuts the service down as per the mode.
+func startStubService(t *testing.T, mode shutdownMode) endpoint.Endpoint {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var draining atomic.Bool
+	srv := &http.Server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.Write([]byte("done"))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		if draining.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc("/shutdown", func(w http.ResponseWriter, r *http.Request) {
+		switch mode {
+		case graceful:
+			go srv.Shutdown(context.Background())
+		case draining503:
+			draining.Store(true)
+		case abrupt:
+			go srv.Close()
+		}
+	})
+	srv.Handler = mux
+
+	go srv.Serve(ln)
+	t.Cleanup(func() { srv.Close() })
+
+	return endpoint.Endpoint{Name: "127.0.0.1", Port: ln.Addr().(*net.TCPAddr).Port}
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         shutdownMode
+		shutdownPath string
+		wantSuccess  int64
+		wantDropped  int64
+		wantAccepted int64
+		wantErrors   int64
+	}{
+		{
+			name:        "graceful",
+			mode:        graceful,
+			wantSuccess: 1,
+		},
+		{
+			name:        "draining_503",
+			mode:        draining503,
+			wantSuccess: 1,
+		},
+		{
+			name:        "abrupt",
+			mode:        abrupt,
+			wantDropped: 1,
+		},
+		{
+			name:         "ignored",
+			mode:         ignore,
+			wantAccepted: 1,
+		},
+		{
+			name:         "shutdown_error",
+			mode:         graceful,
+			shutdownPath: "/no-such-admin",
+			wantErrors:   1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			target := startStubService(t, test.mode)
+
+			shutdownPath := test.shutdownPath
+			if shutdownPath == "" {
+				shutdownPath = "/shutdown"
+			}
+
+			opts := options.DefaultOptions()
+			opts.Timeout = 5 * time.Second
+			opts.ProbeConf = &configpb.ProbeConf{
+				InflightPath:        proto.String("/slow"),
+				ShutdownPath:        proto.String(shutdownPath),
+				ShutdownDelayMsec:   proto.Int32(100),
+				NewRequestDelayMsec: proto.Int32(50),
+			}
+
+			p := &Probe{}
+			require.NoError(t, p.Init("drain_test", opts))
+
+			res := p.newResult()
+			p.runProbe(context.Background(), target, res)
+			result := res.(*probeResult)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantDropped, result.inflightDropped, "inflight_dropped")
+			assert.Equal(t, test.wantAccepted, result.newRequestAccepted, "new_request_accepted")
+			assert.Equal(t, test.wantErrors, result.shutdownErrors, "shutdown_errors")
+
+			if test.wantSuccess == 1 {
+				// Drain duration: in-flight request takes 500ms, and the
+				// shutdown is triggered 100ms after it started.
+				drain := time.Duration(result.latency.(*metrics.Float).Float64()) * opts.LatencyUnit
+				assert.Greater(t, drain, 300*time.Millisecond, "drain duration")
+				assert.Less(t, drain, 500*time.Millisecond, "drain duration")
+			}
+		})
+	}
+}
+
+func TestURL(t *testing.T) {
+	p := &Probe{}
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{Scheme: configpb.ProbeConf_HTTPS.Enum()}
+	require.NoError(t, p.Init("drain_test", opts))
+
+	assert.Equal(t, "https://svc.example.com/x", p.url(endpoint.Endpoint{Name: "svc.example.com"}, 0, "/x"))
+	assert.Equal(t, "https://svc.example.com:8443/x", p.url(endpoint.Endpoint{Name: "svc.example.com", Port: 8443}, 0, "/x"))
+	assert.Equal(t, "https://svc.example.com:9000/admin", p.url(endpoint.Endpoint{Name: "svc.example.com", Port: 8443}, 9000, "/admin"))
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package freshness implements a probe type that verifies that a data feed's
+// latest timestamp is recent.
+package freshness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/file"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/freshness/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+const targetPlaceholder = "@target@"
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	jqQuery *gojq.Query
+	re      *regexp.Regexp
+	maxAge  time.Duration
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+type probeResult struct {
+	total, success int64
+	stale          int64
+	latency        metrics.LatencyValue
+
+	// Last data age, valid only if ageKnown is true.
+	ageSec   float64
+	ageKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("stale", metrics.NewInt(result.stale)).
+		AddLabel("ptype", "freshness")
+}
+
+// GaugeMetrics returns the last data age.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.ageKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("data_age_seconds", metrics.NewFloat(result.ageSec)).
+		AddLabel("ptype", "freshness")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not freshness probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetFeedUrl() == "" {
+		return errors.New("feed_url is required")
+	}
+	if p.c.GetMaxAgeSec() <= 0 {
+		return fmt.Errorf("max_age_sec should be positive, got %d", p.c.GetMaxAgeSec())
+	}
+	p.maxAge = time.Duration(p.c.GetMaxAgeSec()) * time.Second
+
+	var err error
+	switch p.c.TimestampExtractor.(type) {
+	case *configpb.ProbeConf_TimestampJqFilter:
+		if p.jqQuery, err = gojq.Parse(p.c.GetTimestampJqFilter()); err != nil {
+			return fmt.Errorf("error parsing timestamp_jq_filter (%s): %v", p.c.GetTimestampJqFilter(), err)
+		}
+	case *configpb.ProbeConf_TimestampRegex:
+		if p.re, err = regexp.Compile(p.c.GetTimestampRegex()); err != nil {
+			return fmt.Errorf("invalid timestamp_regex (%s): %v", p.c.GetTimestampRegex(), err)
+		}
+	}
+
+	if p.now == nil {
+		p.now = time.Now
+	}
+
+	return nil
+}
+
+// extractTimestamp extracts the timestamp string from the feed.
+func (p *Probe) extractTimestamp(feed []byte) (string, error) {
+	if p.re != nil {
+		m := p.re.FindSubmatch(feed)
+		if m == nil {
+			return "", fmt.Errorf("timestamp_regex (%s) didn't match", p.re.String())
+		}
+		if len(m) > 1 {
+			return string(m[1]), nil
+		}
+		return string(m[0]), nil
+	}
+
+	if p.jqQuery == nil {
+		return strings.TrimSpace(string(feed)), nil
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(feed, &input); err != nil {
+		return "", fmt.Errorf("feed is not a valid JSON: %v", err)
+	}
+
+	var lastItem interface{}
+	iter := p.jqQuery.Run(input)
+	for {
+		item, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := item.(error); ok {
+			return "", err
+		}
+		lastItem = item
+	}
+
+	switch v := lastItem.(type) {
+	case nil:
+		return "", fmt.Errorf("timestamp_jq_filter (%s) returned null", p.c.GetTimestampJqFilter())
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	default:
+		return "", fmt.Errorf("unexpected timestamp type %T: %v", v, v)
+	}
+}
+
+// parseTimestamp parses the timestamp as per the configured format.
+func (p *Probe) parseTimestamp(s string) (time.Time, error) {
+	switch format := p.c.GetTimestampFormat(); format {
+	case "rfc3339":
+		return time.Parse(time.RFC3339Nano, s)
+	case "unix", "unix_ms":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %s timestamp: %s", format, s)
+		}
+		if format == "unix_ms" {
+			f /= 1000
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	default:
+		return time.Parse(format, s)
+	}
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.ageKnown = false
+
+	feedURL := strings.ReplaceAll(p.c.GetFeedUrl(), targetPlaceholder, target.Name)
+
+	start := time.Now()
+	feed, err := file.ReadFile(ctx, feedURL)
+	latency := time.Since(start)
+	if err != nil {
+		p.l.Warningf("Target: %s, error fetching feed %s: %v", target.Name, feedURL, err)
+		return
+	}
+
+	tsStr, err := p.extractTimestamp(feed)
+	if err != nil {
+		p.l.Warningf("Target: %s, error extracting timestamp from feed %s: %v", target.Name, feedURL, err)
+		return
+	}
+	ts, err := p.parseTimestamp(tsStr)
+	if err != nil {
+		p.l.Warningf("Target: %s, error parsing timestamp from feed %s: %v", target.Name, feedURL, err)
+		return
+	}
+
+	age := p.now().Sub(ts)
+	result.ageSec, result.ageKnown = age.Seconds(), true
+
+	if age > p.maxAge {
+		p.l.Warningf("Target: %s, feed %s is stale, latest timestamp: %s, age: %s", target.Name, feedURL, ts.Format(time.RFC3339), age.Round(time.Second))
+		result.stale++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
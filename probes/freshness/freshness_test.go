@@ -0,0 +1,225 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freshness
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/freshness/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var testNow = time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+func testProbe(t *testing.T, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	if c.MaxAgeSec == nil {
+		c.MaxAgeSec = proto.Int32(600)
+	}
+	opts := options.DefaultOptions()
+	opts.ProbeConf = c
+
+	p := &Probe{now: func() time.Time { return testNow }}
+	require.NoError(t, p.Init("test-freshness", opts))
+	return p
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		conf        *configpb.ProbeConf
+		feed        string
+		wantAge     float64
+		wantAgeNA   bool
+		wantSuccess int64
+		wantStale   int64
+	}{
+		{
+			name:        "fresh_plain",
+			conf:        &configpb.ProbeConf{},
+			feed:        "2026-10-14T11:55:00Z\n",
+			wantAge:     300,
+			wantSuccess: 1,
+		},
+		{
+			name:      "stale_plain",
+			conf:      &configpb.ProbeConf{},
+			feed:      "2026-10-14T11:00:00Z",
+			wantAge:   3600,
+			wantStale: 1,
+		},
+		{
+			name: "fresh_json",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: ".meta.updated_at"},
+			},
+			feed:        `{"meta": {"updated_at": "2026-10-14T11:59:30.5Z"}, "rows": 10}`,
+			wantAge:     29.5,
+			wantSuccess: 1,
+		},
+		{
+			name: "stale_json_unix",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: "[.items[].ts] | max"},
+				TimestampFormat:    proto.String("unix"),
+			},
+			feed:      `{"items": [{"ts": 1791970000}, {"ts": 1791971000}]}`,
+			wantAge:   8200,
+			wantStale: 1,
+		},
+		{
+			name: "fresh_json_unix_ms",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: ".ts"},
+				TimestampFormat:    proto.String("unix_ms"),
+			},
+			feed:        `{"ts": 1791979140000}`,
+			wantAge:     60,
+			wantSuccess: 1,
+		},
+		{
+			name: "fresh_regex_custom_format",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampRegex{TimestampRegex: `last_updated=(\S+ \S+)`},
+				TimestampFormat:    proto.String("2006-01-02 15:04:05"),
+			},
+			feed:        "rows=100\nlast_updated=2026-10-14 11:58:00\n",
+			wantAge:     120,
+			wantSuccess: 1,
+		},
+		{
+			name: "regex_no_match",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampRegex{TimestampRegex: `last_updated=(\S+)`},
+			},
+			feed:      "rows=100\n",
+			wantAgeNA: true,
+		},
+		{
+			name: "jq_null",
+			conf: &configpb.ProbeConf{
+				TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: ".missing"},
+			},
+			feed:      `{"ts": "2026-10-14T11:55:00Z"}`,
+			wantAgeNA: true,
+		},
+		{
+			name:      "bad_timestamp",
+			conf:      &configpb.ProbeConf{},
+			feed:      "yesterday",
+			wantAgeNA: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			feedFile := filepath.Join(t.TempDir(), "feed")
+			require.NoError(t, os.WriteFile(feedFile, []byte(test.feed), 0644))
+
+			test.conf.FeedUrl = proto.String(feedFile)
+			p := testProbe(t, test.conf)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: ""}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantStale, result.stale, "stale")
+
+			em := result.GaugeMetrics(time.Now(), p.opts)
+			if test.wantAgeNA {
+				assert.Nil(t, em, "gauge metrics")
+				return
+			}
+			require.NotNil(t, em, "gauge metrics")
+			assert.InDelta(t, test.wantAge, em.Metric("data_age_seconds").(metrics.NumValue).Float64(), 0.001)
+		})
+	}
+}
+
+func TestRunProbeHTTP(t *testing.T) {
+	feeds := map[string]string{
+		"/fresh.json": `{"updated_at": "2026-10-14T11:59:00Z"}`,
+		"/stale.json": `{"updated_at": "2026-10-13T12:00:00Z"}`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		feed, ok := feeds[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(feed))
+	}))
+	defer ts.Close()
+
+	p := testProbe(t, &configpb.ProbeConf{
+		FeedUrl:            proto.String(ts.URL + "/@target@.json"),
+		TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: ".updated_at"},
+	})
+
+	results := make(map[string]*probeResult)
+	for _, target := range []string{"fresh", "stale", "missing"} {
+		result := p.newResult().(*probeResult)
+		p.runProbe(context.Background(), endpoint.Endpoint{Name: target}, result)
+		results[target] = result
+	}
+
+	assert.Equal(t, int64(1), results["fresh"].success)
+	assert.Equal(t, 60.0, results["fresh"].ageSec)
+	assert.Equal(t, int64(1), results["stale"].stale)
+	assert.Equal(t, 86400.0, results["stale"].ageSec)
+	assert.Equal(t, int64(0), results["missing"].success)
+	assert.False(t, results["missing"].ageKnown)
+}
+
+func TestInitErrors(t *testing.T) {
+	tests := map[string]*configpb.ProbeConf{
+		"no_feed_url": {MaxAgeSec: proto.Int32(60)},
+		"no_max_age":  {FeedUrl: proto.String("/tmp/feed")},
+		"bad_jq": {
+			FeedUrl:            proto.String("/tmp/feed"),
+			MaxAgeSec:          proto.Int32(60),
+			TimestampExtractor: &configpb.ProbeConf_TimestampJqFilter{TimestampJqFilter: ".["},
+		},
+		"bad_regex": {
+			FeedUrl:            proto.String("/tmp/feed"),
+			MaxAgeSec:          proto.Int32(60),
+			TimestampExtractor: &configpb.ProbeConf_TimestampRegex{TimestampRegex: "("},
+		},
+	}
+
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = c
+			err := (&Probe{}).Init("test-freshness", opts)
+			assert.Error(t, err)
+			assert.False(t, strings.HasPrefix(err.Error(), "not freshness"), err.Error())
+		})
+	}
+}
@@ -0,0 +1,352 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package georouting implements a probe type that verifies that clients in
+// a region are routed to that region's endpoint.
+package georouting
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/georouting/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/miekg/dns"
+)
+
+const defaultDNSPort = 53
+
+// clientContext is the parsed ProbeConf.ClientContext.
+type clientContext struct {
+	name           string
+	expectedRegion string
+	subnet         *net.IPNet
+	sourceIP       net.IP
+}
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	clientContexts []*clientContext
+	resolver       string
+	port           int
+	dnsClient      *dns.Client
+	client         *http.Client
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	resolveErrors  int64
+	requestErrors  int64
+	misroutes      *metrics.Map[int64]
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		misroutes: metrics.NewMap("client"),
+	}
+	for _, cc := range p.clientContexts {
+		result.misroutes.IncKeyBy(cc.name, 0)
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("resolve_errors", metrics.NewInt(result.resolveErrors)).
+		AddMetric("request_errors", metrics.NewInt(result.requestErrors)).
+		AddMetric("misroutes", result.misroutes.Clone()).
+		AddLabel("ptype", "geo_routing")
+}
+
+func parseClientContext(c *configpb.ProbeConf_ClientContext, haveResolver bool) (*clientContext, error) {
+	cc := &clientContext{
+		name:           c.GetName(),
+		expectedRegion: c.GetExpectedRegion(),
+	}
+	if cc.name == "" || cc.expectedRegion == "" {
+		return nil, fmt.Errorf("client_context should have a name and an expected_region: %v", c)
+	}
+
+	if s := c.GetClientSubnet(); s != "" {
+		if !haveResolver {
+			return nil, fmt.Errorf("client_context %s: client_subnet requires resolver to be set", cc.name)
+		}
+		_, subnet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("client_context %s: invalid client_subnet (%s): %v", cc.name, s, err)
+		}
+		cc.subnet = subnet
+	}
+
+	if s := c.GetSourceIp(); s != "" {
+		if cc.sourceIP = net.ParseIP(s); cc.sourceIP == nil {
+			return nil, fmt.Errorf("client_context %s: invalid source_ip: %s", cc.name, s)
+		}
+	}
+	return cc, nil
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not geo_routing probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if len(p.c.GetClientContext()) == 0 {
+		return errors.New("at least one client_context is required")
+	}
+
+	if p.resolver = p.c.GetResolver(); p.resolver != "" {
+		if _, _, err := net.SplitHostPort(p.resolver); err != nil {
+			p.resolver = net.JoinHostPort(p.resolver, strconv.Itoa(defaultDNSPort))
+		}
+		p.dnsClient = &dns.Client{Timeout: p.opts.Timeout}
+	}
+
+	names := make(map[string]bool)
+	for _, c := range p.c.GetClientContext() {
+		cc, err := parseClientContext(c, p.resolver != "")
+		if err != nil {
+			return err
+		}
+		if names[cc.name] {
+			return fmt.Errorf("duplicate client_context name: %s", cc.name)
+		}
+		names[cc.name] = true
+		p.clientContexts = append(p.clientContexts, cc)
+	}
+
+	if !strings.HasPrefix(p.c.GetRelativeUrl(), "/") {
+		return fmt.Errorf("relative_url should start with /, got: %s", p.c.GetRelativeUrl())
+	}
+
+	if p.port = int(p.c.GetPort()); p.port == 0 {
+		p.port = 443
+		if p.c.GetScheme() == configpb.ProbeConf_HTTP {
+			p.port = 80
+		}
+	}
+
+	t := &http.Transport{
+		// Every request goes to the address resolved for its client
+		// context, so connections are not reused.
+		DisableKeepAlives: true,
+		DialContext:       dialResolved,
+		TLSClientConfig:   &tls.Config{},
+	}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(t.TLSClientConfig, p.c.GetTlsConfig()); err != nil {
+			return fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+	p.client = &http.Client{
+		Transport: t,
+		// Redirects may lead to other regions' endpoints, so they are not
+		// followed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return nil
+}
+
+type dialAddrKey struct{}
+
+// dialAddr is the address to connect to, and the local IP to connect from,
+// for a request.
+type dialAddr struct {
+	ip, sourceIP net.IP
+}
+
+// dialResolved connects to the address in the context, instead of resolving
+// the request's host. That keeps the target name in the Host header and the
+// TLS server name, while connecting to the address resolved for the client
+// context.
+func dialResolved(ctx context.Context, network, addr string) (net.Conn, error) {
+	da, ok := ctx.Value(dialAddrKey{}).(*dialAddr)
+	if !ok {
+		return nil, fmt.Errorf("no resolved address for %s", addr)
+	}
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	d := &net.Dialer{}
+	if da.sourceIP != nil {
+		d.LocalAddr = &net.TCPAddr{IP: da.sourceIP}
+	}
+	return d.DialContext(ctx, network, net.JoinHostPort(da.ip.String(), port))
+}
+
+// newQuery returns an A query for the target, with the client subnet in the
+// EDNS client-subnet option if it's set.
+func newQuery(target string, subnet *net.IPNet) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(target), dns.TypeA)
+	if subnet == nil {
+		return m
+	}
+
+	m.SetEdns0(dns.DefaultMsgSize, false)
+	ones, _ := subnet.Mask.Size()
+	ecs := &dns.EDNS0_SUBNET{
+		Code:          dns.EDNS0SUBNET,
+		Family:        1,
+		SourceNetmask: uint8(ones),
+		Address:       subnet.IP,
+	}
+	if subnet.IP.To4() == nil {
+		ecs.Family = 2
+	}
+	opt := m.IsEdns0()
+	opt.Option = append(opt.Option, ecs)
+	return m
+}
+
+// resolve returns the target's address for the client context.
+func (p *Probe) resolve(ctx context.Context, target string, cc *clientContext) (net.IP, error) {
+	if p.resolver == "" {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		return addrs[0].IP, nil
+	}
+
+	resp, _, err := p.dnsClient.ExchangeContext(ctx, newQuery(target, cc.subnet), p.resolver)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return nil, fmt.Errorf("resolver returned %s", dns.RcodeToString[resp.Rcode])
+	}
+	for _, rr := range resp.Answer {
+		if a, ok := rr.(*dns.A); ok {
+			return a.A, nil
+		}
+	}
+	return nil, errors.New("no A records in the response")
+}
+
+// fetchRegion sends the request to the given address and returns the
+// region reported by the endpoint.
+func (p *Probe) fetchRegion(ctx context.Context, target string, cc *clientContext, ip net.IP) (string, error) {
+	scheme := "https"
+	if p.c.GetScheme() == configpb.ProbeConf_HTTP {
+		scheme = "http"
+	}
+	reqURL := fmt.Sprintf("%s://%s%s", scheme, net.JoinHostPort(target, strconv.Itoa(p.port)), p.c.GetRelativeUrl())
+
+	ctx = context.WithValue(ctx, dialAddrKey{}, &dialAddr{ip: ip, sourceIP: cc.sourceIP})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	region := resp.Header.Get(p.c.GetRegionHeader())
+	if region == "" {
+		return "", fmt.Errorf("no %s header in the response (status code: %d)", p.c.GetRegionHeader(), resp.StatusCode)
+	}
+	return region, nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+
+	for _, cc := range p.clientContexts {
+		result.total++
+		start := time.Now()
+
+		ip, err := p.resolve(ctx, target.Name, cc)
+		if err != nil {
+			p.l.Warningf("Target: %s, client: %s, error resolving the target: %v", target.Name, cc.name, err)
+			result.resolveErrors++
+			continue
+		}
+
+		region, err := p.fetchRegion(ctx, target.Name, cc, ip)
+		if err != nil {
+			p.l.Warningf("Target: %s, client: %s, request to %s failed: %v", target.Name, cc.name, ip, err)
+			result.requestErrors++
+			continue
+		}
+
+		if region != cc.expectedRegion {
+			p.l.Warningf("Target: %s, client: %s, misrouted to region %s (%s), expected: %s", target.Name, cc.name, region, ip, cc.expectedRegion)
+			result.misroutes.IncKey(cc.name)
+			continue
+		}
+
+		result.success++
+		result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+	}
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package georouting
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/georouting/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const testTarget = "api.example.test"
+
+// regionIPs maps the regions to their endpoints' IPs, and the client subnets
+// routed to them.
+var regionIPs = map[string]struct {
+	ip, subnet string
+}{
+	"us-east1":     {"127.0.0.1", "10.1.0.0/16"},
+	"europe-west1": {"127.0.0.2", "10.2.0.0/16"},
+}
+
+// startResolver starts a stub GeoDNS resolver, that answers the queries for
+// testTarget based on the EDNS client-subnet option. Clients without a
+// subnet are routed to us-east1.
+func startResolver(t *testing.T) string {
+	t.Helper()
+
+	handler := func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		if req.Question[0].Name != dns.Fqdn(testTarget) {
+			resp.Rcode = dns.RcodeNameError
+			w.WriteMsg(resp)
+			return
+		}
+
+		ip := regionIPs["us-east1"].ip
+		if opt := req.IsEdns0(); opt != nil {
+			for _, o := range opt.Option {
+				ecs, ok := o.(*dns.EDNS0_SUBNET)
+				if !ok {
+					continue
+				}
+				for _, r := range regionIPs {
+					if _, subnet, _ := net.ParseCIDR(r.subnet); subnet.Contains(ecs.Address) {
+						ip = r.ip
+					}
+				}
+			}
+		}
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+			A:   net.ParseIP(ip),
+		})
+		w.WriteMsg(resp)
+	}
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	started := make(chan struct{})
+	srv := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(handler), NotifyStartedFunc: func() { close(started) }}
+	go srv.ActivateAndServe()
+	t.Cleanup(func() { srv.Shutdown() })
+	<-started
+
+	return pc.LocalAddr().String()
+}
+
+// startEndpoints starts an HTTP endpoint for each region, all on the same
+// port, and returns the port. Endpoints report their region in the X-Region
+// header, except for the /no-region path. For the /by-source path, they
+// report the region of the client's IP instead.
+func startEndpoints(t *testing.T) int {
+	t.Helper()
+
+	port := 0
+	for region, r := range regionIPs {
+		region := region
+		ln, err := net.Listen("tcp", net.JoinHostPort(r.ip, strconv.Itoa(port)))
+		require.NoError(t, err)
+		port = ln.Addr().(*net.TCPAddr).Port
+
+		srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/no-region":
+			case "/by-source":
+				host, _, _ := net.SplitHostPort(req.RemoteAddr)
+				for region, r := range regionIPs {
+					if r.ip == host {
+						w.Header().Set("X-Region", region)
+					}
+				}
+			default:
+				w.Header().Set("X-Region", region)
+			}
+			w.Write([]byte(req.Host))
+		})}
+		go srv.Serve(ln)
+		t.Cleanup(func() { srv.Close() })
+	}
+	return port
+}
+
+func testProbe(t *testing.T, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	c.Resolver = proto.String(startResolver(t))
+	c.Scheme = configpb.ProbeConf_HTTP.Enum()
+	c.Port = proto.Int32(int32(startEndpoints(t)))
+
+	opts := options.DefaultOptions()
+	opts.ProbeConf = c
+
+	p := &Probe{}
+	require.NoError(t, p.Init("test-geo-routing", opts))
+	return p
+}
+
+func newClientContext(name, subnet, expectedRegion string) *configpb.ProbeConf_ClientContext {
+	return &configpb.ProbeConf_ClientContext{
+		Name:           proto.String(name),
+		ClientSubnet:   proto.String(subnet),
+		ExpectedRegion: proto.String(expectedRegion),
+	}
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name              string
+		target            string
+		relativeURL       string
+		clientContexts    []*configpb.ProbeConf_ClientContext
+		wantSuccess       int64
+		wantResolveErrors int64
+		wantRequestErrors int64
+		wantMisroutes     map[string]int64
+	}{
+		{
+			name: "routed",
+			clientContexts: []*configpb.ProbeConf_ClientContext{
+				newClientContext("us", "10.1.2.0/24", "us-east1"),
+				newClientContext("eu", "10.2.2.0/24", "europe-west1"),
+			},
+			wantSuccess:   2,
+			wantMisroutes: map[string]int64{"us": 0, "eu": 0},
+		},
+		{
+			name: "misrouted",
+			clientContexts: []*configpb.ProbeConf_ClientContext{
+				newClientContext("us", "10.1.2.0/24", "us-east1"),
+				newClientContext("eu", "10.3.2.0/24", "europe-west1"),
+			},
+			wantSuccess:   1,
+			wantMisroutes: map[string]int64{"us": 0, "eu": 1},
+		},
+		{
+			name:   "resolve_error",
+			target: "unknown.example.test",
+			clientContexts: []*configpb.ProbeConf_ClientContext{
+				newClientContext("us", "10.1.2.0/24", "us-east1"),
+			},
+			wantResolveErrors: 1,
+			wantMisroutes:     map[string]int64{"us": 0},
+		},
+		{
+			name:        "no_region_header",
+			relativeURL: "/no-region",
+			clientContexts: []*configpb.ProbeConf_ClientContext{
+				newClientContext("us", "10.1.2.0/24", "us-east1"),
+				newClientContext("eu", "10.2.2.0/24", "europe-west1"),
+			},
+			wantRequestErrors: 2,
+			wantMisroutes:     map[string]int64{"us": 0, "eu": 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &configpb.ProbeConf{ClientContext: test.clientContexts}
+			if test.relativeURL != "" {
+				c.RelativeUrl = proto.String(test.relativeURL)
+			}
+			p := testProbe(t, c)
+
+			target := test.target
+			if target == "" {
+				target = testTarget
+			}
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: target}, result)
+
+			assert.Equal(t, int64(len(test.clientContexts)), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantResolveErrors, result.resolveErrors, "resolve_errors")
+			assert.Equal(t, test.wantRequestErrors, result.requestErrors, "request_errors")
+			for client, want := range test.wantMisroutes {
+				assert.Equal(t, want, result.misroutes.GetKey(client), "misroutes for %s", client)
+			}
+		})
+	}
+}
+
+func TestRunProbeSourceIP(t *testing.T) {
+	// Clients are told apart by their source IP, and the endpoint reports
+	// the region based on it.
+	p := testProbe(t, &configpb.ProbeConf{
+		RelativeUrl: proto.String("/by-source"),
+		ClientContext: []*configpb.ProbeConf_ClientContext{
+			{Name: proto.String("us"), SourceIp: proto.String("127.0.0.1"), ExpectedRegion: proto.String("us-east1")},
+			{Name: proto.String("eu"), SourceIp: proto.String("127.0.0.2"), ExpectedRegion: proto.String("europe-west1")},
+			{Name: proto.String("eu-misrouted"), SourceIp: proto.String("127.0.0.1"), ExpectedRegion: proto.String("europe-west1")},
+		},
+	})
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), endpoint.Endpoint{Name: testTarget}, result)
+
+	assert.Equal(t, int64(3), result.total, "total")
+	assert.Equal(t, int64(2), result.success, "success")
+	assert.Equal(t, int64(1), result.misroutes.GetKey("eu-misrouted"), "misroutes")
+}
+
+func TestNewQuery(t *testing.T) {
+	_, subnet, _ := net.ParseCIDR("198.51.100.0/24")
+	m := newQuery(testTarget, subnet)
+
+	assert.Equal(t, dns.Fqdn(testTarget), m.Question[0].Name)
+	opt := m.IsEdns0()
+	require.NotNil(t, opt, "EDNS0 OPT record")
+	require.Len(t, opt.Option, 1)
+	ecs := opt.Option[0].(*dns.EDNS0_SUBNET)
+	assert.Equal(t, uint16(1), ecs.Family)
+	assert.Equal(t, uint8(24), ecs.SourceNetmask)
+	assert.Equal(t, "198.51.100.0", ecs.Address.String())
+
+	assert.Nil(t, newQuery(testTarget, nil).IsEdns0(), "no EDNS0 without subnet")
+}
+
+func TestInitErrors(t *testing.T) {
+	us := newClientContext("us", "10.1.0.0/16", "us-east1")
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"no_client_context":     {Resolver: proto.String("127.0.0.1")},
+		"subnet_no_resolver":    {ClientContext: []*configpb.ProbeConf_ClientContext{us}},
+		"bad_subnet":            {Resolver: proto.String("127.0.0.1"), ClientContext: []*configpb.ProbeConf_ClientContext{newClientContext("us", "10.1.0.0", "us-east1")}},
+		"no_expected_region":    {Resolver: proto.String("127.0.0.1"), ClientContext: []*configpb.ProbeConf_ClientContext{newClientContext("us", "10.1.0.0/16", "")}},
+		"duplicate_name":        {Resolver: proto.String("127.0.0.1"), ClientContext: []*configpb.ProbeConf_ClientContext{us, us}},
+		"bad_source_ip":         {ClientContext: []*configpb.ProbeConf_ClientContext{{Name: proto.String("us"), ExpectedRegion: proto.String("us"), SourceIp: proto.String("localhost")}}},
+		"relative_url_no_slash": {Resolver: proto.String("127.0.0.1"), RelativeUrl: proto.String("health"), ClientContext: []*configpb.ProbeConf_ClientContext{us}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("test-geo-routing", opts))
+		})
+	}
+}
+
+func TestInitDefaults(t *testing.T) {
+	opts := options.DefaultOptions()
+	opts.Timeout = 2 * time.Second
+	opts.ProbeConf = &configpb.ProbeConf{
+		Resolver:      proto.String("127.0.0.1"),
+		ClientContext: []*configpb.ProbeConf_ClientContext{newClientContext("us", "10.1.0.0/16", "us-east1")},
+	}
+	p := &Probe{}
+	require.NoError(t, p.Init("test-geo-routing", opts))
+	assert.Equal(t, "127.0.0.1:53", p.resolver)
+	assert.Equal(t, 443, p.port)
+	assert.Equal(t, 2*time.Second, p.dnsClient.Timeout)
+}
@@ -24,9 +24,14 @@ import (
 
 	configpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
 	"github.com/fullstorydev/grpcurl"
+	protov1 "github.com/golang/protobuf/proto" //lint:ignore SA1019 grpcurl's event handler uses it
 	"github.com/jhump/protoreflect/grpcreflect"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 func (p *Probe) initDescriptorSource() error {
@@ -61,7 +66,52 @@ func (r response) String() string {
 	return string(r)
 }
 
-func (p *Probe) callServiceMethod(ctx context.Context, req *configpb.GenericRequest, descSrc grpcurl.DescriptorSource, conn *grpc.ClientConn) (response, error) {
+// respSizeCallOption is a call option to collect the serialized size of the
+// response messages of a generic request. For streaming calls, sizes of all
+// the messages in the stream are added up.
+type respSizeCallOption struct {
+	grpc.EmptyCallOption
+	size     int
+	messages int
+}
+
+// metadataHandler is a grpcurl event handler that additionally fills response
+// header and trailer for grpc.Header and grpc.Trailer call options, and
+// response size for respSizeCallOption, as grpcurl doesn't take call options.
+type metadataHandler struct {
+	*grpcurl.DefaultEventHandler
+	callOpts []grpc.CallOption
+}
+
+func (h *metadataHandler) OnReceiveResponse(resp protov1.Message) {
+	h.DefaultEventHandler.OnReceiveResponse(resp)
+	for _, opt := range h.callOpts {
+		if o, ok := opt.(*respSizeCallOption); ok {
+			o.size += proto.Size(protoadapt.MessageV2Of(resp))
+			o.messages++
+		}
+	}
+}
+
+func (h *metadataHandler) OnReceiveHeaders(md metadata.MD) {
+	h.DefaultEventHandler.OnReceiveHeaders(md)
+	for _, opt := range h.callOpts {
+		if o, ok := opt.(grpc.HeaderCallOption); ok {
+			*o.HeaderAddr = md
+		}
+	}
+}
+
+func (h *metadataHandler) OnReceiveTrailers(stat *status.Status, md metadata.MD) {
+	h.DefaultEventHandler.OnReceiveTrailers(stat, md)
+	for _, opt := range h.callOpts {
+		if o, ok := opt.(grpc.TrailerCallOption); ok {
+			*o.TrailerAddr = md
+		}
+	}
+}
+
+func (p *Probe) callServiceMethod(ctx context.Context, req *configpb.GenericRequest, descSrc grpcurl.DescriptorSource, conn *grpc.ClientConn, callOpts []grpc.CallOption) (response, error) {
 	in := strings.NewReader(req.GetBody())
 	rf, formatter, err := grpcurl.RequestParserAndFormatter(grpcurl.FormatJSON, descSrc, in, grpcurl.FormatOptions{})
 	if err != nil {
@@ -69,7 +119,10 @@ func (p *Probe) callServiceMethod(ctx context.Context, req *configpb.GenericRequ
 	}
 
 	var out bytes.Buffer
-	h := &grpcurl.DefaultEventHandler{Out: &out, Formatter: formatter}
+	h := &metadataHandler{
+		DefaultEventHandler: &grpcurl.DefaultEventHandler{Out: &out, Formatter: formatter},
+		callOpts:            callOpts,
+	}
 
 	if err := grpcurl.InvokeRPC(ctx, descSrc, conn, req.GetCallServiceMethod(), nil, h, rf.Next); err != nil {
 		return "", fmt.Errorf("error invoking gRPC: %v", err)
@@ -91,7 +144,10 @@ func (p *Probe) callServiceMethod(ctx context.Context, req *configpb.GenericRequ
 	return response(buf.String()), nil
 }
 
-func (p *Probe) genericRequest(ctx context.Context, conn *grpc.ClientConn, req *configpb.GenericRequest) (response, error) {
+// genericRequest sends a generic request. Of the call options, only
+// grpc.Header, grpc.Trailer and respSizeCallOption are supported, and only
+// for the call_service_method requests.
+func (p *Probe) genericRequest(ctx context.Context, conn *grpc.ClientConn, req *configpb.GenericRequest, callOpts ...grpc.CallOption) (response, error) {
 	// If we didn't load protoset from a file, we'll get it everytime
 	// from the server.
 	descSrc := p.descSrc
@@ -119,7 +175,7 @@ func (p *Probe) genericRequest(ctx context.Context, conn *grpc.ClientConn, req *
 		}
 		return response(strings.ReplaceAll(d.AsProto().String(), "  ", " ")), nil
 	case *configpb.GenericRequest_CallServiceMethod:
-		return p.callServiceMethod(ctx, req, descSrc, conn)
+		return p.callServiceMethod(ctx, req, descSrc, conn, callOpts)
 	}
 
 	return "", fmt.Errorf("invalid request type: %v", req)
@@ -87,6 +87,12 @@ type Probe struct {
 	creds    credentials.TransportCredentials
 	descSrc  grpcurl.DescriptorSource
 
+	// Set if load-balancing check is enabled.
+	lbChecker *lbChecker
+
+	// Set if response_size_distribution is configured.
+	respSizeDist *metrics.Distribution
+
 	// Targets and cancellation function for each target.
 	targets     []endpoint.Endpoint
 	cancelFuncs map[string]context.CancelFunc
@@ -110,6 +116,18 @@ type probeRunResult struct {
 	latency           metrics.LatencyValue
 	connectErrors     metrics.Int
 	validationFailure *metrics.Map[int64]
+
+	// Load-balancing check metrics. Set only if lb_check is enabled.
+	backendRPCs    *metrics.Map[int64]
+	lbConcentrated metrics.Int
+
+	// Response size metrics. Set only if response_size_distribution is
+	// configured.
+	respSize     *metrics.Distribution
+	respMessages metrics.Int
+
+	// Channel establishment latency. Set only if fresh_channel is enabled.
+	connectLatency metrics.LatencyValue
 }
 
 func (p *Probe) transportCredentials() (credentials.TransportCredentials, error) {
@@ -200,6 +218,18 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 		}
 	}
 
+	if p.c.GetLbCheck() != nil {
+		if p.lbChecker, err = newLBChecker(p.c.GetLbCheck()); err != nil {
+			return err
+		}
+	}
+
+	if p.c.GetResponseSizeDistribution() != nil {
+		if p.respSizeDist, err = metrics.NewDistributionFromProto(p.c.GetResponseSizeDistribution()); err != nil {
+			return fmt.Errorf("error creating response size distribution: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -253,12 +283,8 @@ func (p *Probe) updateTargetsAndStartProbes(ctx context.Context) {
 	p.targets = newTargets
 }
 
-// connectWithRetry attempts to connect to a target. On failure, it retries in
-// an infinite loop until successful, incrementing connectErrors for every
-// connection error. On success, it returns a client immediately.
-// Interval between connects is controlled by connect_timeout_msec, defaulting
-// to probe timeout.
-func (p *Probe) connectWithRetry(ctx context.Context, target endpoint.Endpoint, result *probeRunResult, logAttrs ...slog.Attr) *grpc.ClientConn {
+// targetAddr returns the address to dial for the target.
+func (p *Probe) targetAddr(target endpoint.Endpoint) string {
 	addr := target.Name
 	if target.IP != nil {
 		if p.opts.IPVersion == 0 || iputils.IPVersion(target.IP) == p.opts.IPVersion {
@@ -272,10 +298,38 @@ func (p *Probe) connectWithRetry(ctx context.Context, target endpoint.Endpoint,
 		addr = net.JoinHostPort(addr, strconv.Itoa(target.Port))
 	}
 
+	return p.c.GetUriScheme() + addr
+}
+
+// dial connects to the given address, blocking until the connection is
+// established or connect timeout is reached. Connect timeout is controlled
+// by connect_timeout_msec, defaulting to probe timeout.
+func (p *Probe) dial(ctx context.Context, addr string) (*grpc.ClientConn, error) {
 	connectTimeout := p.opts.Timeout
 	if p.c.GetConnectTimeoutMsec() > 0 {
 		connectTimeout = time.Duration(p.c.GetConnectTimeoutMsec()) * time.Millisecond
 	}
+	connCtx, cancelFunc := context.WithTimeout(ctx, connectTimeout)
+	defer cancelFunc()
+
+	// Note we use grpcurl.BlockingDial which uses WithBlock dial option which is
+	// discouraged by the gRPC docs:
+	// https://github.com/grpc/grpc-go/blob/master/Documentation/anti-patterns.md.
+	// In a traditional gRPC client, it makes sense for connections to be
+	// fluid, and come and go, but for  aprober it's important that
+	// connection is established before we start sending RPCs. We'll get a
+	// much better error message if connection fails.
+	return grpcurl.BlockingDial(connCtx, "tcp", addr, p.creds, p.dialOpts...)
+}
+
+// connectWithRetry attempts to connect to a target. On failure, it retries in
+// an infinite loop until successful, recording every connection error (see
+// recordConnectError). On success, it returns a client immediately.
+// Interval between connects is controlled by connect_timeout_msec, defaulting
+// to probe timeout.
+func (p *Probe) connectWithRetry(ctx context.Context, target endpoint.Endpoint, result *probeRunResult, logAttrs ...slog.Attr) *grpc.ClientConn {
+	addr := p.targetAddr(target)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -283,40 +337,55 @@ func (p *Probe) connectWithRetry(ctx context.Context, target endpoint.Endpoint,
 			return nil
 		default:
 		}
-		connCtx, cancelFunc := context.WithTimeout(ctx, connectTimeout)
-
-		if uriScheme := p.c.GetUriScheme(); uriScheme != "" {
-			addr = uriScheme + addr
-		}
 
-		// Note we use grpcurl.BlockingDial which uses WithBlock dial option which is
-		// discouraged by the gRPC docs:
-		// https://github.com/grpc/grpc-go/blob/master/Documentation/anti-patterns.md.
-		// In a traditional gRPC client, it makes sense for connections to be
-		// fluid, and come and go, but for  aprober it's important that
-		// connection is established before we start sending RPCs. We'll get a
-		// much better error message if connection fails.
-		conn, err := grpcurl.BlockingDial(connCtx, "tcp", addr, p.creds, p.dialOpts...)
-		cancelFunc()
-
-		if err != nil {
-			p.l.WarningAttrs("Connect error: "+err.Error(), logAttrs...)
-		} else {
+		conn, err := p.dial(ctx, addr)
+		if err == nil {
 			p.l.InfoAttrs("Connection established", logAttrs...)
 			return conn
 		}
-
-		result.Lock()
-		result.total.Inc()
-		result.connectErrors.Inc()
-		result.Unlock()
+		p.recordConnectError(ctx, err, result, logAttrs...)
 
 		// Sleep before retrying connection.
 		time.Sleep(p.opts.Interval)
 	}
 }
 
-func (p *Probe) healthCheckProbe(ctx context.Context, conn *grpc.ClientConn, logAttrs ...slog.Attr) (*grpc_health_v1.HealthCheckResponse, error) {
+// recordConnectError records a failed connection attempt. A failed connection
+// attempt counts as a failed probe: it's counted in both total and
+// connectErrors, with or without fresh_channel. Failures due to the probe
+// being stopped are not counted.
+func (p *Probe) recordConnectError(ctx context.Context, err error, result *probeRunResult, logAttrs ...slog.Attr) {
+	if ctx.Err() != nil {
+		return
+	}
+	p.l.WarningAttrs("Connect error: "+err.Error(), logAttrs...)
+
+	result.Lock()
+	defer result.Unlock()
+	result.total.Inc()
+	result.connectErrors.Inc()
+}
+
+// freshConnect creates a new connection to the target and records the time
+// it took. On failure, it records the connect error and returns nil; there
+// are no retries.
+func (p *Probe) freshConnect(ctx context.Context, target endpoint.Endpoint, result *probeRunResult, logAttrs ...slog.Attr) *grpc.ClientConn {
+	start := time.Now()
+	conn, err := p.dial(ctx, p.targetAddr(target))
+	latency := time.Since(start)
+
+	if err != nil {
+		p.recordConnectError(ctx, err, result, logAttrs...)
+		return nil
+	}
+
+	result.Lock()
+	defer result.Unlock()
+	result.connectLatency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+	return conn
+}
+
+func (p *Probe) healthCheckProbe(ctx context.Context, conn *grpc.ClientConn, callOpts []grpc.CallOption, logAttrs ...slog.Attr) (*grpc_health_v1.HealthCheckResponse, error) {
 	var resp *grpc_health_v1.HealthCheckResponse
 	var err error
 
@@ -324,7 +393,7 @@ func (p *Probe) healthCheckProbe(ctx context.Context, conn *grpc.ClientConn, log
 		resp, err = p.healthCheckFunc()
 	} else {
 		resp, err = grpc_health_v1.NewHealthClient(conn).
-			Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: p.c.GetHealthCheckService()})
+			Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: p.c.GetHealthCheckService()}, callOpts...)
 	}
 
 	if err != nil {
@@ -352,18 +421,19 @@ func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index
 		al.UpdateForTarget(tgt, "", 0)
 	}
 
-	conn := p.connectWithRetry(ctx, tgt, result, logAttrs...)
-	if conn == nil {
-		return
+	// With fresh_channel, connection is created in every interval instead.
+	var conn *grpc.ClientConn
+	var client spb.ProberClient
+	if !p.c.GetFreshChannel() {
+		conn = p.connectWithRetry(ctx, tgt, result, logAttrs...)
+		if conn == nil {
+			return
+		}
+		defer conn.Close()
+		client = spb.NewProberClient(conn)
 	}
-	defer conn.Close()
-
-	client := spb.NewProberClient(conn)
-	timeout := p.opts.Timeout
-	method := p.c.GetMethod()
 
-	msgSize := p.c.GetBlobSize()
-	msg := make([]byte, msgSize)
+	msg := make([]byte, p.c.GetBlobSize())
 	probeutils.PatternPayload(msg, []byte(msgPattern))
 	ticker := time.NewTicker(p.opts.Interval)
 	for {
@@ -379,69 +449,164 @@ func (p *Probe) oneTargetLoop(ctx context.Context, tgt endpoint.Endpoint, index
 			continue
 		}
 
-		reqCtx, cancelFunc := context.WithTimeout(ctx, timeout)
+		if p.c.GetFreshChannel() {
+			freshConn := p.freshConnect(ctx, tgt, result, logAttrs...)
+			if freshConn == nil {
+				continue
+			}
+			p.runRequests(ctx, freshConn, spb.NewProberClient(freshConn), msg, result, logAttrs...)
+			freshConn.Close()
+			continue
+		}
 
-		reqCtx = p.ctxWithHeaders(reqCtx)
+		p.runRequests(ctx, conn, client, msg, result, logAttrs...)
+	}
+}
 
-		var delta time.Duration
-		start := time.Now()
+// runRequests runs the requests of a probe interval on the given connection.
+func (p *Probe) runRequests(ctx context.Context, conn *grpc.ClientConn, client spb.ProberClient, msg []byte, result *probeRunResult, logAttrs ...slog.Attr) {
+	numRPCs := 1
+	if p.lbChecker != nil {
+		numRPCs = int(p.c.GetLbCheck().GetRpcsPerInterval())
+	}
 
-		var peer peer.Peer
-		opts := []grpc.CallOption{
-			grpc.WaitForReady(true),
-			grpc.Peer(&peer),
+	backendCounts := make(map[string]int64)
+	for i := 0; i < numRPCs; i++ {
+		backend, success := p.runRequest(ctx, conn, client, msg, result, logAttrs...)
+		if success && p.lbChecker != nil {
+			backendCounts[backend]++
 		}
+	}
 
-		var success bool
-		var err error
-		var r fmt.Stringer
-
-		switch method {
-		case configpb.ProbeConf_ECHO:
-			r, err = client.Echo(reqCtx, &pb.EchoMessage{Blob: []byte(msg)}, opts...)
-		case configpb.ProbeConf_READ:
-			r, err = client.BlobRead(reqCtx, &pb.BlobReadRequest{Size: proto.Int32(msgSize)}, opts...)
-		case configpb.ProbeConf_WRITE:
-			r, err = client.BlobWrite(reqCtx, &pb.BlobWriteRequest{Blob: []byte(msg)}, opts...)
-		case configpb.ProbeConf_HEALTH_CHECK:
-			r, err = p.healthCheckProbe(reqCtx, conn, logAttrs...)
-		case configpb.ProbeConf_GENERIC:
-			r, err = p.genericRequest(reqCtx, conn, p.c.GetRequest())
-		default:
-			p.l.Criticalf("Method %v not implemented", method)
-		}
+	if p.lbChecker != nil {
+		p.recordBackends(backendCounts, result, logAttrs...)
+	}
+}
 
-		cancelFunc()
+// runRequest sends a single request to the target and records the result. It
+// returns the backend that served the request, if load-balancing check is
+// enabled, and whether request succeeded.
+func (p *Probe) runRequest(ctx context.Context, conn *grpc.ClientConn, client spb.ProberClient, msg []byte, result *probeRunResult, logAttrs ...slog.Attr) (string, bool) {
+	method := p.c.GetMethod()
+	msgSize := p.c.GetBlobSize()
 
-		p.l.DebugAttrs("Response: "+r.String(), logAttrs...)
+	reqCtx, cancelFunc := context.WithTimeout(ctx, p.opts.Timeout)
 
-		if err != nil {
-			peerAddr := "unknown"
-			if peer.Addr != nil {
-				peerAddr = peer.Addr.String()
-			}
-			p.l.WarningAttrs(fmt.Sprintf("Request failed: %v. ConnState: %v", err, conn.GetState()), append(logAttrs, slog.String("peer", peerAddr))...)
-		} else {
-			success = true
-			delta = time.Since(start)
+	reqCtx = p.ctxWithHeaders(reqCtx)
+
+	var delta time.Duration
+	start := time.Now()
+
+	var peer peer.Peer
+	var header, trailer metadata.MD
+	opts := []grpc.CallOption{
+		grpc.WaitForReady(true),
+		grpc.Peer(&peer),
+	}
+	if p.lbChecker != nil {
+		opts = append(opts, grpc.Header(&header), grpc.Trailer(&trailer))
+	}
+	var sizeOpt *respSizeCallOption
+	if p.respSizeDist != nil && method == configpb.ProbeConf_GENERIC {
+		sizeOpt = &respSizeCallOption{}
+		opts = append(opts, sizeOpt)
+	}
+
+	var success bool
+	var err error
+	var r fmt.Stringer
+
+	switch method {
+	case configpb.ProbeConf_ECHO:
+		r, err = client.Echo(reqCtx, &pb.EchoMessage{Blob: []byte(msg)}, opts...)
+	case configpb.ProbeConf_READ:
+		r, err = client.BlobRead(reqCtx, &pb.BlobReadRequest{Size: proto.Int32(msgSize)}, opts...)
+	case configpb.ProbeConf_WRITE:
+		r, err = client.BlobWrite(reqCtx, &pb.BlobWriteRequest{Blob: []byte(msg)}, opts...)
+	case configpb.ProbeConf_HEALTH_CHECK:
+		r, err = p.healthCheckProbe(reqCtx, conn, opts, logAttrs...)
+	case configpb.ProbeConf_GENERIC:
+		r, err = p.genericRequest(reqCtx, conn, p.c.GetRequest(), opts...)
+	default:
+		p.l.Criticalf("Method %v not implemented", method)
+	}
+
+	cancelFunc()
+
+	p.l.DebugAttrs("Response: "+r.String(), logAttrs...)
+
+	if err != nil {
+		peerAddr := "unknown"
+		if peer.Addr != nil {
+			peerAddr = peer.Addr.String()
 		}
+		p.l.WarningAttrs(fmt.Sprintf("Request failed: %v. ConnState: %v", err, conn.GetState()), append(logAttrs, slog.String("peer", peerAddr))...)
+	} else {
+		success = true
+		delta = time.Since(start)
+	}
 
-		if success && p.opts.Validators != nil {
-			failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{ResponseBody: []byte(r.String())}, result.validationFailure, p.l)
+	if success && p.opts.Validators != nil {
+		failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{ResponseBody: []byte(r.String())}, result.validationFailure, p.l)
 
-			if len(failedValidations) > 0 {
-				p.l.DebugAttrs("Some validations failed", append(logAttrs, slog.String("failed_validations", strings.Join(failedValidations, ",")))...)
-				success = false
-			}
+		if len(failedValidations) > 0 {
+			p.l.DebugAttrs("Some validations failed", append(logAttrs, slog.String("failed_validations", strings.Join(failedValidations, ",")))...)
+			success = false
 		}
+	}
 
-		result.Lock()
-		result.total.Inc()
-		if success {
-			result.success.Inc()
+	var backend string
+	if success && p.lbChecker != nil {
+		if backend, err = p.lbChecker.backendID(r, header, trailer, &peer); err != nil {
+			p.l.WarningAttrs("Error determining backend ID: "+err.Error(), logAttrs...)
+			backend = unknownBackend
 		}
-		result.latency.AddFloat64(delta.Seconds() / p.opts.LatencyUnit.Seconds())
-		result.Unlock()
+	}
+
+	result.Lock()
+	result.total.Inc()
+	if success {
+		result.success.Inc()
+		if size, messages := responseSize(r, sizeOpt); result.respSize != nil && messages > 0 {
+			result.respSize.AddSample(float64(size))
+			result.respMessages.IncBy(int64(messages))
+		}
+	}
+	result.latency.AddFloat64(delta.Seconds() / p.opts.LatencyUnit.Seconds())
+	result.Unlock()
+
+	return backend, success
+}
+
+// responseSize returns the serialized size of the response and the number of
+// response messages. For generic requests, these are collected by the
+// respSizeCallOption. Generic requests other than call_service_method have no
+// response messages.
+func responseSize(r fmt.Stringer, sizeOpt *respSizeCallOption) (int, int) {
+	if sizeOpt != nil {
+		return sizeOpt.size, sizeOpt.messages
+	}
+	if m, ok := r.(proto.Message); ok {
+		return proto.Size(m), 1
+	}
+	return 0, 0
+}
+
+// recordBackends records the distribution of RPCs across backends for an
+// interval.
+func (p *Probe) recordBackends(counts map[string]int64, result *probeRunResult, logAttrs ...slog.Attr) {
+	problem := p.lbChecker.concentrated(counts)
+	if problem != "" {
+		p.l.WarningAttrs("Traffic concentrated on too few backends: "+problem, logAttrs...)
+	}
+
+	result.Lock()
+	defer result.Unlock()
+	for backend, n := range counts {
+		result.backendRPCs.IncKeyBy(backend, n)
+	}
+	if problem != "" {
+		result.lbConcentrated.Inc()
 	}
 }
 
@@ -455,11 +620,25 @@ func (p *Probe) newResult(tgt string) *probeRunResult {
 
 	validationFailure := validators.ValidationFailureMap(p.opts.Validators)
 
-	return &probeRunResult{
+	result := &probeRunResult{
 		target:            tgt,
 		latency:           latencyValue,
 		validationFailure: validationFailure,
 	}
+
+	if p.lbChecker != nil {
+		result.backendRPCs = metrics.NewMap("backend")
+	}
+
+	if p.respSizeDist != nil {
+		result.respSize = p.respSizeDist.CloneDist()
+	}
+
+	if p.c.GetFreshChannel() {
+		result.connectLatency = latencyValue.Clone().(metrics.LatencyValue)
+	}
+
+	return result
 }
 
 // ctxWitHeaders attaches a list of headers to the given context
@@ -512,6 +691,17 @@ func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics)
 				AddLabel("ptype", "grpc").
 				AddLabel("probe", p.name).
 				AddLabel("dst", target.Dst())
+			if result.backendRPCs != nil {
+				em.AddMetric("backend_rpcs", result.backendRPCs.Clone()).
+					AddMetric("lb_concentrated", result.lbConcentrated.Clone())
+			}
+			if result.respSize != nil {
+				em.AddMetric("resp_size", result.respSize.Clone()).
+					AddMetric("resp_messages", result.respMessages.Clone())
+			}
+			if result.connectLatency != nil {
+				em.AddMetric("connect_latency", result.connectLatency.Clone())
+			}
 			result.Unlock()
 
 			if result.validationFailure != nil {
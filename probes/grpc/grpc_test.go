@@ -23,6 +23,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	validators_configpb "github.com/cloudprober/cloudprober/internal/validators/proto"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
+	distpb "github.com/cloudprober/cloudprober/metrics/proto"
 	"github.com/cloudprober/cloudprober/metrics/testutils"
 	configpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
 	"github.com/cloudprober/cloudprober/probes/options"
@@ -42,6 +44,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/proto"
@@ -295,6 +298,167 @@ func TestConnectFailures(t *testing.T) {
 	wg.Wait()
 }
 
+// countingListener counts the accepted connections.
+type countingListener struct {
+	net.Listener
+	accepted atomic.Int64
+}
+
+func (cl *countingListener) Accept() (net.Conn, error) {
+	conn, err := cl.Listener.Accept()
+	if err == nil {
+		cl.accepted.Add(1)
+	}
+	return conn, err
+}
+
+func TestFreshChannel(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Error creating listener: %v", err)
+	}
+	cl := &countingListener{Listener: ln}
+	grpcSrv := grpc.NewServer()
+	spb.RegisterProberServer(grpcSrv, &Server{delay: 10 * time.Millisecond, msg: make([]byte, 1024)})
+	go grpcSrv.Serve(cl)
+	defer grpcSrv.Stop()
+
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr bool
+	}{
+		{
+			name: "success",
+			addr: ln.Addr().String(),
+		},
+		{
+			name:    "connect_failure",
+			addr:    "localhost:9",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl.accepted.Store(0)
+			interval := 100 * time.Millisecond
+
+			p := &Probe{}
+			err := p.Init("grpc-fresh-channel", &options.Options{
+				Targets:             targets.StaticTargets(tt.addr),
+				Interval:            interval,
+				Timeout:             interval,
+				Logger:              &logger.Logger{},
+				LatencyUnit:         time.Millisecond,
+				LatencyMetricName:   "latency",
+				LatencyDist:         metrics.NewDistribution([]float64{1, 5}),
+				StatsExportInterval: 5 * interval,
+				LogMetrics:          func(em *metrics.EventMetrics) {},
+				ProbeConf: &configpb.ProbeConf{
+					NumConns:          proto.Int32(1),
+					InsecureTransport: proto.Bool(true),
+					FreshChannel:      proto.Bool(true),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			dataChan := make(chan *metrics.EventMetrics, 5)
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Start(ctx, dataChan)
+			}()
+
+			ems, err := testutils.MetricsFromChannel(dataChan, 1, 2*time.Second)
+			cancel()
+			wg.Wait()
+			if err != nil || len(ems) != 1 {
+				t.Fatalf("Error getting metrics: %v, ems: %v", err, ems)
+			}
+			em := ems[0]
+
+			total := em.Metric("total").(*metrics.Int).Int64()
+			connectErrors := em.Metric("connecterrors").(*metrics.Int).Int64()
+			connectLatency := em.Metric("connect_latency").(*metrics.Distribution).Data()
+			rpcLatency := em.Metric("latency").(*metrics.Distribution).Data()
+
+			assert.GreaterOrEqual(t, total, int64(3), "total, em: %s", em.String())
+			if tt.wantErr {
+				assert.Equal(t, total, connectErrors, "connecterrors")
+				assert.Equal(t, int64(0), connectLatency.Count, "connect_latency count")
+				assert.Equal(t, int64(0), rpcLatency.Count, "latency count")
+				return
+			}
+
+			// One fresh connection and one RPC per interval.
+			assert.Equal(t, int64(0), connectErrors, "connecterrors")
+			assert.Equal(t, total, em.Metric("success").(*metrics.Int).Int64(), "success")
+			assert.Equal(t, total, rpcLatency.Count, "latency count")
+			assert.Equal(t, total, connectLatency.Count, "connect_latency count")
+			assert.GreaterOrEqual(t, cl.accepted.Load(), total, "accepted connections")
+			assert.Greater(t, connectLatency.Sum, 0.0, "connect_latency sum")
+			// RPCs take at least 10ms due to server delay.
+			assert.GreaterOrEqual(t, rpcLatency.Sum, 10.0*float64(total), "latency sum")
+		})
+	}
+}
+
+// TestConnectErrorAccounting verifies that failed connection attempts are
+// accounted the same way with and without fresh_channel.
+func TestConnectErrorAccounting(t *testing.T) {
+	for _, freshChannel := range []bool{false, true} {
+		t.Run(fmt.Sprintf("fresh_channel=%v", freshChannel), func(t *testing.T) {
+			interval := 100 * time.Millisecond
+
+			p := &Probe{}
+			err := p.Init("grpc-connect-errors", &options.Options{
+				Targets:             targets.StaticTargets("localhost:9"),
+				Interval:            interval,
+				Timeout:             interval,
+				Logger:              &logger.Logger{},
+				StatsExportInterval: 5 * interval,
+				LogMetrics:          func(em *metrics.EventMetrics) {},
+				ProbeConf: &configpb.ProbeConf{
+					NumConns:          proto.Int32(1),
+					InsecureTransport: proto.Bool(true),
+					FreshChannel:      proto.Bool(freshChannel),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+			p.dialOpts = append(p.dialOpts, grpc.WithBlock())
+
+			dataChan := make(chan *metrics.EventMetrics, 5)
+			ctx, cancel := context.WithCancel(context.Background())
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Start(ctx, dataChan)
+			}()
+
+			ems, err := testutils.MetricsFromChannel(dataChan, 1, 2*time.Second)
+			cancel()
+			wg.Wait()
+			if err != nil || len(ems) != 1 {
+				t.Fatalf("Error getting metrics: %v, ems: %v", err, ems)
+			}
+			em := ems[0]
+
+			total := em.Metric("total").(*metrics.Int).Int64()
+			assert.GreaterOrEqual(t, total, int64(2), "total, em: %s", em.String())
+			assert.Equal(t, total, em.Metric("connecterrors").(*metrics.Int).Int64(), "connecterrors, em: %s", em.String())
+			assert.Equal(t, int64(0), em.Metric("success").(*metrics.Int).Int64(), "success, em: %s", em.String())
+		})
+	}
+}
+
 func TestProbeTimeouts(t *testing.T) {
 	interval, timeout := 100*time.Millisecond, 10*time.Millisecond
 
@@ -520,7 +684,7 @@ func TestHealthCheckProbe(t *testing.T) {
 				}, nil
 			}
 
-			_, err := p.healthCheckProbe(context.Background(), nil)
+			_, err := p.healthCheckProbe(context.Background(), nil, nil)
 			if err != nil && !test.wantErr {
 				t.Errorf("Unexpected error: %v", err)
 				return
@@ -595,3 +759,107 @@ func TestTransportCreds(t *testing.T) {
 		})
 	}
 }
+
+func TestResponseSize(t *testing.T) {
+	addr, err := globalGRPCServer(0)
+	if err != nil {
+		t.Fatalf("Error initializing global config: %v", err)
+	}
+
+	conn, err := grpc.DialContext(context.Background(), addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Error connecting to global gRPC server: %v", err)
+	}
+	defer conn.Close()
+
+	tests := []struct {
+		name         string
+		conf         *configpb.ProbeConf
+		wantCounts   []int64
+		wantSum      float64
+		wantMessages int64
+	}{
+		{
+			name: "read_small",
+			conf: &configpb.ProbeConf{
+				Method:   configpb.ProbeConf_READ.Enum(),
+				BlobSize: proto.Int32(100),
+			},
+			// BlobReadResponse: 1 byte tag, 1 byte length, 100 bytes blob.
+			wantCounts:   []int64{0, 2, 0, 0},
+			wantSum:      2 * 102,
+			wantMessages: 2,
+		},
+		{
+			name: "read_large",
+			conf: &configpb.ProbeConf{
+				Method:   configpb.ProbeConf_READ.Enum(),
+				BlobSize: proto.Int32(500),
+			},
+			wantCounts:   []int64{0, 0, 0, 2},
+			wantSum:      2 * 503,
+			wantMessages: 2,
+		},
+		{
+			name: "generic_call_service_method",
+			conf: &configpb.ProbeConf{
+				Method: configpb.ProbeConf_GENERIC.Enum(),
+				Request: &configpb.GenericRequest{
+					RequestType: &configpb.GenericRequest_CallServiceMethod{
+						CallServiceMethod: "cloudprober.servers.grpc.Prober.BlobRead",
+					},
+					Body: proto.String(`{"size": 300}`),
+				},
+			},
+			wantCounts:   []int64{0, 0, 2, 0},
+			wantSum:      2 * 303,
+			wantMessages: 2,
+		},
+		{
+			name: "generic_list_services",
+			conf: &configpb.ProbeConf{
+				Method: configpb.ProbeConf_GENERIC.Enum(),
+				Request: &configpb.GenericRequest{
+					RequestType: &configpb.GenericRequest_ListServices{ListServices: true},
+				},
+			},
+			wantCounts: []int64{0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.conf.InsecureTransport = proto.Bool(true)
+			tt.conf.ResponseSizeDistribution = &distpb.Dist{
+				Buckets: &distpb.Dist_ExplicitBuckets{ExplicitBuckets: "0,200,400"},
+			}
+
+			p := &Probe{}
+			err := p.Init("grpc-resp-size", &options.Options{
+				Targets:             targets.StaticTargets(addr),
+				Interval:            time.Second,
+				Timeout:             time.Second,
+				Logger:              &logger.Logger{},
+				StatsExportInterval: time.Second,
+				LogMetrics:          func(em *metrics.EventMetrics) {},
+				ProbeConf:           tt.conf,
+			})
+			if err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			result := p.newResult(addr)
+			client := spb.NewProberClient(conn)
+			msg := make([]byte, p.c.GetBlobSize())
+			for i := 0; i < 2; i++ {
+				_, success := p.runRequest(context.Background(), conn, client, msg, result)
+				assert.True(t, success, "request success")
+			}
+
+			d := result.respSize.Data()
+			assert.Equal(t, tt.wantCounts, d.BucketCounts, "bucket counts")
+			assert.Equal(t, tt.wantSum, d.Sum, "sum")
+			assert.Equal(t, tt.wantMessages, result.respMessages.Int64(), "messages")
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	configpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
+	"github.com/itchyny/gojq"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// unknownBackend is used when backend ID could not be determined for a
+// successful RPC.
+const unknownBackend = "unknown"
+
+// lbChecker identifies backends serving the RPCs and checks whether traffic
+// is spread across them.
+type lbChecker struct {
+	c       *configpb.ProbeConf_LoadBalancingCheck
+	jqQuery *gojq.Query
+}
+
+func newLBChecker(c *configpb.ProbeConf_LoadBalancingCheck) (*lbChecker, error) {
+	if c.GetRpcsPerInterval() <= 0 {
+		return nil, fmt.Errorf("lb_check: rpcs_per_interval should be positive, got %d", c.GetRpcsPerInterval())
+	}
+	if f := c.GetMaxBackendFraction(); f <= 0 || f > 1 {
+		return nil, fmt.Errorf("lb_check: max_backend_fraction should be in (0, 1], got %v", f)
+	}
+
+	lc := &lbChecker{c: c}
+
+	if field := c.GetBackendIdResponseField(); field != "" {
+		q, err := gojq.Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("lb_check: error parsing backend_id_response_field (%s): %v", field, err)
+		}
+		lc.jqQuery = q
+	}
+
+	return lc, nil
+}
+
+// responseJSON returns the JSON representation of the response.
+func responseJSON(r fmt.Stringer) ([]byte, error) {
+	if m, ok := r.(proto.Message); ok {
+		return protojson.Marshal(m)
+	}
+	return []byte(r.String()), nil
+}
+
+func (lc *lbChecker) backendFromResponse(r fmt.Stringer) (string, error) {
+	b, err := responseJSON(r)
+	if err != nil {
+		return "", err
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(b, &input); err != nil {
+		return "", fmt.Errorf("response is not a valid JSON: %v", err)
+	}
+
+	iter := lc.jqQuery.Run(input)
+	item, ok := iter.Next()
+	if !ok || item == nil {
+		return "", errors.New("backend ID not found in response")
+	}
+	if err, ok := item.(error); ok {
+		return "", err
+	}
+	if s, ok := item.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(item)
+	return string(out), err
+}
+
+// backendID returns the ID of the backend that served the RPC.
+func (lc *lbChecker) backendID(r fmt.Stringer, header, trailer metadata.MD, pr *peer.Peer) (string, error) {
+	if key := lc.c.GetBackendIdMetadataKey(); key != "" {
+		for _, md := range []metadata.MD{header, trailer} {
+			if v := md.Get(key); len(v) > 0 {
+				return v[0], nil
+			}
+		}
+		return "", fmt.Errorf("metadata key %s not found in response", key)
+	}
+
+	if lc.jqQuery != nil {
+		return lc.backendFromResponse(r)
+	}
+
+	if pr != nil && pr.Addr != nil {
+		return pr.Addr.String(), nil
+	}
+	return "", errors.New("peer address not available")
+}
+
+// concentrated checks the distribution of RPCs across backends for an
+// interval. It returns a description of the problem if traffic concentrated
+// on too few backends, and an empty string otherwise.
+func (lc *lbChecker) concentrated(counts map[string]int64) string {
+	var total, maxCount int64
+	var maxBackend string
+	for backend, n := range counts {
+		total += n
+		if n > maxCount || (n == maxCount && backend < maxBackend) {
+			maxCount, maxBackend = n, backend
+		}
+	}
+	if total == 0 {
+		return ""
+	}
+
+	if len(counts) < int(lc.c.GetMinBackends()) {
+		return fmt.Sprintf("only %d backend(s) served the RPCs, want at least %d: %s", len(counts), lc.c.GetMinBackends(), formatCounts(counts))
+	}
+
+	if frac := float64(maxCount) / float64(total); frac > float64(lc.c.GetMaxBackendFraction()) {
+		return fmt.Sprintf("backend %s served %.0f%% of the RPCs, max allowed: %.0f%%: %s", maxBackend, frac*100, lc.c.GetMaxBackendFraction()*100, formatCounts(counts))
+	}
+
+	return ""
+}
+
+func formatCounts(counts map[string]int64) string {
+	var keys []string
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%d", k, counts[k]))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/cloudprober/cloudprober/internal/servers/grpc/proto"
+	spb "github.com/cloudprober/cloudprober/internal/servers/grpc/proto"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/metrics/testutils"
+	configpb "github.com/cloudprober/cloudprober/probes/grpc/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// lbStubServer simulates a load balancer in front of multiple backends. It
+// reports the backend ID in the response header, cycling through backends.
+type lbStubServer struct {
+	backends []string
+	n        atomic.Int64
+
+	spb.UnimplementedProberServer
+}
+
+func (s *lbStubServer) Echo(ctx context.Context, req *pb.EchoMessage) (*pb.EchoMessage, error) {
+	backend := s.backends[int(s.n.Add(1)-1)%len(s.backends)]
+	grpc.SetHeader(ctx, metadata.Pairs("x-backend-id", backend))
+	return req, nil
+}
+
+func startLBStubServer(t *testing.T, backends []string) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Error starting listener: %v", err)
+	}
+
+	grpcSrv := grpc.NewServer()
+	spb.RegisterProberServer(grpcSrv, &lbStubServer{backends: backends})
+	go grpcSrv.Serve(ln)
+	t.Cleanup(grpcSrv.Stop)
+
+	return ln.Addr().String()
+}
+
+func TestLBCheck(t *testing.T) {
+	tests := []struct {
+		name             string
+		backends         []string
+		wantBackends     []string
+		wantConcentrated bool
+	}{
+		{
+			name:         "spread",
+			backends:     []string{"b1", "b2", "b3"},
+			wantBackends: []string{"b1", "b2", "b3"},
+		},
+		{
+			name:             "single_backend",
+			backends:         []string{"b1"},
+			wantBackends:     []string{"b1"},
+			wantConcentrated: true,
+		},
+		{
+			name:             "skewed",
+			backends:         []string{"b1", "b1", "b1", "b1", "b1", "b1", "b1", "b1", "b1", "b2"},
+			wantBackends:     []string{"b1", "b2"},
+			wantConcentrated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := startLBStubServer(t, tt.backends)
+
+			probeOpts := &options.Options{
+				Targets:             targets.StaticTargets(addr),
+				Interval:            100 * time.Millisecond,
+				Timeout:             100 * time.Millisecond,
+				Logger:              &logger.Logger{},
+				StatsExportInterval: 500 * time.Millisecond,
+				LogMetrics:          func(em *metrics.EventMetrics) {},
+				ProbeConf: &configpb.ProbeConf{
+					NumConns:          proto.Int32(1),
+					InsecureTransport: proto.Bool(true),
+					LbCheck: &configpb.ProbeConf_LoadBalancingCheck{
+						RpcsPerInterval: proto.Int32(int32(len(tt.backends))),
+						BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdMetadataKey{
+							BackendIdMetadataKey: "x-backend-id",
+						},
+					},
+				},
+			}
+
+			p := &Probe{}
+			if err := p.Init("grpc-lb", probeOpts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+			dataChan := make(chan *metrics.EventMetrics, 5)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.Start(ctx, dataChan)
+			}()
+
+			ems, err := testutils.MetricsFromChannel(dataChan, 1, 2*time.Second)
+			cancel()
+			wg.Wait()
+			if err != nil || len(ems) != 1 {
+				t.Fatalf("Error getting metrics: %v", err)
+			}
+
+			em := ems[0]
+			backendRPCs := em.Metric("backend_rpcs").(*metrics.Map[int64])
+			assert.ElementsMatch(t, tt.wantBackends, backendRPCs.Keys())
+
+			lbConcentrated := em.Metric("lb_concentrated").(*metrics.Int).Int64()
+			if tt.wantConcentrated {
+				assert.Greater(t, lbConcentrated, int64(0), "lb_concentrated")
+			} else {
+				assert.Equal(t, int64(0), lbConcentrated, "lb_concentrated")
+			}
+		})
+	}
+}
+
+func TestLBCheckerConcentrated(t *testing.T) {
+	lc, err := newLBChecker(&configpb.ProbeConf_LoadBalancingCheck{
+		MaxBackendFraction: proto.Float32(0.6),
+		MinBackends:        proto.Int32(2),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		counts map[string]int64
+		want   bool
+	}{
+		{counts: map[string]int64{}, want: false},
+		{counts: map[string]int64{"b1": 5, "b2": 5}, want: false},
+		{counts: map[string]int64{"b1": 6, "b2": 4}, want: false},
+		{counts: map[string]int64{"b1": 7, "b2": 3}, want: true},
+		{counts: map[string]int64{"b1": 10}, want: true},
+	}
+	for _, tt := range tests {
+		got := lc.concentrated(tt.counts)
+		assert.Equal(t, tt.want, got != "", "counts: %v, got: %s", tt.counts, got)
+	}
+}
+
+func TestLBCheckerBackendID(t *testing.T) {
+	md := metadata.Pairs("x-backend-id", "b1")
+	pr := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443}}
+
+	tests := []struct {
+		name    string
+		c       *configpb.ProbeConf_LoadBalancingCheck
+		r       interface{ String() string }
+		header  metadata.MD
+		trailer metadata.MD
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "metadata_header",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdMetadataKey{BackendIdMetadataKey: "x-backend-id"},
+			},
+			header: md,
+			want:   "b1",
+		},
+		{
+			name: "metadata_trailer",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdMetadataKey{BackendIdMetadataKey: "x-backend-id"},
+			},
+			trailer: md,
+			want:    "b1",
+		},
+		{
+			name: "metadata_missing",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdMetadataKey{BackendIdMetadataKey: "x-backend-id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "generic_response_field",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdResponseField{BackendIdResponseField: ".hostname"},
+			},
+			r:    response(`{"hostname":"b2"}`),
+			want: "b2",
+		},
+		{
+			name: "proto_response_field",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdResponseField{BackendIdResponseField: ".uptimeUs"},
+			},
+			r:    &pb.StatusResponse{UptimeUs: proto.Int64(42)},
+			want: "42",
+		},
+		{
+			name: "response_field_missing",
+			c: &configpb.ProbeConf_LoadBalancingCheck{
+				BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdResponseField{BackendIdResponseField: ".hostname"},
+			},
+			r:       response(`{}`),
+			wantErr: true,
+		},
+		{
+			name: "peer",
+			c:    &configpb.ProbeConf_LoadBalancingCheck{},
+			want: "10.0.0.1:443",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc, err := newLBChecker(tt.c)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got, err := lc.backendID(tt.r, tt.header, tt.trailer, pr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("backendID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewLBCheckerErrors(t *testing.T) {
+	for _, c := range []*configpb.ProbeConf_LoadBalancingCheck{
+		{RpcsPerInterval: proto.Int32(0)},
+		{MaxBackendFraction: proto.Float32(1.5)},
+		{BackendIdSource: &configpb.ProbeConf_LoadBalancingCheck_BackendIdResponseField{BackendIdResponseField: ".["}},
+	} {
+		_, err := newLBChecker(c)
+		assert.Error(t, err, "config: %v", c)
+	}
+}
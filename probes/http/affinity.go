@@ -0,0 +1,133 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+type affinityChecker struct {
+	backendHeader string
+	cookieName    string
+	tokenHeader   string
+	followUps     int
+}
+
+func newAffinityChecker(c *configpb.ProbeConf_SessionAffinityCheck) (*affinityChecker, error) {
+	if c.GetBackendIdHeader() == "" {
+		return nil, fmt.Errorf("session_affinity_check: backend_id_header is required")
+	}
+	if c.GetFollowUpRequests() < 1 {
+		return nil, fmt.Errorf("session_affinity_check: follow_up_requests should be at least 1, got: %d", c.GetFollowUpRequests())
+	}
+	if c.GetCookieName() != "" && c.GetTokenHeader() != "" {
+		return nil, fmt.Errorf("session_affinity_check: only one of cookie_name and token_header can be specified")
+	}
+	return &affinityChecker{
+		backendHeader: c.GetBackendIdHeader(),
+		cookieName:    c.GetCookieName(),
+		tokenHeader:   c.GetTokenHeader(),
+		followUps:     int(c.GetFollowUpRequests()),
+	}, nil
+}
+
+// affinityToken returns a function that adds the affinity token from the
+// given response to the follow-up requests. It returns nil if the response
+// doesn't carry the token.
+func (ac *affinityChecker) affinityToken(resp *http.Response) func(*http.Request) {
+	if ac.tokenHeader != "" {
+		token := resp.Header.Get(ac.tokenHeader)
+		if token == "" {
+			return nil
+		}
+		return func(req *http.Request) { req.Header.Set(ac.tokenHeader, token) }
+	}
+
+	var cookies []*http.Cookie
+	for _, c := range resp.Cookies() {
+		if ac.cookieName == "" || c.Name == ac.cookieName {
+			cookies = append(cookies, c)
+		}
+	}
+	if len(cookies) == 0 {
+		return nil
+	}
+	return func(req *http.Request) {
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+	}
+}
+
+// affinityRequest sends an affinity check request and returns the response
+// with the body already consumed. Affinity requests don't reuse connections.
+func (p *Probe) affinityRequest(ctx context.Context, req *http.Request, client *http.Client, addToken func(*http.Request)) (*http.Response, error) {
+	req = p.prepareRequest(req).Clone(ctx)
+	req.Close = true
+	if addToken != nil {
+		addToken(req)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// checkAffinity runs a session affinity check and updates the result.
+func (p *Probe) checkAffinity(ctx context.Context, req *http.Request, client *http.Client, targetName string, result *probeResult) {
+	ac := p.affinityChecker
+	result.affinityChecks++
+
+	logAttrs := []slog.Attr{slog.String("target", targetName), slog.String("url", req.URL.String())}
+
+	resp, err := p.affinityRequest(ctx, req, client, nil)
+	if err != nil {
+		p.l.WarningAttrs("session affinity: first request failed: "+err.Error(), logAttrs...)
+		result.affinityErrors++
+		return
+	}
+	backend, addToken := resp.Header.Get(ac.backendHeader), ac.affinityToken(resp)
+	if backend == "" || addToken == nil {
+		p.l.WarningAttrs(fmt.Sprintf("session affinity: first response is missing the backend id (%q) or the affinity token", backend), logAttrs...)
+		result.affinityErrors++
+		return
+	}
+
+	for i := 0; i < ac.followUps; i++ {
+		resp, err := p.affinityRequest(ctx, req, client, addToken)
+		if err != nil {
+			p.l.WarningAttrs("session affinity: follow-up request failed: "+err.Error(), logAttrs...)
+			result.affinityErrors++
+			return
+		}
+		if got := resp.Header.Get(ac.backendHeader); got != backend {
+			p.l.WarningAttrs(fmt.Sprintf("session affinity broken: follow-up request %d served by backend %q, session started on %q", i+1, got, backend), logAttrs...)
+			result.affinityBreaks++
+			return
+		}
+	}
+}
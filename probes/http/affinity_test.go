@@ -0,0 +1,141 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// affinityStub simulates a load balancer in front of 3 backends. New
+// sessions are assigned backends in rotation. If sticky is true, requests
+// carrying the SERVERID cookie or the X-Session header are sent to the
+// backend of their session.
+type affinityStub struct {
+	sticky bool
+
+	mu   sync.Mutex
+	next int
+}
+
+func (as *affinityStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	as.mu.Lock()
+	backend := fmt.Sprintf("b%d", as.next%3+1)
+	as.next++
+	as.mu.Unlock()
+
+	if as.sticky {
+		if c, err := r.Cookie("SERVERID"); err == nil {
+			backend = c.Value
+		}
+		if s := r.Header.Get("X-Session"); s != "" {
+			backend = s
+		}
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: "other", Value: "x"})
+	http.SetCookie(w, &http.Cookie{Name: "SERVERID", Value: backend})
+	w.Header().Set("X-Session", backend)
+	w.Header().Set("X-Backend-Id", backend)
+	fmt.Fprintf(w, "served by %s", backend)
+}
+
+func TestCheckAffinity(t *testing.T) {
+	tests := []struct {
+		name        string
+		sticky      bool
+		check       *configpb.ProbeConf_SessionAffinityCheck
+		wantBreaks  int64
+		wantErrors  int64
+		wantInitErr bool
+	}{
+		{
+			name:   "sticky_cookie",
+			sticky: true,
+			check:  &configpb.ProbeConf_SessionAffinityCheck{CookieName: proto.String("SERVERID")},
+		},
+		{
+			name:   "sticky_all_cookies",
+			sticky: true,
+			check:  &configpb.ProbeConf_SessionAffinityCheck{},
+		},
+		{
+			name:   "sticky_token_header",
+			sticky: true,
+			check:  &configpb.ProbeConf_SessionAffinityCheck{TokenHeader: proto.String("X-Session")},
+		},
+		{
+			name:       "broken",
+			check:      &configpb.ProbeConf_SessionAffinityCheck{CookieName: proto.String("SERVERID")},
+			wantBreaks: 1,
+		},
+		{
+			name:       "missing_cookie",
+			sticky:     true,
+			check:      &configpb.ProbeConf_SessionAffinityCheck{CookieName: proto.String("JSESSIONID")},
+			wantErrors: 1,
+		},
+		{
+			name:       "missing_backend_header",
+			sticky:     true,
+			check:      &configpb.ProbeConf_SessionAffinityCheck{BackendIdHeader: proto.String("X-Served-By")},
+			wantErrors: 1,
+		},
+		{
+			name:        "cookie_and_token_header",
+			check:       &configpb.ProbeConf_SessionAffinityCheck{CookieName: proto.String("SERVERID"), TokenHeader: proto.String("X-Session")},
+			wantInitErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewServer(&affinityStub{sticky: test.sticky})
+			defer ts.Close()
+
+			if test.check.BackendIdHeader == nil {
+				test.check.BackendIdHeader = proto.String("X-Backend-Id")
+			}
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{SessionAffinityCheck: test.check}
+
+			p := &Probe{}
+			err := p.Init("http_test", opts)
+			if test.wantInitErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			result := p.newResult()
+			req, _ := http.NewRequest("GET", ts.URL, nil)
+			p.checkAffinity(context.Background(), req, &http.Client{}, "test.com", result)
+
+			assert.Equal(t, int64(1), result.affinityChecks, "affinity_checks")
+			assert.Equal(t, test.wantBreaks, result.affinityBreaks, "affinity_breaks")
+			assert.Equal(t, test.wantErrors, result.affinityErrors, "affinity_errors")
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+// Cache status values, used as keys in the cdn_cache_status map.
+const (
+	cacheHit     = "hit"
+	cacheMiss    = "miss"
+	cacheUnknown = "unknown"
+)
+
+// unknownEdge is used as the edge location if it could not be determined.
+const unknownEdge = "unknown"
+
+var defaultCacheStatusHeaders = []string{"X-Cache", "CF-Cache-Status", "X-Cache-Status", "Cache-Status"}
+
+// Cache status tokens that mean the response was served from the cache.
+// Stale and revalidated responses are served from the cache too, even if
+// cache had to contact the origin for revalidation.
+var cacheHitTokens = map[string]bool{
+	"hit":         true,
+	"stale":       true,
+	"updating":    true,
+	"revalidated": true,
+}
+
+// Cache status tokens that mean the response was fetched from the origin.
+var cacheMissTokens = map[string]bool{
+	"miss":    true,
+	"expired": true,
+	"bypass":  true,
+	"dynamic": true,
+	"pass":    true,
+}
+
+type cdnChecker struct {
+	statusHeaders []string
+	edgeHeader    string
+	edgeRe        *regexp.Regexp
+}
+
+func newCDNChecker(c *configpb.ProbeConf_CDNCheck) (*cdnChecker, error) {
+	cc := &cdnChecker{
+		statusHeaders: c.GetCacheStatusHeader(),
+		edgeHeader:    c.GetEdgeLocationHeader(),
+	}
+	if len(cc.statusHeaders) == 0 {
+		cc.statusHeaders = defaultCacheStatusHeaders
+	}
+
+	if reStr := c.GetEdgeLocationRegex(); reStr != "" {
+		if cc.edgeHeader == "" {
+			return nil, fmt.Errorf("cdn_check: edge_location_regex requires edge_location_header")
+		}
+		re, err := regexp.Compile(reStr)
+		if err != nil {
+			return nil, fmt.Errorf("cdn_check: invalid edge_location_regex (%s): %v", reStr, err)
+		}
+		cc.edgeRe = re
+	}
+
+	return cc, nil
+}
+
+// classifyToken classifies a single cache status token, e.g. "HIT",
+// "TCP_MISS", "Hit from cloudfront".
+func classifyToken(token string) string {
+	// Vendors use various separators: TCP_HIT, RefreshHit, "Hit from X".
+	for _, word := range strings.FieldsFunc(strings.ToLower(token), func(r rune) bool {
+		return r == '_' || r == '-' || r == ' '
+	}) {
+		switch {
+		case cacheHitTokens[word]:
+			return cacheHit
+		case cacheMissTokens[word]:
+			return cacheMiss
+		// Handles forms like "RefreshHit" or "CacheMiss".
+		case strings.HasSuffix(word, "hit"):
+			return cacheHit
+		case strings.HasSuffix(word, "miss"):
+			return cacheMiss
+		}
+	}
+	return cacheUnknown
+}
+
+// parseStructuredCacheStatus parses the Cache-Status header format defined in
+// RFC 9211, e.g. `OriginCache; hit, CDN; fwd=uri-miss`. Each cache adds its
+// entry at the end, so the last entry describes the cache closest to the
+// client.
+func parseStructuredCacheStatus(value string) string {
+	entries := strings.Split(value, ",")
+	params := strings.Split(entries[len(entries)-1], ";")
+	for _, p := range params[1:] {
+		k, _, _ := strings.Cut(strings.TrimSpace(p), "=")
+		switch strings.ToLower(k) {
+		case "hit":
+			return cacheHit
+		case "fwd":
+			return cacheMiss
+		}
+	}
+	return cacheUnknown
+}
+
+// parseCacheStatus parses a cache status header value. Some CDNs report
+// status for multiple cache layers as a comma separated list, e.g. Fastly's
+// "MISS, HIT". Last entry corresponds to the cache closest to the client.
+func parseCacheStatus(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return cacheUnknown
+	}
+	if strings.Contains(value, ";") {
+		return parseStructuredCacheStatus(value)
+	}
+	entries := strings.Split(value, ",")
+	return classifyToken(strings.TrimSpace(entries[len(entries)-1]))
+}
+
+// cacheStatus returns the cache status for a response. It returns
+// cacheUnknown if none of the cache status headers is present.
+func (cc *cdnChecker) cacheStatus(h http.Header) string {
+	for _, name := range cc.statusHeaders {
+		if v := h.Values(name); len(v) > 0 {
+			return parseCacheStatus(strings.Join(v, ","))
+		}
+	}
+	return cacheUnknown
+}
+
+// edgeLocation returns the edge location for a response. It returns an empty
+// string if edge location header is not configured.
+func (cc *cdnChecker) edgeLocation(h http.Header) string {
+	if cc.edgeHeader == "" {
+		return ""
+	}
+
+	v := strings.TrimSpace(h.Get(cc.edgeHeader))
+	if v != "" && cc.edgeRe != nil {
+		m := cc.edgeRe.FindStringSubmatch(v)
+		switch {
+		case len(m) > 1:
+			v = m[1]
+		case len(m) == 1:
+			v = m[0]
+		default:
+			v = ""
+		}
+	}
+
+	if v == "" {
+		return unknownEdge
+	}
+	return v
+}
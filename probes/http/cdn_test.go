@@ -0,0 +1,270 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseCacheStatus(t *testing.T) {
+	tests := map[string]string{
+		"":                                    cacheUnknown,
+		"HIT":                                 cacheHit,
+		"MISS":                                cacheMiss,
+		"Hit from cloudfront":                 cacheHit,
+		"Miss from cloudfront":                cacheMiss,
+		"RefreshHit from cloudfront":          cacheHit,
+		"Error from cloudfront":               cacheUnknown,
+		"TCP_HIT":                             cacheHit,
+		"TCP_REFRESH_MISS":                    cacheMiss,
+		"EXPIRED":                             cacheMiss,
+		"BYPASS":                              cacheMiss,
+		"DYNAMIC":                             cacheMiss,
+		"STALE":                               cacheHit,
+		"REVALIDATED":                         cacheHit,
+		"MISS, HIT":                           cacheHit,
+		"HIT, MISS":                           cacheMiss,
+		"OriginCache; hit":                    cacheHit,
+		"OriginCache; hit, CDN; fwd=uri-miss": cacheMiss,
+		"CDN; fwd=stale; fwd-status=304":      cacheMiss,
+		"CDN; ttl=30":                         cacheUnknown,
+		"garbage":                             cacheUnknown,
+	}
+
+	for value, want := range tests {
+		t.Run(value, func(t *testing.T) {
+			assert.Equal(t, want, parseCacheStatus(value))
+		})
+	}
+}
+
+func TestCDNCheckerCacheStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		resp    http.Header
+		want    string
+	}{
+		{
+			name: "x_cache",
+			resp: http.Header{"X-Cache": {"Hit from cloudfront"}},
+			want: cacheHit,
+		},
+		{
+			name: "cf_cache_status",
+			resp: http.Header{"Cf-Cache-Status": {"MISS"}},
+			want: cacheMiss,
+		},
+		{
+			name: "multiple_values",
+			resp: http.Header{"X-Cache": {"MISS", "HIT"}},
+			want: cacheHit,
+		},
+		{
+			name: "missing_header",
+			resp: http.Header{"Content-Type": {"text/html"}},
+			want: cacheUnknown,
+		},
+		{
+			name:    "custom_header",
+			headers: []string{"X-My-Cache"},
+			resp:    http.Header{"X-My-Cache": {"HIT"}, "X-Cache": {"MISS"}},
+			want:    cacheHit,
+		},
+		{
+			name:    "custom_header_missing",
+			headers: []string{"X-My-Cache"},
+			resp:    http.Header{"X-Cache": {"HIT"}},
+			want:    cacheUnknown,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cc, err := newCDNChecker(&configpb.ProbeConf_CDNCheck{CacheStatusHeader: test.headers})
+			require.NoError(t, err)
+			assert.Equal(t, test.want, cc.cacheStatus(test.resp))
+		})
+	}
+}
+
+func TestCDNCheckerEdgeLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		regex   string
+		resp    http.Header
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "not_configured",
+			resp: http.Header{"X-Amz-Cf-Pop": {"SFO5-C1"}},
+			want: "",
+		},
+		{
+			name:   "header_value",
+			header: "X-Amz-Cf-Pop",
+			resp:   http.Header{"X-Amz-Cf-Pop": {"SFO5-C1"}},
+			want:   "SFO5-C1",
+		},
+		{
+			name:   "regex_group",
+			header: "CF-Ray",
+			regex:  "-([A-Z]+)$",
+			resp:   http.Header{"Cf-Ray": {"7d1c2b3a4e5f6789-SJC"}},
+			want:   "SJC",
+		},
+		{
+			name:   "regex_no_group",
+			header: "X-Amz-Cf-Pop",
+			regex:  "^[A-Z]+",
+			resp:   http.Header{"X-Amz-Cf-Pop": {"SFO5-C1"}},
+			want:   "SFO",
+		},
+		{
+			name:   "regex_no_match",
+			header: "CF-Ray",
+			regex:  "-([A-Z]+)$",
+			resp:   http.Header{"Cf-Ray": {"7d1c2b3a4e5f6789"}},
+			want:   unknownEdge,
+		},
+		{
+			name:   "missing_header",
+			header: "CF-Ray",
+			resp:   http.Header{},
+			want:   unknownEdge,
+		},
+		{
+			name:    "regex_without_header",
+			regex:   "-([A-Z]+)$",
+			wantErr: true,
+		},
+		{
+			name:    "invalid_regex",
+			header:  "CF-Ray",
+			regex:   "-([A-Z]+$",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &configpb.ProbeConf_CDNCheck{}
+			if test.header != "" {
+				c.EdgeLocationHeader = proto.String(test.header)
+			}
+			if test.regex != "" {
+				c.EdgeLocationRegex = proto.String(test.regex)
+			}
+			cc, err := newCDNChecker(c)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, cc.edgeLocation(test.resp))
+		})
+	}
+}
+
+// cdnTestTransport returns responses with headers from the given list, in
+// rotation.
+type cdnTestTransport struct {
+	headers []http.Header
+	n       int
+}
+
+func (tt *cdnTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	h := tt.headers[tt.n%len(tt.headers)]
+	tt.n++
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Header: h}, nil
+}
+
+func TestProbeWithCDNCheck(t *testing.T) {
+	respHeaders := []http.Header{
+		{"X-Cache": {"HIT"}, "X-Amz-Cf-Pop": {"SFO5-C1"}},
+		{"X-Cache": {"MISS"}, "X-Amz-Cf-Pop": {"SFO5-C1"}},
+		{"X-Cache": {"HIT"}, "X-Amz-Cf-Pop": {"IAD89-C2"}},
+		{},
+	}
+
+	tests := []struct {
+		name       string
+		edgeHeader string
+		// Expected cdn_cache_status, keyed by edge label.
+		want map[string]string
+	}{
+		{
+			name: "no_edge",
+			want: map[string]string{
+				"": "map:status,hit:2,miss:1,unknown:1",
+			},
+		},
+		{
+			name:       "with_edge",
+			edgeHeader: "X-Amz-Cf-Pop",
+			want: map[string]string{
+				"IAD89-C2":  "map:status,hit:1",
+				"SFO5-C1":   "map:status,hit:1,miss:1",
+				unknownEdge: "map:status,unknown:1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cdnCheck := &configpb.ProbeConf_CDNCheck{}
+			if test.edgeHeader != "" {
+				cdnCheck.EdgeLocationHeader = proto.String(test.edgeHeader)
+			}
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{CdnCheck: cdnCheck}
+
+			p := &Probe{}
+			require.NoError(t, p.Init("http_test", opts))
+			client := &http.Client{Transport: &cdnTestTransport{headers: respHeaders}}
+
+			result := p.newResult()
+			for range respHeaders {
+				req, _ := http.NewRequest("GET", "http://test.com/", nil)
+				p.doHTTPRequest(req, client, "test.com", result, nil)
+			}
+			assert.Equal(t, int64(len(respHeaders)), result.success)
+
+			dataChan := make(chan *metrics.EventMetrics, 10)
+			p.exportMetrics(time.Now(), result, endpoint.Endpoint{Name: "test.com"}, dataChan)
+			close(dataChan)
+
+			got := make(map[string]string)
+			for em := range dataChan {
+				if m := em.Metric("cdn_cache_status"); m != nil {
+					got[em.Label("edge")] = m.String()
+				}
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+// maxCRLSize limits the size of the CRLs we download.
+const maxCRLSize = 20 << 20
+
+// crlFetchError is returned when a CRL could not be fetched from its
+// distribution point, e.g. because the endpoint is unreachable.
+type crlFetchError struct {
+	url string
+	err error
+}
+
+func (e *crlFetchError) Error() string {
+	return fmt.Sprintf("error fetching CRL from %s: %v", e.url, e.err)
+}
+
+func (e *crlFetchError) Unwrap() error {
+	return e.err
+}
+
+type crlEntry struct {
+	// Serial numbers of the revoked certificates.
+	revoked map[string]bool
+	expiry  time.Time
+}
+
+// crlChecker checks certificates against the CRLs published at their
+// distribution points. CRLs are cached by URL.
+type crlChecker struct {
+	ttl    time.Duration
+	client *http.Client
+	l      *logger.Logger
+
+	mu    sync.Mutex
+	cache map[string]*crlEntry
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+func newCRLChecker(c *configpb.ProbeConf_CRLCheck, timeout time.Duration, l *logger.Logger) *crlChecker {
+	if c.GetFetchTimeoutMsec() > 0 {
+		timeout = time.Duration(c.GetFetchTimeoutMsec()) * time.Millisecond
+	}
+	return &crlChecker{
+		ttl:    time.Duration(c.GetCacheTtlSec()) * time.Second,
+		client: &http.Client{Timeout: timeout},
+		l:      l,
+		cache:  make(map[string]*crlEntry),
+		now:    time.Now,
+	}
+}
+
+func (cc *crlChecker) fetchCRL(url string, issuer *x509.Certificate) (*crlEntry, error) {
+	resp, err := cc.client.Get(url)
+	if err != nil {
+		return nil, &crlFetchError{url: url, err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &crlFetchError{url: url, err: fmt.Errorf("unexpected HTTP status: %s", resp.Status)}
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxCRLSize))
+	if err != nil {
+		return nil, &crlFetchError{url: url, err: err}
+	}
+
+	// CRLs are usually DER encoded, but some servers publish them in PEM.
+	if block, _ := pem.Decode(b); block != nil {
+		b = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(b)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CRL from %s: %v", url, err)
+	}
+	if err := crl.CheckSignatureFrom(issuer); err != nil {
+		return nil, fmt.Errorf("invalid signature on CRL from %s: %v", url, err)
+	}
+
+	entry := &crlEntry{
+		revoked: make(map[string]bool, len(crl.RevokedCertificateEntries)),
+		expiry:  cc.now().Add(cc.ttl),
+	}
+	for _, rc := range crl.RevokedCertificateEntries {
+		entry.revoked[rc.SerialNumber.String()] = true
+	}
+	if !crl.NextUpdate.IsZero() && crl.NextUpdate.Before(entry.expiry) {
+		entry.expiry = crl.NextUpdate
+	}
+	return entry, nil
+}
+
+func (cc *crlChecker) getCRL(url string, issuer *x509.Certificate) (*crlEntry, error) {
+	cc.mu.Lock()
+	entry := cc.cache[url]
+	cc.mu.Unlock()
+
+	if entry != nil && cc.now().Before(entry.expiry) {
+		return entry, nil
+	}
+
+	cc.l.Debugf("Fetching CRL from %s", url)
+	entry, err := cc.fetchCRL(url, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.mu.Lock()
+	cc.cache[url] = entry
+	cc.mu.Unlock()
+
+	return entry, nil
+}
+
+// isRevoked checks the given certificate against the CRL from its
+// distribution points. Distribution points are tried in order until we get a
+// CRL from one of them. Only HTTP(S) distribution points are supported.
+func (cc *crlChecker) isRevoked(cert, issuer *x509.Certificate) (bool, error) {
+	var lastErr error
+	for _, url := range cert.CRLDistributionPoints {
+		if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			continue
+		}
+		entry, err := cc.getCRL(url, issuer)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return entry.revoked[cert.SerialNumber.String()], nil
+	}
+	return false, lastErr
+}
+
+// check checks whether any of the certificates in the chain presented by the
+// server has been revoked. A certificate is checked only if its issuer is
+// also in the chain, so the last certificate in the chain is never checked.
+// It returns an error if revocation status of some certificate could not be
+// determined and no revoked certificate was found.
+func (cc *crlChecker) check(cs *tls.ConnectionState) (bool, error) {
+	chain := cs.PeerCertificates
+	if len(cs.VerifiedChains) > 0 {
+		chain = cs.VerifiedChains[0]
+	}
+
+	var firstErr error
+	for i := 0; i+1 < len(chain); i++ {
+		revoked, err := cc.isRevoked(chain[i], chain[i+1])
+		if revoked {
+			return true, nil
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return false, firstErr
+}
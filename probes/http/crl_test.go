@@ -0,0 +1,291 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testPKI is a CA with leaf certificates pointing to a stub CRL server.
+type testPKI struct {
+	ca          *x509.Certificate
+	validLeaf   *x509.Certificate
+	revokedLeaf *x509.Certificate
+	// Leaf with an unreachable CRL distribution point.
+	unreachableLeaf *x509.Certificate
+
+	crlFetches atomic.Int64
+}
+
+// newTestCert creates a certificate from the template. If parent is nil,
+// certificate is self-signed.
+func newTestCert(t *testing.T, tmpl, parent *x509.Certificate, pub, priv any) *x509.Certificate {
+	t.Helper()
+	if parent == nil {
+		parent = tmpl
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, pub, priv)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	tp := &testPKI{}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tp.ca = newTestCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, nil, &caKey.PublicKey, caKey)
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(24 * time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(3), RevocationTime: time.Now().Add(-time.Minute)},
+		},
+	}, tp.ca, caKey)
+	require.NoError(t, err)
+
+	crlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tp.crlFetches.Add(1)
+		w.Write(crl)
+	}))
+	t.Cleanup(crlServer.Close)
+
+	// Use address of a closed server as an unreachable endpoint.
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := closedServer.URL + "/ca.crl"
+	closedServer.Close()
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newLeaf := func(serial int64, crlURL string) *x509.Certificate {
+		return newTestCert(t, &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: "leaf"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			CRLDistributionPoints: []string{crlURL},
+		}, tp.ca, &leafKey.PublicKey, caKey)
+	}
+	tp.validLeaf = newLeaf(2, crlServer.URL+"/ca.crl")
+	tp.revokedLeaf = newLeaf(3, crlServer.URL+"/ca.crl")
+	tp.unreachableLeaf = newLeaf(4, unreachableURL)
+
+	return tp
+}
+
+func TestCRLCheckerCheck(t *testing.T) {
+	tp := newTestPKI(t)
+
+	tests := []struct {
+		name         string
+		chain        []*x509.Certificate
+		wantRevoked  bool
+		wantErr      bool
+		wantFetchErr bool
+	}{
+		{
+			name:  "valid",
+			chain: []*x509.Certificate{tp.validLeaf, tp.ca},
+		},
+		{
+			name:        "revoked",
+			chain:       []*x509.Certificate{tp.revokedLeaf, tp.ca},
+			wantRevoked: true,
+		},
+		{
+			name:         "unreachable",
+			chain:        []*x509.Certificate{tp.unreachableLeaf, tp.ca},
+			wantErr:      true,
+			wantFetchErr: true,
+		},
+		{
+			name:  "no_issuer_in_chain",
+			chain: []*x509.Certificate{tp.revokedLeaf},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cc := newCRLChecker(&configpb.ProbeConf_CRLCheck{}, time.Second, &logger.Logger{})
+			revoked, err := cc.check(&tls.ConnectionState{PeerCertificates: test.chain})
+			assert.Equal(t, test.wantRevoked, revoked)
+			if !test.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			var fetchErr *crlFetchError
+			assert.Equal(t, test.wantFetchErr, errors.As(err, &fetchErr), "crlFetchError")
+		})
+	}
+}
+
+func TestCRLCheckerCache(t *testing.T) {
+	tp := newTestPKI(t)
+
+	now := time.Now()
+	cc := newCRLChecker(&configpb.ProbeConf_CRLCheck{CacheTtlSec: proto.Int32(60)}, time.Second, &logger.Logger{})
+	cc.now = func() time.Time { return now }
+
+	cs := &tls.ConnectionState{PeerCertificates: []*x509.Certificate{tp.validLeaf, tp.ca}}
+	for i := 0; i < 3; i++ {
+		_, err := cc.check(cs)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), tp.crlFetches.Load(), "CRL fetches within TTL")
+
+	// Revoked leaf uses the same distribution point, served from cache.
+	revoked, err := cc.check(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{tp.revokedLeaf, tp.ca}})
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Equal(t, int64(1), tp.crlFetches.Load(), "CRL fetches within TTL")
+
+	now = now.Add(61 * time.Second)
+	_, err = cc.check(cs)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), tp.crlFetches.Load(), "CRL fetches after TTL")
+}
+
+func TestCRLCheckerBadSignature(t *testing.T) {
+	tp := newTestPKI(t)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherCA := newTestCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, nil, &otherKey.PublicKey, otherKey)
+
+	cc := newCRLChecker(&configpb.ProbeConf_CRLCheck{}, time.Second, &logger.Logger{})
+	_, err = cc.check(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{tp.revokedLeaf, otherCA}})
+	assert.ErrorContains(t, err, "invalid signature")
+}
+
+// crlTestTransport returns responses with the given TLS connection state.
+type crlTestTransport struct {
+	cs *tls.ConnectionState
+}
+
+func (tt *crlTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, TLS: tt.cs}, nil
+}
+
+func TestProbeWithCRLCheck(t *testing.T) {
+	tp := newTestPKI(t)
+
+	tests := []struct {
+		name            string
+		leaf            *x509.Certificate
+		wantSuccess     int64
+		wantRevoked     int64
+		wantFetchErrors int64
+	}{
+		{
+			name:        "valid",
+			leaf:        tp.validLeaf,
+			wantSuccess: 1,
+			wantRevoked: 0,
+		},
+		{
+			name:        "revoked",
+			leaf:        tp.revokedLeaf,
+			wantSuccess: 0,
+			wantRevoked: 1,
+		},
+		{
+			name:            "unreachable",
+			leaf:            tp.unreachableLeaf,
+			wantSuccess:     1,
+			wantRevoked:     -1,
+			wantFetchErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{
+				CrlCheck: &configpb.ProbeConf_CRLCheck{},
+			}
+
+			p := &Probe{}
+			require.NoError(t, p.Init("http_test", opts))
+			p.baseTransport = &crlTestTransport{
+				cs: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{test.leaf, tp.ca}},
+			}
+
+			result := p.newResult()
+			client := &http.Client{Transport: p.baseTransport}
+			req, _ := http.NewRequest("GET", "https://test.com/", nil)
+			p.doHTTPRequest(req, client, "test.com", result, nil)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantRevoked, result.certRevoked, "certRevoked")
+			assert.Equal(t, test.wantFetchErrors, result.crlFetchErrors, "crlFetchErrors")
+
+			dataChan := make(chan *metrics.EventMetrics, 10)
+			p.exportMetrics(time.Now(), result, endpoint.Endpoint{Name: "test.com"}, dataChan)
+			em := <-dataChan
+			assert.Equal(t, test.wantFetchErrors, em.Metric("crl_fetch_errors").(*metrics.Int).Int64())
+			gaugeEM := <-dataChan
+			if test.wantRevoked >= 0 {
+				assert.Equal(t, test.wantRevoked, gaugeEM.Metric("cert_revoked").(*metrics.Int).Int64())
+			} else {
+				assert.Nil(t, gaugeEM.Metric("cert_revoked"))
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"net/http/httptrace"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -86,6 +87,21 @@ type Probe struct {
 	waitGroup   sync.WaitGroup
 
 	requestBody *httpreq.RequestBody
+
+	// Checks server certificates against CRLs, if configured.
+	crlChecker *crlChecker
+	// Classifies responses as CDN cache hits or misses, if configured.
+	cdnChecker *cdnChecker
+	// Verifies session affinity, if configured.
+	affinityChecker *affinityChecker
+	// Parses rate limit headers, if configured.
+	rateLimitChecker *rateLimitChecker
+	// Verifies the traffic share of a rollout, if configured.
+	rolloutChecker *rolloutChecker
+	// Verifies the integrity of uploads, if configured.
+	uploadChecker *uploadChecker
+	// Verifies cache invalidation propagation, if configured.
+	invalidationChecker *invalidationChecker
 }
 
 type latencyDetails struct {
@@ -99,8 +115,36 @@ type probeResult struct {
 	respCodes                    *metrics.Map[int64]
 	respBodies                   *metrics.Map[int64]
 	validationFailure            *metrics.Map[int64]
+	validationDetails            *validators.FailureDetails
 	latencyBreakdown             *latencyDetails
 	sslEarliestExpirationSeconds int64
+	// Whether server certificate was revoked: 1 if revoked, 0 if not, and -1
+	// if unknown.
+	certRevoked    int64
+	crlFetchErrors int64
+	// CDN cache status counts, keyed by the edge location.
+	cdnCacheStatus map[string]*metrics.Map[int64]
+	// Session affinity check counts.
+	affinityChecks, affinityBreaks, affinityErrors int64
+	// Rate limit information from the last response, and rate limit counts.
+	rateLimit                                          rateLimitInfo
+	rateLimitLow, rateLimitThrottled, rateLimitMissing int64
+	// Rollout check counts, and the observed percentage of the new variant
+	// in the last check (-1 if not known).
+	rolloutChecks, rolloutDeviations, rolloutErrors int64
+	rolloutVariants                                 *metrics.Map[int64]
+	rolloutNewPercent                               float64
+	// Upload check counts, latency, and the throughput of the last successful
+	// upload in bytes/sec (-1 if not known).
+	uploadChecks, uploadErrors, uploadPartial int64
+	uploadIntegrityFailures, uploadBytes      int64
+	uploadLatency                             metrics.LatencyValue
+	uploadThroughput                          float64
+	// Invalidation check counts, SLA violations and propagation latency,
+	// keyed by the cache node.
+	invalidationChecks, invalidationErrors int64
+	invalidationSLAViolations              *metrics.Map[int64]
+	invalidationLatency                    map[string]metrics.LatencyValue
 }
 
 func (p *Probe) getTransport() (*http.Transport, error) {
@@ -225,6 +269,44 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 
 	p.baseTransport = transport
 
+	if p.c.GetCrlCheck() != nil {
+		p.crlChecker = newCRLChecker(p.c.GetCrlCheck(), p.opts.Timeout, p.l)
+	}
+
+	if p.c.GetCdnCheck() != nil {
+		if p.cdnChecker, err = newCDNChecker(p.c.GetCdnCheck()); err != nil {
+			return err
+		}
+	}
+
+	if p.c.GetSessionAffinityCheck() != nil {
+		if p.affinityChecker, err = newAffinityChecker(p.c.GetSessionAffinityCheck()); err != nil {
+			return err
+		}
+	}
+
+	if p.c.GetRateLimitCheck() != nil {
+		p.rateLimitChecker = newRateLimitChecker(p.c.GetRateLimitCheck())
+	}
+
+	if p.c.GetRolloutCheck() != nil {
+		if p.rolloutChecker, err = newRolloutChecker(p.c.GetRolloutCheck()); err != nil {
+			return err
+		}
+	}
+
+	if p.c.GetUploadCheck() != nil {
+		if p.uploadChecker, err = newUploadChecker(p.c.GetUploadCheck()); err != nil {
+			return err
+		}
+	}
+
+	if p.c.GetInvalidationCheck() != nil {
+		if p.invalidationChecker, err = newInvalidationChecker(p.c.GetInvalidationCheck(), p.opts.Timeout); err != nil {
+			return err
+		}
+	}
+
 	if p.c.MaxRedirects != nil {
 		p.redirectFunc = func(req *http.Request, via []*http.Request) error {
 			if len(via) >= int(p.c.GetMaxRedirects()) {
@@ -319,6 +401,15 @@ func (p *Probe) doHTTPRequest(req *http.Request, client *http.Client, targetName
 	resp, err := client.Do(req)
 	latency := time.Since(start)
 
+	// CRL check may involve fetching CRLs, so we do it before taking the lock
+	// on the result object.
+	var crlChecked, certRevoked bool
+	var crlErr error
+	if err == nil && p.crlChecker != nil && resp.TLS != nil {
+		crlChecked = true
+		certRevoked, crlErr = p.crlChecker.check(resp.TLS)
+	}
+
 	if resultMu != nil {
 		// Note that we take lock on result object outside of the actual request.
 		resultMu.Lock()
@@ -350,6 +441,18 @@ func (p *Probe) doHTTPRequest(req *http.Request, client *http.Client, targetName
 	resp.Body.Close()
 	result.respCodes.IncKey(strconv.FormatInt(int64(resp.StatusCode), 10))
 
+	if p.cdnChecker != nil {
+		edge := p.cdnChecker.edgeLocation(resp.Header)
+		if result.cdnCacheStatus[edge] == nil {
+			result.cdnCacheStatus[edge] = metrics.NewMap("status")
+		}
+		result.cdnCacheStatus[edge].IncKey(p.cdnChecker.cacheStatus(resp.Header))
+	}
+
+	if p.rateLimitChecker != nil {
+		p.checkRateLimit(resp, targetName, req.URL.String(), result)
+	}
+
 	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
 		now := time.Now()
 		minExpirySeconds := resp.TLS.PeerCertificates[0].NotAfter.Sub(now).Seconds()
@@ -363,8 +466,25 @@ func (p *Probe) doHTTPRequest(req *http.Request, client *http.Client, targetName
 		result.sslEarliestExpirationSeconds = int64(minExpirySeconds)
 	}
 
+	if crlChecked {
+		if crlErr != nil {
+			// We can't determine revocation status if CRL is unavailable, so
+			// we don't fail the request, but we still count the error.
+			p.l.WarningAttrs("CRL check error: "+crlErr.Error(), slog.String("target", targetName), slog.String("url", req.URL.String()))
+			result.crlFetchErrors++
+		}
+		switch {
+		case certRevoked:
+			result.certRevoked = 1
+			p.l.WarningAttrs("server certificate has been revoked", slog.String("target", targetName), slog.String("url", req.URL.String()))
+			return
+		case crlErr == nil:
+			result.certRevoked = 0
+		}
+	}
+
 	if p.opts.Validators != nil {
-		failedValidations := validators.RunValidators(p.opts.Validators, &validators.Input{Response: resp, ResponseBody: respBody}, result.validationFailure, p.l)
+		failedValidations := validators.RunValidatorsWithDetails(p.opts.Validators, &validators.Input{Response: resp, ResponseBody: respBody}, result.validationFailure, result.validationDetails, p.l)
 
 		// If any validation failed, return now, leaving the success and latency
 		// counters unchanged.
@@ -415,6 +535,27 @@ func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, clients
 	reqCtx, cancelReqCtx := context.WithTimeout(ctx, p.opts.Timeout)
 	defer cancelReqCtx()
 
+	// Session affinity check runs after the regular requests, within the same
+	// timeout.
+	if p.affinityChecker != nil {
+		defer p.checkAffinity(reqCtx, req, clients[0], target.Name, result)
+	}
+
+	// Rollout check also runs after the regular requests.
+	if p.rolloutChecker != nil {
+		defer p.checkRollout(reqCtx, req, clients[0], target.Name, result)
+	}
+
+	// Upload check also runs after the regular requests.
+	if p.uploadChecker != nil {
+		defer p.checkUpload(reqCtx, req, clients[0], target.Name, result)
+	}
+
+	// Invalidation check also runs after the regular requests.
+	if p.invalidationChecker != nil {
+		defer p.checkInvalidation(reqCtx, req, clients[0], target.Name, result)
+	}
+
 	if p.c.GetRequestsPerProbe() == 1 {
 		p.doHTTPRequest(req.WithContext(reqCtx), clients[0], target.Name, result, nil)
 		return
@@ -443,10 +584,15 @@ func (p *Probe) newResult() *probeResult {
 	result := &probeResult{
 		respCodes:                    metrics.NewMap("code"),
 		sslEarliestExpirationSeconds: -1,
+		certRevoked:                  -1,
+		rateLimit:                    rateLimitInfo{-1, -1, -1, -1},
+		rolloutNewPercent:            -1,
+		uploadThroughput:             -1,
 	}
 
 	if p.opts.Validators != nil {
 		result.validationFailure = validators.ValidationFailureMap(p.opts.Validators)
+		result.validationDetails = validators.NewFailureDetails()
 	}
 
 	if p.opts.LatencyDist != nil {
@@ -461,6 +607,23 @@ func (p *Probe) newResult() *probeResult {
 		result.respBodies = metrics.NewMap("resp")
 	}
 
+	if p.cdnChecker != nil {
+		result.cdnCacheStatus = make(map[string]*metrics.Map[int64])
+	}
+
+	if p.rolloutChecker != nil {
+		result.rolloutVariants = metrics.NewMap("variant")
+	}
+
+	if p.uploadChecker != nil {
+		result.uploadLatency = result.latency.Clone().(metrics.LatencyValue)
+	}
+
+	if p.invalidationChecker != nil {
+		result.invalidationSLAViolations = metrics.NewMap("node")
+		result.invalidationLatency = make(map[string]metrics.LatencyValue)
+	}
+
 	return result
 }
 
@@ -484,6 +647,50 @@ func (p *Probe) exportMetrics(ts time.Time, result *probeResult, target endpoint
 		em.AddMetric("validation_failure", result.validationFailure)
 	}
 
+	if p.crlChecker != nil {
+		em.AddMetric("crl_fetch_errors", metrics.NewInt(result.crlFetchErrors))
+	}
+
+	if p.affinityChecker != nil {
+		em.AddMetric("affinity_checks", metrics.NewInt(result.affinityChecks)).
+			AddMetric("affinity_breaks", metrics.NewInt(result.affinityBreaks)).
+			AddMetric("affinity_errors", metrics.NewInt(result.affinityErrors))
+	}
+
+	if p.rateLimitChecker != nil {
+		em.AddMetric("ratelimit_low", metrics.NewInt(result.rateLimitLow)).
+			AddMetric("ratelimit_throttled", metrics.NewInt(result.rateLimitThrottled)).
+			AddMetric("ratelimit_headers_missing", metrics.NewInt(result.rateLimitMissing))
+	}
+
+	if p.rolloutChecker != nil {
+		em.AddMetric("rollout_checks", metrics.NewInt(result.rolloutChecks)).
+			AddMetric("rollout_deviations", metrics.NewInt(result.rolloutDeviations)).
+			AddMetric("rollout_errors", metrics.NewInt(result.rolloutErrors)).
+			AddMetric("rollout_variant", result.rolloutVariants.Clone())
+	}
+
+	if p.uploadChecker != nil {
+		em.AddMetric("upload_checks", metrics.NewInt(result.uploadChecks)).
+			AddMetric("upload_errors", metrics.NewInt(result.uploadErrors)).
+			AddMetric("upload_partial", metrics.NewInt(result.uploadPartial)).
+			AddMetric("upload_integrity_failures", metrics.NewInt(result.uploadIntegrityFailures)).
+			AddMetric("upload_bytes", metrics.NewInt(result.uploadBytes)).
+			AddMetric("upload_"+p.opts.LatencyMetricName, result.uploadLatency.Clone())
+	}
+
+	if p.invalidationChecker != nil {
+		em.AddMetric("invalidation_checks", metrics.NewInt(result.invalidationChecks)).
+			AddMetric("invalidation_errors", metrics.NewInt(result.invalidationErrors)).
+			AddMetric("invalidation_sla_violations", result.invalidationSLAViolations.Clone())
+	}
+
+	// If edge location is not configured, all counts are recorded with an
+	// empty edge and exported along with the other metrics.
+	if m := result.cdnCacheStatus[""]; m != nil {
+		em.AddMetric("cdn_cache_status", m.Clone())
+	}
+
 	if result.latencyBreakdown != nil {
 		if dl := result.latencyBreakdown.dnsLatency; dl != nil {
 			em.AddMetric("dns_latency", dl.Clone())
@@ -505,11 +712,84 @@ func (p *Probe) exportMetrics(ts time.Time, result *probeResult, target endpoint
 	em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
 	p.opts.RecordMetrics(target, em, dataChan)
 
-	// SSL earliest cert expiry is exported in an independent EM as it's a
-	// GAUGE metrics.
+	// CDN cache status per edge location is exported in independent EMs, one
+	// for each edge location.
+	var edges []string
+	for edge := range result.cdnCacheStatus {
+		if edge != "" {
+			edges = append(edges, edge)
+		}
+	}
+	sort.Strings(edges)
+	for _, edge := range edges {
+		em := metrics.NewEventMetrics(ts).
+			AddMetric("cdn_cache_status", result.cdnCacheStatus[edge].Clone())
+		em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name).AddLabel("edge", edge)
+		p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+	}
+
+	// Invalidation propagation latency is exported in independent EMs, one
+	// for each cache node.
+	var nodes []string
+	for node := range result.invalidationLatency {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		em := metrics.NewEventMetrics(ts).
+			AddMetric("invalidation_"+p.opts.LatencyMetricName, result.invalidationLatency[node].Clone())
+		em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name).AddLabel("node", node)
+		p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+	}
+
+	// Validation failure details are exported in independent EMs, one for
+	// each validator, reason and value, so that surfacers can filter on them.
+	if result.validationDetails != nil {
+		for _, em := range result.validationDetails.EventMetrics(ts) {
+			em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
+			p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+		}
+	}
+
+	// Rate limit values from the last response are exported in an independent
+	// EM as they are GAUGE metrics.
+	if p.rateLimitChecker != nil {
+		em := metrics.NewEventMetrics(ts)
+		if result.rateLimit.addMetrics(em) {
+			em.Kind = metrics.GAUGE
+			em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
+			p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+		}
+	}
+
+	// Observed percentage of the new variant is exported in an independent EM
+	// as it's a GAUGE metric.
+	if result.rolloutNewPercent >= 0 {
+		em := metrics.NewEventMetrics(ts).
+			AddMetric("rollout_new_percent", metrics.NewFloat(result.rolloutNewPercent))
+		em.Kind = metrics.GAUGE
+		em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
+		p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+	}
+
+	// Throughput of the last successful upload is exported in an independent
+	// EM as it's a GAUGE metric.
+	if result.uploadThroughput >= 0 {
+		em := metrics.NewEventMetrics(ts).
+			AddMetric("upload_throughput_bytes_per_sec", metrics.NewFloat(result.uploadThroughput))
+		em.Kind = metrics.GAUGE
+		em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
+		p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
+	}
+
+	// SSL earliest cert expiry and cert revocation status are exported in an
+	// independent EM as they are GAUGE metrics.
 	if result.sslEarliestExpirationSeconds >= 0 {
 		em := metrics.NewEventMetrics(ts).
 			AddMetric("ssl_earliest_cert_expiry_sec", metrics.NewInt(result.sslEarliestExpirationSeconds))
+		if result.certRevoked >= 0 {
+			em.AddMetric("cert_revoked", metrics.NewInt(result.certRevoked))
+		}
 		em.Kind = metrics.GAUGE
 		em.AddLabel("ptype", "http").AddLabel("probe", p.name).AddLabel("dst", target.Name)
 		p.opts.RecordMetrics(target, em, dataChan, options.WithNoAlert())
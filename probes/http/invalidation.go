@@ -0,0 +1,273 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+// Maximum number of requests to populate the key on a cache node: first
+// request may be a miss that brings the key into the cache.
+const maxPopulateRequests = 2
+
+type invalidationChecker struct {
+	nodes         []*url.URL
+	relURL        *url.URL
+	invURL        *url.URL
+	invMethod     string
+	invBody       string
+	sla           time.Duration
+	pollInterval  time.Duration
+	statusChecker *cdnChecker
+}
+
+func newInvalidationChecker(c *configpb.ProbeConf_InvalidationCheck, timeout time.Duration) (*invalidationChecker, error) {
+	ic := &invalidationChecker{
+		invMethod:     c.GetInvalidationMethod(),
+		invBody:       c.GetInvalidationBody(),
+		sla:           time.Duration(c.GetSlaMsec()) * time.Millisecond,
+		pollInterval:  time.Duration(c.GetPollIntervalMsec()) * time.Millisecond,
+		statusChecker: &cdnChecker{statusHeaders: c.GetCacheStatusHeader()},
+	}
+	if len(ic.statusChecker.statusHeaders) == 0 {
+		ic.statusChecker.statusHeaders = defaultCacheStatusHeaders
+	}
+
+	if ic.sla <= 0 || ic.sla >= timeout {
+		return nil, fmt.Errorf("invalidation_check: sla_msec should be positive and less than the probe timeout (%s), got: %d", timeout, c.GetSlaMsec())
+	}
+	if ic.pollInterval <= 0 {
+		return nil, fmt.Errorf("invalidation_check: poll_interval_msec should be positive, got: %d", c.GetPollIntervalMsec())
+	}
+
+	for _, n := range c.GetCacheNode() {
+		u, err := url.Parse(n)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalidation_check: invalid cache_node (%s), should be a base URL, e.g. http://10.0.0.1:8080", n)
+		}
+		ic.nodes = append(ic.nodes, u)
+	}
+
+	var err error
+	if c.GetRelativeUrl() != "" {
+		if ic.relURL, err = url.Parse(c.GetRelativeUrl()); err != nil {
+			return nil, fmt.Errorf("invalidation_check: invalid relative_url: %v", err)
+		}
+	}
+	if c.GetInvalidationUrl() != "" {
+		if ic.invURL, err = url.Parse(c.GetInvalidationUrl()); err != nil {
+			return nil, fmt.Errorf("invalidation_check: invalid invalidation_url: %v", err)
+		}
+	}
+	return ic, nil
+}
+
+// keyURLs returns the URL of the key, and the URLs to request it from the
+// cache nodes, keyed by the node name. Node URLs have the key's path and
+// query.
+func (ic *invalidationChecker) keyURLs(target *url.URL) (*url.URL, map[string]*url.URL) {
+	keyURL := target
+	if ic.relURL != nil {
+		keyURL = target.ResolveReference(ic.relURL)
+	}
+
+	if len(ic.nodes) == 0 {
+		return keyURL, map[string]*url.URL{keyURL.Host: keyURL}
+	}
+	nodeURLs := make(map[string]*url.URL, len(ic.nodes))
+	for _, n := range ic.nodes {
+		u := *keyURL
+		u.Scheme, u.Host = n.Scheme, n.Host
+		nodeURLs[n.Host] = &u
+	}
+	return keyURL, nodeURLs
+}
+
+// newInvalidationLatency returns a new latency value of the same type as the
+// probe's latency metric.
+func (p *Probe) newInvalidationLatency() metrics.LatencyValue {
+	if p.opts.LatencyDist != nil {
+		return p.opts.LatencyDist.CloneDist()
+	}
+	return metrics.NewFloat(0)
+}
+
+// invalidationRequest sends a request to the given URL, with the probe's
+// headers and Host, and returns the response with its body drained.
+// Requests don't reuse connections, so that requests to the same node don't
+// depend on each other's connections.
+func (p *Probe) invalidationRequest(ctx context.Context, req *http.Request, client *http.Client, method string, u *url.URL, body string) (*http.Response, error) {
+	req = p.prepareRequest(req).Clone(ctx)
+	if req.Host == "" {
+		req.Host = req.URL.Host
+	}
+	req.Method, req.URL, req.Close = method, u, true
+	req.Body, req.ContentLength, req.GetBody = http.NoBody, 0, nil
+	if body != "" {
+		req.Body, req.ContentLength = io.NopCloser(strings.NewReader(body)), int64(len(body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// nodeCacheStatus requests the key from a cache node and returns its cache
+// status.
+func (p *Probe) nodeCacheStatus(ctx context.Context, req *http.Request, client *http.Client, u *url.URL) (string, error) {
+	resp, err := p.invalidationRequest(ctx, req, client, http.MethodGet, u, "")
+	if err != nil {
+		return "", err
+	}
+	return p.invalidationChecker.statusChecker.cacheStatus(resp.Header), nil
+}
+
+// populate makes sure that the key is cached on the node.
+func (p *Probe) populate(ctx context.Context, req *http.Request, client *http.Client, u *url.URL) error {
+	for i := 0; i < maxPopulateRequests; i++ {
+		status, err := p.nodeCacheStatus(ctx, req, client, u)
+		if err != nil {
+			return err
+		}
+		if status == cacheHit {
+			return nil
+		}
+	}
+	return fmt.Errorf("key not cached after %d requests", maxPopulateRequests)
+}
+
+// waitForMiss polls the node until it reports a cache miss, or the SLA
+// expires. It returns the time of the miss, and false if the SLA expired.
+func (p *Probe) waitForMiss(ctx context.Context, req *http.Request, client *http.Client, u *url.URL, deadline time.Time) (time.Time, bool, error) {
+	ic := p.invalidationChecker
+	for {
+		status, err := p.nodeCacheStatus(ctx, req, client, u)
+		now := time.Now()
+		if err != nil {
+			return now, false, err
+		}
+		if status == cacheMiss {
+			return now, !now.After(deadline), nil
+		}
+		if now.Add(ic.pollInterval).After(deadline) {
+			return now, false, nil
+		}
+		select {
+		case <-ctx.Done():
+			return now, false, ctx.Err()
+		case <-time.After(ic.pollInterval):
+		}
+	}
+}
+
+// checkInvalidation runs an invalidation check and updates the result.
+func (p *Probe) checkInvalidation(ctx context.Context, req *http.Request, client *http.Client, targetName string, result *probeResult) {
+	ic := p.invalidationChecker
+	result.invalidationChecks++
+
+	keyURL, nodeURLs := ic.keyURLs(req.URL)
+	logAttrs := []slog.Attr{slog.String("target", targetName), slog.String("url", keyURL.String())}
+
+	// Populate the key on all the nodes, concurrently.
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	populated := make(map[string]*url.URL)
+	for node, u := range nodeURLs {
+		wg.Add(1)
+		go func(node string, u *url.URL) {
+			defer wg.Done()
+			if err := p.populate(ctx, req, client, u); err != nil {
+				p.l.WarningAttrs(fmt.Sprintf("invalidation check: error populating the key on the cache node %s: %v", node, err), logAttrs...)
+				mu.Lock()
+				result.invalidationErrors++
+				mu.Unlock()
+				return
+			}
+			mu.Lock()
+			populated[node] = u
+			mu.Unlock()
+		}(node, u)
+	}
+	wg.Wait()
+	if len(populated) == 0 {
+		return
+	}
+
+	invURL := keyURL
+	if ic.invURL != nil {
+		invURL = req.URL.ResolveReference(ic.invURL)
+	}
+	start := time.Now()
+	if _, err := p.invalidationRequest(ctx, req, client, ic.invMethod, invURL, ic.invBody); err != nil {
+		p.l.WarningAttrs(fmt.Sprintf("invalidation check: invalidation request (%s %s) failed: %v", ic.invMethod, invURL, err), logAttrs...)
+		result.invalidationErrors++
+		return
+	}
+	deadline := start.Add(ic.sla)
+
+	latencies := make(map[string]time.Duration)
+	for node, u := range populated {
+		wg.Add(1)
+		go func(node string, u *url.URL) {
+			defer wg.Done()
+			missAt, withinSLA, err := p.waitForMiss(ctx, req, client, u, deadline)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil:
+				p.l.WarningAttrs(fmt.Sprintf("invalidation check: error requesting the key from the cache node %s: %v", node, err), logAttrs...)
+				result.invalidationErrors++
+			case !withinSLA:
+				p.l.WarningAttrs(fmt.Sprintf("invalidation check: invalidation didn't reach the cache node %s within %s", node, ic.sla), logAttrs...)
+				result.invalidationSLAViolations.IncKey(node)
+			default:
+				latencies[node] = missAt.Sub(start)
+			}
+		}(node, u)
+	}
+	wg.Wait()
+
+	// Make sure that all the nodes show up in the SLA violations map.
+	for node := range nodeURLs {
+		result.invalidationSLAViolations.IncKeyBy(node, 0)
+	}
+	for node, d := range latencies {
+		if result.invalidationLatency[node] == nil {
+			result.invalidationLatency[node] = p.newInvalidationLatency()
+		}
+		result.invalidationLatency[node].AddFloat64(d.Seconds() / p.opts.LatencyUnit.Seconds())
+	}
+}
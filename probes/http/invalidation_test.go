@@ -0,0 +1,235 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// invalidationOrigin records the time of the last invalidation (PURGE
+// request). It fails the invalidation requests if failInvalidation is set.
+type invalidationOrigin struct {
+	mu               sync.Mutex
+	invalidatedAt    time.Time
+	failInvalidation bool
+}
+
+func (o *invalidationOrigin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PURGE" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if o.failInvalidation {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	o.mu.Lock()
+	o.invalidatedAt = time.Now()
+	o.mu.Unlock()
+}
+
+func (o *invalidationOrigin) purgedAt(delay time.Duration) time.Time {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.invalidatedAt.IsZero() {
+		return time.Time{}
+	}
+	return o.invalidatedAt.Add(delay)
+}
+
+// cacheNodeStub simulates a cache node that caches the key on a miss, and
+// gets the invalidation delay after it's sent to the origin. If noCache is
+// set, it never caches the key.
+type cacheNodeStub struct {
+	origin  *invalidationOrigin
+	delay   time.Duration
+	noCache bool
+
+	mu       sync.Mutex
+	cachedAt time.Time
+}
+
+func (n *cacheNodeStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		n.origin.ServeHTTP(w, r)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := time.Now()
+	purgedAt := n.origin.purgedAt(n.delay)
+	purged := !purgedAt.IsZero() && !now.Before(purgedAt) && n.cachedAt.Before(purgedAt)
+	if n.noCache || n.cachedAt.IsZero() || purged {
+		n.cachedAt = now
+		w.Header().Set("X-Cache", "MISS")
+		return
+	}
+	w.Header().Set("X-Cache", "HIT")
+}
+
+func TestCheckInvalidation(t *testing.T) {
+	tests := []struct {
+		name             string
+		delays           []time.Duration
+		noCache          bool
+		failInvalidation bool
+		targetAsNode     bool
+		wantLatency      []bool
+		wantViolations   []int64
+		wantErrors       int64
+	}{
+		{
+			name:           "propagation",
+			delays:         []time.Duration{0, 30 * time.Millisecond, 300 * time.Millisecond},
+			wantLatency:    []bool{true, true, false},
+			wantViolations: []int64{0, 0, 1},
+		},
+		{
+			name:           "target_as_node",
+			delays:         []time.Duration{20 * time.Millisecond},
+			targetAsNode:   true,
+			wantLatency:    []bool{true},
+			wantViolations: []int64{0},
+		},
+		{
+			name:           "not_cached",
+			delays:         []time.Duration{0, 0},
+			noCache:        true,
+			wantLatency:    []bool{false, false},
+			wantViolations: []int64{0, 0},
+			wantErrors:     2,
+		},
+		{
+			name:             "invalidation_failed",
+			delays:           []time.Duration{0},
+			failInvalidation: true,
+			wantLatency:      []bool{false},
+			wantViolations:   []int64{0},
+			wantErrors:       1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			origin := &invalidationOrigin{failInvalidation: test.failInvalidation}
+			originTS := httptest.NewServer(origin)
+			defer originTS.Close()
+
+			var nodeURLs []string
+			for _, delay := range test.delays {
+				ts := httptest.NewServer(&cacheNodeStub{origin: origin, delay: delay, noCache: test.noCache})
+				defer ts.Close()
+				nodeURLs = append(nodeURLs, ts.URL)
+			}
+
+			check := &configpb.ProbeConf_InvalidationCheck{
+				CacheNode:        nodeURLs,
+				RelativeUrl:      proto.String("/static/probe.txt"),
+				InvalidationUrl:  proto.String(originTS.URL + "/purge"),
+				SlaMsec:          proto.Int32(100),
+				PollIntervalMsec: proto.Int32(10),
+			}
+			targetURL := originTS.URL
+			if test.targetAsNode {
+				check.CacheNode, check.InvalidationUrl = nil, nil
+				targetURL = nodeURLs[0]
+			}
+
+			opts := options.DefaultOptions()
+			opts.Timeout = time.Second
+			opts.ProbeConf = &configpb.ProbeConf{InvalidationCheck: check}
+			p := &Probe{}
+			require.NoError(t, p.Init("http_test", opts))
+
+			result := p.newResult()
+			req, _ := http.NewRequest("GET", targetURL, nil)
+			p.checkInvalidation(context.Background(), req, &http.Client{}, "test.com", result)
+
+			assert.Equal(t, int64(1), result.invalidationChecks, "invalidation_checks")
+			assert.Equal(t, test.wantErrors, result.invalidationErrors, "invalidation_errors")
+			for i, nodeURL := range nodeURLs {
+				u, _ := url.Parse(nodeURL)
+				assert.Equal(t, test.wantViolations[i], result.invalidationSLAViolations.GetKey(u.Host), "sla violations, node: %s", u.Host)
+
+				lat := result.invalidationLatency[u.Host]
+				if !test.wantLatency[i] {
+					assert.Nil(t, lat, "latency, node: %s", u.Host)
+					continue
+				}
+				require.NotNil(t, lat, "latency, node: %s", u.Host)
+				latency := time.Duration(lat.(*metrics.Float).Float64()) * opts.LatencyUnit
+				assert.GreaterOrEqual(t, latency, test.delays[i], "latency, node: %s", u.Host)
+				assert.Less(t, latency, 100*time.Millisecond, "latency, node: %s", u.Host)
+			}
+		})
+	}
+}
+
+func TestNewInvalidationChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   *configpb.ProbeConf_InvalidationCheck
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			check: &configpb.ProbeConf_InvalidationCheck{SlaMsec: proto.Int32(500)},
+		},
+		{
+			name:    "sla_exceeds_timeout",
+			check:   &configpb.ProbeConf_InvalidationCheck{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_poll_interval",
+			check:   &configpb.ProbeConf_InvalidationCheck{SlaMsec: proto.Int32(500), PollIntervalMsec: proto.Int32(0)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_cache_node",
+			check:   &configpb.ProbeConf_InvalidationCheck{SlaMsec: proto.Int32(500), CacheNode: []string{"cache-1"}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ic, err := newInvalidationChecker(test.check, time.Second)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PURGE", ic.invMethod)
+			assert.Equal(t, 100*time.Millisecond, ic.pollInterval)
+			assert.Equal(t, defaultCacheStatusHeaders, ic.statusChecker.statusHeaders)
+		})
+	}
+}
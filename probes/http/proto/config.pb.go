@@ -220,6 +220,118 @@ func (ProbeConf_LatencyBreakdown) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 2}
 }
 
+type ProbeConf_UploadCheck_Encoding int32
+
+const (
+	ProbeConf_UploadCheck_MULTIPART ProbeConf_UploadCheck_Encoding = 0
+	ProbeConf_UploadCheck_CHUNKED   ProbeConf_UploadCheck_Encoding = 1
+)
+
+// Enum value maps for ProbeConf_UploadCheck_Encoding.
+var (
+	ProbeConf_UploadCheck_Encoding_name = map[int32]string{
+		0: "MULTIPART",
+		1: "CHUNKED",
+	}
+	ProbeConf_UploadCheck_Encoding_value = map[string]int32{
+		"MULTIPART": 0,
+		"CHUNKED":   1,
+	}
+)
+
+func (x ProbeConf_UploadCheck_Encoding) Enum() *ProbeConf_UploadCheck_Encoding {
+	p := new(ProbeConf_UploadCheck_Encoding)
+	*p = x
+	return p
+}
+
+func (x ProbeConf_UploadCheck_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbeConf_UploadCheck_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes[3].Descriptor()
+}
+
+func (ProbeConf_UploadCheck_Encoding) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes[3]
+}
+
+func (x ProbeConf_UploadCheck_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ProbeConf_UploadCheck_Encoding) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ProbeConf_UploadCheck_Encoding(num)
+	return nil
+}
+
+// Deprecated: Use ProbeConf_UploadCheck_Encoding.Descriptor instead.
+func (ProbeConf_UploadCheck_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 8, 0}
+}
+
+type ProbeConf_UploadCheck_ChecksumType int32
+
+const (
+	ProbeConf_UploadCheck_SHA256 ProbeConf_UploadCheck_ChecksumType = 0
+	ProbeConf_UploadCheck_MD5    ProbeConf_UploadCheck_ChecksumType = 1
+)
+
+// Enum value maps for ProbeConf_UploadCheck_ChecksumType.
+var (
+	ProbeConf_UploadCheck_ChecksumType_name = map[int32]string{
+		0: "SHA256",
+		1: "MD5",
+	}
+	ProbeConf_UploadCheck_ChecksumType_value = map[string]int32{
+		"SHA256": 0,
+		"MD5":    1,
+	}
+)
+
+func (x ProbeConf_UploadCheck_ChecksumType) Enum() *ProbeConf_UploadCheck_ChecksumType {
+	p := new(ProbeConf_UploadCheck_ChecksumType)
+	*p = x
+	return p
+}
+
+func (x ProbeConf_UploadCheck_ChecksumType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProbeConf_UploadCheck_ChecksumType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes[4].Descriptor()
+}
+
+func (ProbeConf_UploadCheck_ChecksumType) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes[4]
+}
+
+func (x ProbeConf_UploadCheck_ChecksumType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ProbeConf_UploadCheck_ChecksumType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ProbeConf_UploadCheck_ChecksumType(num)
+	return nil
+}
+
+// Deprecated: Use ProbeConf_UploadCheck_ChecksumType.Descriptor instead.
+func (ProbeConf_UploadCheck_ChecksumType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 8, 1}
+}
+
 // Next tag: 21
 type ProbeConf struct {
 	state         protoimpl.MessageState
@@ -332,7 +444,14 @@ type ProbeConf struct {
 	//
 	//	latency_breakdown: [ ALL_STAGES ]
 	//	latency_breakdown: [ DNS_LATENCY, CONNECT_LATENCY, TLS_HANDSHAKE_LATENCY ]
-	LatencyBreakdown []ProbeConf_LatencyBreakdown `protobuf:"varint,22,rep,name=latency_breakdown,json=latencyBreakdown,enum=cloudprober.probes.http.ProbeConf_LatencyBreakdown" json:"latency_breakdown,omitempty"`
+	LatencyBreakdown     []ProbeConf_LatencyBreakdown    `protobuf:"varint,22,rep,name=latency_breakdown,json=latencyBreakdown,enum=cloudprober.probes.http.ProbeConf_LatencyBreakdown" json:"latency_breakdown,omitempty"`
+	CrlCheck             *ProbeConf_CRLCheck             `protobuf:"bytes,25,opt,name=crl_check,json=crlCheck" json:"crl_check,omitempty"`
+	CdnCheck             *ProbeConf_CDNCheck             `protobuf:"bytes,26,opt,name=cdn_check,json=cdnCheck" json:"cdn_check,omitempty"`
+	SessionAffinityCheck *ProbeConf_SessionAffinityCheck `protobuf:"bytes,27,opt,name=session_affinity_check,json=sessionAffinityCheck" json:"session_affinity_check,omitempty"`
+	RateLimitCheck       *ProbeConf_RateLimitCheck       `protobuf:"bytes,28,opt,name=rate_limit_check,json=rateLimitCheck" json:"rate_limit_check,omitempty"`
+	RolloutCheck         *ProbeConf_RolloutCheck         `protobuf:"bytes,29,opt,name=rollout_check,json=rolloutCheck" json:"rollout_check,omitempty"`
+	UploadCheck          *ProbeConf_UploadCheck          `protobuf:"bytes,30,opt,name=upload_check,json=uploadCheck" json:"upload_check,omitempty"`
+	InvalidationCheck    *ProbeConf_InvalidationCheck    `protobuf:"bytes,31,opt,name=invalidation_check,json=invalidationCheck" json:"invalidation_check,omitempty"`
 	// Interval between targets.
 	IntervalBetweenTargetsMsec *int32 `protobuf:"varint,97,opt,name=interval_between_targets_msec,json=intervalBetweenTargetsMsec,def=10" json:"interval_between_targets_msec,omitempty"`
 	// Requests per probe.
@@ -556,6 +675,55 @@ func (x *ProbeConf) GetLatencyBreakdown() []ProbeConf_LatencyBreakdown {
 	return nil
 }
 
+func (x *ProbeConf) GetCrlCheck() *ProbeConf_CRLCheck {
+	if x != nil {
+		return x.CrlCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetCdnCheck() *ProbeConf_CDNCheck {
+	if x != nil {
+		return x.CdnCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetSessionAffinityCheck() *ProbeConf_SessionAffinityCheck {
+	if x != nil {
+		return x.SessionAffinityCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetRateLimitCheck() *ProbeConf_RateLimitCheck {
+	if x != nil {
+		return x.RateLimitCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetRolloutCheck() *ProbeConf_RolloutCheck {
+	if x != nil {
+		return x.RolloutCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetUploadCheck() *ProbeConf_UploadCheck {
+	if x != nil {
+		return x.UploadCheck
+	}
+	return nil
+}
+
+func (x *ProbeConf) GetInvalidationCheck() *ProbeConf_InvalidationCheck {
+	if x != nil {
+		return x.InvalidationCheck
+	}
+	return nil
+}
+
 func (x *ProbeConf) GetIntervalBetweenTargetsMsec() int32 {
 	if x != nil && x.IntervalBetweenTargetsMsec != nil {
 		return *x.IntervalBetweenTargetsMsec
@@ -648,207 +816,1265 @@ func (x *ProbeConf_Header) GetValue() string {
 	return ""
 }
 
-var File_github_com_cloudprober_cloudprober_probes_http_proto_config_proto protoreflect.FileDescriptor
+// Certificate revocation check using CRLs. If enabled, probe fetches the
+// CRL from the distribution points listed in the server certificates, and
+// checks whether any of the certificates in the chain has been revoked.
+// Requests to servers presenting a revoked certificate are considered
+// failed. Each response is checked for revocation, and the result of the
+// last check is exported as the cert_revoked gauge (1 if revoked, 0
+// otherwise). Errors in fetching or parsing CRLs are counted in the
+// crl_fetch_errors counter; they don't fail the probe.
+type ProbeConf_CRLCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDesc = []byte{
-	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x68, 0x74, 0x74, 0x70,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x44, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
-	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x0e, 0x0a,
-	0x09, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x3a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68,
-	0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x3a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x12, 0x42, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x17, 0x65, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x73, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50,
-	0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a,
-	0x03, 0x47, 0x45, 0x54, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x43, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x46, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x14, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65,
-	0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6f, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x6f, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6f, 0x61, 0x75, 0x74,
-	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x74, 0x74, 0x70, 0x32, 0x12, 0x36, 0x0a, 0x17,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x55,
-	0x72, 0x6c, 0x12, 0x6c, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
-	0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x32, 0x35, 0x36, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
-	0x78, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x60, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
-	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e,
-	0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c,
-	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
-	0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
-	0x6e, 0x12, 0x45, 0x0a, 0x1d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x65,
-	0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x73,
-	0x65, 0x63, 0x18, 0x61, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x1a, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x2f, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x62,
-	0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x31, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x73, 0x50, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x16, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d,
-	0x73, 0x65, 0x63, 0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x14, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73,
-	0x65, 0x63, 0x1a, 0x32, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x45, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x22, 0x52, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x53, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a,
-	0x04, 0x48, 0x45, 0x41, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x12, 0x0b,
-	0x0a, 0x07, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x06, 0x22, 0xa4, 0x01, 0x0a, 0x10,
-	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
-	0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x44, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x53,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43,
-	0x59, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x4c,
-	0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f,
-	0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43,
-	0x59, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49,
-	0x52, 0x53, 0x54, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59,
-	0x10, 0x06, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x68,
-	0x74, 0x74, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	// How long to cache a CRL. CRLs are refreshed sooner if their
+	// next update time comes before this.
+	CacheTtlSec *int32 `protobuf:"varint,1,opt,name=cache_ttl_sec,json=cacheTtlSec,def=3600" json:"cache_ttl_sec,omitempty"`
+	// Timeout for fetching a CRL. Default is to use the probe timeout.
+	FetchTimeoutMsec *int32 `protobuf:"varint,2,opt,name=fetch_timeout_msec,json=fetchTimeoutMsec" json:"fetch_timeout_msec,omitempty"`
 }
 
-var (
-	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescOnce sync.Once
-	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDesc
+// Default values for ProbeConf_CRLCheck fields.
+const (
+	Default_ProbeConf_CRLCheck_CacheTtlSec = int32(3600)
 )
 
-func file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP() []byte {
-	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescOnce.Do(func() {
-		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData)
-	})
-	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData
+func (x *ProbeConf_CRLCheck) Reset() {
+	*x = ProbeConf_CRLCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_goTypes = []any{
-	(ProbeConf_Scheme)(0),           // 0: cloudprober.probes.http.ProbeConf.Scheme
-	(ProbeConf_Method)(0),           // 1: cloudprober.probes.http.ProbeConf.Method
-	(ProbeConf_LatencyBreakdown)(0), // 2: cloudprober.probes.http.ProbeConf.LatencyBreakdown
-	(*ProbeConf)(nil),               // 3: cloudprober.probes.http.ProbeConf
-	(*ProbeConf_Header)(nil),        // 4: cloudprober.probes.http.ProbeConf.Header
-	nil,                             // 5: cloudprober.probes.http.ProbeConf.HeaderEntry
-	nil,                             // 6: cloudprober.probes.http.ProbeConf.ProxyConnectHeaderEntry
-	(*proto.Config)(nil),            // 7: cloudprober.oauth.Config
-	(*proto1.TLSConfig)(nil),        // 8: cloudprober.tlsconfig.TLSConfig
+func (x *ProbeConf_CRLCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_depIdxs = []int32{
-	0, // 0: cloudprober.probes.http.ProbeConf.protocol:type_name -> cloudprober.probes.http.ProbeConf.Scheme
-	0, // 1: cloudprober.probes.http.ProbeConf.scheme:type_name -> cloudprober.probes.http.ProbeConf.Scheme
-	1, // 2: cloudprober.probes.http.ProbeConf.method:type_name -> cloudprober.probes.http.ProbeConf.Method
-	4, // 3: cloudprober.probes.http.ProbeConf.headers:type_name -> cloudprober.probes.http.ProbeConf.Header
-	5, // 4: cloudprober.probes.http.ProbeConf.header:type_name -> cloudprober.probes.http.ProbeConf.HeaderEntry
-	7, // 5: cloudprober.probes.http.ProbeConf.oauth_config:type_name -> cloudprober.oauth.Config
-	8, // 6: cloudprober.probes.http.ProbeConf.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
-	6, // 7: cloudprober.probes.http.ProbeConf.proxy_connect_header:type_name -> cloudprober.probes.http.ProbeConf.ProxyConnectHeaderEntry
-	2, // 8: cloudprober.probes.http.ProbeConf.latency_breakdown:type_name -> cloudprober.probes.http.ProbeConf.LatencyBreakdown
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+
+func (*ProbeConf_CRLCheck) ProtoMessage() {}
+
+func (x *ProbeConf_CRLCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_init() }
-func file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_init() {
-	if File_github_com_cloudprober_cloudprober_probes_http_proto_config_proto != nil {
-		return
+// Deprecated: Use ProbeConf_CRLCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_CRLCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *ProbeConf_CRLCheck) GetCacheTtlSec() int32 {
+	if x != nil && x.CacheTtlSec != nil {
+		return *x.CacheTtlSec
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*ProbeConf); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ProbeConf_Header); i {
+	return Default_ProbeConf_CRLCheck_CacheTtlSec
+}
+
+func (x *ProbeConf_CRLCheck) GetFetchTimeoutMsec() int32 {
+	if x != nil && x.FetchTimeoutMsec != nil {
+		return *x.FetchTimeoutMsec
+	}
+	return 0
+}
+
+// CDN cache status check. If enabled, probe classifies responses as cache
+// hits or misses using the cache status response headers, and exports
+// the counts in the cdn_cache_status map metric (keys: hit, miss,
+// unknown). If edge_location_header is configured, counts are exported
+// per edge location, with the edge location in the "edge" label.
+//
+// Example:
+//
+//	cdn_check {
+//	  edge_location_header: "CF-Ray"
+//	  edge_location_regex: "-([A-Z]+)$"
+//	}
+type ProbeConf_CDNCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response headers containing the cache status. Headers are looked up in
+	// the given order and the first one present in the response is used.
+	// Default: X-Cache, CF-Cache-Status, X-Cache-Status, Cache-Status.
+	CacheStatusHeader []string `protobuf:"bytes,1,rep,name=cache_status_header,json=cacheStatusHeader" json:"cache_status_header,omitempty"`
+	// Response header containing the edge location, e.g. X-Amz-Cf-Pop.
+	EdgeLocationHeader *string `protobuf:"bytes,2,opt,name=edge_location_header,json=edgeLocationHeader" json:"edge_location_header,omitempty"`
+	// Regex to extract the edge location from the edge location header. If
+	// regex has a capture group, the first group is used, otherwise the
+	// whole match is used. Default is to use the header value as it is.
+	EdgeLocationRegex *string `protobuf:"bytes,3,opt,name=edge_location_regex,json=edgeLocationRegex" json:"edge_location_regex,omitempty"`
+}
+
+func (x *ProbeConf_CDNCheck) Reset() {
+	*x = ProbeConf_CDNCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_CDNCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_CDNCheck) ProtoMessage() {}
+
+func (x *ProbeConf_CDNCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_CDNCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_CDNCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *ProbeConf_CDNCheck) GetCacheStatusHeader() []string {
+	if x != nil {
+		return x.CacheStatusHeader
+	}
+	return nil
+}
+
+func (x *ProbeConf_CDNCheck) GetEdgeLocationHeader() string {
+	if x != nil && x.EdgeLocationHeader != nil {
+		return *x.EdgeLocationHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_CDNCheck) GetEdgeLocationRegex() string {
+	if x != nil && x.EdgeLocationRegex != nil {
+		return *x.EdgeLocationRegex
+	}
+	return ""
+}
+
+// Session affinity check verifies that a load balancer keeps sending the
+// requests of a session to the same backend. In every probe run, after the
+// regular request, probe sends a request to obtain the affinity token
+// (cookie or header), and then sends follow-up requests with that token,
+// verifying that the same backend (identified by a response header)
+// responds to all of them. Affinity requests don't reuse connections, so
+// that affinity doesn't come from the connection reuse.
+//
+// Results are exported as affinity_checks, affinity_breaks and
+// affinity_errors counters. Affinity requests are not counted in the
+// regular total and success metrics.
+//
+// Example:
+//
+//	session_affinity_check {
+//	  backend_id_header: "X-Backend-Id"
+//	  cookie_name: "SERVERID"
+//	}
+type ProbeConf_SessionAffinityCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response header identifying the backend that served the request.
+	BackendIdHeader *string `protobuf:"bytes,1,opt,name=backend_id_header,json=backendIdHeader" json:"backend_id_header,omitempty"`
+	// Name of the affinity cookie. If not specified, all cookies set by the
+	// first response are sent in the follow-up requests.
+	CookieName *string `protobuf:"bytes,2,opt,name=cookie_name,json=cookieName" json:"cookie_name,omitempty"`
+	// Response header carrying the affinity token. If specified, it's used
+	// instead of cookies: its value from the first response is sent as a
+	// request header with the same name in the follow-up requests.
+	TokenHeader *string `protobuf:"bytes,3,opt,name=token_header,json=tokenHeader" json:"token_header,omitempty"`
+	// Number of follow-up requests in every check.
+	FollowUpRequests *int32 `protobuf:"varint,4,opt,name=follow_up_requests,json=followUpRequests,def=3" json:"follow_up_requests,omitempty"`
+}
+
+// Default values for ProbeConf_SessionAffinityCheck fields.
+const (
+	Default_ProbeConf_SessionAffinityCheck_FollowUpRequests = int32(3)
+)
+
+func (x *ProbeConf_SessionAffinityCheck) Reset() {
+	*x = ProbeConf_SessionAffinityCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_SessionAffinityCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_SessionAffinityCheck) ProtoMessage() {}
+
+func (x *ProbeConf_SessionAffinityCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_SessionAffinityCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_SessionAffinityCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *ProbeConf_SessionAffinityCheck) GetBackendIdHeader() string {
+	if x != nil && x.BackendIdHeader != nil {
+		return *x.BackendIdHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_SessionAffinityCheck) GetCookieName() string {
+	if x != nil && x.CookieName != nil {
+		return *x.CookieName
+	}
+	return ""
+}
+
+func (x *ProbeConf_SessionAffinityCheck) GetTokenHeader() string {
+	if x != nil && x.TokenHeader != nil {
+		return *x.TokenHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_SessionAffinityCheck) GetFollowUpRequests() int32 {
+	if x != nil && x.FollowUpRequests != nil {
+		return *x.FollowUpRequests
+	}
+	return Default_ProbeConf_SessionAffinityCheck_FollowUpRequests
+}
+
+// Rate limit check parses the rate limit headers in the responses, and
+// exports the remaining quota, limit, reset time and Retry-After as GAUGE
+// metrics: ratelimit_remaining, ratelimit_limit, ratelimit_reset_sec and
+// retry_after_sec. Values come from the last response; metrics are not
+// exported if the corresponding headers were missing in it.
+//
+// Responses with remaining quota below min_remaining, throttled (429)
+// responses, and responses without the remaining quota header are counted
+// in ratelimit_low, ratelimit_throttled and ratelimit_headers_missing.
+//
+// Headers are looked up in the given order, and the first one present in
+// the response is used. If none is present, the structured RateLimit
+// header (e.g. "limit=100, remaining=50, reset=30" or
+// '"default";r=50;t=30') is used.
+// Example:
+//
+//	rate_limit_check {
+//	  min_remaining: 100
+//	}
+type ProbeConf_RateLimitCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default: X-RateLimit-Remaining, RateLimit-Remaining,
+	// X-Rate-Limit-Remaining.
+	RemainingHeader []string `protobuf:"bytes,1,rep,name=remaining_header,json=remainingHeader" json:"remaining_header,omitempty"`
+	// Default: X-RateLimit-Limit, RateLimit-Limit, X-Rate-Limit-Limit.
+	LimitHeader []string `protobuf:"bytes,2,rep,name=limit_header,json=limitHeader" json:"limit_header,omitempty"`
+	// Reset headers may contain either the seconds until the reset, or the
+	// reset time as Unix epoch seconds; large values are assumed to be epoch
+	// seconds. Default: X-RateLimit-Reset, RateLimit-Reset,
+	// X-Rate-Limit-Reset.
+	ResetHeader []string `protobuf:"bytes,3,rep,name=reset_header,json=resetHeader" json:"reset_header,omitempty"`
+	// Remaining quota below this is flagged.
+	MinRemaining *int64 `protobuf:"varint,4,opt,name=min_remaining,json=minRemaining" json:"min_remaining,omitempty"`
+}
+
+func (x *ProbeConf_RateLimitCheck) Reset() {
+	*x = ProbeConf_RateLimitCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_RateLimitCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_RateLimitCheck) ProtoMessage() {}
+
+func (x *ProbeConf_RateLimitCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_RateLimitCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_RateLimitCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *ProbeConf_RateLimitCheck) GetRemainingHeader() []string {
+	if x != nil {
+		return x.RemainingHeader
+	}
+	return nil
+}
+
+func (x *ProbeConf_RateLimitCheck) GetLimitHeader() []string {
+	if x != nil {
+		return x.LimitHeader
+	}
+	return nil
+}
+
+func (x *ProbeConf_RateLimitCheck) GetResetHeader() []string {
+	if x != nil {
+		return x.ResetHeader
+	}
+	return nil
+}
+
+func (x *ProbeConf_RateLimitCheck) GetMinRemaining() int64 {
+	if x != nil && x.MinRemaining != nil {
+		return *x.MinRemaining
+	}
+	return 0
+}
+
+// Rollout check verifies that a progressive rollout serves the expected
+// share of the traffic. In every probe run, after the regular request,
+// probe sends the configured number of requests, classifies the responses
+// into variants, and compares the observed percentage of the new variant
+// with the expected percentage. Rollout requests don't reuse connections.
+//
+// Responses are classified either by a response header carrying the
+// variant name, or by a body marker: responses whose body matches the
+// marker are in the new variant, others in the "other" variant. Failed
+// requests and non-2xx responses are not classified.
+//
+// Results are exported as rollout_checks, rollout_deviations (checks where
+// the observed percentage was off by more than the tolerance) and
+// rollout_errors (failed requests) counters, rollout_variant counts per
+// variant, and the observed percentage of the last check as a GAUGE metric,
+// rollout_new_percent. Rollout requests are not counted in the regular
+// total and success metrics.
+//
+// Example:
+//
+//	rollout_check {
+//	  variant_header: "X-Variant"
+//	  new_variant: "v2"
+//	  expected_percent: 20
+//	}
+type ProbeConf_RolloutCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Response header carrying the variant name.
+	VariantHeader *string `protobuf:"bytes,1,opt,name=variant_header,json=variantHeader" json:"variant_header,omitempty"`
+	// Regex to match the new variant's response body with. Only one of
+	// variant_header and body_marker can be specified.
+	BodyMarker *string `protobuf:"bytes,2,opt,name=body_marker,json=bodyMarker" json:"body_marker,omitempty"`
+	// Name of the variant being rolled out. With body_marker, it's the
+	// variant name used for the matching responses.
+	NewVariant *string `protobuf:"bytes,3,opt,name=new_variant,json=newVariant,def=new" json:"new_variant,omitempty"`
+	// Number of requests in every check.
+	Requests *int32 `protobuf:"varint,4,opt,name=requests,def=100" json:"requests,omitempty"`
+	// Expected percentage of the responses served by the new variant.
+	ExpectedPercent *float32 `protobuf:"fixed32,5,opt,name=expected_percent,json=expectedPercent" json:"expected_percent,omitempty"`
+	// Allowed deviation from the expected percentage, in percentage points.
+	TolerancePercent *float32 `protobuf:"fixed32,6,opt,name=tolerance_percent,json=tolerancePercent,def=5" json:"tolerance_percent,omitempty"`
+}
+
+// Default values for ProbeConf_RolloutCheck fields.
+const (
+	Default_ProbeConf_RolloutCheck_NewVariant       = string("new")
+	Default_ProbeConf_RolloutCheck_Requests         = int32(100)
+	Default_ProbeConf_RolloutCheck_TolerancePercent = float32(5)
+)
+
+func (x *ProbeConf_RolloutCheck) Reset() {
+	*x = ProbeConf_RolloutCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_RolloutCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_RolloutCheck) ProtoMessage() {}
+
+func (x *ProbeConf_RolloutCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_RolloutCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_RolloutCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *ProbeConf_RolloutCheck) GetVariantHeader() string {
+	if x != nil && x.VariantHeader != nil {
+		return *x.VariantHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_RolloutCheck) GetBodyMarker() string {
+	if x != nil && x.BodyMarker != nil {
+		return *x.BodyMarker
+	}
+	return ""
+}
+
+func (x *ProbeConf_RolloutCheck) GetNewVariant() string {
+	if x != nil && x.NewVariant != nil {
+		return *x.NewVariant
+	}
+	return Default_ProbeConf_RolloutCheck_NewVariant
+}
+
+func (x *ProbeConf_RolloutCheck) GetRequests() int32 {
+	if x != nil && x.Requests != nil {
+		return *x.Requests
+	}
+	return Default_ProbeConf_RolloutCheck_Requests
+}
+
+func (x *ProbeConf_RolloutCheck) GetExpectedPercent() float32 {
+	if x != nil && x.ExpectedPercent != nil {
+		return *x.ExpectedPercent
+	}
+	return 0
+}
+
+func (x *ProbeConf_RolloutCheck) GetTolerancePercent() float32 {
+	if x != nil && x.TolerancePercent != nil {
+		return *x.TolerancePercent
+	}
+	return Default_ProbeConf_RolloutCheck_TolerancePercent
+}
+
+// Upload check verifies that large uploads complete, and that the server
+// stores them intact. In every probe run, after the regular request, probe
+// uploads a generated payload of size_bytes, either as a multipart/form-data
+// file or as a raw body with chunked transfer encoding, and verifies the
+// checksum (and optionally the size) reported by the server. Upload
+// requests don't reuse connections.
+//
+// Server's checksum is read from the checksum_header response header, or
+// from the checksum_json_field of a JSON response body, and is compared
+// with the payload's checksum in hex or base64 encoding. Surrounding quotes,
+// e.g. of the ETag header, are ignored. If size_header or size_json_field is
+// configured, the reported size should match the payload size as well.
+//
+// Results are exported as upload_checks, upload_errors (failed requests and
+// non-2xx responses), upload_partial (uploads interrupted before the whole
+// payload was sent, or reported smaller by the server),
+// upload_integrity_failures (checksum or size mismatches, or a missing
+// checksum) and upload_bytes (bytes sent) counters, and the latency of the
+// successful uploads, with "upload_" prefixed to the probe's latency metric
+// name. Throughput of the last successful upload is exported as a GAUGE
+// metric, upload_throughput_bytes_per_sec. Upload requests are not counted
+// in the regular total and success metrics.
+//
+// Example:
+//
+//	upload_check {
+//	  relative_url: "/v1/upload"
+//	  size_bytes: 104857600
+//	  checksum_json_field: "sha256"
+//	  size_json_field: "size"
+//	}
+type ProbeConf_UploadCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL to upload to, relative to the probe's URL, e.g. "/v1/upload" or
+	// "upload?bucket=probes". Default is to use the probe's URL.
+	RelativeUrl *string                         `protobuf:"bytes,1,opt,name=relative_url,json=relativeUrl" json:"relative_url,omitempty"`
+	Method      *ProbeConf_Method               `protobuf:"varint,2,opt,name=method,enum=cloudprober.probes.http.ProbeConf_Method,def=1" json:"method,omitempty"`
+	Encoding    *ProbeConf_UploadCheck_Encoding `protobuf:"varint,3,opt,name=encoding,enum=cloudprober.probes.http.ProbeConf_UploadCheck_Encoding,def=0" json:"encoding,omitempty"`
+	// Size of the generated payload.
+	SizeBytes *int64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,def=1048576" json:"size_bytes,omitempty"`
+	// Form field name for the multipart uploads.
+	FormField    *string                             `protobuf:"bytes,5,opt,name=form_field,json=formField,def=file" json:"form_field,omitempty"`
+	ChecksumType *ProbeConf_UploadCheck_ChecksumType `protobuf:"varint,6,opt,name=checksum_type,json=checksumType,enum=cloudprober.probes.http.ProbeConf_UploadCheck_ChecksumType,def=0" json:"checksum_type,omitempty"`
+	// Where to find the server's checksum. Exactly one of these should be
+	// specified. JSON field can be a dot-separated path, e.g. "file.sha256".
+	ChecksumHeader    *string `protobuf:"bytes,7,opt,name=checksum_header,json=checksumHeader" json:"checksum_header,omitempty"`
+	ChecksumJsonField *string `protobuf:"bytes,8,opt,name=checksum_json_field,json=checksumJsonField" json:"checksum_json_field,omitempty"`
+	// Where to find the server's reported size, if it should be verified. At
+	// most one of these can be specified.
+	SizeHeader    *string `protobuf:"bytes,9,opt,name=size_header,json=sizeHeader" json:"size_header,omitempty"`
+	SizeJsonField *string `protobuf:"bytes,10,opt,name=size_json_field,json=sizeJsonField" json:"size_json_field,omitempty"`
+}
+
+// Default values for ProbeConf_UploadCheck fields.
+const (
+	Default_ProbeConf_UploadCheck_Method       = ProbeConf_POST
+	Default_ProbeConf_UploadCheck_Encoding     = ProbeConf_UploadCheck_MULTIPART
+	Default_ProbeConf_UploadCheck_SizeBytes    = int64(1048576)
+	Default_ProbeConf_UploadCheck_FormField    = string("file")
+	Default_ProbeConf_UploadCheck_ChecksumType = ProbeConf_UploadCheck_SHA256
+)
+
+func (x *ProbeConf_UploadCheck) Reset() {
+	*x = ProbeConf_UploadCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_UploadCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_UploadCheck) ProtoMessage() {}
+
+func (x *ProbeConf_UploadCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_UploadCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_UploadCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 8}
+}
+
+func (x *ProbeConf_UploadCheck) GetRelativeUrl() string {
+	if x != nil && x.RelativeUrl != nil {
+		return *x.RelativeUrl
+	}
+	return ""
+}
+
+func (x *ProbeConf_UploadCheck) GetMethod() ProbeConf_Method {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return Default_ProbeConf_UploadCheck_Method
+}
+
+func (x *ProbeConf_UploadCheck) GetEncoding() ProbeConf_UploadCheck_Encoding {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return Default_ProbeConf_UploadCheck_Encoding
+}
+
+func (x *ProbeConf_UploadCheck) GetSizeBytes() int64 {
+	if x != nil && x.SizeBytes != nil {
+		return *x.SizeBytes
+	}
+	return Default_ProbeConf_UploadCheck_SizeBytes
+}
+
+func (x *ProbeConf_UploadCheck) GetFormField() string {
+	if x != nil && x.FormField != nil {
+		return *x.FormField
+	}
+	return Default_ProbeConf_UploadCheck_FormField
+}
+
+func (x *ProbeConf_UploadCheck) GetChecksumType() ProbeConf_UploadCheck_ChecksumType {
+	if x != nil && x.ChecksumType != nil {
+		return *x.ChecksumType
+	}
+	return Default_ProbeConf_UploadCheck_ChecksumType
+}
+
+func (x *ProbeConf_UploadCheck) GetChecksumHeader() string {
+	if x != nil && x.ChecksumHeader != nil {
+		return *x.ChecksumHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_UploadCheck) GetChecksumJsonField() string {
+	if x != nil && x.ChecksumJsonField != nil {
+		return *x.ChecksumJsonField
+	}
+	return ""
+}
+
+func (x *ProbeConf_UploadCheck) GetSizeHeader() string {
+	if x != nil && x.SizeHeader != nil {
+		return *x.SizeHeader
+	}
+	return ""
+}
+
+func (x *ProbeConf_UploadCheck) GetSizeJsonField() string {
+	if x != nil && x.SizeJsonField != nil {
+		return *x.SizeJsonField
+	}
+	return ""
+}
+
+// Invalidation check verifies that invalidating a cached key purges it from
+// all the cache nodes within an SLA. In every probe run, after the regular
+// request, probe:
+//  1. Populates the key on each cache node, by requesting it until the
+//     node reports a cache hit (at most two requests).
+//  2. Sends the invalidation request.
+//  3. Requests the key from each cache node until the node reports a
+//     cache miss, i.e. it had to refresh the key from the origin.
+//
+// Cache status is determined from the cache status headers, the same way
+// as for cdn_check. Invalidation check requests don't reuse connections.
+//
+// Results are exported as invalidation_checks, invalidation_errors (failed
+// or non-2xx requests, nodes that couldn't be populated, and failed
+// invalidation requests) counters, invalidation_sla_violations counters per
+// cache node ("node" label), and the propagation latency (time from the
+// invalidation request to the first miss) for each cache node, with
+// "invalidation_" prefixed to the probe's latency metric name. Requests are
+// not counted in the regular total and success metrics.
+//
+// Example:
+//
+//	invalidation_check {
+//	  cache_node: "http://cache-1.example.com"
+//	  cache_node: "http://cache-2.example.com"
+//	  relative_url: "/static/probe.txt"
+//	  invalidation_url: "https://api.example.com/v1/purge?key=/static/probe.txt"
+//	  invalidation_method: "POST"
+//	  sla_msec: 2000
+//	}
+type ProbeConf_InvalidationCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cache nodes, as base URLs, e.g. "http://10.0.0.1:8080". Key is
+	// requested from each of these, with the probe's Host header. If not
+	// specified, key is requested from the target itself.
+	CacheNode []string `protobuf:"bytes,1,rep,name=cache_node,json=cacheNode" json:"cache_node,omitempty"`
+	// URL of the cached key, relative to the probe's URL, e.g.
+	// "/static/probe.txt". Default is to use the probe's URL.
+	RelativeUrl *string `protobuf:"bytes,2,opt,name=relative_url,json=relativeUrl" json:"relative_url,omitempty"`
+	// URL for the invalidation request, absolute or relative to the probe's
+	// URL. Default is to send the invalidation request to the key's URL,
+	// e.g. for Varnish's PURGE.
+	InvalidationUrl    *string `protobuf:"bytes,3,opt,name=invalidation_url,json=invalidationUrl" json:"invalidation_url,omitempty"`
+	InvalidationMethod *string `protobuf:"bytes,4,opt,name=invalidation_method,json=invalidationMethod,def=PURGE" json:"invalidation_method,omitempty"`
+	// Body of the invalidation request, e.g. a JSON listing the keys.
+	InvalidationBody *string `protobuf:"bytes,5,opt,name=invalidation_body,json=invalidationBody" json:"invalidation_body,omitempty"`
+	// Response headers containing the cache status, see cdn_check.
+	CacheStatusHeader []string `protobuf:"bytes,6,rep,name=cache_status_header,json=cacheStatusHeader" json:"cache_status_header,omitempty"`
+	// Time within which the invalidation should reach all the cache nodes.
+	// It should be less than the probe timeout.
+	SlaMsec *int32 `protobuf:"varint,7,opt,name=sla_msec,json=slaMsec,def=5000" json:"sla_msec,omitempty"`
+	// Interval between the key requests while waiting for a cache miss.
+	PollIntervalMsec *int32 `protobuf:"varint,8,opt,name=poll_interval_msec,json=pollIntervalMsec,def=100" json:"poll_interval_msec,omitempty"`
+}
+
+// Default values for ProbeConf_InvalidationCheck fields.
+const (
+	Default_ProbeConf_InvalidationCheck_InvalidationMethod = string("PURGE")
+	Default_ProbeConf_InvalidationCheck_SlaMsec            = int32(5000)
+	Default_ProbeConf_InvalidationCheck_PollIntervalMsec   = int32(100)
+)
+
+func (x *ProbeConf_InvalidationCheck) Reset() {
+	*x = ProbeConf_InvalidationCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeConf_InvalidationCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeConf_InvalidationCheck) ProtoMessage() {}
+
+func (x *ProbeConf_InvalidationCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeConf_InvalidationCheck.ProtoReflect.Descriptor instead.
+func (*ProbeConf_InvalidationCheck) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP(), []int{0, 9}
+}
+
+func (x *ProbeConf_InvalidationCheck) GetCacheNode() []string {
+	if x != nil {
+		return x.CacheNode
+	}
+	return nil
+}
+
+func (x *ProbeConf_InvalidationCheck) GetRelativeUrl() string {
+	if x != nil && x.RelativeUrl != nil {
+		return *x.RelativeUrl
+	}
+	return ""
+}
+
+func (x *ProbeConf_InvalidationCheck) GetInvalidationUrl() string {
+	if x != nil && x.InvalidationUrl != nil {
+		return *x.InvalidationUrl
+	}
+	return ""
+}
+
+func (x *ProbeConf_InvalidationCheck) GetInvalidationMethod() string {
+	if x != nil && x.InvalidationMethod != nil {
+		return *x.InvalidationMethod
+	}
+	return Default_ProbeConf_InvalidationCheck_InvalidationMethod
+}
+
+func (x *ProbeConf_InvalidationCheck) GetInvalidationBody() string {
+	if x != nil && x.InvalidationBody != nil {
+		return *x.InvalidationBody
+	}
+	return ""
+}
+
+func (x *ProbeConf_InvalidationCheck) GetCacheStatusHeader() []string {
+	if x != nil {
+		return x.CacheStatusHeader
+	}
+	return nil
+}
+
+func (x *ProbeConf_InvalidationCheck) GetSlaMsec() int32 {
+	if x != nil && x.SlaMsec != nil {
+		return *x.SlaMsec
+	}
+	return Default_ProbeConf_InvalidationCheck_SlaMsec
+}
+
+func (x *ProbeConf_InvalidationCheck) GetPollIntervalMsec() int32 {
+	if x != nil && x.PollIntervalMsec != nil {
+		return *x.PollIntervalMsec
+	}
+	return Default_ProbeConf_InvalidationCheck_PollIntervalMsec
+}
+
+var File_github_com_cloudprober_cloudprober_probes_http_proto_config_proto protoreflect.FileDescriptor
+
+var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x68, 0x74, 0x74, 0x70,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x44, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x21, 0x0a,
+	0x09, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x4d, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x3a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x3a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x12, 0x42, 0x0a, 0x1a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x17, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x73, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x46, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a,
+	0x03, 0x47, 0x45, 0x54, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x43, 0x0a, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x46, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65,
+	0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6f, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x6f, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6f, 0x61, 0x75, 0x74,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x32, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x74, 0x74, 0x70, 0x32, 0x12, 0x36, 0x0a, 0x17,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x65, 0x72, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x74, 0x6c, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x55,
+	0x72, 0x6c, 0x12, 0x6c, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x32, 0x35, 0x36, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x60, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x64, 0x6f, 0x77, 0x6e, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x48, 0x0a, 0x09, 0x63, 0x72, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x43, 0x52, 0x4c, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x08, 0x63, 0x72, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x48, 0x0a, 0x09, 0x63,
+	0x64, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x43, 0x44, 0x4e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x64, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x6d, 0x0a, 0x16, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x14,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x12, 0x5b, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x54, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x51, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x63, 0x0a, 0x12, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x11, 0x69, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x45, 0x0a, 0x1d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x73, 0x65, 0x63,
+	0x18, 0x61, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x02, 0x31, 0x30, 0x52, 0x1a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x2f, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x62, 0x20, 0x01,
+	0x28, 0x05, 0x3a, 0x01, 0x31, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x37, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65,
+	0x63, 0x18, 0x63, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x30, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63,
+	0x1a, 0x32, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x45, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x08, 0x43, 0x52, 0x4c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x33, 0x36, 0x30, 0x30, 0x52,
+	0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x12,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x66, 0x65, 0x74, 0x63, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x1a, 0x9c, 0x01, 0x0a, 0x08, 0x43,
+	0x44, 0x4e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x64, 0x67, 0x65, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x64, 0x67,
+	0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x64, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x65, 0x78, 0x1a, 0xb7, 0x01, 0x0a, 0x14, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x2f, 0x0a, 0x12, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x75, 0x70, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01,
+	0x33, 0x52, 0x10, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x1a, 0xa6, 0x01, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0xf8, 0x01, 0x0a,
+	0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x0a,
+	0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x03, 0x6e, 0x65, 0x77, 0x52,
+	0x0a, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x31,
+	0x30, 0x30, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x11, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x3a, 0x01, 0x35, 0x52, 0x10, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0xff, 0x04, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x47, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x5e, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x09,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x41, 0x52, 0x54, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x3a, 0x07, 0x31, 0x30, 0x34, 0x38, 0x35, 0x37, 0x36,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x3a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x68, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2e, 0x68, 0x74, 0x74,
+	0x70, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x3a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x52, 0x0c, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x69, 0x7a, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x26, 0x0a, 0x08,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x50, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x55, 0x4e, 0x4b,
+	0x45, 0x44, 0x10, 0x01, 0x22, 0x23, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x4d, 0x44, 0x35, 0x10, 0x01, 0x1a, 0xe9, 0x02, 0x0a, 0x11, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x13,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x05, 0x50, 0x55, 0x52, 0x47, 0x45,
+	0x52, 0x12, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x04, 0x35, 0x30, 0x30, 0x30, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x4d, 0x73,
+	0x65, 0x63, 0x12, 0x31, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03,
+	0x31, 0x30, 0x30, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4d, 0x73, 0x65, 0x63, 0x22, 0x1d, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x10, 0x01, 0x22, 0x52, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x07,
+	0x0a, 0x03, 0x47, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45,
+	0x41, 0x44, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04,
+	0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x06, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x10, 0x0a,
+	0x0c, 0x4e, 0x4f, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x47, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x4c, 0x41, 0x54, 0x45,
+	0x4e, 0x43, 0x59, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x4c, 0x53, 0x5f, 0x48, 0x41, 0x4e,
+	0x44, 0x53, 0x48, 0x41, 0x4b, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x04,
+	0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x51, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x41,
+	0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x06, 0x42,
+	0x0d, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x36,
+	0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x2f, 0x68, 0x74, 0x74, 0x70,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+}
+
+var (
+	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescOnce sync.Once
+	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData = file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDesc
+)
+
+func file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescGZIP() []byte {
+	file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescOnce.Do(func() {
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData)
+	})
+	return file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDescData
+}
+
+var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_goTypes = []any{
+	(ProbeConf_Scheme)(0),                   // 0: cloudprober.probes.http.ProbeConf.Scheme
+	(ProbeConf_Method)(0),                   // 1: cloudprober.probes.http.ProbeConf.Method
+	(ProbeConf_LatencyBreakdown)(0),         // 2: cloudprober.probes.http.ProbeConf.LatencyBreakdown
+	(ProbeConf_UploadCheck_Encoding)(0),     // 3: cloudprober.probes.http.ProbeConf.UploadCheck.Encoding
+	(ProbeConf_UploadCheck_ChecksumType)(0), // 4: cloudprober.probes.http.ProbeConf.UploadCheck.ChecksumType
+	(*ProbeConf)(nil),                       // 5: cloudprober.probes.http.ProbeConf
+	(*ProbeConf_Header)(nil),                // 6: cloudprober.probes.http.ProbeConf.Header
+	nil,                                     // 7: cloudprober.probes.http.ProbeConf.HeaderEntry
+	nil,                                     // 8: cloudprober.probes.http.ProbeConf.ProxyConnectHeaderEntry
+	(*ProbeConf_CRLCheck)(nil),              // 9: cloudprober.probes.http.ProbeConf.CRLCheck
+	(*ProbeConf_CDNCheck)(nil),              // 10: cloudprober.probes.http.ProbeConf.CDNCheck
+	(*ProbeConf_SessionAffinityCheck)(nil),  // 11: cloudprober.probes.http.ProbeConf.SessionAffinityCheck
+	(*ProbeConf_RateLimitCheck)(nil),        // 12: cloudprober.probes.http.ProbeConf.RateLimitCheck
+	(*ProbeConf_RolloutCheck)(nil),          // 13: cloudprober.probes.http.ProbeConf.RolloutCheck
+	(*ProbeConf_UploadCheck)(nil),           // 14: cloudprober.probes.http.ProbeConf.UploadCheck
+	(*ProbeConf_InvalidationCheck)(nil),     // 15: cloudprober.probes.http.ProbeConf.InvalidationCheck
+	(*proto.Config)(nil),                    // 16: cloudprober.oauth.Config
+	(*proto1.TLSConfig)(nil),                // 17: cloudprober.tlsconfig.TLSConfig
+}
+var file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_depIdxs = []int32{
+	0,  // 0: cloudprober.probes.http.ProbeConf.protocol:type_name -> cloudprober.probes.http.ProbeConf.Scheme
+	0,  // 1: cloudprober.probes.http.ProbeConf.scheme:type_name -> cloudprober.probes.http.ProbeConf.Scheme
+	1,  // 2: cloudprober.probes.http.ProbeConf.method:type_name -> cloudprober.probes.http.ProbeConf.Method
+	6,  // 3: cloudprober.probes.http.ProbeConf.headers:type_name -> cloudprober.probes.http.ProbeConf.Header
+	7,  // 4: cloudprober.probes.http.ProbeConf.header:type_name -> cloudprober.probes.http.ProbeConf.HeaderEntry
+	16, // 5: cloudprober.probes.http.ProbeConf.oauth_config:type_name -> cloudprober.oauth.Config
+	17, // 6: cloudprober.probes.http.ProbeConf.tls_config:type_name -> cloudprober.tlsconfig.TLSConfig
+	8,  // 7: cloudprober.probes.http.ProbeConf.proxy_connect_header:type_name -> cloudprober.probes.http.ProbeConf.ProxyConnectHeaderEntry
+	2,  // 8: cloudprober.probes.http.ProbeConf.latency_breakdown:type_name -> cloudprober.probes.http.ProbeConf.LatencyBreakdown
+	9,  // 9: cloudprober.probes.http.ProbeConf.crl_check:type_name -> cloudprober.probes.http.ProbeConf.CRLCheck
+	10, // 10: cloudprober.probes.http.ProbeConf.cdn_check:type_name -> cloudprober.probes.http.ProbeConf.CDNCheck
+	11, // 11: cloudprober.probes.http.ProbeConf.session_affinity_check:type_name -> cloudprober.probes.http.ProbeConf.SessionAffinityCheck
+	12, // 12: cloudprober.probes.http.ProbeConf.rate_limit_check:type_name -> cloudprober.probes.http.ProbeConf.RateLimitCheck
+	13, // 13: cloudprober.probes.http.ProbeConf.rollout_check:type_name -> cloudprober.probes.http.ProbeConf.RolloutCheck
+	14, // 14: cloudprober.probes.http.ProbeConf.upload_check:type_name -> cloudprober.probes.http.ProbeConf.UploadCheck
+	15, // 15: cloudprober.probes.http.ProbeConf.invalidation_check:type_name -> cloudprober.probes.http.ProbeConf.InvalidationCheck
+	1,  // 16: cloudprober.probes.http.ProbeConf.UploadCheck.method:type_name -> cloudprober.probes.http.ProbeConf.Method
+	3,  // 17: cloudprober.probes.http.ProbeConf.UploadCheck.encoding:type_name -> cloudprober.probes.http.ProbeConf.UploadCheck.Encoding
+	4,  // 18: cloudprober.probes.http.ProbeConf.UploadCheck.checksum_type:type_name -> cloudprober.probes.http.ProbeConf.UploadCheck.ChecksumType
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_init() }
+func file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_init() {
+	if File_github_com_cloudprober_cloudprober_probes_http_proto_config_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_CRLCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_CDNCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_SessionAffinityCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_RateLimitCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_RolloutCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_UploadCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ProbeConf_InvalidationCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +2095,8 @@ func file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_init
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_probes_http_proto_config_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
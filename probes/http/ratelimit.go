@@ -0,0 +1,198 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+var (
+	defaultRemainingHeaders = []string{"X-RateLimit-Remaining", "RateLimit-Remaining", "X-Rate-Limit-Remaining"}
+	defaultLimitHeaders     = []string{"X-RateLimit-Limit", "RateLimit-Limit", "X-Rate-Limit-Limit"}
+	defaultResetHeaders     = []string{"X-RateLimit-Reset", "RateLimit-Reset", "X-Rate-Limit-Reset"}
+)
+
+// Reset values above this are treated as Unix epoch seconds, rather than
+// seconds until the reset. It corresponds to Sep 2001.
+const minEpochReset = 1e9
+
+type rateLimitChecker struct {
+	remainingHeaders, limitHeaders, resetHeaders []string
+	minRemaining                                 int64
+}
+
+// rateLimitInfo is the rate limit information parsed from a response. Fields
+// are -1 if the information is missing.
+type rateLimitInfo struct {
+	remaining, limit, resetSec, retryAfterSec int64
+}
+
+func newRateLimitChecker(c *configpb.ProbeConf_RateLimitCheck) *rateLimitChecker {
+	rc := &rateLimitChecker{
+		remainingHeaders: c.GetRemainingHeader(),
+		limitHeaders:     c.GetLimitHeader(),
+		resetHeaders:     c.GetResetHeader(),
+		minRemaining:     c.GetMinRemaining(),
+	}
+	if len(rc.remainingHeaders) == 0 {
+		rc.remainingHeaders = defaultRemainingHeaders
+	}
+	if len(rc.limitHeaders) == 0 {
+		rc.limitHeaders = defaultLimitHeaders
+	}
+	if len(rc.resetHeaders) == 0 {
+		rc.resetHeaders = defaultResetHeaders
+	}
+	return rc
+}
+
+// parseNumber parses a rate limit header value. Some APIs report multiple
+// policies, e.g. "100, 100;w=60", in which case the first value is used.
+func parseNumber(value string) (int64, bool) {
+	value, _, _ = strings.Cut(value, ",")
+	value, _, _ = strings.Cut(value, ";")
+	f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || f < 0 || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, false
+	}
+	return int64(math.Ceil(f)), true
+}
+
+// lookupNumber returns the value of the first header present in h.
+func lookupNumber(h http.Header, headers []string) int64 {
+	for _, name := range headers {
+		if value := h.Get(name); value != "" {
+			if n, ok := parseNumber(value); ok {
+				return n
+			}
+			return -1
+		}
+	}
+	return -1
+}
+
+// parseStructuredRateLimit parses the RateLimit header, as defined by the
+// IETF httpapi drafts. Both the older "limit=100, remaining=50, reset=30"
+// and the newer `"default";r=50;t=30` forms are handled.
+func parseStructuredRateLimit(value string, info *rateLimitInfo) {
+	for _, param := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			continue
+		}
+		n, ok := parseNumber(v)
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(k) {
+		case "remaining", "r":
+			info.remaining = n
+		case "limit":
+			info.limit = n
+		case "reset", "t":
+			info.resetSec = n
+		}
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which may contain either
+// the seconds to wait, or an HTTP date.
+func parseRetryAfter(value string, now time.Time) int64 {
+	if value == "" {
+		return -1
+	}
+	if n, ok := parseNumber(value); ok {
+		return n
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return -1
+	}
+	if d := t.Sub(now); d > 0 {
+		return int64(math.Ceil(d.Seconds()))
+	}
+	return 0
+}
+
+func (rc *rateLimitChecker) parse(h http.Header, now time.Time) rateLimitInfo {
+	info := rateLimitInfo{
+		remaining:     lookupNumber(h, rc.remainingHeaders),
+		limit:         lookupNumber(h, rc.limitHeaders),
+		resetSec:      lookupNumber(h, rc.resetHeaders),
+		retryAfterSec: parseRetryAfter(h.Get("Retry-After"), now),
+	}
+
+	if info.remaining == -1 {
+		if value := h.Get("RateLimit"); value != "" {
+			parseStructuredRateLimit(value, &info)
+		}
+	}
+
+	if info.resetSec >= minEpochReset {
+		info.resetSec = max(0, info.resetSec-now.Unix())
+	}
+	return info
+}
+
+// checkRateLimit parses the rate limit headers in the response and updates
+// the result.
+func (p *Probe) checkRateLimit(resp *http.Response, targetName, url string, result *probeResult) {
+	rc := p.rateLimitChecker
+	info := rc.parse(resp.Header, time.Now())
+	result.rateLimit = info
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		result.rateLimitThrottled++
+		p.l.Warningf("Target: %s, URL: %s, rate limited (429), retry after: %ds", targetName, url, info.retryAfterSec)
+	}
+
+	if info.remaining == -1 {
+		result.rateLimitMissing++
+		p.l.Debugf("Target: %s, URL: %s, no rate limit remaining header in the response", targetName, url)
+		return
+	}
+	if info.remaining < rc.minRemaining {
+		result.rateLimitLow++
+		p.l.Warningf("Target: %s, URL: %s, rate limit remaining %d is below %d", targetName, url, info.remaining, rc.minRemaining)
+	}
+}
+
+// addMetrics adds the known rate limit values to the given EventMetrics. It
+// returns false if nothing is known.
+func (info rateLimitInfo) addMetrics(em *metrics.EventMetrics) bool {
+	added := false
+	for _, m := range []struct {
+		name  string
+		value int64
+	}{
+		{"ratelimit_remaining", info.remaining},
+		{"ratelimit_limit", info.limit},
+		{"ratelimit_reset_sec", info.resetSec},
+		{"retry_after_sec", info.retryAfterSec},
+	} {
+		if m.value >= 0 {
+			em.AddMetric(m.name, metrics.NewInt(m.value))
+			added = true
+		}
+	}
+	return added
+}
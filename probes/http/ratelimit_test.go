@@ -0,0 +1,179 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRateLimitParse(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	epochIn := func(d time.Duration) string { return strconv.FormatInt(now.Add(d).Unix(), 10) }
+
+	tests := []struct {
+		name   string
+		conf   *configpb.ProbeConf_RateLimitCheck
+		header http.Header
+		want   rateLimitInfo
+	}{
+		{
+			name: "github",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"5000"},
+				"X-Ratelimit-Remaining": {"4999"},
+				"X-Ratelimit-Reset":     {epochIn(30 * time.Minute)},
+			},
+			want: rateLimitInfo{remaining: 4999, limit: 5000, resetSec: 1800, retryAfterSec: -1},
+		},
+		{
+			name: "ietf_draft_fields",
+			header: http.Header{
+				"Ratelimit-Limit":     {"100, 100;w=60"},
+				"Ratelimit-Remaining": {"50"},
+				"Ratelimit-Reset":     {"30"},
+			},
+			want: rateLimitInfo{remaining: 50, limit: 100, resetSec: 30, retryAfterSec: -1},
+		},
+		{
+			name:   "ietf_structured_old",
+			header: http.Header{"Ratelimit": {"limit=100, remaining=50, reset=5"}},
+			want:   rateLimitInfo{remaining: 50, limit: 100, resetSec: 5, retryAfterSec: -1},
+		},
+		{
+			name:   "ietf_structured_new",
+			header: http.Header{"Ratelimit": {`"default";r=50;t=30`}},
+			want:   rateLimitInfo{remaining: 50, limit: -1, resetSec: 30, retryAfterSec: -1},
+		},
+		{
+			name: "twitter",
+			header: http.Header{
+				"X-Rate-Limit-Limit":     {"900"},
+				"X-Rate-Limit-Remaining": {"0"},
+				"X-Rate-Limit-Reset":     {epochIn(-time.Minute)},
+			},
+			want: rateLimitInfo{remaining: 0, limit: 900, resetSec: 0, retryAfterSec: -1},
+		},
+		{
+			name:   "retry_after_seconds",
+			header: http.Header{"Retry-After": {"120"}},
+			want:   rateLimitInfo{remaining: -1, limit: -1, resetSec: -1, retryAfterSec: 120},
+		},
+		{
+			name:   "retry_after_date",
+			header: http.Header{"Retry-After": {now.Add(90 * time.Second).Format(http.TimeFormat)}},
+			want:   rateLimitInfo{remaining: -1, limit: -1, resetSec: -1, retryAfterSec: 90},
+		},
+		{
+			name:   "fractional_reset",
+			header: http.Header{"X-Ratelimit-Remaining": {"10"}, "X-Ratelimit-Reset": {"1.5"}},
+			want:   rateLimitInfo{remaining: 10, limit: -1, resetSec: 2, retryAfterSec: -1},
+		},
+		{
+			name:   "invalid_values",
+			header: http.Header{"X-Ratelimit-Remaining": {"lots"}, "Retry-After": {"soon"}},
+			want:   rateLimitInfo{remaining: -1, limit: -1, resetSec: -1, retryAfterSec: -1},
+		},
+		{
+			name:   "missing",
+			header: http.Header{},
+			want:   rateLimitInfo{remaining: -1, limit: -1, resetSec: -1, retryAfterSec: -1},
+		},
+		{
+			name:   "custom_header",
+			conf:   &configpb.ProbeConf_RateLimitCheck{RemainingHeader: []string{"X-Quota-Left"}},
+			header: http.Header{"X-Quota-Left": {"7"}, "X-Ratelimit-Remaining": {"100"}},
+			want:   rateLimitInfo{remaining: 7, limit: -1, resetSec: -1, retryAfterSec: -1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc := newRateLimitChecker(test.conf)
+			assert.Equal(t, test.want, rc.parse(test.header, now))
+		})
+	}
+}
+
+// rateLimitTestTransport returns responses with the given status codes and
+// headers, in rotation.
+type rateLimitTestTransport struct {
+	resps []*http.Response
+	n     int
+}
+
+func (tt *rateLimitTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := tt.resps[tt.n%len(tt.resps)]
+	tt.n++
+	return &http.Response{StatusCode: r.StatusCode, Header: r.Header, Body: http.NoBody}, nil
+}
+
+func TestProbeWithRateLimitCheck(t *testing.T) {
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{
+		RateLimitCheck: &configpb.ProbeConf_RateLimitCheck{MinRemaining: proto.Int64(10)},
+	}
+
+	p := &Probe{}
+	require.NoError(t, p.Init("http_test", opts))
+	client := &http.Client{Transport: &rateLimitTestTransport{resps: []*http.Response{
+		{StatusCode: http.StatusOK, Header: http.Header{"X-Ratelimit-Remaining": {"50"}, "X-Ratelimit-Limit": {"100"}}},
+		{StatusCode: http.StatusOK},
+		{StatusCode: http.StatusOK, Header: http.Header{"X-Ratelimit-Remaining": {"5"}, "X-Ratelimit-Limit": {"100"}}},
+		{StatusCode: http.StatusTooManyRequests, Header: http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Limit": {"100"}, "Retry-After": {"30"}}},
+	}}}
+
+	result := p.newResult()
+	for i := 0; i < 4; i++ {
+		req, _ := http.NewRequest("GET", "http://test.com/", nil)
+		p.doHTTPRequest(req, client, "test.com", result, nil)
+	}
+
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	p.exportMetrics(time.Now(), result, endpoint.Endpoint{Name: "test.com"}, dataChan)
+	close(dataChan)
+
+	got := make(map[string]string)
+	for em := range dataChan {
+		for _, name := range em.MetricsKeys() {
+			if name == "ratelimit_remaining" {
+				assert.True(t, em.Kind == metrics.GAUGE, "rate limit values are GAUGE metrics")
+			}
+			got[name] = em.Metric(name).String()
+		}
+	}
+
+	for name, want := range map[string]string{
+		"ratelimit_low":             "2",
+		"ratelimit_throttled":       "1",
+		"ratelimit_headers_missing": "1",
+		"ratelimit_remaining":       "0",
+		"ratelimit_limit":           "100",
+		"retry_after_sec":           "30",
+	} {
+		assert.Equal(t, want, got[name], name)
+	}
+	assert.NotContains(t, got, "ratelimit_reset_sec", "reset header was missing")
+}
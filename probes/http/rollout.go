@@ -0,0 +1,147 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"regexp"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+// otherVariant is the variant of the responses that don't match the body
+// marker, or don't carry the variant header.
+const otherVariant = "other"
+
+type rolloutChecker struct {
+	variantHeader string
+	bodyMarker    *regexp.Regexp
+	newVariant    string
+	requests      int
+	expected      float64
+	tolerance     float64
+}
+
+func newRolloutChecker(c *configpb.ProbeConf_RolloutCheck) (*rolloutChecker, error) {
+	if (c.GetVariantHeader() == "") == (c.GetBodyMarker() == "") {
+		return nil, fmt.Errorf("rollout_check: exactly one of variant_header and body_marker should be specified")
+	}
+	if c.GetRequests() < 1 {
+		return nil, fmt.Errorf("rollout_check: requests should be at least 1, got: %d", c.GetRequests())
+	}
+	if c.GetExpectedPercent() < 0 || c.GetExpectedPercent() > 100 {
+		return nil, fmt.Errorf("rollout_check: expected_percent should be between 0 and 100, got: %v", c.GetExpectedPercent())
+	}
+	if c.GetTolerancePercent() < 0 {
+		return nil, fmt.Errorf("rollout_check: tolerance_percent can't be negative, got: %v", c.GetTolerancePercent())
+	}
+
+	rc := &rolloutChecker{
+		variantHeader: c.GetVariantHeader(),
+		newVariant:    c.GetNewVariant(),
+		requests:      int(c.GetRequests()),
+		expected:      float64(c.GetExpectedPercent()),
+		tolerance:     float64(c.GetTolerancePercent()),
+	}
+	if c.GetBodyMarker() != "" {
+		re, err := regexp.Compile(c.GetBodyMarker())
+		if err != nil {
+			return nil, fmt.Errorf("rollout_check: invalid body_marker: %v", err)
+		}
+		rc.bodyMarker = re
+	}
+	return rc, nil
+}
+
+// variant returns the variant of the given response.
+func (rc *rolloutChecker) variant(resp *http.Response, body []byte) string {
+	if rc.bodyMarker != nil {
+		if rc.bodyMarker.Match(body) {
+			return rc.newVariant
+		}
+		return otherVariant
+	}
+	if v := resp.Header.Get(rc.variantHeader); v != "" {
+		return v
+	}
+	return otherVariant
+}
+
+// rolloutRequest sends a rollout check request and returns the response and
+// its body. Rollout requests don't reuse connections, so that all requests
+// are not pinned to the same backend.
+func (p *Probe) rolloutRequest(ctx context.Context, req *http.Request, client *http.Client) (*http.Response, []byte, error) {
+	req = p.prepareRequest(req).Clone(ctx)
+	req.Close = true
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// checkRollout runs a rollout check and updates the result.
+func (p *Probe) checkRollout(ctx context.Context, req *http.Request, client *http.Client, targetName string, result *probeResult) {
+	rc := p.rolloutChecker
+	result.rolloutChecks++
+
+	logAttrs := []slog.Attr{slog.String("target", targetName), slog.String("url", req.URL.String())}
+
+	var classified, newCount int
+	for i := 0; i < rc.requests; i++ {
+		resp, body, err := p.rolloutRequest(ctx, req, client)
+		if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+			if err == nil {
+				err = fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+			}
+			p.l.WarningAttrs("rollout check: request failed: "+err.Error(), logAttrs...)
+			result.rolloutErrors++
+			// No point in continuing if the context is done.
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		variant := rc.variant(resp, body)
+		result.rolloutVariants.IncKey(variant)
+		classified++
+		if variant == rc.newVariant {
+			newCount++
+		}
+	}
+
+	if classified == 0 {
+		return
+	}
+
+	observed := float64(newCount) * 100 / float64(classified)
+	result.rolloutNewPercent = observed
+	if math.Abs(observed-rc.expected) > rc.tolerance {
+		p.l.WarningAttrs(fmt.Sprintf("rollout check: %.1f%% of the responses (%d/%d) served by the variant %q, expected: %.1f%% (+/-%.1f)", observed, newCount, classified, rc.newVariant, rc.expected, rc.tolerance), logAttrs...)
+		result.rolloutDeviations++
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// rolloutStub serves every newEvery-th request from the new variant (v2),
+// and the rest from v1. If failEvery is set, every failEvery-th request
+// fails with a 503.
+type rolloutStub struct {
+	newEvery, failEvery int
+
+	mu sync.Mutex
+	n  int
+}
+
+func (rs *rolloutStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rs.mu.Lock()
+	rs.n++
+	n := rs.n
+	rs.mu.Unlock()
+
+	if rs.failEvery > 0 && n%rs.failEvery == 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	variant := "v1"
+	if n%rs.newEvery == 0 {
+		variant = "v2"
+	}
+	w.Header().Set("X-Variant", variant)
+	fmt.Fprintf(w, "<html><body data-version=%q>hello</body></html>", variant)
+}
+
+func TestCheckRollout(t *testing.T) {
+	tests := []struct {
+		name           string
+		failEvery      int
+		check          *configpb.ProbeConf_RolloutCheck
+		wantVariants   map[string]int64
+		wantPercent    float64
+		wantDeviations int64
+		wantErrors     int64
+		wantInitErr    bool
+	}{
+		{
+			name: "header",
+			check: &configpb.ProbeConf_RolloutCheck{
+				VariantHeader:   proto.String("X-Variant"),
+				NewVariant:      proto.String("v2"),
+				ExpectedPercent: proto.Float32(20),
+			},
+			wantVariants: map[string]int64{"v1": 40, "v2": 10},
+			wantPercent:  20,
+		},
+		{
+			name: "header_deviation",
+			check: &configpb.ProbeConf_RolloutCheck{
+				VariantHeader:    proto.String("X-Variant"),
+				NewVariant:       proto.String("v2"),
+				ExpectedPercent:  proto.Float32(30),
+				TolerancePercent: proto.Float32(5),
+			},
+			wantVariants:   map[string]int64{"v1": 40, "v2": 10},
+			wantPercent:    20,
+			wantDeviations: 1,
+		},
+		{
+			name: "body_marker",
+			check: &configpb.ProbeConf_RolloutCheck{
+				BodyMarker:      proto.String(`data-version="v2"`),
+				ExpectedPercent: proto.Float32(25),
+			},
+			wantVariants: map[string]int64{"other": 40, "new": 10},
+			wantPercent:  20,
+		},
+		{
+			name:      "errors",
+			failEvery: 10,
+			check: &configpb.ProbeConf_RolloutCheck{
+				VariantHeader:   proto.String("X-Variant"),
+				NewVariant:      proto.String("v2"),
+				ExpectedPercent: proto.Float32(0),
+			},
+			// Every 5th request is v2, but every 10th fails.
+			wantVariants:   map[string]int64{"v1": 40, "v2": 5},
+			wantPercent:    100.0 * 5 / 45,
+			wantDeviations: 1,
+			wantErrors:     5,
+		},
+		{
+			name:        "header_and_body_marker",
+			check:       &configpb.ProbeConf_RolloutCheck{VariantHeader: proto.String("X-Variant"), BodyMarker: proto.String("v2")},
+			wantInitErr: true,
+		},
+		{
+			name:        "no_classifier",
+			check:       &configpb.ProbeConf_RolloutCheck{},
+			wantInitErr: true,
+		},
+		{
+			name:        "bad_expected_percent",
+			check:       &configpb.ProbeConf_RolloutCheck{VariantHeader: proto.String("X-Variant"), ExpectedPercent: proto.Float32(120)},
+			wantInitErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewServer(&rolloutStub{newEvery: 5, failEvery: test.failEvery})
+			defer ts.Close()
+
+			test.check.Requests = proto.Int32(50)
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{RolloutCheck: test.check}
+
+			p := &Probe{}
+			err := p.Init("http_test", opts)
+			if test.wantInitErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			result := p.newResult()
+			req, _ := http.NewRequest("GET", ts.URL, nil)
+			p.checkRollout(context.Background(), req, &http.Client{}, "test.com", result)
+
+			assert.Equal(t, int64(1), result.rolloutChecks, "rollout_checks")
+			assert.Equal(t, test.wantDeviations, result.rolloutDeviations, "rollout_deviations")
+			assert.Equal(t, test.wantErrors, result.rolloutErrors, "rollout_errors")
+			assert.InDelta(t, test.wantPercent, result.rolloutNewPercent, 0.001, "rollout_new_percent")
+			for variant, want := range test.wantVariants {
+				assert.Equal(t, want, result.rolloutVariants.GetKey(variant), "variant: %s", variant)
+			}
+			assert.Len(t, result.rolloutVariants.Keys(), len(test.wantVariants))
+		})
+	}
+}
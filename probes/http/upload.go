@@ -0,0 +1,299 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"math/rand"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+)
+
+// uploadFileName is the file name used for the multipart uploads.
+const uploadFileName = "cloudprober-upload.bin"
+
+// Only this much of the upload response body is read, for the JSON fields.
+const maxUploadRespBodySize = 1 << 20
+
+type uploadChecker struct {
+	relURL    *url.URL
+	method    string
+	encoding  configpb.ProbeConf_UploadCheck_Encoding
+	size      int64
+	formField string
+	newHash   func() hash.Hash
+
+	checksumHeader, checksumField string
+	sizeHeader, sizeField         string
+}
+
+func newUploadChecker(c *configpb.ProbeConf_UploadCheck) (*uploadChecker, error) {
+	if (c.GetChecksumHeader() == "") == (c.GetChecksumJsonField() == "") {
+		return nil, errors.New("upload_check: exactly one of checksum_header and checksum_json_field should be specified")
+	}
+	if c.GetSizeHeader() != "" && c.GetSizeJsonField() != "" {
+		return nil, errors.New("upload_check: only one of size_header and size_json_field can be specified")
+	}
+	if c.GetSizeBytes() <= 0 {
+		return nil, fmt.Errorf("upload_check: size_bytes should be positive, got: %d", c.GetSizeBytes())
+	}
+
+	uc := &uploadChecker{
+		method:         c.GetMethod().String(),
+		encoding:       c.GetEncoding(),
+		size:           c.GetSizeBytes(),
+		formField:      c.GetFormField(),
+		checksumHeader: c.GetChecksumHeader(),
+		checksumField:  c.GetChecksumJsonField(),
+		sizeHeader:     c.GetSizeHeader(),
+		sizeField:      c.GetSizeJsonField(),
+	}
+
+	if c.GetRelativeUrl() != "" {
+		u, err := url.Parse(c.GetRelativeUrl())
+		if err != nil {
+			return nil, fmt.Errorf("upload_check: invalid relative_url: %v", err)
+		}
+		uc.relURL = u
+	}
+
+	switch c.GetChecksumType() {
+	case configpb.ProbeConf_UploadCheck_MD5:
+		uc.newHash = md5.New
+	default:
+		uc.newHash = sha256.New
+	}
+	return uc, nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(b []byte) (int, error) {
+	n, err := cr.r.Read(b)
+	cr.n += int64(n)
+	return n, err
+}
+
+// multipartEnvelope returns the multipart body parts before and after the
+// file content, and the content type of the body.
+func (uc *uploadChecker) multipartEnvelope() ([]byte, []byte, string, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	if _, err := mw.CreateFormFile(uc.formField, uploadFileName); err != nil {
+		return nil, nil, "", err
+	}
+	head := bytes.Clone(buf.Bytes())
+	buf.Reset()
+	if err := mw.Close(); err != nil {
+		return nil, nil, "", err
+	}
+	return head, buf.Bytes(), mw.FormDataContentType(), nil
+}
+
+// newUploadRequest returns the upload request for the generated payload. The
+// payload is generated while the request body is read, with its checksum
+// computed along the way, so that large payloads are not held in memory.
+func (p *Probe) newUploadRequest(ctx context.Context, req *http.Request, payload io.Reader) (*http.Request, error) {
+	uc := p.uploadChecker
+
+	u := req.URL
+	if uc.relURL != nil {
+		u = req.URL.ResolveReference(uc.relURL)
+	}
+
+	var body io.Reader
+	var contentLength int64
+	var contentType string
+	switch uc.encoding {
+	case configpb.ProbeConf_UploadCheck_CHUNKED:
+		// Unknown content length makes the transport use chunked encoding.
+		body, contentLength, contentType = payload, -1, "application/octet-stream"
+	default:
+		head, tail, ct, err := uc.multipartEnvelope()
+		if err != nil {
+			return nil, err
+		}
+		body = io.MultiReader(bytes.NewReader(head), payload, bytes.NewReader(tail))
+		contentLength, contentType = int64(len(head))+uc.size+int64(len(tail)), ct
+	}
+
+	uploadReq, err := http.NewRequestWithContext(ctx, uc.method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	uploadReq.Header = req.Header.Clone()
+	uploadReq.Host = req.Host
+	uploadReq.ContentLength = contentLength
+	uploadReq.Header.Set("Content-Type", contentType)
+	uploadReq.Close = true
+
+	if p.oauthTS != nil {
+		tok, err := getToken(p.oauthTS, p.l)
+		if err != nil {
+			p.l.Error("Error getting OAuth token: ", err.Error())
+			tok = "<token-missing>"
+		}
+		uploadReq.Header.Set("Authorization", "Bearer "+tok)
+	}
+	return uploadReq, nil
+}
+
+// jsonField returns the value of the dot-separated field path in the JSON
+// body, as a string.
+func jsonField(body []byte, path string) (string, bool) {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", false
+	}
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if v, ok = m[k]; !ok {
+			return "", false
+		}
+	}
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// reported returns the value reported by the server in the given header or
+// JSON field, whichever is configured.
+func reported(resp *http.Response, body []byte, header, field string) (string, bool) {
+	if header != "" {
+		v := resp.Header.Get(header)
+		return v, v != ""
+	}
+	return jsonField(body, field)
+}
+
+// verifyUpload verifies the checksum and the size reported by the server.
+// Returned partial is true if the server reported a smaller size.
+func (uc *uploadChecker) verifyUpload(resp *http.Response, body, sum []byte) (partial bool, err error) {
+	checksum, ok := reported(resp, body, uc.checksumHeader, uc.checksumField)
+	if !ok {
+		return false, errors.New("no checksum in the response")
+	}
+	checksum = strings.Trim(strings.TrimSpace(checksum), `"`)
+	if !strings.EqualFold(checksum, hex.EncodeToString(sum)) && checksum != base64.StdEncoding.EncodeToString(sum) {
+		return false, fmt.Errorf("checksum mismatch, got: %s, want: %x", checksum, sum)
+	}
+
+	if uc.sizeHeader == "" && uc.sizeField == "" {
+		return false, nil
+	}
+	sizeStr, ok := reported(resp, body, uc.sizeHeader, uc.sizeField)
+	if !ok {
+		return false, errors.New("no size in the response")
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(sizeStr), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid size in the response (%s): %v", sizeStr, err)
+	}
+	if size != uc.size {
+		return size < uc.size, fmt.Errorf("size mismatch, got: %d, want: %d", size, uc.size)
+	}
+	return false, nil
+}
+
+// checkUpload runs an upload check and updates the result.
+func (p *Probe) checkUpload(ctx context.Context, req *http.Request, client *http.Client, targetName string, result *probeResult) {
+	uc := p.uploadChecker
+	result.uploadChecks++
+
+	h := uc.newHash()
+	payload := &countingReader{r: io.TeeReader(io.LimitReader(rand.New(rand.NewSource(time.Now().UnixNano())), uc.size), h)}
+	defer func() { result.uploadBytes += payload.n }()
+
+	uploadReq, err := p.newUploadRequest(ctx, req, payload)
+	if err != nil {
+		p.l.Warning("upload check: error creating request: ", err.Error())
+		result.uploadErrors++
+		return
+	}
+	logAttrs := []slog.Attr{slog.String("target", targetName), slog.String("url", uploadReq.URL.String())}
+
+	start := time.Now()
+	resp, err := client.Do(uploadReq)
+	if err != nil {
+		if payload.n < uc.size {
+			p.l.WarningAttrs(fmt.Sprintf("upload check: upload interrupted after %d of %d bytes: %v", payload.n, uc.size, err), logAttrs...)
+			result.uploadPartial++
+		} else {
+			p.l.WarningAttrs("upload check: request failed: "+err.Error(), logAttrs...)
+		}
+		result.uploadErrors++
+		return
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxUploadRespBodySize))
+	latency := time.Since(start)
+
+	// Server may respond before reading the whole payload, e.g. with 413.
+	if payload.n < uc.size {
+		p.l.WarningAttrs(fmt.Sprintf("upload check: server responded (status: %d) after %d of %d bytes", resp.StatusCode, payload.n, uc.size), logAttrs...)
+		result.uploadPartial++
+		result.uploadErrors++
+		return
+	}
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if err == nil {
+			err = fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		}
+		p.l.WarningAttrs("upload check: request failed: "+err.Error(), logAttrs...)
+		result.uploadErrors++
+		return
+	}
+
+	partial, err := uc.verifyUpload(resp, body, h.Sum(nil))
+	if err != nil {
+		p.l.WarningAttrs("upload check: integrity check failed: "+err.Error(), logAttrs...)
+		if partial {
+			result.uploadPartial++
+		}
+		result.uploadIntegrityFailures++
+		return
+	}
+
+	result.uploadLatency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+	result.uploadThroughput = float64(uc.size) / latency.Seconds()
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// uploadStub accepts multipart (file in the "file" field) and raw uploads,
+// and reports the SHA256 checksum and size of the received content in the
+// X-Checksum and X-Size headers, and the MD5 checksum (base64) and size in
+// the JSON body.
+type uploadStub struct {
+	// Modifies the reported size and checksum.
+	sizeDelta int64
+	corrupt   bool
+	// Responds with 413 without reading the body.
+	reject bool
+
+	gotChunked bool
+}
+
+func (us *uploadStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if us.reject {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	us.gotChunked = len(r.TransferEncoding) > 0 && r.TransferEncoding[0] == "chunked"
+
+	var content io.Reader = r.Body
+	if r.Header.Get("Content-Type") != "application/octet-stream" {
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+		content = f
+	}
+
+	sha, md := sha256.New(), md5.New()
+	n, err := io.Copy(io.MultiWriter(sha, md), content)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if us.corrupt {
+		sha.Write([]byte("x"))
+		md.Write([]byte("x"))
+	}
+
+	w.Header().Set("X-Checksum", hex.EncodeToString(sha.Sum(nil)))
+	w.Header().Set("X-Size", strconv.FormatInt(n+us.sizeDelta, 10))
+	json.NewEncoder(w).Encode(map[string]any{
+		"upload": map[string]any{
+			"md5":  base64.StdEncoding.EncodeToString(md.Sum(nil)),
+			"size": n + us.sizeDelta,
+		},
+	})
+}
+
+func TestCheckUpload(t *testing.T) {
+	const size = 256 * 1024
+
+	tests := []struct {
+		name                string
+		stub                *uploadStub
+		check               *configpb.ProbeConf_UploadCheck
+		wantErrors          int64
+		wantPartial         int64
+		wantIntegrityErrors int64
+		wantChunked         bool
+		wantInitErr         bool
+	}{
+		{
+			name:  "multipart_header",
+			stub:  &uploadStub{},
+			check: &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), SizeHeader: proto.String("X-Size")},
+		},
+		{
+			name: "chunked_json",
+			stub: &uploadStub{},
+			check: &configpb.ProbeConf_UploadCheck{
+				Encoding:          configpb.ProbeConf_UploadCheck_CHUNKED.Enum(),
+				ChecksumType:      configpb.ProbeConf_UploadCheck_MD5.Enum(),
+				ChecksumJsonField: proto.String("upload.md5"),
+				SizeJsonField:     proto.String("upload.size"),
+			},
+			wantChunked: true,
+		},
+		{
+			name:                "checksum_mismatch",
+			stub:                &uploadStub{corrupt: true},
+			check:               &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum")},
+			wantIntegrityErrors: 1,
+		},
+		{
+			name:                "missing_checksum",
+			stub:                &uploadStub{},
+			check:               &configpb.ProbeConf_UploadCheck{ChecksumJsonField: proto.String("upload.sha256")},
+			wantIntegrityErrors: 1,
+		},
+		{
+			name:                "size_mismatch",
+			stub:                &uploadStub{sizeDelta: 1},
+			check:               &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), SizeJsonField: proto.String("upload.size")},
+			wantIntegrityErrors: 1,
+		},
+		{
+			name:                "partial_reported",
+			stub:                &uploadStub{sizeDelta: -1024},
+			check:               &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), SizeJsonField: proto.String("upload.size")},
+			wantPartial:         1,
+			wantIntegrityErrors: 1,
+		},
+		{
+			name: "rejected_early",
+			stub: &uploadStub{reject: true},
+			check: &configpb.ProbeConf_UploadCheck{
+				ChecksumHeader: proto.String("X-Checksum"),
+				// Large enough to not fit in the socket buffers.
+				SizeBytes: proto.Int64(64 << 20),
+			},
+			wantErrors:  1,
+			wantPartial: 1,
+		},
+		{
+			name:        "no_checksum_source",
+			check:       &configpb.ProbeConf_UploadCheck{},
+			wantInitErr: true,
+		},
+		{
+			name:        "both_checksum_sources",
+			check:       &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), ChecksumJsonField: proto.String("md5")},
+			wantInitErr: true,
+		},
+		{
+			name:        "both_size_sources",
+			check:       &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), SizeHeader: proto.String("X-Size"), SizeJsonField: proto.String("size")},
+			wantInitErr: true,
+		},
+		{
+			name:        "bad_size",
+			check:       &configpb.ProbeConf_UploadCheck{ChecksumHeader: proto.String("X-Checksum"), SizeBytes: proto.Int64(0)},
+			wantInitErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.check.SizeBytes == nil {
+				test.check.SizeBytes = proto.Int64(size)
+			}
+			test.check.RelativeUrl = proto.String("/upload")
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{UploadCheck: test.check}
+
+			p := &Probe{}
+			err := p.Init("http_test", opts)
+			if test.wantInitErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			mux := http.NewServeMux()
+			mux.Handle("/upload", test.stub)
+			ts := httptest.NewServer(mux)
+			defer ts.Close()
+
+			result := p.newResult()
+			req, _ := http.NewRequest("GET", ts.URL+"/index.html", nil)
+			p.checkUpload(context.Background(), req, &http.Client{}, "test.com", result)
+
+			assert.Equal(t, int64(1), result.uploadChecks, "upload_checks")
+			assert.Equal(t, test.wantErrors, result.uploadErrors, "upload_errors")
+			assert.Equal(t, test.wantPartial, result.uploadPartial, "upload_partial")
+			assert.Equal(t, test.wantIntegrityErrors, result.uploadIntegrityFailures, "upload_integrity_failures")
+			assert.Equal(t, test.wantChunked, test.stub.gotChunked, "chunked")
+
+			if test.wantErrors+test.wantIntegrityErrors > 0 {
+				assert.Equal(t, float64(-1), result.uploadThroughput, "upload_throughput")
+				assert.Less(t, result.uploadBytes, int64(test.check.GetSizeBytes())+1)
+				return
+			}
+			assert.Equal(t, int64(size), result.uploadBytes, "upload_bytes")
+			assert.Greater(t, result.uploadThroughput, float64(0), "upload_throughput")
+			assert.Greater(t, result.uploadLatency.(*metrics.Float).Float64(), float64(0), "upload_latency")
+		})
+	}
+}
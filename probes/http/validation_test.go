@@ -0,0 +1,86 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/validators"
+	validatorpb "github.com/cloudprober/cloudprober/internal/validators/proto"
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/probes/http/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// statusTestTransport returns responses with status codes from the given
+// list, in rotation.
+type statusTestTransport struct {
+	codes []int
+	n     int
+}
+
+func (tt *statusTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	code := tt.codes[tt.n%len(tt.codes)]
+	tt.n++
+	return &http.Response{StatusCode: code, Body: http.NoBody}, nil
+}
+
+func TestValidationFailureDetails(t *testing.T) {
+	vc := &validatorpb.Validator{}
+	require.NoError(t, prototext.Unmarshal([]byte(`
+		name: "status_2xx"
+		http_validator {
+			success_status_codes: "200-299"
+		}
+	`), vc))
+	vs, err := validators.Init([]*validatorpb.Validator{vc}, nil)
+	require.NoError(t, err)
+
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{}
+	opts.Validators = vs
+
+	p := &Probe{}
+	require.NoError(t, p.Init("http_test", opts))
+	client := &http.Client{Transport: &statusTestTransport{codes: []int{200, 404, 503, 404}}}
+
+	result := p.newResult()
+	for i := 0; i < 4; i++ {
+		req, _ := http.NewRequest("GET", "http://test.com/", nil)
+		p.doHTTPRequest(req, client, "test.com", result, nil)
+	}
+	assert.Equal(t, int64(1), result.success)
+
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	p.exportMetrics(time.Now(), result, endpoint.Endpoint{Name: "test.com"}, dataChan)
+	close(dataChan)
+
+	got := make(map[string]string)
+	for em := range dataChan {
+		if m := em.Metric(validators.FailureDetailMetricName); m != nil {
+			assert.Equal(t, "status_2xx", em.Label("validator"))
+			assert.Equal(t, "status_code", em.Label("reason"))
+			assert.Equal(t, "test.com", em.Label("dst"))
+			got[em.Label("value")] = m.String()
+		}
+	}
+	assert.Equal(t, map[string]string{"404": "2", "503": "1"}, got)
+}
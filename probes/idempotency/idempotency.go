@@ -0,0 +1,300 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotency implements a probe type that verifies that a write API
+// doesn't create duplicate resources for the retried requests.
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/idempotency/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+// keyPrefix is prepended to the idempotency keys, to make them easy to spot
+// in the service logs.
+const keyPrefix = "cloudprober-"
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	scheme  string
+	client  *http.Client
+	jqQuery *gojq.Query
+}
+
+type probeResult struct {
+	total, success        int64
+	latency               metrics.LatencyValue
+	idempotencyViolations int64
+	createErrors          int64
+	cleanupErrors         int64
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("idempotency_violations", metrics.NewInt(result.idempotencyViolations)).
+		AddMetric("create_errors", metrics.NewInt(result.createErrors)).
+		AddMetric("cleanup_errors", metrics.NewInt(result.cleanupErrors)).
+		AddLabel("ptype", "idempotency")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not idempotency probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	for _, path := range []string{p.c.GetCreatePath(), p.c.GetDeletePath()} {
+		if path != "" && !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("request path should start with a /, got: %s", path)
+		}
+	}
+
+	if filter := p.c.GetResourceIdJqFilter(); filter != "" {
+		q, err := gojq.Parse(filter)
+		if err != nil {
+			return fmt.Errorf("error parsing resource_id_jq_filter (%s): %v", filter, err)
+		}
+		p.jqQuery = q
+	}
+
+	p.scheme = strings.ToLower(p.c.GetScheme().String())
+
+	t := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{},
+	}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(t.TLSClientConfig, p.c.GetTlsConfig()); err != nil {
+			return fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+	if p.opts.SourceIP != nil {
+		t.DialContext = (&net.Dialer{LocalAddr: &net.TCPAddr{IP: p.opts.SourceIP}}).DialContext
+	}
+	p.client = &http.Client{Transport: t}
+
+	return nil
+}
+
+func (p *Probe) url(target endpoint.Endpoint, path string) string {
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	host := target.Name
+	if port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return p.scheme + "://" + host + path
+}
+
+// newKey returns a new random idempotency key.
+func newKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return keyPrefix + hex.EncodeToString(b)
+}
+
+// resourceID returns the ID of the created resource from the create
+// response.
+func (p *Probe) resourceID(header http.Header, body []byte) (string, error) {
+	if h := p.c.GetResourceIdHeader(); h != "" {
+		if id := header.Get(h); id != "" {
+			return id, nil
+		}
+		return "", fmt.Errorf("header %s not found in response", h)
+	}
+
+	if p.jqQuery == nil {
+		return "", errors.New("neither resource_id_header nor resource_id_jq_filter is configured")
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(body, &input); err != nil {
+		return "", fmt.Errorf("response is not a valid JSON: %v", err)
+	}
+	item, ok := p.jqQuery.Run(input).Next()
+	if !ok || item == nil {
+		return "", errors.New("resource ID not found in response")
+	}
+	if err, ok := item.(error); ok {
+		return "", err
+	}
+	if s, ok := item.(string); ok {
+		return s, nil
+	}
+	out, err := json.Marshal(item)
+	return string(out), err
+}
+
+// create sends the create request with the given idempotency key, and
+// returns the ID of the created resource.
+func (p *Probe) create(ctx context.Context, target endpoint.Endpoint, key string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, p.c.GetCreateMethod(), p.url(target, p.c.GetCreatePath()), strings.NewReader(p.c.GetBody()))
+	if err != nil {
+		return "", err
+	}
+	if p.c.GetBody() != "" {
+		req.Header.Set("Content-Type", p.c.GetContentType())
+	}
+	req.Header.Set(p.c.GetIdempotencyKeyHeader(), key)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return p.resourceID(resp.Header, body)
+}
+
+// cleanup deletes the resource with the given ID.
+func (p *Probe) cleanup(ctx context.Context, target endpoint.Endpoint, id string) error {
+	path := strings.ReplaceAll(p.c.GetDeletePath(), "{id}", url.PathEscape(id))
+	req, err := http.NewRequestWithContext(ctx, p.c.GetDeleteMethod(), p.url(target, path), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	// A 404 means that resource is already gone.
+	if resp.StatusCode != http.StatusNotFound && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	var createdIDs []string
+	defer func() {
+		if p.c.GetDeletePath() == "" {
+			return
+		}
+		for _, id := range createdIDs {
+			if err := p.cleanup(ctx, target, id); err != nil {
+				p.l.Warningf("Target: %s, error deleting resource %s: %v", target.Name, id, err)
+				result.cleanupErrors++
+			}
+		}
+	}()
+
+	key := newKey()
+	start := time.Now()
+
+	id1, err := p.create(ctx, target, key)
+	if err != nil {
+		p.l.Warningf("Target: %s, create request failed: %v", target.Name, err)
+		result.createErrors++
+		return
+	}
+	createdIDs = append(createdIDs, id1)
+
+	id2, err := p.create(ctx, target, key)
+	if err != nil {
+		p.l.Warningf("Target: %s, retried create request (key: %s) failed: %v", target.Name, key, err)
+		result.createErrors++
+		return
+	}
+	latency := time.Since(start)
+
+	if id2 != id1 {
+		p.l.Warningf("Target: %s, retried create request (key: %s) created a new resource: %s, first resource: %s", target.Name, key, id2, id1)
+		createdIDs = append(createdIDs, id2)
+		result.idempotencyViolations++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/idempotency/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// orderStub is an orders API. If idempotent is set, create requests with an
+// already seen idempotency key return the existing order.
+type orderStub struct {
+	idempotent bool
+	failCreate bool
+
+	mu      sync.Mutex
+	nextID  int
+	byKey   map[string]string
+	orders  map[string]bool
+	deleted []string
+}
+
+func (s *orderStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/orders":
+		if s.failCreate {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		key := r.Header.Get("Idempotency-Key")
+		id, ok := s.byKey[key]
+		if !ok || !s.idempotent {
+			s.nextID++
+			id = fmt.Sprintf("ord-%d", s.nextID)
+			s.byKey[key] = id
+			s.orders[id] = true
+		}
+		w.Header().Set("Location", "/orders/"+id)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"order": {"id": %q}}`, id)
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/orders/"):
+		id := strings.TrimPrefix(r.URL.Path, "/orders/")
+		if !s.orders[id] {
+			http.NotFound(w, r)
+			return
+		}
+		delete(s.orders, id)
+		s.deleted = append(s.deleted, id)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name           string
+		idempotent     bool
+		failCreate     bool
+		conf           *configpb.ProbeConf
+		wantSuccess    int64
+		wantViolations int64
+		wantErrors     int64
+		wantDeleted    []string
+	}{
+		{
+			name:        "idempotent_jq",
+			idempotent:  true,
+			conf:        &configpb.ProbeConf{ResourceIdSource: &configpb.ProbeConf_ResourceIdJqFilter{ResourceIdJqFilter: ".order.id"}},
+			wantSuccess: 1,
+			wantDeleted: []string{"ord-1"},
+		},
+		{
+			name:        "idempotent_header_no_cleanup",
+			idempotent:  true,
+			conf:        &configpb.ProbeConf{ResourceIdSource: &configpb.ProbeConf_ResourceIdHeader{ResourceIdHeader: "Location"}},
+			wantSuccess: 1,
+		},
+		{
+			name:           "not_idempotent",
+			conf:           &configpb.ProbeConf{ResourceIdSource: &configpb.ProbeConf_ResourceIdJqFilter{ResourceIdJqFilter: ".order.id"}},
+			wantViolations: 1,
+			wantDeleted:    []string{"ord-1", "ord-2"},
+		},
+		{
+			name:       "create_error",
+			failCreate: true,
+			conf:       &configpb.ProbeConf{ResourceIdSource: &configpb.ProbeConf_ResourceIdJqFilter{ResourceIdJqFilter: ".order.id"}},
+			wantErrors: 1,
+		},
+		{
+			name:       "missing_resource_id",
+			idempotent: true,
+			conf:       &configpb.ProbeConf{ResourceIdSource: &configpb.ProbeConf_ResourceIdHeader{ResourceIdHeader: "X-Order-Id"}},
+			wantErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stub := &orderStub{
+				idempotent: test.idempotent,
+				failCreate: test.failCreate,
+				byKey:      make(map[string]string),
+				orders:     make(map[string]bool),
+			}
+			ts := httptest.NewServer(stub)
+			defer ts.Close()
+			port := ts.Listener.Addr().(*net.TCPAddr).Port
+
+			test.conf.CreatePath = proto.String("/orders")
+			test.conf.Body = proto.String(`{"item": "test"}`)
+			if test.wantDeleted != nil {
+				test.conf.DeletePath = proto.String("/orders/{id}")
+			}
+
+			opts := options.DefaultOptions()
+			opts.Timeout = 5 * time.Second
+			opts.ProbeConf = test.conf
+
+			p := &Probe{}
+			require.NoError(t, p.Init("idempotency_test", opts))
+
+			res := p.newResult()
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: "127.0.0.1", Port: port}, res)
+			result := res.(*probeResult)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantViolations, result.idempotencyViolations, "idempotency_violations")
+			assert.Equal(t, test.wantErrors, result.createErrors, "create_errors")
+			assert.Equal(t, int64(0), result.cleanupErrors, "cleanup_errors")
+			assert.Equal(t, test.wantDeleted, stub.deleted, "deleted orders")
+		})
+	}
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"bad_path":      {CreatePath: proto.String("orders")},
+		"bad_jq_filter": {CreatePath: proto.String("/orders"), ResourceIdSource: &configpb.ProbeConf_ResourceIdJqFilter{ResourceIdJqFilter: ".["}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("idempotency_test", opts))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8sapi implements a probe type that measures the latency of the
+// Kubernetes API server for representative API calls.
+package k8sapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/kubeclient"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	configpb "github.com/cloudprober/cloudprober/probes/k8sapi/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultRequests are used if no requests are configured.
+var defaultRequests = []*configpb.Request{
+	{
+		Verb: configpb.Request_GET.Enum(),
+		Path: proto.String("/api/v1/namespaces/default"),
+	},
+	{
+		Verb: configpb.Request_LIST.Enum(),
+		Path: proto.String("/api/v1/namespaces"),
+	},
+}
+
+// apiClient makes requests to the API server. It's implemented by
+// *kubeclient.Client, and makes it possible to mock the client in tests.
+type apiClient interface {
+	Do(ctx context.Context, method, path string, body []byte) ([]byte, error)
+}
+
+// Probe holds aggregate information about all probe runs.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	client   apiClient
+	requests []*configpb.Request
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+
+	// Per-verb metrics.
+	verbTotal   *metrics.Map[int64]
+	verbSuccess *metrics.Map[int64]
+	verbLatency *metrics.Map[float64]
+	throttled   *metrics.Map[int64]
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		verbTotal:   metrics.NewMap("verb"),
+		verbSuccess: metrics.NewMap("verb"),
+		verbLatency: metrics.NewMapFloat("verb"),
+		throttled:   metrics.NewMap("verb"),
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("verb_total", result.verbTotal.Clone()).
+		AddMetric("verb_success", result.verbSuccess.Clone()).
+		AddMetric("verb_"+opts.LatencyMetricName, result.verbLatency.Clone()).
+		AddMetric("throttled", result.throttled.Clone()).
+		AddLabel("ptype", "k8sapi")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not k8sapi probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	p.requests = p.c.GetRequest()
+	if len(p.requests) == 0 {
+		p.requests = defaultRequests
+	}
+	for _, req := range p.requests {
+		if !strings.HasPrefix(req.GetPath(), "/") {
+			return fmt.Errorf("request path should start with a /, got: %s", req.GetPath())
+		}
+		if req.GetVerb() == configpb.Request_LIST && req.GetLimit() <= 0 {
+			return fmt.Errorf("limit should be positive for LIST requests, got: %d", req.GetLimit())
+		}
+	}
+
+	if p.client == nil {
+		var kc *kubeclient.Client
+		var err error
+		if p.c.GetKubeconfig() != "" {
+			kc, err = kubeclient.NewFromKubeconfig(p.c.GetKubeconfig(), p.c.GetKubeconfigContext(), p.l)
+		} else {
+			kc, err = kubeclient.New(p.c.GetApiServerAddress(), p.c.GetTlsConfig(), p.l)
+		}
+		if err != nil {
+			return err
+		}
+		p.client = kc
+	}
+
+	return nil
+}
+
+// requestPath returns the API path for the request, including the limit
+// parameter for LIST requests.
+func requestPath(req *configpb.Request) string {
+	if req.GetVerb() != configpb.Request_LIST {
+		return req.GetPath()
+	}
+	sep := "?"
+	if strings.Contains(req.GetPath(), "?") {
+		sep = "&"
+	}
+	return req.GetPath() + sep + "limit=" + strconv.Itoa(int(req.GetLimit()))
+}
+
+func (p *Probe) runProbe(ctx context.Context, _ endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	failed := false
+	start := time.Now()
+	for _, req := range p.requests {
+		verb, path := strings.ToLower(req.GetVerb().String()), requestPath(req)
+		result.verbTotal.IncKey(verb)
+
+		reqStart := time.Now()
+		_, err := p.client.Do(ctx, http.MethodGet, path, nil)
+		latency := time.Since(reqStart)
+
+		if err != nil {
+			failed = true
+			var statusErr *kubeclient.StatusError
+			if errors.As(err, &statusErr) && statusErr.Code == http.StatusTooManyRequests {
+				p.l.Warningf("Request %s %s was throttled by the API server", req.GetVerb(), path)
+				result.throttled.IncKey(verb)
+				continue
+			}
+			p.l.Warningf("Request %s %s failed: %v", req.GetVerb(), path, err)
+			continue
+		}
+
+		result.verbSuccess.IncKey(verb)
+		result.verbLatency.IncKeyBy(verb, latency.Seconds()/p.opts.LatencyUnit.Seconds())
+	}
+
+	if failed {
+		return
+	}
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	configpb "github.com/cloudprober/cloudprober/probes/k8sapi/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeAPIServer serves a few Kubernetes API paths. It requires the bearer
+// token "test-token".
+func fakeAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			http.Error(w, `{"reason": "Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default":
+			fmt.Fprint(w, `{"kind": "Namespace", "metadata": {"name": "default"}}`)
+		case "/api/v1/namespaces":
+			if r.URL.Query().Get("limit") != "10" {
+				http.Error(w, `{"reason": "BadRequest"}`, http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"kind": "NamespaceList", "items": []}`)
+		case "/api/v1/pods":
+			http.Error(w, `{"reason": "TooManyRequests"}`, http.StatusTooManyRequests)
+		default:
+			http.Error(w, `{"reason": "NotFound"}`, http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// writeKubeconfig writes a kubeconfig for the given test server and returns
+// its path.
+func writeKubeconfig(t *testing.T, ts *httptest.Server) string {
+	t.Helper()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	kubeconfig := fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test-cluster
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: test
+  context:
+    cluster: test-cluster
+    user: test-user
+users:
+- name: test-user
+  user:
+    token: test-token
+`, ts.URL, base64.StdEncoding.EncodeToString(ca))
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(path, []byte(kubeconfig), 0600))
+	return path
+}
+
+func TestRunProbe(t *testing.T) {
+	ts := fakeAPIServer(t)
+	kubeconfig := writeKubeconfig(t, ts)
+
+	tests := []struct {
+		name          string
+		requests      []*configpb.Request
+		wantSuccess   int64
+		wantTotal     string
+		wantSuccesses string
+		wantThrottled string
+	}{
+		{
+			name:          "default_requests",
+			wantSuccess:   1,
+			wantTotal:     "map:verb,get:1,list:1",
+			wantSuccesses: "map:verb,get:1,list:1",
+			wantThrottled: "map:verb",
+		},
+		{
+			name: "throttled",
+			requests: []*configpb.Request{
+				{Verb: configpb.Request_GET.Enum(), Path: proto.String("/api/v1/namespaces/default")},
+				{Verb: configpb.Request_LIST.Enum(), Path: proto.String("/api/v1/pods")},
+			},
+			wantTotal:     "map:verb,get:1,list:1",
+			wantSuccesses: "map:verb,get:1",
+			wantThrottled: "map:verb,list:1",
+		},
+		{
+			name: "not_found",
+			requests: []*configpb.Request{
+				{Verb: configpb.Request_GET.Enum(), Path: proto.String("/api/v1/namespaces/missing")},
+			},
+			wantTotal:     "map:verb,get:1",
+			wantSuccesses: "map:verb",
+			wantThrottled: "map:verb",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{
+				Request:    test.requests,
+				Kubeconfig: proto.String(kubeconfig),
+			}
+
+			p := &Probe{}
+			require.NoError(t, p.Init("test-k8sapi", opts))
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+
+			em := result.Metrics(time.Now(), opts)
+			assert.Equal(t, test.wantTotal, em.Metric("verb_total").String(), "verb_total")
+			assert.Equal(t, test.wantSuccesses, em.Metric("verb_success").String(), "verb_success")
+			assert.Equal(t, test.wantThrottled, em.Metric("throttled").String(), "throttled")
+			for _, verb := range result.verbSuccess.Keys() {
+				assert.Greater(t, result.verbLatency.GetKey(verb), 0.0, "verb_latency for %s", verb)
+			}
+		})
+	}
+}
+
+func TestRequestPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"/api/v1/pods":                     "/api/v1/pods?limit=5",
+		"/api/v1/pods?labelSelector=app=x": "/api/v1/pods?labelSelector=app=x&limit=5",
+	} {
+		req := &configpb.Request{Verb: configpb.Request_LIST.Enum(), Path: proto.String(path), Limit: proto.Int32(5)}
+		assert.Equal(t, want, requestPath(req))
+	}
+
+	req := &configpb.Request{Path: proto.String("/api/v1/namespaces/default")}
+	assert.Equal(t, "/api/v1/namespaces/default", requestPath(req))
+}
+
+func TestInitErrors(t *testing.T) {
+	tests := map[string]*configpb.Request{
+		"relative_path": {Path: proto.String("api/v1/pods")},
+		"zero_limit":    {Verb: configpb.Request_LIST.Enum(), Path: proto.String("/api/v1/pods"), Limit: proto.Int32(0)},
+	}
+
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{Request: []*configpb.Request{req}}
+			assert.Error(t, (&Probe{client: &noopClient{}}).Init("test-k8sapi", opts))
+		})
+	}
+}
+
+type noopClient struct{}
+
+func (*noopClient) Do(context.Context, string, string, []byte) ([]byte, error) {
+	return nil, nil
+}
@@ -169,12 +169,13 @@ func BuildProbeOptions(p *configpb.ProbeDef, ldLister endpoint.Lister, globalTar
 	}
 
 	if p.GetTargets() == nil {
-		if p.GetType() != configpb.ProbeDef_USER_DEFINED && p.GetType() != configpb.ProbeDef_EXTERNAL && p.GetType() != configpb.ProbeDef_EXTENSION {
-			return nil, fmt.Errorf("targets requied for probe type: %s", p.GetType().String())
-		} else {
+		switch p.GetType() {
+		case configpb.ProbeDef_USER_DEFINED, configpb.ProbeDef_EXTERNAL, configpb.ProbeDef_EXTENSION, configpb.ProbeDef_POD_STARTUP, configpb.ProbeDef_FRESHNESS:
 			p.Targets = &targetspb.TargetsDef{
 				Type: &targetspb.TargetsDef_DummyTargets{},
 			}
+		default:
+			return nil, fmt.Errorf("targets requied for probe type: %s", p.GetType().String())
 		}
 	}
 
@@ -0,0 +1,129 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podstartup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudprober/cloudprober/probes/common/kubeclient"
+)
+
+// Following types are a minimal subset of the Kubernetes Pod API.
+
+type objectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type container struct {
+	Name    string   `json:"name"`
+	Image   string   `json:"image"`
+	Command []string `json:"command,omitempty"`
+}
+
+type podSpec struct {
+	Containers                    []container       `json:"containers"`
+	RestartPolicy                 string            `json:"restartPolicy"`
+	NodeSelector                  map[string]string `json:"nodeSelector,omitempty"`
+	TerminationGracePeriodSeconds int64             `json:"terminationGracePeriodSeconds"`
+}
+
+type podCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type containerStateWaiting struct {
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type containerStatus struct {
+	Name  string `json:"name"`
+	State struct {
+		Waiting *containerStateWaiting `json:"waiting,omitempty"`
+	} `json:"state"`
+}
+
+type podStatus struct {
+	Phase             string            `json:"phase,omitempty"`
+	Conditions        []podCondition    `json:"conditions,omitempty"`
+	ContainerStatuses []containerStatus `json:"containerStatuses,omitempty"`
+}
+
+type pod struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   objectMeta `json:"metadata"`
+	Spec       podSpec    `json:"spec"`
+	Status     podStatus  `json:"status,omitempty"`
+}
+
+func (p *pod) condition(condType string) *podCondition {
+	for i := range p.Status.Conditions {
+		if p.Status.Conditions[i].Type == condType {
+			return &p.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// podClient is the interface to manage pods. It's implemented by
+// apiPodClient for the real API server, and by a fake for tests.
+type podClient interface {
+	createPod(ctx context.Context, p *pod) error
+	getPod(ctx context.Context, namespace, name string) (*pod, error)
+	deletePod(ctx context.Context, namespace, name string) error
+}
+
+type apiPodClient struct {
+	c *kubeclient.Client
+}
+
+func podsPath(namespace string) string {
+	return fmt.Sprintf("/api/v1/namespaces/%s/pods", namespace)
+}
+
+func (ac *apiPodClient) createPod(ctx context.Context, p *pod) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	_, err = ac.c.Do(ctx, http.MethodPost, podsPath(p.Metadata.Namespace), b)
+	return err
+}
+
+func (ac *apiPodClient) getPod(ctx context.Context, namespace, name string) (*pod, error) {
+	b, err := ac.c.Do(ctx, http.MethodGet, podsPath(namespace)+"/"+name, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &pod{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("error parsing pod %s/%s: %v", namespace, name, err)
+	}
+	return p, nil
+}
+
+func (ac *apiPodClient) deletePod(ctx context.Context, namespace, name string) error {
+	_, err := ac.c.Do(ctx, http.MethodDelete, podsPath(namespace)+"/"+name+"?gracePeriodSeconds=0", nil)
+	return err
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package podstartup implements a probe type that measures how long it takes
+// for a canary pod to become Ready in a Kubernetes cluster.
+package podstartup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/kubeclient"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/podstartup/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+// Failure types. These are used as keys in the failures map.
+const (
+	failureCreate     = "create"
+	failureScheduling = "scheduling"
+	failureImagePull  = "image_pull"
+	failurePodFailed  = "pod_failed"
+	failureTimeout    = "timeout"
+)
+
+// cleanupTimeout is the timeout for deleting the canary pod. Cleanup uses
+// its own timeout, as probe's context has usually expired by then.
+const cleanupTimeout = 30 * time.Second
+
+// Container waiting reasons that indicate image pull problems.
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":      true,
+	"ImagePullBackOff":  true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// Probe holds aggregate information about all probe runs.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	client podClient
+	// Used to generate unique pod names.
+	nameSuffix func() string
+}
+
+type probeResult struct {
+	total, success  int64
+	cleanupFailures int64
+	latency         metrics.LatencyValue
+	failures        *metrics.Map[int64]
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		failures: metrics.NewMap("type"),
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("failures", result.failures.Clone()).
+		AddMetric("cleanup_failures", metrics.NewInt(result.cleanupFailures)).
+		AddLabel("ptype", "podstartup")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not podstartup probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	p.nameSuffix = func() string {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+
+	if p.client == nil {
+		kc, err := kubeclient.New(p.c.GetApiServerAddress(), p.c.GetTlsConfig(), p.l)
+		if err != nil {
+			return err
+		}
+		p.client = &apiPodClient{c: kc}
+	}
+
+	return nil
+}
+
+func (p *Probe) canaryPod() *pod {
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "cloudprober",
+		"cloudprober.org/probe":        p.name,
+	}
+	for k, v := range p.c.GetLabels() {
+		labels[k] = v
+	}
+
+	return &pod{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Metadata: objectMeta{
+			Name:      p.c.GetPodNamePrefix() + p.nameSuffix(),
+			Namespace: p.c.GetNamespace(),
+			Labels:    labels,
+		},
+		Spec: podSpec{
+			Containers: []container{
+				{
+					Name:    "canary",
+					Image:   p.c.GetImage(),
+					Command: p.c.GetCommand(),
+				},
+			},
+			RestartPolicy: "Never",
+			NodeSelector:  p.c.GetNodeSelector(),
+		},
+	}
+}
+
+// podBlocker returns a failure type if pod is blocked on something that
+// prevents it from becoming ready, e.g. scheduling or image pull.
+func podBlocker(cp *pod) (string, string) {
+	if cond := cp.condition("PodScheduled"); cond != nil && cond.Status == "False" {
+		return failureScheduling, cond.Reason + ": " + cond.Message
+	}
+	for _, cs := range cp.Status.ContainerStatuses {
+		if w := cs.State.Waiting; w != nil && imagePullReasons[w.Reason] {
+			return failureImagePull, w.Reason + ": " + w.Message
+		}
+	}
+	return "", ""
+}
+
+// waitForReady waits for the pod to become ready. It returns an empty string
+// on success, and failure type otherwise.
+func (p *Probe) waitForReady(ctx context.Context, namespace, name string) string {
+	ticker := time.NewTicker(time.Duration(p.c.GetPollIntervalMsec()) * time.Millisecond)
+	defer ticker.Stop()
+
+	// Last state that's blocking the pod, used to classify timeouts.
+	blocker, blockerMsg := "", ""
+
+	for {
+		cp, err := p.client.getPod(ctx, namespace, name)
+		if err != nil {
+			// API errors may be transient, keep trying until timeout.
+			p.l.Warningf("Error getting pod %s/%s: %v", namespace, name, err)
+		} else {
+			if cond := cp.condition("Ready"); cond != nil && cond.Status == "True" {
+				return ""
+			}
+			if cp.Status.Phase == "Failed" || cp.Status.Phase == "Succeeded" {
+				p.l.Warningf("Pod %s/%s terminated with phase %s before becoming ready", namespace, name, cp.Status.Phase)
+				return failurePodFailed
+			}
+			blocker, blockerMsg = podBlocker(cp)
+		}
+
+		select {
+		case <-ctx.Done():
+			if blocker != "" {
+				p.l.Warningf("Pod %s/%s didn't become ready: %s (%s)", namespace, name, blocker, blockerMsg)
+				return blocker
+			}
+			p.l.Warningf("Pod %s/%s didn't become ready before timeout", namespace, name)
+			return failureTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Probe) cleanup(namespace, name string, result *probeResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	if err := p.client.deletePod(ctx, namespace, name); err != nil {
+		p.l.Errorf("Error deleting canary pod %s/%s: %v", namespace, name, err)
+		result.cleanupFailures++
+	}
+}
+
+func (p *Probe) runProbe(ctx context.Context, _ endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	cp := p.canaryPod()
+	namespace, name := cp.Metadata.Namespace, cp.Metadata.Name
+
+	start := time.Now()
+	if err := p.client.createPod(ctx, cp); err != nil {
+		p.l.Warningf("Error creating canary pod %s/%s: %v", namespace, name, err)
+		result.failures.IncKey(failureCreate)
+		return
+	}
+	defer p.cleanup(namespace, name, result)
+
+	if failure := p.waitForReady(ctx, namespace, name); failure != "" {
+		result.failures.IncKey(failure)
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
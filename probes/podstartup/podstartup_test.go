@@ -0,0 +1,262 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podstartup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/podstartup/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakePodClient simulates pod lifecycle. Pod status for each getPod call is
+// decided by the statusFn.
+type fakePodClient struct {
+	mu        sync.Mutex
+	pods      map[string]*pod
+	polls     map[string]int
+	deleted   []string
+	createErr error
+	deleteErr error
+	statusFn  func(poll int) podStatus
+}
+
+func newFakePodClient(statusFn func(poll int) podStatus) *fakePodClient {
+	return &fakePodClient{
+		pods:     make(map[string]*pod),
+		polls:    make(map[string]int),
+		statusFn: statusFn,
+	}
+}
+
+func (fc *fakePodClient) createPod(_ context.Context, p *pod) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.createErr != nil {
+		return fc.createErr
+	}
+	fc.pods[p.Metadata.Namespace+"/"+p.Metadata.Name] = p
+	return nil
+}
+
+func (fc *fakePodClient) getPod(_ context.Context, namespace, name string) (*pod, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	key := namespace + "/" + name
+	p, ok := fc.pods[key]
+	if !ok {
+		return nil, fmt.Errorf("pod %s not found", key)
+	}
+	fc.polls[key]++
+	p.Status = fc.statusFn(fc.polls[key])
+	return p, nil
+}
+
+func (fc *fakePodClient) deletePod(_ context.Context, namespace, name string) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.deleteErr != nil {
+		return fc.deleteErr
+	}
+	key := namespace + "/" + name
+	delete(fc.pods, key)
+	fc.deleted = append(fc.deleted, key)
+	return nil
+}
+
+func readyAfter(n int) func(int) podStatus {
+	return func(poll int) podStatus {
+		if poll < n {
+			return podStatus{Phase: "Pending"}
+		}
+		return podStatus{
+			Phase:      "Running",
+			Conditions: []podCondition{{Type: "PodScheduled", Status: "True"}, {Type: "Ready", Status: "True"}},
+		}
+	}
+}
+
+func unschedulable(int) podStatus {
+	return podStatus{
+		Phase: "Pending",
+		Conditions: []podCondition{{
+			Type:    "PodScheduled",
+			Status:  "False",
+			Reason:  "Unschedulable",
+			Message: "0/3 nodes are available",
+		}},
+	}
+}
+
+func imagePullError(int) podStatus {
+	st := podStatus{
+		Phase:      "Pending",
+		Conditions: []podCondition{{Type: "PodScheduled", Status: "True"}},
+	}
+	cs := containerStatus{Name: "canary"}
+	cs.State.Waiting = &containerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}
+	st.ContainerStatuses = []containerStatus{cs}
+	return st
+}
+
+func testProbe(t *testing.T, fc *fakePodClient, c *configpb.ProbeConf) *Probe {
+	t.Helper()
+
+	opts := options.DefaultOptions()
+	opts.Timeout = 200 * time.Millisecond
+	opts.ProbeConf = c
+
+	p := &Probe{client: fc}
+	if err := p.Init("test-podstartup", opts); err != nil {
+		t.Fatalf("Error initializing probe: %v", err)
+	}
+	i := 0
+	p.nameSuffix = func() string {
+		i++
+		return fmt.Sprintf("%d", i)
+	}
+	return p
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name         string
+		statusFn     func(int) podStatus
+		createErr    error
+		deleteErr    error
+		wantSuccess  int64
+		wantFailures map[string]int64
+		wantDeleted  []string
+		wantCleanup  int64
+	}{
+		{
+			name:        "ready",
+			statusFn:    readyAfter(3),
+			wantSuccess: 1,
+			wantDeleted: []string{"canary-ns/canary-1"},
+		},
+		{
+			name:         "unschedulable",
+			statusFn:     unschedulable,
+			wantFailures: map[string]int64{"scheduling": 1},
+			wantDeleted:  []string{"canary-ns/canary-1"},
+		},
+		{
+			name:         "image_pull",
+			statusFn:     imagePullError,
+			wantFailures: map[string]int64{"image_pull": 1},
+			wantDeleted:  []string{"canary-ns/canary-1"},
+		},
+		{
+			name:         "timeout",
+			statusFn:     readyAfter(1000),
+			wantFailures: map[string]int64{"timeout": 1},
+			wantDeleted:  []string{"canary-ns/canary-1"},
+		},
+		{
+			name:         "pod_failed",
+			statusFn:     func(int) podStatus { return podStatus{Phase: "Failed"} },
+			wantFailures: map[string]int64{"pod_failed": 1},
+			wantDeleted:  []string{"canary-ns/canary-1"},
+		},
+		{
+			name:         "create_error",
+			statusFn:     readyAfter(1),
+			createErr:    errors.New("forbidden"),
+			wantFailures: map[string]int64{"create": 1},
+		},
+		{
+			name:        "cleanup_error",
+			statusFn:    readyAfter(1),
+			deleteErr:   errors.New("internal error"),
+			wantSuccess: 1,
+			wantCleanup: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fc := newFakePodClient(test.statusFn)
+			fc.createErr, fc.deleteErr = test.createErr, test.deleteErr
+
+			p := testProbe(t, fc, &configpb.ProbeConf{
+				Namespace:        proto.String("canary-ns"),
+				PodNamePrefix:    proto.String("canary-"),
+				PollIntervalMsec: proto.Int32(10),
+			})
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantCleanup, result.cleanupFailures, "cleanup failures")
+			for k, v := range test.wantFailures {
+				assert.Equal(t, v, result.failures.GetKey(k), "failures[%s]", k)
+			}
+			if test.wantFailures == nil {
+				assert.Empty(t, result.failures.Keys(), "failures")
+			}
+			assert.Equal(t, test.wantDeleted, fc.deleted, "deleted pods")
+		})
+	}
+}
+
+func TestCanaryPod(t *testing.T) {
+	fc := newFakePodClient(readyAfter(1))
+	p := testProbe(t, fc, &configpb.ProbeConf{
+		Image:        proto.String("busybox"),
+		Command:      []string{"sleep", "3600"},
+		Labels:       map[string]string{"team": "sre"},
+		NodeSelector: map[string]string{"pool": "canary"},
+	})
+
+	cp := p.canaryPod()
+	assert.Equal(t, "cloudprober-canary-1", cp.Metadata.Name)
+	assert.Equal(t, "default", cp.Metadata.Namespace)
+	assert.Equal(t, map[string]string{
+		"app.kubernetes.io/managed-by": "cloudprober",
+		"cloudprober.org/probe":        "test-podstartup",
+		"team":                         "sre",
+	}, cp.Metadata.Labels)
+	assert.Equal(t, []container{{Name: "canary", Image: "busybox", Command: []string{"sleep", "3600"}}}, cp.Spec.Containers)
+	assert.Equal(t, "Never", cp.Spec.RestartPolicy)
+	assert.Equal(t, map[string]string{"pool": "canary"}, cp.Spec.NodeSelector)
+
+	// Unique names across runs.
+	assert.NotEqual(t, cp.Metadata.Name, p.canaryPod().Metadata.Name)
+}
+
+func TestMetrics(t *testing.T) {
+	fc := newFakePodClient(readyAfter(1))
+	p := testProbe(t, fc, &configpb.ProbeConf{PollIntervalMsec: proto.Int32(10)})
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), endpoint.Endpoint{}, result)
+
+	em := result.Metrics(time.Now(), p.opts)
+	assert.Equal(t, "podstartup", em.Label("ptype"))
+	assert.Equal(t, int64(1), em.Metric("success").(metrics.NumValue).Int64())
+	assert.Equal(t, int64(0), em.Metric("cleanup_failures").(metrics.NumValue).Int64())
+}
@@ -20,16 +20,37 @@ package probes
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/cloudprober/cloudprober/internal/validators"
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/autoscale"
+	"github.com/cloudprober/cloudprober/probes/backup"
+	"github.com/cloudprober/cloudprober/probes/callback"
+	"github.com/cloudprober/cloudprober/probes/configvalue"
+	"github.com/cloudprober/cloudprober/probes/counterrate"
 	"github.com/cloudprober/cloudprober/probes/dns"
+	"github.com/cloudprober/cloudprober/probes/drain"
 	"github.com/cloudprober/cloudprober/probes/external"
+	"github.com/cloudprober/cloudprober/probes/freshness"
+	"github.com/cloudprober/cloudprober/probes/georouting"
 	grpcprobe "github.com/cloudprober/cloudprober/probes/grpc"
 	httpprobe "github.com/cloudprober/cloudprober/probes/http"
+	"github.com/cloudprober/cloudprober/probes/idempotency"
+	"github.com/cloudprober/cloudprober/probes/k8sapi"
 	"github.com/cloudprober/cloudprober/probes/options"
 	"github.com/cloudprober/cloudprober/probes/ping"
+	"github.com/cloudprober/cloudprober/probes/podstartup"
+	"github.com/cloudprober/cloudprober/probes/propagation"
 	configpb "github.com/cloudprober/cloudprober/probes/proto"
+	"github.com/cloudprober/cloudprober/probes/queuedepth"
+	"github.com/cloudprober/cloudprober/probes/replicationlag"
+	"github.com/cloudprober/cloudprober/probes/searchindex"
+	"github.com/cloudprober/cloudprober/probes/sidecar"
+	"github.com/cloudprober/cloudprober/probes/smtp"
 	"github.com/cloudprober/cloudprober/probes/tcp"
 	"github.com/cloudprober/cloudprober/probes/udp"
 	"github.com/cloudprober/cloudprober/probes/udplistener"
@@ -75,6 +96,51 @@ type ProbeInfo struct {
 	SourceIP      string
 }
 
+// schemaTypeMetrics lists the metrics that the probe types export besides
+// total, success, latency and validation_failure. Map metrics are listed
+// with their map key name, as the map key is exported as a label.
+var schemaTypeMetrics = map[configpb.ProbeDef_Type]map[string]string{
+	configpb.ProbeDef_HTTP: {"timeouts": "", "resp-code": "code"},
+	configpb.ProbeDef_DNS:  {"timeouts": ""},
+	configpb.ProbeDef_GRPC: {"connecterrors": ""},
+	configpb.ProbeDef_UDP:  {"delayed": ""},
+}
+
+// SchemaMetrics returns the EventMetrics that the probe is expected to
+// export, for the surfacers' schema manifests. Label values are left empty.
+// Metrics that depend on the probe responses, e.g. external probe's payload
+// metrics, are not included.
+func (pi *ProbeInfo) SchemaMetrics() []*metrics.EventMetrics {
+	em := metrics.NewEventMetrics(time.Time{}).
+		AddMetric("total", metrics.NewInt(0)).
+		AddMetric("success", metrics.NewInt(0)).
+		AddMetric(pi.Options.LatencyMetricName, metrics.NewFloat(0))
+
+	typeMetrics := schemaTypeMetrics[pi.ProbeDef.GetType()]
+	var names []string
+	for name := range typeMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		mapName := typeMetrics[name]
+		if mapName != "" {
+			em.AddMetric(name, metrics.NewMap(mapName))
+		} else {
+			em.AddMetric(name, metrics.NewInt(0))
+		}
+	}
+	if pi.Options.Validators != nil {
+		em.AddMetric("validation_failure", validators.ValidationFailureMap(pi.Options.Validators))
+	}
+
+	em.AddLabel("ptype", strings.ToLower(pi.Type)).AddLabel("probe", pi.Name).AddLabel("dst", "")
+	for _, al := range pi.Options.AdditionalLabels {
+		em.AddLabel(al.Key, "")
+	}
+	return []*metrics.EventMetrics{em}
+}
+
 func getExtensionProbe(p *configpb.ProbeDef) (Probe, interface{}, error) {
 	extensionMapMu.RLock()
 	defer extensionMapMu.RUnlock()
@@ -144,6 +210,57 @@ func initProbe(p *configpb.ProbeDef, opts *options.Options) (probe Probe, probeC
 	case configpb.ProbeDef_TCP:
 		probe = &tcp.Probe{}
 		probeConf = p.GetTcpProbe()
+	case configpb.ProbeDef_SMTP:
+		probe = &smtp.Probe{}
+		probeConf = p.GetSmtpProbe()
+	case configpb.ProbeDef_CONFIG_VALUE:
+		probe = &configvalue.Probe{}
+		probeConf = p.GetConfigValueProbe()
+	case configpb.ProbeDef_POD_STARTUP:
+		probe = &podstartup.Probe{}
+		probeConf = p.GetPodStartupProbe()
+	case configpb.ProbeDef_REPLICATION_LAG:
+		probe = &replicationlag.Probe{}
+		probeConf = p.GetReplicationLagProbe()
+	case configpb.ProbeDef_CALLBACK:
+		probe = &callback.Probe{}
+		probeConf = p.GetCallbackProbe()
+	case configpb.ProbeDef_FRESHNESS:
+		probe = &freshness.Probe{}
+		probeConf = p.GetFreshnessProbe()
+	case configpb.ProbeDef_QUEUE_DEPTH:
+		probe = &queuedepth.Probe{}
+		probeConf = p.GetQueueDepthProbe()
+	case configpb.ProbeDef_K8S_API:
+		probe = &k8sapi.Probe{}
+		probeConf = p.GetK8SApiProbe()
+	case configpb.ProbeDef_DRAIN:
+		probe = &drain.Probe{}
+		probeConf = p.GetDrainProbe()
+	case configpb.ProbeDef_IDEMPOTENCY:
+		probe = &idempotency.Probe{}
+		probeConf = p.GetIdempotencyProbe()
+	case configpb.ProbeDef_BACKUP:
+		probe = &backup.Probe{}
+		probeConf = p.GetBackupProbe()
+	case configpb.ProbeDef_SEARCH_INDEX:
+		probe = &searchindex.Probe{}
+		probeConf = p.GetSearchIndexProbe()
+	case configpb.ProbeDef_AUTOSCALE:
+		probe = &autoscale.Probe{}
+		probeConf = p.GetAutoscaleProbe()
+	case configpb.ProbeDef_PROPAGATION:
+		probe = &propagation.Probe{}
+		probeConf = p.GetPropagationProbe()
+	case configpb.ProbeDef_COUNTER_RATE:
+		probe = &counterrate.Probe{}
+		probeConf = p.GetCounterRateProbe()
+	case configpb.ProbeDef_GEO_ROUTING:
+		probe = &georouting.Probe{}
+		probeConf = p.GetGeoRoutingProbe()
+	case configpb.ProbeDef_SIDECAR:
+		probe = &sidecar.Probe{}
+		probeConf = p.GetSidecarProbe()
 	case configpb.ProbeDef_UDP:
 		probe = &udp.Probe{}
 		probeConf = p.GetUdpProbe()
@@ -19,10 +19,14 @@
 package probes
 
 import (
+	"testing"
+
+	"github.com/cloudprober/cloudprober/internal/validators"
 	"github.com/cloudprober/cloudprober/probes/options"
 	configpb "github.com/cloudprober/cloudprober/probes/proto"
 	targetspb "github.com/cloudprober/cloudprober/targets/proto"
-	"testing"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 // This test is to make sure that we don't panic on empty config.
@@ -52,3 +56,30 @@ func TestProbesAllowEmptyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestProbeInfoSchemaMetrics(t *testing.T) {
+	probeDef := &configpb.ProbeDef{
+		Name: proto.String("web"),
+		Type: configpb.ProbeDef_HTTP.Enum(),
+	}
+	pi := &ProbeInfo{
+		ProbeDef: probeDef,
+		Options: &options.Options{
+			LatencyMetricName: "latency",
+			Validators:        []*validators.Validator{{Name: "status"}},
+			AdditionalLabels:  []*options.AdditionalLabel{{Key: "env"}},
+		},
+		Name: "web",
+		Type: probeDef.GetType().String(),
+	}
+
+	ems := pi.SchemaMetrics()
+	if len(ems) != 1 {
+		t.Fatalf("SchemaMetrics() returned %d EventMetrics, want 1", len(ems))
+	}
+	em := ems[0]
+	assert.Equal(t, []string{"total", "success", "latency", "resp-code", "timeouts", "validation_failure"}, em.MetricsKeys())
+	assert.Equal(t, []string{"ptype", "probe", "dst", "env"}, em.LabelsKeys())
+	assert.Equal(t, "http", em.Label("ptype"))
+	assert.Equal(t, "web", em.Label("probe"))
+}
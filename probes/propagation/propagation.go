@@ -0,0 +1,236 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package propagation implements a probe type that verifies that the writes
+// to an eventually consistent system propagate within the expected time.
+package propagation
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/propagation/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+const (
+	tokenPlaceholder  = "{token}"
+	targetPlaceholder = "@target@"
+
+	// tokenPrefix is prepended to the tokens, to make the probe writes easy
+	// to spot.
+	tokenPrefix = "cloudprober-"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	client       *http.Client
+	pollInterval time.Duration
+}
+
+type probeResult struct {
+	total, success      int64
+	latency             metrics.LatencyValue
+	writeErrors         int64
+	propagationTimeouts int64
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("write_errors", metrics.NewInt(result.writeErrors)).
+		AddMetric("propagation_timeouts", metrics.NewInt(result.propagationTimeouts)).
+		AddLabel("ptype", "propagation")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not propagation probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	for _, u := range []string{p.c.GetWriteUrl(), p.c.GetReadUrl()} {
+		if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+			return fmt.Errorf("URL should be an http:// or https:// URL, got: %s", u)
+		}
+	}
+	if !strings.Contains(p.c.GetWriteUrl()+p.c.GetWriteBody(), tokenPlaceholder) {
+		return fmt.Errorf("%s should be in write_url or write_body", tokenPlaceholder)
+	}
+
+	if p.c.GetPollIntervalMsec() <= 0 {
+		return fmt.Errorf("poll_interval_msec should be positive, got %d", p.c.GetPollIntervalMsec())
+	}
+	p.pollInterval = time.Duration(p.c.GetPollIntervalMsec()) * time.Millisecond
+
+	t := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{},
+	}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(t.TLSClientConfig, p.c.GetTlsConfig()); err != nil {
+			return fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+	if p.opts.SourceIP != nil {
+		t.DialContext = (&net.Dialer{LocalAddr: &net.TCPAddr{IP: p.opts.SourceIP}}).DialContext
+	}
+	p.client = &http.Client{Transport: t}
+
+	return nil
+}
+
+// expandURL returns the URL with the placeholders replaced. Token is
+// URL-escaped.
+func expandURL(rawURL string, target endpoint.Endpoint, token string) string {
+	u := strings.ReplaceAll(rawURL, targetPlaceholder, target.Name)
+	return strings.ReplaceAll(u, tokenPlaceholder, url.PathEscape(token))
+}
+
+// newToken returns a new random token.
+func newToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return tokenPrefix + hex.EncodeToString(b)
+}
+
+// do sends the request and returns the response body. Non-2xx responses are
+// returned as errors.
+func (p *Probe) do(ctx context.Context, method, url, body string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", p.c.GetContentType())
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// waitForPropagation reads from the read URL until the response contains the
+// token, or the context is canceled.
+func (p *Probe) waitForPropagation(ctx context.Context, target endpoint.Endpoint, token string) bool {
+	readURL := expandURL(p.c.GetReadUrl(), target, token)
+
+	for {
+		resp, err := p.do(ctx, http.MethodGet, readURL, "")
+		if err == nil && bytes.Contains(resp, []byte(token)) {
+			return true
+		}
+		// Read errors are expected until the write propagates, e.g. 404s
+		// for the new keys.
+		if err != nil && ctx.Err() == nil {
+			p.l.Debugf("Target: %s, read request for token %s failed: %v", target.Name, token, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	token := newToken()
+	body := strings.ReplaceAll(p.c.GetWriteBody(), tokenPlaceholder, token)
+
+	if _, err := p.do(ctx, p.c.GetWriteMethod(), expandURL(p.c.GetWriteUrl(), target, token), body); err != nil {
+		p.l.Warningf("Target: %s, write request failed: %v", target.Name, err)
+		result.writeErrors++
+		return
+	}
+
+	start := time.Now()
+	if !p.waitForPropagation(ctx, target, token) {
+		p.l.Warningf("Target: %s, write with token %s didn't propagate in %s", target.Name, token, p.opts.Timeout)
+		result.propagationTimeouts++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
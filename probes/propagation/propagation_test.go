@@ -0,0 +1,187 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/propagation/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// kvStub is a key-value store with a primary (write) and a replica (read)
+// endpoint. Writes show up at the replica after the configured delay.
+type kvStub struct {
+	delay     time.Duration
+	failWrite bool
+
+	mu     sync.Mutex
+	values map[string]string
+	at     map[string]time.Time
+}
+
+func newKVStub(delay time.Duration) *kvStub {
+	return &kvStub{
+		delay:  delay,
+		values: make(map[string]string),
+		at:     make(map[string]time.Time),
+	}
+}
+
+func (s *kvStub) primary(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Method != http.MethodPut || s.failWrite {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	b, _ := io.ReadAll(r.Body)
+	s.values[r.URL.Path] = string(b)
+	s.at[r.URL.Path] = time.Now().Add(s.delay)
+}
+
+func (s *kvStub) replica(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.values[r.URL.Path]
+	if !ok || time.Now().Before(s.at[r.URL.Path]) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write([]byte(v))
+}
+
+func testProbe(t *testing.T, s *kvStub, timeout time.Duration) *Probe {
+	t.Helper()
+
+	primary := httptest.NewServer(http.HandlerFunc(s.primary))
+	t.Cleanup(primary.Close)
+	replica := httptest.NewServer(http.HandlerFunc(s.replica))
+	t.Cleanup(replica.Close)
+
+	opts := options.DefaultOptions()
+	opts.Timeout = timeout
+	opts.ProbeConf = &configpb.ProbeConf{
+		WriteUrl:         proto.String(primary.URL + "/keys/@target@"),
+		ReadUrl:          proto.String(replica.URL + "/keys/@target@"),
+		PollIntervalMsec: proto.Int32(10),
+	}
+
+	p := &Probe{}
+	require.NoError(t, p.Init("test-propagation", opts))
+	return p
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name         string
+		delay        time.Duration
+		failWrite    bool
+		wantSuccess  int64
+		wantErrors   int64
+		wantTimeouts int64
+	}{
+		{
+			name:        "immediate",
+			wantSuccess: 1,
+		},
+		{
+			name:        "delayed",
+			delay:       100 * time.Millisecond,
+			wantSuccess: 1,
+		},
+		{
+			name:         "timeout",
+			delay:        time.Hour,
+			wantTimeouts: 1,
+		},
+		{
+			name:       "write_error",
+			failWrite:  true,
+			wantErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newKVStub(test.delay)
+			s.failWrite = test.failWrite
+			p := testProbe(t, s, 500*time.Millisecond)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: "k1"}, result)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantErrors, result.writeErrors, "write_errors")
+			assert.Equal(t, test.wantTimeouts, result.propagationTimeouts, "propagation_timeouts")
+
+			if test.wantSuccess == 1 {
+				latency := time.Duration(result.latency.(*metrics.Float).Float64()) * p.opts.LatencyUnit
+				assert.GreaterOrEqual(t, latency, test.delay, "propagation latency")
+				assert.Less(t, latency, test.delay+200*time.Millisecond, "propagation latency")
+			}
+		})
+	}
+}
+
+func TestRunProbeStaleValue(t *testing.T) {
+	s := newKVStub(100 * time.Millisecond)
+	p := testProbe(t, s, 500*time.Millisecond)
+	target := endpoint.Endpoint{Name: "k1"}
+
+	// Replica already has a value for the key from a previous run; probe
+	// should wait for the new token.
+	s.values["/keys/k1"] = newToken()
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), target, result)
+
+	assert.Equal(t, int64(1), result.success, "success")
+	latency := time.Duration(result.latency.(*metrics.Float).Float64()) * p.opts.LatencyUnit
+	assert.GreaterOrEqual(t, latency, 100*time.Millisecond, "propagation latency")
+}
+
+func TestExpandURL(t *testing.T) {
+	got := expandURL("http://kv.@target@/keys/{token}", endpoint.Endpoint{Name: "us-east"}, "a b")
+	assert.Equal(t, "http://kv.us-east/keys/a%20b", got)
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"bad_write_url": {WriteUrl: proto.String("kv/keys/{token}"), ReadUrl: proto.String("http://kv/keys")},
+		"bad_read_url":  {WriteUrl: proto.String("http://kv/keys"), ReadUrl: proto.String("/keys")},
+		"no_token":      {WriteUrl: proto.String("http://kv/keys"), WriteBody: proto.String("v"), ReadUrl: proto.String("http://kv/keys")},
+		"bad_poll":      {WriteUrl: proto.String("http://kv/keys"), ReadUrl: proto.String("http://kv/keys"), PollIntervalMsec: proto.Int32(0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("test-propagation", opts))
+		})
+	}
+}
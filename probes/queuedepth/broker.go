@@ -0,0 +1,205 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queuedepth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	configpb "github.com/cloudprober/cloudprober/probes/queuedepth/proto"
+)
+
+// broker returns the depth of a queue.
+type broker interface {
+	depth(ctx context.Context, queue string) (int64, error)
+}
+
+// getJSON fetches the given URL and decodes the JSON response into v.
+func getJSON(ctx context.Context, client *http.Client, req *http.Request, v any) error {
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %s from %s: %s", resp.Status, req.URL.Redacted(), strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response from %s: %v", req.URL.Redacted(), err)
+	}
+	return nil
+}
+
+type rabbitMQBroker struct {
+	c        *configpb.RabbitMQ
+	password string
+	client   *http.Client
+}
+
+func newRabbitMQBroker(c *configpb.RabbitMQ) (*rabbitMQBroker, error) {
+	if c.GetApiUrl() == "" {
+		return nil, errors.New("rabbitmq: api_url is required")
+	}
+	b := &rabbitMQBroker{c: c, password: c.GetPassword(), client: &http.Client{}}
+	if c.GetPasswordEnvVar() != "" {
+		b.password = os.Getenv(c.GetPasswordEnvVar())
+	}
+	return b, nil
+}
+
+func (b *rabbitMQBroker) depth(ctx context.Context, queue string) (int64, error) {
+	u := strings.TrimSuffix(b.c.GetApiUrl(), "/") + "/api/queues/" + url.PathEscape(b.c.GetVhost()) + "/" + url.PathEscape(queue)
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.SetBasicAuth(b.c.GetUsername(), b.password)
+
+	var resp struct {
+		Messages *int64 `json:"messages"`
+	}
+	if err := getJSON(ctx, b.client, req, &resp); err != nil {
+		return 0, err
+	}
+	// RabbitMQ leaves out the message stats until the queue is initialized.
+	if resp.Messages == nil {
+		return 0, fmt.Errorf("no messages count for queue %s", queue)
+	}
+	return *resp.Messages, nil
+}
+
+type burrowBroker struct {
+	c      *configpb.KafkaBurrow
+	client *http.Client
+}
+
+func newBurrowBroker(c *configpb.KafkaBurrow) (*burrowBroker, error) {
+	if c.GetApiUrl() == "" || c.GetCluster() == "" {
+		return nil, errors.New("kafka_burrow: api_url and cluster are required")
+	}
+	return &burrowBroker{c: c, client: &http.Client{}}, nil
+}
+
+func (b *burrowBroker) depth(ctx context.Context, group string) (int64, error) {
+	u := strings.TrimSuffix(b.c.GetApiUrl(), "/") + "/v3/kafka/" + url.PathEscape(b.c.GetCluster()) + "/consumer/" + url.PathEscape(group) + "/lag"
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var resp struct {
+		Error   bool   `json:"error"`
+		Message string `json:"message"`
+		Status  struct {
+			TotalLag int64 `json:"totallag"`
+		} `json:"status"`
+	}
+	if err := getJSON(ctx, b.client, req, &resp); err != nil {
+		return 0, err
+	}
+	if resp.Error {
+		return 0, fmt.Errorf("burrow error for consumer group %s: %s", group, resp.Message)
+	}
+	return resp.Status.TotalLag, nil
+}
+
+// sqsAPI is the subset of the SQS client used by the probe. It makes it
+// possible to mock the client in tests.
+type sqsAPI interface {
+	GetQueueUrl(context.Context, *sqs.GetQueueUrlInput, ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
+	GetQueueAttributes(context.Context, *sqs.GetQueueAttributesInput, ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+}
+
+type sqsBroker struct {
+	client sqsAPI
+
+	// Queue URLs by queue name.
+	mu        sync.Mutex
+	queueURLs map[string]string
+}
+
+func newSQSBroker(ctx context.Context, c *configpb.SQS) (*sqsBroker, error) {
+	var optFns []func(*config.LoadOptions) error
+	if c.GetRegion() != "" {
+		optFns = append(optFns, config.WithRegion(c.GetRegion()))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("sqs: error loading AWS config: %v", err)
+	}
+
+	client := sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		if c.GetEndpoint() != "" {
+			o.BaseEndpoint = aws.String(c.GetEndpoint())
+		}
+	})
+	return &sqsBroker{client: client, queueURLs: make(map[string]string)}, nil
+}
+
+func (b *sqsBroker) queueURL(ctx context.Context, queue string) (string, error) {
+	if strings.HasPrefix(queue, "https://") || strings.HasPrefix(queue, "http://") {
+		return queue, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if u, ok := b.queueURLs[queue]; ok {
+		return u, nil
+	}
+	out, err := b.client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(queue)})
+	if err != nil {
+		return "", err
+	}
+	b.queueURLs[queue] = aws.ToString(out.QueueUrl)
+	return b.queueURLs[queue], nil
+}
+
+func (b *sqsBroker) depth(ctx context.Context, queue string) (int64, error) {
+	u, err := b.queueURL(ctx, queue)
+	if err != nil {
+		return 0, err
+	}
+
+	attr := types.QueueAttributeNameApproximateNumberOfMessages
+	out, err := b.client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(u),
+		AttributeNames: []types.QueueAttributeName{attr},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	v, ok := out.Attributes[string(attr)]
+	if !ok {
+		return 0, fmt.Errorf("%s attribute missing for queue %s", attr, queue)
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package queuedepth implements a probe type that verifies that the depth of
+// message queues is below a threshold.
+package queuedepth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/queuedepth/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	broker broker
+}
+
+type probeResult struct {
+	total, success int64
+	aboveThreshold int64
+	brokerErrors   int64
+	latency        metrics.LatencyValue
+
+	// Last queue depth, valid only if depthKnown is true.
+	depth      int64
+	depthKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("above_threshold", metrics.NewInt(result.aboveThreshold)).
+		AddMetric("broker_errors", metrics.NewInt(result.brokerErrors)).
+		AddLabel("ptype", "queuedepth")
+}
+
+// GaugeMetrics returns the last queue depth.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.depthKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("queue_depth", metrics.NewInt(result.depth)).
+		AddLabel("ptype", "queuedepth")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not queuedepth probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetMaxDepth() < 0 {
+		return fmt.Errorf("max_depth should not be negative, got %d", p.c.GetMaxDepth())
+	}
+
+	var err error
+	switch p.c.GetBroker().(type) {
+	case *configpb.ProbeConf_Rabbitmq:
+		p.broker, err = newRabbitMQBroker(p.c.GetRabbitmq())
+	case *configpb.ProbeConf_Sqs:
+		p.broker, err = newSQSBroker(context.Background(), p.c.GetSqs())
+	case *configpb.ProbeConf_KafkaBurrow:
+		p.broker, err = newBurrowBroker(p.c.GetKafkaBurrow())
+	default:
+		err = errors.New("broker is not configured")
+	}
+	return err
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.depthKnown = false
+
+	start := time.Now()
+	depth, err := p.broker.depth(ctx, target.Name)
+	latency := time.Since(start)
+	if err != nil {
+		p.l.Warningf("Target: %s, error getting queue depth from the broker: %v", target.Name, err)
+		result.brokerErrors++
+		return
+	}
+
+	result.depth, result.depthKnown = depth, true
+
+	if depth > p.c.GetMaxDepth() {
+		p.l.Warningf("Target: %s, queue depth %d is above the threshold %d", target.Name, depth, p.c.GetMaxDepth())
+		result.aboveThreshold++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
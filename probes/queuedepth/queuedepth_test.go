@@ -0,0 +1,219 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queuedepth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/queuedepth/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// testDepths are the queue depths served by the stub brokers. Queues not
+// listed here don't exist.
+var testDepths = map[string]int64{
+	"orders":   5,
+	"payments": 500,
+}
+
+// stubBrokerAPI serves RabbitMQ management and Burrow APIs.
+func stubBrokerAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	// ServeMux is not used as it redirects the paths with the escaped default
+	// vhost ("/").
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if queue, ok := strings.CutPrefix(r.URL.EscapedPath(), "/api/queues/%2F/"); ok {
+			if user, pass, _ := r.BasicAuth(); user != "guest" || pass != "secret" {
+				http.Error(w, `{"error":"not_authorised"}`, http.StatusUnauthorized)
+				return
+			}
+			depth, ok := testDepths[queue]
+			if !ok {
+				http.Error(w, `{"error":"Object Not Found"}`, http.StatusNotFound)
+				return
+			}
+			fmt.Fprintf(w, `{"name": %q, "messages": %d, "messages_ready": %d}`, queue, depth, depth)
+			return
+		}
+
+		if group, ok := strings.CutPrefix(r.URL.Path, "/v3/kafka/prod/consumer/"); ok {
+			depth, ok := testDepths[strings.TrimSuffix(group, "/lag")]
+			if !ok {
+				fmt.Fprintf(w, `{"error": true, "message": "consumer group not found"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"error": false, "status": {"status": "OK", "totallag": %d}}`, depth)
+			return
+		}
+
+		http.NotFound(w, r)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(handler))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+type mockSQS struct{}
+
+func (mockSQS) GetQueueUrl(_ context.Context, in *sqs.GetQueueUrlInput, _ ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	if _, ok := testDepths[aws.ToString(in.QueueName)]; !ok {
+		return nil, errors.New("AWS.SimpleQueueService.NonExistentQueue")
+	}
+	return &sqs.GetQueueUrlOutput{QueueUrl: aws.String("https://sqs.local/123/" + aws.ToString(in.QueueName))}, nil
+}
+
+func (mockSQS) GetQueueAttributes(_ context.Context, in *sqs.GetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	queue := aws.ToString(in.QueueUrl)[len("https://sqs.local/123/"):]
+	return &sqs.GetQueueAttributesOutput{
+		Attributes: map[string]string{"ApproximateNumberOfMessages": strconv.FormatInt(testDepths[queue], 10)},
+	}, nil
+}
+
+func TestRunProbe(t *testing.T) {
+	ts := stubBrokerAPI(t)
+
+	tests := map[string]*configpb.ProbeConf{
+		"rabbitmq": {
+			Broker: &configpb.ProbeConf_Rabbitmq{
+				Rabbitmq: &configpb.RabbitMQ{
+					ApiUrl:   proto.String(ts.URL),
+					Password: proto.String("secret"),
+				},
+			},
+		},
+		"kafka_burrow": {
+			Broker: &configpb.ProbeConf_KafkaBurrow{
+				KafkaBurrow: &configpb.KafkaBurrow{
+					ApiUrl:  proto.String(ts.URL),
+					Cluster: proto.String("prod"),
+				},
+			},
+		},
+		"sqs": {
+			Broker: &configpb.ProbeConf_Sqs{
+				Sqs: &configpb.SQS{Region: proto.String("us-east-1")},
+			},
+		},
+	}
+
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			c.MaxDepth = proto.Int64(100)
+			opts := options.DefaultOptions()
+			opts.ProbeConf = c
+
+			p := &Probe{}
+			require.NoError(t, p.Init("test-queuedepth", opts))
+			if sb, ok := p.broker.(*sqsBroker); ok {
+				sb.client = mockSQS{}
+			}
+
+			results := make(map[string]*probeResult)
+			for _, queue := range []string{"orders", "payments", "missing"} {
+				result := p.newResult().(*probeResult)
+				p.runProbe(context.Background(), endpoint.Endpoint{Name: queue}, result)
+				assert.Equal(t, int64(1), result.total, "total")
+				results[queue] = result
+			}
+
+			assert.Equal(t, int64(1), results["orders"].success, "orders: success")
+			assert.Equal(t, int64(5), results["orders"].depth, "orders: depth")
+
+			assert.Equal(t, int64(0), results["payments"].success, "payments: success")
+			assert.Equal(t, int64(1), results["payments"].aboveThreshold, "payments: above_threshold")
+			em := results["payments"].GaugeMetrics(time.Now(), p.opts)
+			require.NotNil(t, em, "payments: gauge metrics")
+			assert.Equal(t, int64(500), em.Metric("queue_depth").(metrics.NumValue).Int64())
+
+			assert.Equal(t, int64(1), results["missing"].brokerErrors, "missing: broker_errors")
+			assert.Equal(t, int64(0), results["missing"].aboveThreshold, "missing: above_threshold")
+			assert.Nil(t, results["missing"].GaugeMetrics(time.Now(), p.opts), "missing: gauge metrics")
+		})
+	}
+}
+
+func TestSQSQueueURLs(t *testing.T) {
+	b := &sqsBroker{client: mockSQS{}, queueURLs: make(map[string]string)}
+
+	depth, err := b.depth(context.Background(), "https://sqs.local/123/payments")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), depth)
+	assert.Empty(t, b.queueURLs, "queue URL targets are not looked up")
+
+	_, err = b.depth(context.Background(), "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"orders": "https://sqs.local/123/orders"}, b.queueURLs)
+
+	_, err = b.depth(context.Background(), "missing")
+	assert.Error(t, err)
+}
+
+func TestRabbitMQAuthError(t *testing.T) {
+	ts := stubBrokerAPI(t)
+
+	b, err := newRabbitMQBroker(&configpb.RabbitMQ{
+		ApiUrl:         proto.String(ts.URL),
+		PasswordEnvVar: proto.String("TEST_QUEUEDEPTH_RABBITMQ_PASSWORD"),
+	})
+	require.NoError(t, err)
+
+	_, err = b.depth(context.Background(), "orders")
+	assert.ErrorContains(t, err, "401")
+
+	t.Setenv("TEST_QUEUEDEPTH_RABBITMQ_PASSWORD", "secret")
+	b, _ = newRabbitMQBroker(b.c)
+	depth, err := b.depth(context.Background(), "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), depth)
+}
+
+func TestInitErrors(t *testing.T) {
+	tests := map[string]*configpb.ProbeConf{
+		"no_broker": {MaxDepth: proto.Int64(10)},
+		"negative_max_depth": {
+			MaxDepth: proto.Int64(-1),
+			Broker:   &configpb.ProbeConf_Rabbitmq{Rabbitmq: &configpb.RabbitMQ{ApiUrl: proto.String("http://localhost")}},
+		},
+		"burrow_no_cluster": {
+			MaxDepth: proto.Int64(10),
+			Broker:   &configpb.ProbeConf_KafkaBurrow{KafkaBurrow: &configpb.KafkaBurrow{ApiUrl: proto.String("http://localhost")}},
+		},
+	}
+
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = c
+			assert.Error(t, (&Probe{}).Init("test-queuedepth", opts))
+		})
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replicationlag implements a probe type that monitors replication
+// lag of database replicas.
+package replicationlag
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/replicationlag/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib" // Registers the "pgx" driver.
+)
+
+const (
+	defaultPostgresPort = 5432
+	defaultMySQLPort    = 3306
+)
+
+// postgresLagQuery returns the replication lag in seconds. If replica has
+// replayed all the WAL it has received, lag is 0, even if last transaction
+// replay was a while ago (e.g. because there were no writes on the primary).
+// Query returns NULL if database is not a replica.
+const postgresLagQuery = "SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 " +
+	"ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()) END"
+
+// MySQL renamed SHOW SLAVE STATUS to SHOW REPLICA STATUS in 8.0.22, and
+// removed the old statement in 8.4. We try the new statement first.
+var (
+	mysqlStatusQueries = []string{"SHOW REPLICA STATUS", "SHOW SLAVE STATUS"}
+	mysqlLagColumns    = []string{"Seconds_Behind_Source", "Seconds_Behind_Master"}
+)
+
+var errLagNotAvailable = errors.New("replication lag not available, database is not a replica or replication is not running")
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	driver string
+	// Opens a database handle, replaced in tests.
+	openDB func(driverName, dsn string) (*sql.DB, error)
+}
+
+type probeResult struct {
+	total, success int64
+	lagExceeded    int64
+	latency        metrics.LatencyValue
+
+	// Last replication lag, valid only if lagKnown is true.
+	lagSec   float64
+	lagKnown bool
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("lag_exceeded", metrics.NewInt(result.lagExceeded)).
+		AddLabel("ptype", "replicationlag")
+}
+
+// GaugeMetrics returns the last replication lag.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if !result.lagKnown {
+		return nil
+	}
+	return metrics.NewEventMetrics(ts).
+		AddMetric("replication_lag_sec", metrics.NewFloat(result.lagSec)).
+		AddLabel("ptype", "replicationlag")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not replication lag probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if p.c.GetMaxLagMsec() < 0 {
+		return fmt.Errorf("max_lag_msec should not be negative, got %d", p.c.GetMaxLagMsec())
+	}
+
+	switch p.c.GetDatabase() {
+	case configpb.ProbeConf_POSTGRES:
+		p.driver = "pgx"
+	case configpb.ProbeConf_MYSQL:
+		p.driver = "mysql"
+	default:
+		return fmt.Errorf("unsupported database: %s", p.c.GetDatabase())
+	}
+
+	if p.openDB == nil {
+		p.openDB = sql.Open
+	}
+
+	return nil
+}
+
+func (p *Probe) defaultPort() int {
+	if p.c.GetDatabase() == configpb.ProbeConf_MYSQL {
+		return defaultMySQLPort
+	}
+	return defaultPostgresPort
+}
+
+// dsn returns the data source name for connecting to the given address.
+func (p *Probe) dsn(addr string) string {
+	if p.c.GetDatabase() == configpb.ProbeConf_MYSQL {
+		cfg := mysql.NewConfig()
+		cfg.User = p.c.GetUser()
+		cfg.Passwd = p.c.GetPassword()
+		cfg.Net = "tcp"
+		cfg.Addr = addr
+		cfg.DBName = p.c.GetDbname()
+		cfg.Timeout = p.opts.Timeout
+		if len(p.c.GetConnectionParams()) > 0 {
+			cfg.Params = make(map[string]string)
+			for k, v := range p.c.GetConnectionParams() {
+				cfg.Params[k] = v
+			}
+		}
+		return cfg.FormatDSN()
+	}
+
+	q := url.Values{}
+	for k, v := range p.c.GetConnectionParams() {
+		q.Set(k, v)
+	}
+	if q.Get("connect_timeout") == "" {
+		q.Set("connect_timeout", strconv.Itoa(int(math.Ceil(p.opts.Timeout.Seconds()))))
+	}
+
+	u := &url.URL{
+		Scheme:   "postgres",
+		Host:     addr,
+		Path:     "/" + p.c.GetDbname(),
+		RawQuery: q.Encode(),
+	}
+	if p.c.GetUser() != "" {
+		if p.c.GetPassword() != "" {
+			u.User = url.UserPassword(p.c.GetUser(), p.c.GetPassword())
+		} else {
+			u.User = url.User(p.c.GetUser())
+		}
+	}
+	return u.String()
+}
+
+func scanLag(row *sql.Row) (float64, error) {
+	var lag sql.NullFloat64
+	if err := row.Scan(&lag); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errLagNotAvailable
+		}
+		return 0, err
+	}
+	if !lag.Valid {
+		return 0, errLagNotAvailable
+	}
+	return lag.Float64, nil
+}
+
+// mysqlLag returns MySQL replication lag. If replica has multiple
+// replication channels, it returns the maximum lag across all channels.
+func mysqlLag(ctx context.Context, db *sql.DB) (float64, error) {
+	var rows *sql.Rows
+	var err error
+	for _, q := range mysqlStatusQueries {
+		if rows, err = db.QueryContext(ctx, q); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	lagCol := -1
+	for i, col := range cols {
+		for _, lc := range mysqlLagColumns {
+			if col == lc {
+				lagCol = i
+			}
+		}
+	}
+	if lagCol == -1 {
+		return 0, fmt.Errorf("replication lag column not found in replica status, columns: %v", cols)
+	}
+
+	dest := make([]any, len(cols))
+	for i := range dest {
+		dest[i] = new(sql.RawBytes)
+	}
+	var lag sql.NullFloat64
+	dest[lagCol] = &lag
+
+	maxLag, found := 0.0, false
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		// Lag is NULL if replication is not running.
+		if !lag.Valid {
+			return 0, errLagNotAvailable
+		}
+		if !found || lag.Float64 > maxLag {
+			maxLag, found = lag.Float64, true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, errLagNotAvailable
+	}
+	return maxLag, nil
+}
+
+func (p *Probe) queryLag(ctx context.Context, db *sql.DB) (float64, error) {
+	if q := p.c.GetQuery(); q != "" {
+		return scanLag(db.QueryRowContext(ctx, q))
+	}
+	if p.c.GetDatabase() == configpb.ProbeConf_MYSQL {
+		return mysqlLag(ctx, db)
+	}
+	return scanLag(db.QueryRowContext(ctx, postgresLagQuery))
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.lagKnown = false
+
+	host, ipLabel := target.Name, ""
+	if target.IP != nil {
+		host = target.IP.String()
+		ipLabel = host
+	}
+
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	if port == 0 {
+		port = p.defaultPort()
+	}
+
+	for _, al := range p.opts.AdditionalLabels {
+		al.UpdateForTarget(target, ipLabel, port)
+	}
+
+	db, err := p.openDB(p.driver, p.dsn(net.JoinHostPort(host, strconv.Itoa(port))))
+	if err != nil {
+		p.l.Warningf("Target: %s, error opening database: %v", target.Name, err)
+		return
+	}
+	defer db.Close()
+
+	start := time.Now()
+	lag, err := p.queryLag(ctx, db)
+	latency := time.Since(start)
+	if err != nil {
+		p.l.Warningf("Target: %s, error getting replication lag: %v", target.Name, err)
+		return
+	}
+	result.lagSec, result.lagKnown = lag, true
+
+	if maxLag := p.c.GetMaxLagMsec(); maxLag > 0 && lag*1000 > float64(maxLag) {
+		p.l.Warningf("Target: %s, replication lag %.3fs exceeds the max allowed lag %dms", target.Name, lag, maxLag)
+		result.lagExceeded++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
@@ -0,0 +1,307 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package searchindex implements a probe type that verifies that the newly
+// indexed documents become searchable within the expected time.
+package searchindex
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/searchindex/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/itchyny/gojq"
+)
+
+const (
+	tokenPlaceholder = "{token}"
+
+	// tokenPrefix is prepended to the tokens, to make the probe documents
+	// easy to spot in the index.
+	tokenPrefix = "cloudprober-"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	scheme       string
+	client       *http.Client
+	jqQuery      *gojq.Query
+	pollInterval time.Duration
+}
+
+type probeResult struct {
+	total, success     int64
+	latency            metrics.LatencyValue
+	indexErrors        int64
+	visibilityTimeouts int64
+	cleanupErrors      int64
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("index_errors", metrics.NewInt(result.indexErrors)).
+		AddMetric("visibility_timeouts", metrics.NewInt(result.visibilityTimeouts)).
+		AddMetric("cleanup_errors", metrics.NewInt(result.cleanupErrors)).
+		AddLabel("ptype", "search_index")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not search_index probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	for _, path := range []string{p.c.GetIndexPath(), p.c.GetSearchPath(), p.c.GetDeletePath()} {
+		if path != "" && !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("request path should start with a /, got: %s", path)
+		}
+	}
+
+	if p.c.GetPollIntervalMsec() <= 0 {
+		return fmt.Errorf("poll_interval_msec should be positive, got %d", p.c.GetPollIntervalMsec())
+	}
+	p.pollInterval = time.Duration(p.c.GetPollIntervalMsec()) * time.Millisecond
+
+	if filter := p.c.GetVisibleJqFilter(); filter != "" {
+		q, err := gojq.Parse(filter)
+		if err != nil {
+			return fmt.Errorf("error parsing visible_jq_filter (%s): %v", filter, err)
+		}
+		p.jqQuery = q
+	}
+
+	p.scheme = strings.ToLower(p.c.GetScheme().String())
+
+	t := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{},
+	}
+	if p.c.GetTlsConfig() != nil {
+		if err := tlsconfig.UpdateTLSConfig(t.TLSClientConfig, p.c.GetTlsConfig()); err != nil {
+			return fmt.Errorf("tls_config error: %v", err)
+		}
+	}
+	if p.opts.SourceIP != nil {
+		t.DialContext = (&net.Dialer{LocalAddr: &net.TCPAddr{IP: p.opts.SourceIP}}).DialContext
+	}
+	p.client = &http.Client{Transport: t}
+
+	return nil
+}
+
+func (p *Probe) url(target endpoint.Endpoint, path, token string) string {
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	host := target.Name
+	if port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(port))
+	}
+	return p.scheme + "://" + host + strings.ReplaceAll(path, tokenPlaceholder, url.PathEscape(token))
+}
+
+// newToken returns a new random token.
+func newToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return tokenPrefix + hex.EncodeToString(b)
+}
+
+// do sends the request and returns the response body. Non-2xx responses are
+// returned as errors.
+func (p *Probe) do(ctx context.Context, method, url, body string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", p.c.GetContentType())
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// visible tells if the document with the token is in the search response.
+func (p *Probe) visible(resp []byte, token string) (bool, error) {
+	if p.jqQuery == nil {
+		return bytes.Contains(resp, []byte(token)), nil
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(resp, &input); err != nil {
+		return false, fmt.Errorf("search response is not a valid JSON: %v", err)
+	}
+	item, _ := p.jqQuery.Run(input).Next()
+	switch v := item.(type) {
+	case error:
+		return false, v
+	case bool:
+		return v, nil
+	case float64:
+		return v > 0, nil
+	case int:
+		return v > 0, nil
+	case nil:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected visible_jq_filter result type %T: %v", v, v)
+	}
+}
+
+// waitForVisibility searches for the document until it shows up in the
+// search results, or the context is canceled.
+func (p *Probe) waitForVisibility(ctx context.Context, target endpoint.Endpoint, token string) bool {
+	searchURL := p.url(target, p.c.GetSearchPath(), token)
+	searchBody := strings.ReplaceAll(p.c.GetSearchBody(), tokenPlaceholder, token)
+
+	for {
+		resp, err := p.do(ctx, p.c.GetSearchMethod(), searchURL, searchBody)
+		if err == nil {
+			var found bool
+			if found, err = p.visible(resp, token); found {
+				return true
+			}
+		}
+		if err != nil && ctx.Err() == nil {
+			p.l.Warningf("Target: %s, search request for token %s failed: %v", target.Name, token, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(p.pollInterval):
+		}
+	}
+}
+
+// cleanup deletes the document with the given token.
+func (p *Probe) cleanup(ctx context.Context, target endpoint.Endpoint, token string) error {
+	_, err := p.do(ctx, http.MethodDelete, p.url(target, p.c.GetDeletePath(), token), "")
+	return err
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	timeoutCtx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	token := newToken()
+	body := strings.ReplaceAll(p.c.GetIndexBody(), tokenPlaceholder, token)
+
+	if _, err := p.do(timeoutCtx, p.c.GetIndexMethod(), p.url(target, p.c.GetIndexPath(), token), body); err != nil {
+		p.l.Warningf("Target: %s, index request failed: %v", target.Name, err)
+		result.indexErrors++
+		return
+	}
+
+	// Run the cleanup even if the document didn't show up within the
+	// timeout, as it may have been indexed anyway.
+	defer func() {
+		if p.c.GetDeletePath() == "" {
+			return
+		}
+		cleanupCtx, cancel := context.WithTimeout(ctx, p.opts.Timeout)
+		defer cancel()
+		if err := p.cleanup(cleanupCtx, target, token); err != nil {
+			p.l.Warningf("Target: %s, error deleting document %s: %v", target.Name, token, err)
+			result.cleanupErrors++
+		}
+	}()
+
+	start := time.Now()
+	if !p.waitForVisibility(timeoutCtx, target, token) {
+		p.l.Warningf("Target: %s, document %s didn't become searchable in %s", target.Name, token, p.opts.Timeout)
+		result.visibilityTimeouts++
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(time.Since(start).Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
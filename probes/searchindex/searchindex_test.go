@@ -0,0 +1,233 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchindex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/searchindex/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// searchStub is a search engine that makes the documents searchable after
+// the configured delay.
+type searchStub struct {
+	delay     time.Duration
+	failIndex bool
+
+	mu      sync.Mutex
+	docs    map[string]time.Time
+	deleted []string
+}
+
+func (s *searchStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/docs/"):
+		if s.failIndex {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var doc struct{ Title string }
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.docs[doc.Title] = time.Now().Add(s.delay)
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/search":
+		q := r.URL.Query().Get("q")
+		var hits []string
+		if visibleAt, ok := s.docs[q]; ok && time.Now().After(visibleAt) {
+			hits = append(hits, q)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": len(hits), "hits": hits})
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/docs/"):
+		token := strings.TrimPrefix(r.URL.Path, "/docs/")
+		delete(s.docs, token)
+		s.deleted = append(s.deleted, token)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestRunProbe(t *testing.T) {
+	tests := []struct {
+		name           string
+		delay          time.Duration
+		failIndex      bool
+		jqFilter       string
+		wantSuccess    int64
+		wantTimeouts   int64
+		wantErrors     int64
+		wantMinLatency time.Duration
+		wantDeleted    bool
+	}{
+		{
+			name:        "visible_right_away",
+			wantSuccess: 1,
+			wantDeleted: true,
+		},
+		{
+			name:           "visible_after_delay",
+			delay:          300 * time.Millisecond,
+			jqFilter:       ".total",
+			wantSuccess:    1,
+			wantMinLatency: 300 * time.Millisecond,
+			wantDeleted:    true,
+		},
+		{
+			name:         "not_visible_before_timeout",
+			delay:        5 * time.Second,
+			jqFilter:     ".total > 0",
+			wantTimeouts: 1,
+			wantDeleted:  true,
+		},
+		{
+			name:       "index_error",
+			failIndex:  true,
+			wantErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stub := &searchStub{
+				delay:     test.delay,
+				failIndex: test.failIndex,
+				docs:      make(map[string]time.Time),
+			}
+			ts := httptest.NewServer(stub)
+			defer ts.Close()
+			port := ts.Listener.Addr().(*net.TCPAddr).Port
+
+			opts := options.DefaultOptions()
+			opts.Timeout = time.Second
+			opts.ProbeConf = &configpb.ProbeConf{
+				IndexPath:        proto.String("/docs/{token}"),
+				IndexMethod:      proto.String(http.MethodPut),
+				IndexBody:        proto.String(`{"title": "{token}"}`),
+				SearchPath:       proto.String("/search?q={token}"),
+				VisibleJqFilter:  proto.String(test.jqFilter),
+				PollIntervalMsec: proto.Int32(50),
+				DeletePath:       proto.String("/docs/{token}"),
+			}
+			if test.jqFilter == "" {
+				opts.ProbeConf.(*configpb.ProbeConf).VisibleJqFilter = nil
+			}
+
+			p := &Probe{}
+			require.NoError(t, p.Init("search_index_test", opts))
+
+			res := p.newResult()
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: "127.0.0.1", Port: port}, res)
+			result := res.(*probeResult)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			assert.Equal(t, test.wantTimeouts, result.visibilityTimeouts, "visibility_timeouts")
+			assert.Equal(t, test.wantErrors, result.indexErrors, "index_errors")
+			assert.Equal(t, int64(0), result.cleanupErrors, "cleanup_errors")
+			assert.Empty(t, stub.docs, "documents left in the index")
+
+			if test.wantDeleted {
+				require.Len(t, stub.deleted, 1)
+				assert.True(t, strings.HasPrefix(stub.deleted[0], tokenPrefix), stub.deleted[0])
+			} else {
+				assert.Empty(t, stub.deleted)
+			}
+
+			if test.wantSuccess == 1 {
+				latency := time.Duration(result.latency.(*metrics.Float).Float64()) * opts.LatencyUnit
+				assert.GreaterOrEqual(t, latency, test.wantMinLatency, "visibility latency")
+				assert.Less(t, latency, test.wantMinLatency+200*time.Millisecond, "visibility latency")
+			}
+		})
+	}
+}
+
+func TestVisible(t *testing.T) {
+	token := "cloudprober-abc"
+	for _, test := range []struct {
+		filter  string
+		resp    string
+		want    bool
+		wantErr bool
+	}{
+		{resp: fmt.Sprintf(`{"hits": [%q]}`, token), want: true},
+		{resp: `{"hits": []}`},
+		{filter: ".hits | length", resp: `{"hits": ["x"]}`, want: true},
+		{filter: ".hits | length", resp: `{"hits": []}`},
+		{filter: ".found", resp: `{"found": true}`, want: true},
+		{filter: ".found", resp: `{}`},
+		{filter: ".hits", resp: `{"hits": ["x"]}`, wantErr: true},
+		{filter: ".found", resp: `not json`, wantErr: true},
+	} {
+		t.Run(test.filter+":"+test.resp, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = &configpb.ProbeConf{
+				IndexPath:  proto.String("/docs"),
+				IndexBody:  proto.String("{}"),
+				SearchPath: proto.String("/search"),
+			}
+			if test.filter != "" {
+				opts.ProbeConf.(*configpb.ProbeConf).VisibleJqFilter = proto.String(test.filter)
+			}
+			p := &Probe{}
+			require.NoError(t, p.Init("search_index_test", opts))
+
+			got, err := p.visible([]byte(test.resp), token)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"bad_path":          {IndexPath: proto.String("docs"), SearchPath: proto.String("/search")},
+		"bad_jq_filter":     {IndexPath: proto.String("/docs"), SearchPath: proto.String("/search"), VisibleJqFilter: proto.String(".[")},
+		"bad_poll_interval": {IndexPath: proto.String("/docs"), SearchPath: proto.String("/search"), PollIntervalMsec: proto.Int32(0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("search_index_test", opts))
+		})
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sidecar implements a probe type that verifies that a service mesh
+// sidecar proxy is healthy, using its admin endpoint.
+package sidecar
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/sidecar/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+var invalidMetricChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// stat is the parsed ProbeConf.Stat.
+type stat struct {
+	name       string
+	re         *regexp.Regexp
+	metricName string
+	rate       bool
+}
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	stats  []*stat
+	client *http.Client
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+type statValue struct {
+	metricName string
+	value      float64
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	notReady       int64
+	statsErrors    int64
+
+	// State from the last run, exported as gauges.
+	runs  int64
+	ready bool
+	// Stats exported in the last run, in the config order.
+	statValues []statValue
+
+	// Raw values of the rate stats from the previous run, and the time they
+	// were read at, used to compute the rates.
+	prevValues map[string]float64
+	prevTime   time.Time
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		prevValues: make(map[string]float64),
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("not_ready", metrics.NewInt(result.notReady)).
+		AddMetric("stats_errors", metrics.NewInt(result.statsErrors)).
+		AddLabel("ptype", "sidecar")
+}
+
+// GaugeMetrics returns the readiness and the selected stats from the last
+// run.
+func (result *probeResult) GaugeMetrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	if result.runs == 0 {
+		return nil
+	}
+	ready := int64(0)
+	if result.ready {
+		ready = 1
+	}
+	em := metrics.NewEventMetrics(ts).
+		AddMetric("ready", metrics.NewInt(ready)).
+		AddLabel("ptype", "sidecar")
+	for _, sv := range result.statValues {
+		em.AddMetric(sv.metricName, metrics.NewFloat(sv.value))
+	}
+	return em
+}
+
+func parseStat(c *configpb.ProbeConf_Stat) (*stat, error) {
+	if (c.GetName() == "") == (c.GetRegex() == "") {
+		return nil, fmt.Errorf("exactly one of name and regex should be set for a stat: %v", c)
+	}
+
+	s := &stat{
+		name:       c.GetName(),
+		metricName: c.GetMetricName(),
+		rate:       c.GetRate(),
+	}
+	if c.GetRegex() != "" {
+		re, err := regexp.Compile("^(?:" + c.GetRegex() + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid stat regex (%s): %v", c.GetRegex(), err)
+		}
+		s.re = re
+		if s.metricName == "" {
+			return nil, fmt.Errorf("metric_name is required for the stat regex: %s", c.GetRegex())
+		}
+	}
+	if s.metricName == "" {
+		s.metricName = invalidMetricChars.ReplaceAllString(s.name, "_")
+	}
+	return s, nil
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not sidecar probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	for _, path := range []string{p.c.GetReadyPath(), p.c.GetStatsPath()} {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("ready_path and stats_path should start with /, got: %s", path)
+		}
+	}
+
+	metricNames := make(map[string]bool)
+	for _, c := range p.c.GetStat() {
+		s, err := parseStat(c)
+		if err != nil {
+			return err
+		}
+		if metricNames[s.metricName] {
+			return fmt.Errorf("duplicate stat metric_name: %s", s.metricName)
+		}
+		metricNames[s.metricName] = true
+		p.stats = append(p.stats, s)
+	}
+
+	p.client = &http.Client{}
+	if p.now == nil {
+		p.now = time.Now
+	}
+
+	return nil
+}
+
+func (p *Probe) baseURL(target endpoint.Endpoint) string {
+	port := int(p.c.GetPort())
+	if target.Port != 0 {
+		port = target.Port
+	}
+	return "http://" + net.JoinHostPort(target.Name, strconv.Itoa(port))
+}
+
+func (p *Probe) get(ctx context.Context, url string) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, b, err
+}
+
+// parseStats parses the stats in the Envoy's plain text format. Lines with
+// non-numeric values, e.g. histograms, are skipped.
+func parseStats(b []byte) map[string]float64 {
+	stats := make(map[string]float64)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			continue
+		}
+		stats[strings.TrimSpace(name)] = v
+	}
+	return stats
+}
+
+// value returns the raw value of the stat.
+func (s *stat) value(stats map[string]float64) (float64, error) {
+	if s.re == nil {
+		v, ok := stats[s.name]
+		if !ok {
+			return 0, fmt.Errorf("stat %s not found", s.name)
+		}
+		return v, nil
+	}
+
+	var sum float64
+	for name, v := range stats {
+		if s.re.MatchString(name) {
+			sum += v
+		}
+	}
+	return sum, nil
+}
+
+// readStats reads the selected stats and updates the result with their
+// values.
+func (p *Probe) readStats(ctx context.Context, target endpoint.Endpoint, result *probeResult) error {
+	code, b, err := p.get(ctx, p.baseURL(target)+p.c.GetStatsPath())
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK {
+		return fmt.Errorf("stats endpoint returned status code: %d", code)
+	}
+	ts, stats := p.now(), parseStats(b)
+
+	var values []statValue
+	rawValues := make(map[string]float64)
+	for _, s := range p.stats {
+		v, err := s.value(stats)
+		if err != nil {
+			return err
+		}
+		if !s.rate {
+			values = append(values, statValue{s.metricName, v})
+			continue
+		}
+
+		rawValues[s.metricName] = v
+		prev, ok := result.prevValues[s.metricName]
+		if !ok || !ts.After(result.prevTime) {
+			continue
+		}
+		increase := v - prev
+		if v < prev {
+			// Counter has restarted from 0.
+			increase = v
+		}
+		values = append(values, statValue{s.metricName, increase / ts.Sub(result.prevTime).Seconds()})
+	}
+
+	result.statValues = values
+	result.prevValues, result.prevTime = rawValues, ts
+	return nil
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+	result.runs++
+
+	start := time.Now()
+	code, _, err := p.get(ctx, p.baseURL(target)+p.c.GetReadyPath())
+	latency := time.Since(start)
+	result.ready = err == nil && code == http.StatusOK
+	if !result.ready {
+		if err != nil {
+			p.l.Warningf("Target: %s, readiness check failed: %v", target.Name, err)
+		} else {
+			p.l.Warningf("Target: %s, sidecar not ready, status code: %d", target.Name, code)
+		}
+		result.notReady++
+	}
+
+	// Stats are read even if the sidecar is not ready, as they may help
+	// explain why.
+	statsOK := true
+	if len(p.stats) > 0 {
+		if err := p.readStats(ctx, target, result); err != nil {
+			p.l.Warningf("Target: %s, error reading stats: %v", target.Name, err)
+			result.statsErrors++
+			result.statValues = nil
+			statsOK = false
+		}
+	}
+
+	if !result.ready || !statsOK {
+		return
+	}
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:         p.name,
+		DataChan:          dataChan,
+		Opts:              p.opts,
+		NewResult:         p.newResult,
+		RunProbeForTarget: p.runProbe,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
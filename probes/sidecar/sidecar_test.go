@@ -0,0 +1,212 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/sidecar/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeAdmin is a stub Envoy admin endpoint.
+type fakeAdmin struct {
+	mu      sync.Mutex
+	ready   bool
+	rq5xx   [2]int
+	cxTotal int
+}
+
+func (fa *fakeAdmin) set(ready bool, cxTotal, rq5xx1, rq5xx2 int) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	fa.ready, fa.cxTotal, fa.rq5xx = ready, cxTotal, [2]int{rq5xx1, rq5xx2}
+}
+
+func (fa *fakeAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/ready":
+		if !fa.ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("PRE_INITIALIZING\n"))
+			return
+		}
+		w.Write([]byte("LIVE\n"))
+	case "/stats":
+		fmt.Fprintf(w, "cluster.backend_a.upstream_rq_5xx: %d\n", fa.rq5xx[0])
+		fmt.Fprintf(w, "cluster.backend_b.upstream_rq_5xx: %d\n", fa.rq5xx[1])
+		fmt.Fprintf(w, "cluster.backend_a.upstream_rq_2xx: 1000\n")
+		fmt.Fprintf(w, "server.total_connections: %d\n", fa.cxTotal)
+		fmt.Fprintf(w, "cluster.backend_a.upstream_rq_time: P0(nan,1) P25(nan,2.05)\n")
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testProbe(t *testing.T, c *configpb.ProbeConf) (*Probe, *fakeAdmin, endpoint.Endpoint) {
+	t.Helper()
+
+	fa := &fakeAdmin{}
+	ts := httptest.NewServer(fa)
+	t.Cleanup(ts.Close)
+	u, _ := url.Parse(ts.URL)
+	port, _ := strconv.Atoi(u.Port())
+
+	opts := options.DefaultOptions()
+	opts.ProbeConf = c
+
+	// Clock moves 10s for every call.
+	now := time.Now()
+	p := &Probe{now: func() time.Time {
+		now = now.Add(10 * time.Second)
+		return now
+	}}
+	require.NoError(t, p.Init("test-sidecar", opts))
+	return p, fa, endpoint.Endpoint{Name: u.Hostname(), Port: port}
+}
+
+func testConf() *configpb.ProbeConf {
+	return &configpb.ProbeConf{
+		Stat: []*configpb.ProbeConf_Stat{
+			{Name: proto.String("server.total_connections")},
+			{
+				Regex:      proto.String(`cluster\..*\.upstream_rq_5xx`),
+				MetricName: proto.String("upstream_5xx_rate"),
+				Rate:       proto.Bool(true),
+			},
+		},
+	}
+}
+
+func gauges(em *metrics.EventMetrics) map[string]float64 {
+	if em == nil {
+		return nil
+	}
+	out := make(map[string]float64)
+	for _, k := range em.MetricsKeys() {
+		switch v := em.Metric(k).(type) {
+		case *metrics.Int:
+			out[k] = v.Float64()
+		case *metrics.Float:
+			out[k] = v.Float64()
+		}
+	}
+	return out
+}
+
+func TestRunProbe(t *testing.T) {
+	p, fa, target := testProbe(t, testConf())
+	result := p.newResult().(*probeResult)
+	assert.Nil(t, result.GaugeMetrics(time.Now(), p.opts), "no gauges before the first run")
+
+	// Each run: readiness, total_connections, 5xx counters for the two
+	// clusters, and the expected gauges. There is no rate in the first run.
+	steps := []struct {
+		ready          bool
+		cxTotal        int
+		rq5xx1, rq5xx2 int
+		wantGauges     map[string]float64
+	}{
+		{true, 10, 0, 5, map[string]float64{"ready": 1, "server_total_connections": 10}},
+		{true, 12, 10, 15, map[string]float64{"ready": 1, "server_total_connections": 12, "upstream_5xx_rate": 2}},
+		{false, 3, 10, 15, map[string]float64{"ready": 0, "server_total_connections": 3, "upstream_5xx_rate": 0}},
+		// Counter reset: new value is the increase.
+		{true, 4, 10, 0, map[string]float64{"ready": 1, "server_total_connections": 4, "upstream_5xx_rate": 1}},
+	}
+
+	for i, step := range steps {
+		fa.set(step.ready, step.cxTotal, step.rq5xx1, step.rq5xx2)
+		p.runProbe(context.Background(), target, result)
+		assert.Equal(t, step.wantGauges, gauges(result.GaugeMetrics(time.Now(), p.opts)), "step %d", i)
+	}
+
+	assert.Equal(t, int64(4), result.total, "total")
+	assert.Equal(t, int64(3), result.success, "success")
+	assert.Equal(t, int64(1), result.notReady, "not_ready")
+	assert.Equal(t, int64(0), result.statsErrors, "stats_errors")
+}
+
+func TestRunProbeStatsErrors(t *testing.T) {
+	for name, c := range map[string]*configpb.ProbeConf{
+		"missing_stat":   {Stat: []*configpb.ProbeConf_Stat{{Name: proto.String("server.uptime")}}},
+		"bad_stats_path": {StatsPath: proto.String("/no-stats"), Stat: testConf().Stat},
+	} {
+		t.Run(name, func(t *testing.T) {
+			p, fa, target := testProbe(t, c)
+			fa.set(true, 10, 0, 0)
+
+			result := p.newResult().(*probeResult)
+			p.runProbe(context.Background(), target, result)
+
+			assert.Equal(t, int64(0), result.success, "success")
+			assert.Equal(t, int64(0), result.notReady, "not_ready")
+			assert.Equal(t, int64(1), result.statsErrors, "stats_errors")
+			assert.Equal(t, map[string]float64{"ready": 1}, gauges(result.GaugeMetrics(time.Now(), p.opts)))
+		})
+	}
+}
+
+func TestRunProbeReadyOnly(t *testing.T) {
+	p, fa, target := testProbe(t, &configpb.ProbeConf{})
+	fa.set(true, 0, 0, 0)
+
+	result := p.newResult().(*probeResult)
+	p.runProbe(context.Background(), target, result)
+	assert.Equal(t, int64(1), result.success, "success")
+
+	// Unreachable admin endpoint.
+	target.Port = 1
+	p.runProbe(context.Background(), target, result)
+	assert.Equal(t, int64(1), result.notReady, "not_ready")
+	assert.Equal(t, map[string]float64{"ready": 0}, gauges(result.GaugeMetrics(time.Now(), p.opts)))
+}
+
+func TestParseStats(t *testing.T) {
+	got := parseStats([]byte("a.b: 1\nc_d: 2.5\nhist: P0(nan,1) P25(nan,2)\nno-value\n  e : 3\n"))
+	assert.Equal(t, map[string]float64{"a.b": 1, "c_d": 2.5, "e": 3}, got)
+}
+
+func TestInitErrors(t *testing.T) {
+	for name, conf := range map[string]*configpb.ProbeConf{
+		"ready_path_no_slash":   {ReadyPath: proto.String("ready")},
+		"no_name_or_regex":      {Stat: []*configpb.ProbeConf_Stat{{MetricName: proto.String("x")}}},
+		"name_and_regex":        {Stat: []*configpb.ProbeConf_Stat{{Name: proto.String("a"), Regex: proto.String("a")}}},
+		"regex_no_metric_name":  {Stat: []*configpb.ProbeConf_Stat{{Regex: proto.String("a.*")}}},
+		"bad_regex":             {Stat: []*configpb.ProbeConf_Stat{{Regex: proto.String("("), MetricName: proto.String("x")}}},
+		"duplicate_metric_name": {Stat: []*configpb.ProbeConf_Stat{{Name: proto.String("a.b")}, {Name: proto.String("a_b")}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := options.DefaultOptions()
+			opts.ProbeConf = conf
+			assert.Error(t, (&Probe{}).Init("test-sidecar", opts))
+		})
+	}
+}
@@ -0,0 +1,390 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package smtp implements an SMTP probe type. It connects to the target
+// SMTP server, performs EHLO, STARTTLS and AUTH, and optionally sends a test
+// message, recording latency for each of these phases.
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/probes/common/sched"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/smtp/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+)
+
+// Probe phases. These are used as keys in the phase_latency map.
+const (
+	phaseConnect = "connect"
+	phaseEHLO    = "ehlo"
+	phaseTLS     = "tls"
+	phaseAuth    = "auth"
+	phaseSend    = "send"
+)
+
+// Failure types. These are used as keys in the failures map.
+const (
+	failureConnect    = "connect"
+	failureTimeout    = "timeout"
+	failureTLS        = "tls"
+	failureAuth       = "auth"
+	failureGreylisted = "greylisted"
+	failureRejected   = "rejected"
+	failureOther      = "other"
+)
+
+// Probe holds aggregate information about all probe runs, per-target.
+type Probe struct {
+	name string
+	opts *options.Options
+	c    *configpb.ProbeConf
+	l    *logger.Logger
+
+	tlsConfig   *tls.Config
+	network     string
+	dialContext func(context.Context, string, string) (net.Conn, error)
+}
+
+type probeResult struct {
+	total, success int64
+	latency        metrics.LatencyValue
+	phaseLatency   *metrics.Map[float64]
+	failures       *metrics.Map[int64]
+}
+
+func (p *Probe) newResult() sched.ProbeResult {
+	result := &probeResult{
+		phaseLatency: metrics.NewMapFloat("phase"),
+		failures:     metrics.NewMap("type"),
+	}
+
+	if p.opts.LatencyDist != nil {
+		result.latency = p.opts.LatencyDist.CloneDist()
+	} else {
+		result.latency = metrics.NewFloat(0)
+	}
+
+	return result
+}
+
+func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).
+		AddMetric("total", metrics.NewInt(result.total)).
+		AddMetric("success", metrics.NewInt(result.success)).
+		AddMetric(opts.LatencyMetricName, result.latency.Clone()).
+		AddMetric("phase_latency", result.phaseLatency.Clone()).
+		AddMetric("failures", result.failures.Clone()).
+		AddLabel("ptype", "smtp")
+}
+
+// Init initializes the probe with the given params.
+func (p *Probe) Init(name string, opts *options.Options) error {
+	if opts.ProbeConf == nil {
+		opts.ProbeConf = &configpb.ProbeConf{}
+	}
+
+	c, ok := opts.ProbeConf.(*configpb.ProbeConf)
+	if !ok {
+		return fmt.Errorf("not smtp probe config")
+	}
+	p.name = name
+	p.opts = opts
+	if p.l = opts.Logger; p.l == nil {
+		p.l = &logger.Logger{}
+	}
+	p.c = c
+
+	if len(p.c.GetRcptTo()) > 0 && p.c.GetMailFrom() == "" {
+		return fmt.Errorf("mail_from is required to send a test message")
+	}
+
+	if p.c.GetTlsMode() != configpb.ProbeConf_NONE {
+		p.tlsConfig = &tls.Config{}
+		if p.c.GetTlsConfig() != nil {
+			if err := tlsconfig.UpdateTLSConfig(p.tlsConfig, p.c.GetTlsConfig()); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.network = "tcp"
+	if p.opts.IPVersion != 0 {
+		p.network += strconv.Itoa(p.opts.IPVersion)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: p.opts.Timeout,
+	}
+	if p.opts.SourceIP != nil {
+		dialer.LocalAddr = &net.TCPAddr{
+			IP: p.opts.SourceIP,
+		}
+	}
+	p.dialContext = dialer.DialContext
+
+	return nil
+}
+
+func (p *Probe) auth(host string) smtp.Auth {
+	a := p.c.GetAuth()
+	switch a.GetMechanism() {
+	case configpb.Auth_LOGIN:
+		return &loginAuth{username: a.GetUsername(), password: a.GetPassword(), host: host}
+	case configpb.Auth_CRAM_MD5:
+		return smtp.CRAMMD5Auth(a.GetUsername(), a.GetPassword())
+	default:
+		return smtp.PlainAuth("", a.GetUsername(), a.GetPassword(), host)
+	}
+}
+
+// classifyError returns the failure type for the given error. Transient
+// (4xx) SMTP replies to MAIL, RCPT and DATA commands are usually a result of
+// greylisting, so we classify them separately from permanent rejections.
+func classifyError(phase string, err error) string {
+	var netErr net.Error
+	if errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return failureTimeout
+	}
+
+	var tpErr *textproto.Error
+	isTransient := errors.As(err, &tpErr) && tpErr.Code >= 400 && tpErr.Code < 500
+
+	switch phase {
+	case phaseConnect:
+		return failureConnect
+	case phaseTLS:
+		return failureTLS
+	case phaseAuth:
+		return failureAuth
+	case phaseSend:
+		if isTransient {
+			return failureGreylisted
+		}
+		if tpErr != nil {
+			return failureRejected
+		}
+	}
+	return failureOther
+}
+
+func (p *Probe) message(target string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", p.c.GetMailFrom())
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(p.c.GetRcptTo(), ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", p.c.GetSubject())
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "Message-ID: <%d.%s@%s>\r\n", time.Now().UnixNano(), p.name, p.c.GetEhloHostname())
+	fmt.Fprintf(&b, "X-Cloudprober-Target: %s\r\n", target)
+	b.WriteString("\r\n")
+	b.WriteString(p.c.GetBody())
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
+
+// doSMTP runs the SMTP session and records per-phase latencies. If there is
+// an error, it returns the phase that failed along with the error.
+func (p *Probe) doSMTP(ctx context.Context, host, addr string, phaseLatency map[string]time.Duration) (string, error) {
+	start := time.Now()
+
+	conn, err := p.dialContext(ctx, p.network, addr)
+	if err != nil {
+		return phaseConnect, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if p.c.GetTlsMode() == configpb.ProbeConf_IMPLICIT {
+		tlsConn := tls.Client(conn, p.tlsConfigForHost(host))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return phaseTLS, err
+		}
+		conn = tlsConn
+	}
+
+	// NewClient reads the server greeting.
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return phaseConnect, err
+	}
+	defer client.Close()
+	phaseLatency[phaseConnect] = time.Since(start)
+
+	phaseStart := time.Now()
+	if err := client.Hello(p.c.GetEhloHostname()); err != nil {
+		return phaseEHLO, err
+	}
+	// Hello only records the hostname; EHLO is sent before the first command.
+	// We use NOOP for that, as it returns EHLO errors, unlike Extension.
+	if err := client.Noop(); err != nil {
+		return phaseEHLO, err
+	}
+	hasStartTLS, _ := client.Extension("STARTTLS")
+	phaseLatency[phaseEHLO] = time.Since(phaseStart)
+
+	if p.c.GetTlsMode() == configpb.ProbeConf_STARTTLS {
+		if !hasStartTLS {
+			return phaseTLS, errors.New("server doesn't support STARTTLS")
+		}
+		phaseStart = time.Now()
+		if err := client.StartTLS(p.tlsConfigForHost(host)); err != nil {
+			return phaseTLS, err
+		}
+		phaseLatency[phaseTLS] = time.Since(phaseStart)
+	}
+
+	if p.c.GetAuth() != nil {
+		phaseStart = time.Now()
+		if err := client.Auth(p.auth(host)); err != nil {
+			return phaseAuth, err
+		}
+		phaseLatency[phaseAuth] = time.Since(phaseStart)
+	}
+
+	if len(p.c.GetRcptTo()) > 0 {
+		phaseStart = time.Now()
+		if err := p.send(client, host); err != nil {
+			return phaseSend, err
+		}
+		phaseLatency[phaseSend] = time.Since(phaseStart)
+	}
+
+	// We don't fail the probe on QUIT errors.
+	if err := client.Quit(); err != nil {
+		p.l.Debugf("Error in QUIT for %s: %v", addr, err)
+	}
+	return "", nil
+}
+
+func (p *Probe) send(client *smtp.Client, target string) error {
+	if err := client.Mail(p.c.GetMailFrom()); err != nil {
+		return err
+	}
+	for _, rcpt := range p.c.GetRcptTo() {
+		if err := client.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(p.message(target)); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func (p *Probe) tlsConfigForHost(host string) *tls.Config {
+	tlsConfig := p.tlsConfig.Clone()
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = host
+	}
+	return tlsConfig
+}
+
+func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sched.ProbeResult) {
+	ctx, cancelCtx := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancelCtx()
+
+	result := res.(*probeResult)
+	result.total++
+
+	host := target.Name
+	ipLabel := ""
+
+	resolveFirst := false
+	if p.c.ResolveFirst != nil {
+		resolveFirst = p.c.GetResolveFirst()
+	} else {
+		resolveFirst = target.IP != nil
+	}
+	if resolveFirst {
+		ip, err := target.Resolve(p.opts.IPVersion, p.opts.Targets)
+		if err != nil {
+			p.l.Error("target: ", target.Name, ", resolve error: ", err.Error())
+			result.failures.IncKey(failureConnect)
+			return
+		}
+		host = ip.String()
+		ipLabel = host
+	}
+
+	port := int(p.c.GetPort())
+	if port == 0 {
+		port = target.Port
+	}
+	if port == 0 {
+		port = 25
+		if p.c.GetTlsMode() == configpb.ProbeConf_IMPLICIT {
+			port = 465
+		}
+	}
+
+	for _, al := range p.opts.AdditionalLabels {
+		al.UpdateForTarget(target, ipLabel, port)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	phaseLatency := make(map[string]time.Duration)
+	start := time.Now()
+	// We use target name for TLS verification and auth, even if we
+	// resolved it first.
+	phase, err := p.doSMTP(ctx, target.Name, addr, phaseLatency)
+	latency := time.Since(start)
+
+	if err != nil {
+		failure := classifyError(phase, err)
+		p.l.Warningf("Target: %s, phase: %s, failure: %s, error: %v", target.Name, phase, failure, err)
+		result.failures.IncKey(failure)
+		return
+	}
+
+	result.success++
+	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+	for phase, d := range phaseLatency {
+		result.phaseLatency.IncKeyBy(phase, d.Seconds()/p.opts.LatencyUnit.Seconds())
+	}
+}
+
+// Start starts and runs the probe indefinitely.
+func (p *Probe) Start(ctx context.Context, dataChan chan *metrics.EventMetrics) {
+	s := &sched.Scheduler{
+		ProbeName:              p.name,
+		DataChan:               dataChan,
+		Opts:                   p.opts,
+		NewResult:              p.newResult,
+		RunProbeForTarget:      p.runProbe,
+		IntervalBetweenTargets: time.Duration(p.c.GetIntervalBetweenTargetsMsec()) * time.Millisecond,
+	}
+	s.UpdateTargetsAndStartProbes(ctx)
+}
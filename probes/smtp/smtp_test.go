@@ -0,0 +1,324 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package smtp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/internal/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/smtp/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// stubServer is a minimal SMTP server for tests.
+type stubServer struct {
+	ln        net.Listener
+	tlsConfig *tls.Config
+
+	rcptCode int  // If non-zero, reply to RCPT with this code.
+	hang     bool // Don't send the greeting.
+	noTLS    bool // Don't advertise STARTTLS.
+	password string
+	gotData  chan string
+}
+
+func testCert(t *testing.T) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func newStubServer(t *testing.T, s *stubServer) *stubServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ln = ln
+	s.tlsConfig = &tls.Config{Certificates: []tls.Certificate{testCert(t)}}
+	s.gotData = make(chan string, 1)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *stubServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *stubServer) serve(conn net.Conn) {
+	defer conn.Close()
+	if s.hang {
+		time.Sleep(time.Second)
+		return
+	}
+
+	tc := textproto.NewConn(conn)
+	tc.PrintfLine("220 localhost ESMTP stub")
+
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO":
+			if s.noTLS {
+				tc.PrintfLine("250-localhost\r\n250 AUTH PLAIN LOGIN")
+			} else {
+				tc.PrintfLine("250-localhost\r\n250-STARTTLS\r\n250 AUTH PLAIN LOGIN")
+			}
+		case "STARTTLS":
+			tc.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			tc = textproto.NewConn(conn)
+		case "AUTH":
+			s.handleAuth(tc, line)
+		case "NOOP", "MAIL":
+			tc.PrintfLine("250 OK")
+		case "RCPT":
+			if s.rcptCode != 0 {
+				tc.PrintfLine("%d try again later", s.rcptCode)
+				continue
+			}
+			tc.PrintfLine("250 OK")
+		case "DATA":
+			tc.PrintfLine("354 Go ahead")
+			data, _ := tc.ReadDotLines()
+			s.gotData <- strings.Join(data, "\n")
+			tc.PrintfLine("250 Queued")
+		case "QUIT":
+			tc.PrintfLine("221 Bye")
+			return
+		default:
+			tc.PrintfLine("502 Unknown command")
+		}
+	}
+}
+
+func (s *stubServer) handleAuth(tc *textproto.Conn, line string) {
+	fields := strings.Fields(line)
+	var user, pass string
+	switch strings.ToUpper(fields[1]) {
+	case "PLAIN":
+		b, _ := base64.StdEncoding.DecodeString(fields[2])
+		parts := strings.Split(string(b), "\x00")
+		user, pass = parts[1], parts[2]
+	case "LOGIN":
+		tc.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Username:")))
+		l, _ := tc.ReadLine()
+		b, _ := base64.StdEncoding.DecodeString(l)
+		user = string(b)
+		tc.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Password:")))
+		l, _ = tc.ReadLine()
+		b, _ = base64.StdEncoding.DecodeString(l)
+		pass = string(b)
+	}
+	if user == "user" && pass == s.password {
+		tc.PrintfLine("235 Authenticated")
+		return
+	}
+	tc.PrintfLine("535 Authentication failed")
+}
+
+func TestRunProbe(t *testing.T) {
+	insecureTLS := &tlsconfigpb.TLSConfig{DisableCertValidation: proto.Bool(true)}
+
+	tests := []struct {
+		desc        string
+		server      *stubServer
+		conf        *configpb.ProbeConf
+		wantSuccess int64
+		wantFailure string
+		wantPhases  []string
+		wantData    bool
+	}{
+		{
+			desc:   "plain-connect-only",
+			server: &stubServer{},
+			conf: &configpb.ProbeConf{
+				TlsMode: configpb.ProbeConf_NONE.Enum(),
+			},
+			wantSuccess: 1,
+			wantPhases:  []string{"connect", "ehlo"},
+		},
+		{
+			desc:   "starttls-auth-send",
+			server: &stubServer{password: "secret"},
+			conf: &configpb.ProbeConf{
+				TlsConfig: insecureTLS,
+				Auth: &configpb.Auth{
+					Username: proto.String("user"),
+					Password: proto.String("secret"),
+				},
+				MailFrom: proto.String("prober@example.com"),
+				RcptTo:   []string{"sink@example.com"},
+			},
+			wantSuccess: 1,
+			wantPhases:  []string{"auth", "connect", "ehlo", "send", "tls"},
+			wantData:    true,
+		},
+		{
+			desc:   "login-auth",
+			server: &stubServer{password: "secret"},
+			conf: &configpb.ProbeConf{
+				TlsConfig: insecureTLS,
+				Auth: &configpb.Auth{
+					Mechanism: configpb.Auth_LOGIN.Enum(),
+					Username:  proto.String("user"),
+					Password:  proto.String("secret"),
+				},
+			},
+			wantSuccess: 1,
+			wantPhases:  []string{"auth", "connect", "ehlo", "tls"},
+		},
+		{
+			desc:   "auth-failure",
+			server: &stubServer{password: "secret"},
+			conf: &configpb.ProbeConf{
+				TlsConfig: insecureTLS,
+				Auth: &configpb.Auth{
+					Username: proto.String("user"),
+					Password: proto.String("wrong"),
+				},
+			},
+			wantFailure: failureAuth,
+		},
+		{
+			desc:        "starttls-not-supported",
+			server:      &stubServer{noTLS: true},
+			conf:        &configpb.ProbeConf{},
+			wantFailure: failureTLS,
+		},
+		{
+			desc:        "tls-cert-validation-failure",
+			server:      &stubServer{},
+			conf:        &configpb.ProbeConf{},
+			wantFailure: failureTLS,
+		},
+		{
+			desc:   "greylisted",
+			server: &stubServer{rcptCode: 451},
+			conf: &configpb.ProbeConf{
+				TlsMode:  configpb.ProbeConf_NONE.Enum(),
+				MailFrom: proto.String("prober@example.com"),
+				RcptTo:   []string{"sink@example.com"},
+			},
+			wantFailure: failureGreylisted,
+		},
+		{
+			desc:   "rejected",
+			server: &stubServer{rcptCode: 550},
+			conf: &configpb.ProbeConf{
+				TlsMode:  configpb.ProbeConf_NONE.Enum(),
+				MailFrom: proto.String("prober@example.com"),
+				RcptTo:   []string{"sink@example.com"},
+			},
+			wantFailure: failureRejected,
+		},
+		{
+			desc:        "timeout",
+			server:      &stubServer{hang: true},
+			conf:        &configpb.ProbeConf{TlsMode: configpb.ProbeConf_NONE.Enum()},
+			wantFailure: failureTimeout,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			s := newStubServer(t, test.server)
+
+			opts := options.DefaultOptions()
+			opts.Timeout = 500 * time.Millisecond
+			opts.ProbeConf = test.conf
+
+			p := &Probe{}
+			if err := p.Init("smtp-test", opts); err != nil {
+				t.Fatalf("Error initializing probe: %v", err)
+			}
+
+			res := p.newResult()
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: "127.0.0.1", Port: s.port()}, res)
+			result := res.(*probeResult)
+
+			assert.Equal(t, int64(1), result.total, "total")
+			assert.Equal(t, test.wantSuccess, result.success, "success")
+			if test.wantFailure != "" {
+				assert.Equal(t, []string{test.wantFailure}, result.failures.Keys(), "failures")
+				assert.Equal(t, int64(1), result.failures.GetKey(test.wantFailure))
+			} else {
+				assert.Empty(t, result.failures.Keys(), "failures")
+				assert.Equal(t, test.wantPhases, result.phaseLatency.Keys(), "phases")
+			}
+
+			if test.wantData {
+				data := <-s.gotData
+				assert.Contains(t, data, "Subject: cloudprober test message")
+				assert.Contains(t, data, "To: sink@example.com")
+			}
+		})
+	}
+}
+
+func TestInitErrors(t *testing.T) {
+	opts := options.DefaultOptions()
+	opts.ProbeConf = &configpb.ProbeConf{RcptTo: []string{"sink@example.com"}}
+	assert.Error(t, (&Probe{}).Init("smtp-test", opts))
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	"github.com/cloudprober/cloudprober/internal/tlsconfig"
+	configpb "github.com/cloudprober/cloudprober/probes/tcp/proto"
+)
+
+type resumptionChecker struct {
+	tlsConfig  *tls.Config
+	ticketWait time.Duration
+}
+
+func newResumptionChecker(c *configpb.ProbeConf_TLSResumptionCheck) (*resumptionChecker, error) {
+	tlsConfig := &tls.Config{}
+	if err := tlsconfig.UpdateTLSConfig(tlsConfig, c.GetTlsConfig()); err != nil {
+		return nil, fmt.Errorf("tls_resumption_check: tls_config error: %v", err)
+	}
+	if c.GetSessionTicketWaitMsec() < 0 {
+		return nil, fmt.Errorf("tls_resumption_check: invalid session_ticket_wait_msec: %d", c.GetSessionTicketWaitMsec())
+	}
+	return &resumptionChecker{
+		tlsConfig:  tlsConfig,
+		ticketWait: time.Duration(c.GetSessionTicketWaitMsec()) * time.Millisecond,
+	}, nil
+}
+
+// tlsHandshake connects to addr and performs a TLS handshake. If ticketWait
+// is non-zero, it also waits for the TLS 1.3 session tickets, which are only
+// processed while reading from the connection.
+func (p *Probe) tlsHandshake(ctx context.Context, addr string, cfg *tls.Config, ticketWait time.Duration) (tls.ConnectionState, time.Duration, error) {
+	rawConn, err := p.dialContext(ctx, p.network, addr)
+	if err != nil {
+		return tls.ConnectionState{}, 0, err
+	}
+	conn := tls.Client(rawConn, cfg)
+	defer conn.Close()
+
+	start := time.Now()
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return tls.ConnectionState{}, 0, err
+	}
+	latency := time.Since(start)
+
+	state := conn.ConnectionState()
+	if ticketWait > 0 && state.Version == tls.VersionTLS13 {
+		// Read fails once the deadline is reached; by then session tickets
+		// sent by the server have been stored in the session cache.
+		conn.SetReadDeadline(time.Now().Add(ticketWait))
+		conn.Read(make([]byte, 1))
+	}
+	return state, latency, nil
+}
+
+// checkResumption performs two TLS handshakes with the target, the second
+// one reusing the session state from the first, and updates the result.
+func (p *Probe) checkResumption(ctx context.Context, addr, targetName string, result *probeResult) {
+	rc := p.resumptionChecker
+	result.tlsResumptionChecks++
+
+	// New session cache for each check, so that the first handshake is
+	// always a full handshake.
+	cfg := rc.tlsConfig.Clone()
+	cfg.ClientSessionCache = tls.NewLRUClientSessionCache(1)
+	if cfg.ServerName == "" {
+		cfg.ServerName = targetName
+	}
+
+	_, fullLatency, err := p.tlsHandshake(ctx, addr, cfg, rc.ticketWait)
+	if err != nil {
+		p.l.Warning("Target:", targetName, ", TLS resumption check: first handshake failed: ", err.Error())
+		result.tlsResumptionErrors++
+		return
+	}
+
+	state, resumedLatency, err := p.tlsHandshake(ctx, addr, cfg, 0)
+	if err != nil {
+		p.l.Warning("Target:", targetName, ", TLS resumption check: second handshake failed: ", err.Error())
+		result.tlsResumptionErrors++
+		return
+	}
+
+	if !state.DidResume {
+		p.l.Info("Target:", targetName, ", TLS session was not resumed")
+		return
+	}
+	result.tlsResumed++
+	result.tlsHandshakeLatencyDiff.AddFloat64((fullLatency - resumedLatency).Seconds() / p.opts.LatencyUnit.Seconds())
+}
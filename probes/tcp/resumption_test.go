@@ -0,0 +1,112 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tcp
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	tlsconfigpb "github.com/cloudprober/cloudprober/internal/tlsconfig/proto"
+	"github.com/cloudprober/cloudprober/probes/options"
+	configpb "github.com/cloudprober/cloudprober/probes/tcp/proto"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTLSResumptionCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		serverTLS   *tls.Config
+		noTLS       bool
+		wantResumed int64
+		wantErrors  int64
+	}{
+		{
+			name:        "tls13",
+			serverTLS:   &tls.Config{},
+			wantResumed: 1,
+		},
+		{
+			name:        "tls12",
+			serverTLS:   &tls.Config{MaxVersion: tls.VersionTLS12},
+			wantResumed: 1,
+		},
+		{
+			name:      "resumption_not_supported",
+			serverTLS: &tls.Config{SessionTicketsDisabled: true},
+		},
+		{
+			name:       "not_tls",
+			noTLS:      true,
+			wantErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewUnstartedServer(http.NotFoundHandler())
+			if test.noTLS {
+				ts.Start()
+			} else {
+				ts.TLS = test.serverTLS
+				ts.StartTLS()
+			}
+			defer ts.Close()
+
+			host, portStr, _ := net.SplitHostPort(ts.Listener.Addr().String())
+			port, _ := strconv.Atoi(portStr)
+
+			opts := options.DefaultOptions()
+			opts.Timeout = 5 * time.Second
+			opts.ProbeConf = &configpb.ProbeConf{
+				TlsResumptionCheck: &configpb.ProbeConf_TLSResumptionCheck{
+					TlsConfig: &tlsconfigpb.TLSConfig{DisableCertValidation: proto.Bool(true)},
+				},
+			}
+			p := &Probe{}
+			require.NoError(t, p.Init("tcp_test", opts))
+
+			res := p.newResult()
+			p.runProbe(context.Background(), endpoint.Endpoint{Name: host, Port: port}, res)
+
+			result := res.(*probeResult)
+			assert.Equal(t, int64(1), result.success, "success")
+			assert.Equal(t, int64(1), result.tlsResumptionChecks, "tls_resumption_checks")
+			assert.Equal(t, test.wantResumed, result.tlsResumed, "tls_resumed")
+			assert.Equal(t, test.wantErrors, result.tlsResumptionErrors, "tls_resumption_errors")
+
+			em := result.Metrics(time.Now(), opts)
+			assert.Equal(t, strconv.FormatInt(test.wantResumed, 10), em.Metric("tls_resumed").String())
+			assert.NotNil(t, em.Metric("tls_handshake_latency_diff"))
+		})
+	}
+}
+
+func TestTLSResumptionCheckNotConfigured(t *testing.T) {
+	p := &Probe{}
+	opts := options.DefaultOptions()
+	require.NoError(t, p.Init("tcp_test", opts))
+
+	em := p.newResult().Metrics(time.Now(), opts)
+	assert.Nil(t, em.Metric("tls_resumed"))
+}
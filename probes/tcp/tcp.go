@@ -41,16 +41,24 @@ type Probe struct {
 	// book-keeping params
 	network     string
 	dialContext func(context.Context, string, string) (net.Conn, error) // Keeps some dialing related config
+
+	resumptionChecker *resumptionChecker
 }
 
 type probeResult struct {
 	total, success    int64
 	latency           metrics.LatencyValue
 	validationFailure *metrics.Map[int64]
+
+	// TLS session resumption check.
+	tlsResumptionChecks, tlsResumed, tlsResumptionErrors int64
+	tlsHandshakeLatencyDiff                              *metrics.Float
 }
 
 func (p *Probe) newResult() sched.ProbeResult {
-	result := &probeResult{}
+	result := &probeResult{
+		tlsHandshakeLatencyDiff: metrics.NewFloat(0),
+	}
 
 	if p.opts.Validators != nil {
 		result.validationFailure = validators.ValidationFailureMap(p.opts.Validators)
@@ -76,6 +84,13 @@ func (result *probeResult) Metrics(ts time.Time, opts *options.Options) *metrics
 		em.AddMetric("validation_failure", result.validationFailure)
 	}
 
+	if result.tlsResumptionChecks > 0 {
+		em.AddMetric("tls_resumption_checks", metrics.NewInt(result.tlsResumptionChecks)).
+			AddMetric("tls_resumed", metrics.NewInt(result.tlsResumed)).
+			AddMetric("tls_resumption_errors", metrics.NewInt(result.tlsResumptionErrors)).
+			AddMetric("tls_handshake_latency_diff", result.tlsHandshakeLatencyDiff.Clone())
+	}
+
 	return em
 }
 
@@ -117,6 +132,14 @@ func (p *Probe) Init(name string, opts *options.Options) error {
 	}
 	p.dialContext = dialer.DialContext
 
+	if p.c.GetTlsResumptionCheck() != nil {
+		rc, err := newResumptionChecker(p.c.GetTlsResumptionCheck())
+		if err != nil {
+			return err
+		}
+		p.resumptionChecker = rc
+	}
+
 	return nil
 }
 
@@ -180,6 +203,10 @@ func (p *Probe) runProbe(ctx context.Context, target endpoint.Endpoint, res sche
 	}
 	result.success++
 	result.latency.AddFloat64(latency.Seconds() / p.opts.LatencyUnit.Seconds())
+
+	if p.resumptionChecker != nil {
+		p.checkResumption(ctx, addr, target.Name, result)
+	}
 }
 
 // Start starts and runs the probe indefinitely.
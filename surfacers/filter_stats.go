@@ -0,0 +1,82 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// Names of the filter stats metrics.
+const (
+	filterAllowedMetricName = "surfacer_filter_allowed"
+	filterDroppedMetricName = "surfacer_filter_dropped"
+)
+
+// filterStatsEventMetrics returns the EventMetrics with the surfacer's
+// filter stats. They are labeled as the system variables, as they are
+// cloudprober's own metrics.
+func (sw *surfacerWrapper) filterStatsEventMetrics(ts time.Time, name string) *metrics.EventMetrics {
+	stats := sw.opts.Stats()
+
+	dropped := metrics.NewMap("filter")
+	dropped.IncKeyBy("label", stats.LabelDropped)
+	dropped.IncKeyBy("name", stats.NameDropped)
+
+	return metrics.NewEventMetrics(ts).
+		AddMetric(filterAllowedMetricName, metrics.NewInt(stats.Allowed)).
+		AddMetric(filterDroppedMetricName, dropped).
+		AddLabel("ptype", "sysvars").
+		AddLabel("probe", "sysvars").
+		AddLabel("surfacer", name)
+}
+
+// filterStatsLoop sends the filter stats to dataChan every interval, until
+// the context is canceled.
+func (sw *surfacerWrapper) filterStatsLoop(ctx context.Context, name string, interval time.Duration, dataChan chan<- *metrics.EventMetrics) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			select {
+			case dataChan <- sw.filterStatsEventMetrics(ts, name):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// StartFilterStats starts exporting the filter stats of the surfacers that
+// have filter_stats_interval_sec set. Stats are sent to dataChan, the channel
+// that carries the probes' and the system variables' metrics, so that they
+// are written to all the surfacers, like the other internal metrics, and
+// not only to the surfacer that they are about. Surfacers in the shadow
+// mode don't export their filter stats.
+func StartFilterStats(ctx context.Context, surfacers []*SurfacerInfo, dataChan chan<- *metrics.EventMetrics) {
+	for _, si := range surfacers {
+		sw, ok := si.Surfacer.(*surfacerWrapper)
+		if !ok || sw.shadow != nil || sw.opts.Config.GetFilterStatsIntervalSec() <= 0 {
+			continue
+		}
+		go sw.filterStatsLoop(ctx, surfacerName(si), time.Duration(sw.opts.Config.GetFilterStatsIntervalSec())*time.Second, dataChan)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// FiltersURL is the URL at which surfacers' metrics filters can be viewed
+// and updated at runtime.
+//
+// GET  /surfacers/filters?name=<surfacer>  returns the current filters.
+// POST /surfacers/filters?name=<surfacer>  replaces the filters with the ones
+// in the request body, validating them the same way as at the startup (see
+// Options.NewFilters). All the surfacers with the given name are updated, or
+// none of them if the filters are not valid. Updates are allowed only for the
+// surfacers with allow_filters_update set. Body should be a SurfacerDef in
+// text format, e.g.:
+//
+//	ignore_metrics_with_label { key: "probe" value: "noisy-probe" }
+//	allow_metrics_with_name: "^(success|total)$"
+//
+// Surfacer name is the name given in the config, or if not set, surfacer type
+// in lower case, e.g. "prometheus".
+const FiltersURL = "/surfacers/filters"
+
+// ConfigURL is the URL at which surfacers' effective config, i.e. config with
+// the defaults and the currently active filters, can be viewed.
+//
+// GET  /surfacers/config?name=<surfacer>  returns the effective config.
+const ConfigURL = "/surfacers/config"
+
+// maxFiltersBodySize limits the size of the filters update request.
+const maxFiltersBodySize = 1 << 20
+
+type filtersHandler struct {
+	surfacerOpts map[string][]*options.Options
+}
+
+func surfacerName(si *SurfacerInfo) string {
+	if si.Name != "" {
+		return si.Name
+	}
+	return strings.ToLower(si.Type)
+}
+
+func newFiltersHandler(surfacers []*SurfacerInfo) *filtersHandler {
+	fh := &filtersHandler{
+		surfacerOpts: make(map[string][]*options.Options),
+	}
+	for _, si := range surfacers {
+		sw, ok := si.Surfacer.(*surfacerWrapper)
+		if !ok || sw.opts == nil {
+			continue
+		}
+		name := surfacerName(si)
+		fh.surfacerOpts[name] = append(fh.surfacerOpts[name], sw.opts)
+	}
+	return fh
+}
+
+// lookup returns the name and options of the surfacer in the request. If
+// surfacer is not found, it writes the error response and returns nil
+// options.
+func (fh *filtersHandler) lookup(w http.ResponseWriter, r *http.Request) (string, []*options.Options) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		http.Error(w, "surfacer name is required, use ?name=<surfacer>", http.StatusBadRequest)
+		return "", nil
+	}
+
+	optsList := fh.surfacerOpts[name]
+	if len(optsList) == 0 {
+		http.Error(w, fmt.Sprintf("surfacer %s not found", name), http.StatusNotFound)
+		return "", nil
+	}
+	return name, optsList
+}
+
+// serveConfig serves the effective config of a surfacer.
+func (fh *filtersHandler) serveConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	_, optsList := fh.lookup(w, r)
+	if optsList == nil {
+		return
+	}
+	fmt.Fprint(w, prototext.Format(optsList[0].EffectiveConfig()))
+}
+
+func (fh *filtersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, optsList := fh.lookup(w, r)
+	if optsList == nil {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		fmt.Fprint(w, prototext.Format(optsList[0].FiltersConfig()))
+
+	case http.MethodPost:
+		for _, opts := range optsList {
+			if !opts.Config.GetAllowFiltersUpdate() {
+				http.Error(w, fmt.Sprintf("filters update is not allowed for surfacer %s, see allow_filters_update", name), http.StatusForbidden)
+				return
+			}
+		}
+
+		b, err := io.ReadAll(io.LimitReader(r.Body, maxFiltersBodySize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sdef := &surfacerpb.SurfacerDef{}
+		if err := prototext.Unmarshal(b, sdef); err != nil {
+			http.Error(w, fmt.Sprintf("error parsing filters: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Build and validate the filters for all the surfacers with this
+		// name first, and swap them in only if they are valid for all, so
+		// that a failed update doesn't leave the surfacers with different
+		// filters.
+		newFilters := make([]*options.Filters, len(optsList))
+		for i, opts := range optsList {
+			if newFilters[i], err = opts.NewFilters(sdef); err != nil {
+				http.Error(w, fmt.Sprintf("invalid filters: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+		for i, opts := range optsList {
+			opts.ApplyFilters(newFilters[i])
+			opts.Logger.Infof("Updated metrics filters for surfacer %s: %s", name, prototext.MarshalOptions{}.Format(sdef))
+		}
+		fmt.Fprint(w, prototext.Format(optsList[0].FiltersConfig()))
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFiltersHandler(t *testing.T) {
+	srvMux := http.NewServeMux()
+	runconfig.SetDefaultHTTPServeMux(srvMux)
+
+	ts := &testSurfacer{}
+	Register("s-filters", ts)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:               proto.String("s-filters"),
+			Type:               surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowFiltersUpdate: proto.Bool(true),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+				{
+					Key:   proto.String("probe"),
+					Value: proto.String("sysvars"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	do := func(method, query, body string) (int, string) {
+		t.Helper()
+		req := httptest.NewRequest(method, FiltersURL+query, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		srvMux.ServeHTTP(w, req)
+		return w.Code, w.Body.String()
+	}
+
+	writeAll := func() {
+		ts.received = nil
+		for _, em := range testEventMetrics {
+			for _, s := range si {
+				s.Surfacer.Write(context.Background(), em)
+			}
+		}
+	}
+
+	writeAll()
+	if assert.Len(t, ts.received, 1, "before update") {
+		assert.Equal(t, "google_homepage", ts.received[0].Label("probe"))
+	}
+
+	code, body := do(http.MethodGet, "?name=s-filters", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "sysvars")
+
+	// Errors.
+	code, _ = do(http.MethodGet, "", "")
+	assert.Equal(t, http.StatusBadRequest, code, "missing name")
+	code, _ = do(http.MethodGet, "?name=unknown", "")
+	assert.Equal(t, http.StatusNotFound, code, "unknown surfacer")
+	code, _ = do(http.MethodPut, "?name=s-filters", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code, "bad method")
+	code, _ = do(http.MethodPost, "?name=s-filters", "ignore_metrics_with_label {")
+	assert.Equal(t, http.StatusBadRequest, code, "bad textproto")
+	code, _ = do(http.MethodPost, "?name=s-filters", `allow_metrics_with_name: "(total"`)
+	assert.Equal(t, http.StatusBadRequest, code, "bad regex")
+	code, _ = do(http.MethodPost, "?name=s-filters", `allow_metrics_with_label { key: "probe" value: "sysvars" } ignore_metrics_with_label { key: "probe" value: "sysvars" }`)
+	assert.Equal(t, http.StatusBadRequest, code, "conflicting filters")
+
+	// Failed updates should leave filters untouched.
+	writeAll()
+	assert.Len(t, ts.received, 1, "after failed updates")
+
+	// Ignore google_homepage instead of sysvars.
+	code, body = do(http.MethodPost, "?name=s-filters", `ignore_metrics_with_label { key: "probe" value: "google_homepage" }`)
+	assert.Equal(t, http.StatusOK, code, body)
+	assert.Contains(t, body, "google_homepage")
+
+	writeAll()
+	if assert.Len(t, ts.received, 1, "after update") {
+		assert.Equal(t, "sysvars", ts.received[0].Label("probe"))
+	}
+
+	// Value filters are updated along with the label filters: none of the
+	// sysvars metrics is called "total".
+	code, body = do(http.MethodPost, "?name=s-filters", `ignore_metrics_with_label { key: "probe" value: "google_homepage" } allow_metrics_with_value { metric_name: "total" op: GT value: 0 }`)
+	assert.Equal(t, http.StatusOK, code, body)
+	assert.Contains(t, body, "allow_metrics_with_value")
+	writeAll()
+	assert.Len(t, ts.received, 0, "after value filters update")
+
+	code, body = do(http.MethodPost, "?name=s-filters", `ignore_metrics_with_label { key: "probe" value: "google_homepage" }`)
+	assert.Equal(t, http.StatusOK, code, body)
+
+	// Effective config reflects the defaults and the updated filters.
+	doConfig := func(method, query string) (int, string) {
+		t.Helper()
+		req := httptest.NewRequest(method, ConfigURL+query, nil)
+		w := httptest.NewRecorder()
+		srvMux.ServeHTTP(w, req)
+		return w.Code, w.Body.String()
+	}
+	code, body = doConfig(http.MethodGet, "?name=s-filters")
+	assert.Equal(t, http.StatusOK, code, body)
+	assert.Contains(t, body, "google_homepage")
+	assert.NotContains(t, body, "sysvars")
+	assert.Contains(t, body, "metrics_buffer_size:")
+	assert.Contains(t, body, "add_failure_metric:")
+
+	code, _ = doConfig(http.MethodGet, "?name=unknown")
+	assert.Equal(t, http.StatusNotFound, code, "unknown surfacer")
+	code, _ = doConfig(http.MethodPost, "?name=s-filters")
+	assert.Equal(t, http.StatusMethodNotAllowed, code, "bad method")
+}
+
+func TestFiltersHandlerAtomicUpdate(t *testing.T) {
+	srvMux := http.NewServeMux()
+	runconfig.SetDefaultHTTPServeMux(srvMux)
+
+	Register("s-atomic", &testSurfacer{})
+
+	// Two surfacers with the same name, and different filters.
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("s-atomic"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowFiltersUpdate:     proto.Bool(true),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("sysvars")}},
+		},
+		{
+			Name:                   proto.String("s-atomic"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowFiltersUpdate:     proto.Bool(true),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("google_homepage")}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+	fh := newFiltersHandler(si)
+
+	filters := func() []string {
+		var out []string
+		for _, opts := range fh.surfacerOpts["s-atomic"] {
+			out = append(out, prototext.MarshalOptions{}.Format(opts.FiltersConfig()))
+		}
+		return out
+	}
+	before := filters()
+	require.Len(t, before, 2)
+
+	post := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, FiltersURL+"?name=s-atomic", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		fh.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	for _, body := range []string{
+		`allow_metrics_with_name: "(total"`,
+		`allow_metrics_with_label { key: "probe" value: "sysvars" } ignore_metrics_with_label { key: "probe" value: "sysvars" }`,
+	} {
+		assert.Equal(t, http.StatusBadRequest, post(body), body)
+		assert.Equal(t, before, filters(), "filters after failed update: %s", body)
+	}
+
+	assert.Equal(t, http.StatusOK, post(`ignore_metrics_with_name: "^latency$"`))
+	for i, f := range filters() {
+		assert.Equal(t, `ignore_metrics_with_name:"^latency$"`, strings.TrimSpace(f), "surfacer %d", i)
+	}
+}
+
+func TestFiltersHandlerUpdateNotAllowed(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	Register("s-readonly", &testSurfacer{})
+
+	// Updates should be allowed for all the surfacers with the name.
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("s-readonly"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowFiltersUpdate:     proto.Bool(true),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("sysvars")}},
+		},
+		{
+			Name:                   proto.String("s-readonly"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("sysvars")}},
+		},
+	})
+	require.NoError(t, err)
+	fh := newFiltersHandler(si)
+
+	do := func(method, body string) (int, string) {
+		req := httptest.NewRequest(method, FiltersURL+"?name=s-readonly", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		fh.ServeHTTP(w, req)
+		return w.Code, w.Body.String()
+	}
+
+	code, body := do(http.MethodPost, `ignore_metrics_with_name: "^latency$"`)
+	assert.Equal(t, http.StatusForbidden, code, body)
+	assert.Contains(t, body, "allow_filters_update")
+
+	for i, opts := range fh.surfacerOpts["s-readonly"] {
+		assert.Empty(t, opts.FiltersConfig().GetIgnoreMetricsWithName(), "surfacer %d", i)
+	}
+
+	code, body = do(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, code, body)
+	assert.Contains(t, body, "sysvars")
+}
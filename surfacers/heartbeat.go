@@ -0,0 +1,53 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// heartbeatMetricName is the name of the heartbeat metric.
+const heartbeatMetricName = "surfacer_heartbeat"
+
+// newHeartbeatTicker returns the channel the heartbeats are written on, and
+// a function to stop it. It's replaced in tests to use a fake clock.
+var newHeartbeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(interval)
+	return ticker.C, ticker.Stop
+}
+
+// heartbeatLoop writes the heartbeat metric every interval (see
+// writeInternal), until the context is canceled.
+func (sw *surfacerWrapper) heartbeatLoop(ctx context.Context, name string, interval time.Duration) {
+	tickC, stop := newHeartbeatTicker(interval)
+	defer stop()
+
+	var count int64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-tickC:
+			count++
+			em := metrics.NewEventMetrics(ts).
+				AddMetric(heartbeatMetricName, metrics.NewInt(count)).
+				AddLabel("surfacer", name)
+			sw.writeInternal(ctx, em)
+		}
+	}
+}
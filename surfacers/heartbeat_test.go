@@ -0,0 +1,191 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeTicker is a ticker driven by a fake clock. Ticks are delivered
+// synchronously as the clock advances.
+type fakeTicker struct {
+	now      time.Time
+	next     time.Time
+	interval time.Duration
+	c        chan time.Time
+	stopped  chan struct{}
+}
+
+func (ft *fakeTicker) advance(d time.Duration) {
+	ft.now = ft.now.Add(d)
+	for !ft.next.After(ft.now) {
+		ft.c <- ft.next
+		ft.next = ft.next.Add(ft.interval)
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tickerCreated := make(chan *fakeTicker, 1)
+	oldNewHeartbeatTicker := newHeartbeatTicker
+	defer func() { newHeartbeatTicker = oldNewHeartbeatTicker }()
+	newHeartbeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+		ft := &fakeTicker{
+			now:      start,
+			next:     start.Add(interval),
+			interval: interval,
+			c:        make(chan time.Time),
+			stopped:  make(chan struct{}),
+		}
+		tickerCreated <- ft
+		return ft.c, func() { close(ft.stopped) }
+	}
+
+	bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)}
+	Register("hb", bs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:                 proto.String("hb"),
+			Type:                 surfacerpb.Type_USER_DEFINED.Enum(),
+			HeartbeatIntervalSec: proto.Int32(30),
+		},
+	})
+	require.NoError(t, err)
+
+	ft := <-tickerCreated
+	assert.Equal(t, 30*time.Second, ft.interval)
+
+	// No heartbeat before the first interval.
+	ft.advance(29 * time.Second)
+	assert.Len(t, bs.buf, 0)
+
+	// 1m31s since start: 3 heartbeats.
+	ft.advance(62 * time.Second)
+
+	// Last tick is received but may not be written yet; cancel and wait for
+	// the loop to exit.
+	cancel()
+	<-ft.stopped
+
+	var got []time.Time
+	close(bs.buf)
+	for em := range bs.buf {
+		assert.Equal(t, "hb", em.Label("surfacer"))
+		assert.Equal(t, int64(len(got)+1), em.Metric("surfacer_heartbeat").(metrics.NumValue).Int64())
+		got = append(got, em.Timestamp)
+	}
+	assert.Equal(t, []time.Time{start.Add(30 * time.Second), start.Add(60 * time.Second), start.Add(90 * time.Second)}, got)
+}
+
+func TestHeartbeatWrite(t *testing.T) {
+	tests := []struct {
+		name      string
+		sdef      *surfacerpb.SurfacerDef
+		shadow    bool
+		wantWrite bool
+	}{
+		{
+			name:      "default",
+			sdef:      &surfacerpb.SurfacerDef{},
+			wantWrite: true,
+		},
+		{
+			name:   "shadow",
+			sdef:   &surfacerpb.SurfacerDef{},
+			shadow: true,
+		},
+		{
+			name: "filtered",
+			sdef: &surfacerpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+					{
+						Key:   proto.String("surfacer"),
+						Value: proto.String("hb"),
+					},
+				},
+			},
+		},
+	}
+
+	oldNewHeartbeatTicker := newHeartbeatTicker
+	defer func() { newHeartbeatTicker = oldNewHeartbeatTicker }()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tickC, stopped := make(chan time.Time), make(chan struct{})
+			newHeartbeatTicker = func(interval time.Duration) (<-chan time.Time, func()) {
+				return tickC, func() { close(stopped) }
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)}
+			sw := &surfacerWrapper{
+				Surfacer: bs,
+				opts:     options.BuildOptionsForTest(test.sdef),
+			}
+			sw.opts.AdditionalLabels = [][2]string{{"env", "prod"}}
+			if test.shadow {
+				sw.shadow = &shadowStats{}
+			}
+			go sw.heartbeatLoop(ctx, "hb", time.Second)
+
+			tickC <- time.Now()
+			cancel()
+			<-stopped
+
+			if !test.wantWrite {
+				assert.Len(t, bs.buf, 0, "heartbeat")
+				return
+			}
+			require.Len(t, bs.buf, 1)
+			em := <-bs.buf
+			assert.NotNil(t, em.Metric(heartbeatMetricName), "heartbeat: %s", em.String())
+			assert.Equal(t, "prod", em.Label("env"), "additional label")
+		})
+	}
+}
+
+func TestHeartbeatConfig(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+	Register("hb", &testSurfacer{})
+
+	_, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                 proto.String("hb"),
+			Type:                 surfacerpb.Type_USER_DEFINED.Enum(),
+			HeartbeatIntervalSec: proto.Int32(-1),
+		},
+	})
+	assert.Error(t, err)
+}
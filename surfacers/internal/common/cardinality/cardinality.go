@@ -0,0 +1,315 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cardinality implements a guard that limits the number of distinct
+// series surfaced per metric name.
+package cardinality
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// OverflowLabel is the label added to the series that collects data for the
+// series that went over the budget.
+const OverflowLabel = "overflow"
+
+// OverflowCountMetricName is the name of the CUMULATIVE metric that counts the
+// writes folded into the overflow series since the last reset, keyed by the
+// metric name.
+const OverflowCountMetricName = "surfacer_series_overflow"
+
+// overflowKeepLabels are the labels that overflow series keep from the folded
+// series, so that overflow can be attributed to a probe. There is an overflow
+// series for each metric name and combination of these labels.
+var overflowKeepLabels = []string{"ptype", "probe"}
+
+// overflowState is the state of a metric's overflow series. last keeps the
+// last value of each series folded into the overflow series, and total is
+// the aggregated value: sum of the last values for GAUGE metrics, and sum of
+// the increments for CUMULATIVE metrics, so that it never goes backwards.
+// To keep the memory bounded, last tracks at most as many series as the
+// budget; values of the series beyond that are counted, but not folded.
+type overflowState struct {
+	metricName string
+	count      int64
+	last       map[string]metrics.Value
+	total      metrics.Value
+
+	// Aggregation errors are logged once per overflow series, until the
+	// next reset.
+	foldErrLogged bool
+}
+
+// Guard keeps track of the distinct series seen for each metric name, and
+// folds series beyond the configured budget into an overflow series.
+type Guard struct {
+	maxSeries     int
+	resetInterval time.Duration
+	l             *logger.Logger
+
+	mu     sync.Mutex
+	series map[string]map[string]bool
+	// Overflow state, keyed by the metric name and the overflow labels.
+	overflow  map[string]*overflowState
+	lastReset time.Time
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+// New returns a new cardinality guard. It returns nil if maxSeries is not
+// positive, i.e. there is no limit. Reset interval should be positive if
+// there is a limit, otherwise the budget would be used up once for good.
+func New(maxSeries int, resetInterval time.Duration, l *logger.Logger) (*Guard, error) {
+	if maxSeries <= 0 {
+		return nil, nil
+	}
+	if resetInterval <= 0 {
+		return nil, fmt.Errorf("series_budget_reset_interval_sec should be positive with max_series_per_metric, got %s", resetInterval)
+	}
+	g := &Guard{
+		maxSeries:     maxSeries,
+		resetInterval: resetInterval,
+		l:             l,
+		now:           time.Now,
+	}
+	g.reset()
+	return g, nil
+}
+
+func (g *Guard) reset() {
+	g.series = make(map[string]map[string]bool)
+	g.overflow = make(map[string]*overflowState)
+	g.lastReset = g.now()
+}
+
+func (g *Guard) maybeReset() {
+	if g.now().Sub(g.lastReset) < g.resetInterval {
+		return
+	}
+	for _, st := range g.overflow {
+		g.l.Warningf("Metric %s went over the series budget (%d) %d times since last reset", st.metricName, g.maxSeries, st.count)
+	}
+	g.reset()
+}
+
+func seriesKey(em *metrics.EventMetrics) string {
+	var b strings.Builder
+	for i, k := range em.LabelsKeys() {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k + "=" + em.Label(k))
+	}
+	return b.String()
+}
+
+func newEMLike(em *metrics.EventMetrics) *metrics.EventMetrics {
+	newEM := metrics.NewEventMetrics(em.Timestamp)
+	newEM.Kind = em.Kind
+	newEM.LatencyUnit = em.LatencyUnit
+	newEM.MetricUnits = em.MetricUnits
+	return newEM
+}
+
+// withOverflowLabels adds the overflow labels to the given overflow
+// EventMetrics, keeping overflowKeepLabels from the folded series.
+func withOverflowLabels(overflowEM, em *metrics.EventMetrics) *metrics.EventMetrics {
+	for _, k := range overflowKeepLabels {
+		if v := em.Label(k); v != "" {
+			overflowEM.AddLabel(k, v)
+		}
+	}
+	return overflowEM.AddLabel(OverflowLabel, "true")
+}
+
+// sum returns the sum of the given values.
+func sum(vals map[string]metrics.Value) (metrics.Value, error) {
+	var total metrics.Value
+	for _, v := range vals {
+		if total == nil {
+			total = v.Clone()
+			continue
+		}
+		if err := total.Add(v); err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
+// fold folds the series' value into the metric's overflow series, and returns
+// the overflow series' value. Series beyond maxTracked are not folded, and it
+// returns the current value for them, nil if there is none yet. It returns an
+// error if the values can't be aggregated, e.g. for string values.
+func (st *overflowState) fold(key string, val metrics.Value, kind metrics.Kind, maxTracked int) (metrics.Value, error) {
+	last, tracked := st.last[key]
+	if !tracked && len(st.last) >= maxTracked {
+		return st.total, nil
+	}
+
+	// Update a copy of the total, so that an aggregation error doesn't leave
+	// it half-updated.
+	var total metrics.Value
+	if kind == metrics.GAUGE {
+		// Replace the series' last value in the sum. Subtraction reports a
+		// reset only if the sum goes below the last value, which is possible
+		// only with negative values; re-sum the last values in that case.
+		total = val.Clone()
+		if st.total != nil {
+			total = st.total.Clone()
+			if err := total.Add(val); err != nil {
+				return nil, err
+			}
+		}
+		if last != nil {
+			reset, err := total.SubtractCounter(last)
+			if err != nil {
+				return nil, err
+			}
+			if reset {
+				st.last[key] = val.Clone()
+				if total, err = sum(st.last); err != nil {
+					return nil, err
+				}
+			}
+		}
+	} else {
+		delta := val.Clone()
+		if last != nil {
+			if reset, err := delta.SubtractCounter(last); err != nil {
+				return nil, err
+			} else if reset {
+				delta = val.Clone()
+			}
+		}
+		total = delta
+		if st.total != nil {
+			total = st.total.Clone()
+			if err := total.Add(delta); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	st.last[key] = val.Clone()
+	st.total = total
+	return st.total, nil
+}
+
+// Apply runs the given EventMetrics through the guard. It returns the
+// EventMetrics containing the metrics that are within the budget (nil if
+// there are none), and the overflow EventMetrics for the metrics that went
+// over the budget. Overflow EventMetrics are labeled with the overflow label
+// and overflowKeepLabels only: first one has the aggregated values of all the
+// series folded into the overflow series (see overflowState), and is left out
+// if no value could be aggregated; second one has the overflow counts in the
+// OverflowCountMetricName metric, and is always CUMULATIVE.
+func (g *Guard) Apply(em *metrics.EventMetrics) (*metrics.EventMetrics, []*metrics.EventMetrics) {
+	if g == nil {
+		return em, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.maybeReset()
+
+	key := seriesKey(em)
+	var kept []string
+	overflowEM := withOverflowLabels(newEMLike(em), em)
+	overflowKey := seriesKey(overflowEM)
+	overflowCounts := metrics.NewMap("metric")
+	for _, name := range em.MetricsKeys() {
+		seen := g.series[name]
+		if seen == nil {
+			seen = make(map[string]bool)
+			g.series[name] = seen
+		}
+		if seen[key] || len(seen) < g.maxSeries {
+			seen[key] = true
+			kept = append(kept, name)
+			continue
+		}
+		st := g.overflow[name+"|"+overflowKey]
+		if st == nil {
+			g.l.Warningf("Metric %s went over the series budget (%d), folding new series into the overflow series (%s)", name, g.maxSeries, overflowKey)
+			st = &overflowState{metricName: name, last: make(map[string]metrics.Value)}
+			g.overflow[name+"|"+overflowKey] = st
+		}
+		st.count++
+		overflowCounts.IncKeyBy(name, st.count)
+
+		total, err := st.fold(key, em.Metric(name), em.Kind, g.maxSeries)
+		if err != nil {
+			if !st.foldErrLogged {
+				g.l.Warningf("Metric %s: error aggregating the overflow series (%s), dropping it: %v", name, overflowKey, err)
+				st.foldErrLogged = true
+			}
+			continue
+		}
+		if total != nil {
+			overflowEM.AddMetric(name, total.Clone())
+		}
+	}
+
+	if len(overflowCounts.Keys()) == 0 {
+		return em, nil
+	}
+
+	var overflowEMs []*metrics.EventMetrics
+	if len(overflowEM.MetricsKeys()) != 0 {
+		overflowEMs = append(overflowEMs, overflowEM)
+	}
+	countEM := withOverflowLabels(metrics.NewEventMetrics(em.Timestamp), em).AddMetric(OverflowCountMetricName, overflowCounts)
+	countEM.Kind = metrics.CUMULATIVE
+	overflowEMs = append(overflowEMs, countEM)
+
+	if len(kept) == 0 {
+		return nil, overflowEMs
+	}
+
+	keptEM := newEMLike(em)
+	for _, k := range em.LabelsKeys() {
+		keptEM.AddLabel(k, em.Label(k))
+	}
+	for _, name := range kept {
+		keptEM.AddMetric(name, em.Metric(name))
+	}
+	return keptEM, overflowEMs
+}
+
+// OverflowCount returns the number of writes for the given metric that were
+// folded into the overflow series since the last reset, across all the
+// overflow series of the metric.
+func (g *Guard) OverflowCount(metricName string) int64 {
+	if g == nil {
+		return 0
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var count int64
+	for _, st := range g.overflow {
+		if st.metricName == metricName {
+			count += st.count
+		}
+	}
+	return count
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEM(dst string) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(10)).
+		AddLabel("ptype", "http").
+		AddLabel("probe", "p1").
+		AddLabel("dst", dst)
+}
+
+func TestNewNoLimit(t *testing.T) {
+	g, err := New(0, time.Hour, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, g)
+
+	em := testEM("t1")
+	kept, overflow := g.Apply(em)
+	assert.Equal(t, em, kept)
+	assert.Nil(t, overflow)
+}
+
+func TestNewInvalidResetInterval(t *testing.T) {
+	_, err := New(10, 0, nil)
+	assert.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	g, _ := New(2, time.Hour, nil)
+
+	for _, dst := range []string{"t1", "t2", "t1"} {
+		em := testEM(dst)
+		kept, overflow := g.Apply(em)
+		assert.Equal(t, em, kept, "dst: %s", dst)
+		assert.Nil(t, overflow, "dst: %s", dst)
+	}
+
+	// Overflow series aggregates the values of t3 and t4, and keeps the
+	// probe labels.
+	for i, dst := range []string{"t3", "t4"} {
+		kept, overflow := g.Apply(testEM(dst))
+		assert.Nil(t, kept, "dst: %s", dst)
+		assert.Len(t, overflow, 2)
+		for _, em := range overflow {
+			assert.Equal(t, []string{"ptype", "probe", OverflowLabel}, em.LabelsKeys())
+			assert.Equal(t, "p1", em.Label("probe"))
+			assert.Equal(t, "true", em.Label(OverflowLabel))
+		}
+		assert.Equal(t, int64(10*(i+1)), overflow[0].Metric("total").(*metrics.Int).Int64(), "dst: %s", dst)
+		assert.Equal(t, int64(i+1), overflow[1].Metric(OverflowCountMetricName).(*metrics.Map[int64]).GetKey("total"), "dst: %s", dst)
+	}
+	assert.Equal(t, int64(2), g.OverflowCount("total"))
+
+	// Known series are still allowed.
+	kept, overflow := g.Apply(testEM("t2"))
+	assert.NotNil(t, kept)
+	assert.Nil(t, overflow)
+}
+
+func TestApplyPartialOverflow(t *testing.T) {
+	g, _ := New(1, time.Hour, nil)
+
+	g.Apply(metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(1)).
+		AddLabel("dst", "t1"))
+
+	// "total" is over budget now, but "success" is not.
+	kept, overflow := g.Apply(metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(2)).
+		AddMetric("success", metrics.NewInt(1)).
+		AddLabel("dst", "t2"))
+
+	assert.Equal(t, []string{"success"}, kept.MetricsKeys())
+	assert.Equal(t, "t2", kept.Label("dst"))
+	assert.Len(t, overflow, 2)
+	assert.Equal(t, []string{"total"}, overflow[0].MetricsKeys())
+	assert.Equal(t, []string{OverflowCountMetricName}, overflow[1].MetricsKeys())
+	assert.Equal(t, int64(1), g.OverflowCount("total"))
+	assert.Equal(t, int64(0), g.OverflowCount("success"))
+}
+
+func TestReset(t *testing.T) {
+	now := time.Now()
+	g, _ := New(1, time.Minute, nil)
+	g.now = func() time.Time { return now }
+	g.reset()
+
+	g.Apply(testEM("t1"))
+	_, overflow := g.Apply(testEM("t2"))
+	assert.NotNil(t, overflow)
+	assert.Equal(t, int64(1), g.OverflowCount("total"))
+
+	// Not enough time has passed, t2 still overflows.
+	now = now.Add(30 * time.Second)
+	_, overflow = g.Apply(testEM("t2"))
+	assert.NotNil(t, overflow)
+
+	// After the reset interval, budget is available again.
+	now = now.Add(31 * time.Second)
+	kept, overflow := g.Apply(testEM("t2"))
+	assert.NotNil(t, kept)
+	assert.Nil(t, overflow)
+	assert.Equal(t, int64(0), g.OverflowCount("total"))
+}
+
+func TestOverflowAggregation(t *testing.T) {
+	newEM := func(kind metrics.Kind, dst string, v int64) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(v)).
+			AddLabel("dst", dst)
+		em.Kind = kind
+		return em
+	}
+
+	type write struct {
+		dst string
+		v   int64
+	}
+	tests := []struct {
+		name   string
+		kind   metrics.Kind
+		writes []write
+		want   []int64
+	}{
+		{
+			// Sum of the increments: t2 reset from 15 to 3 adds 3.
+			name:   "cumulative",
+			kind:   metrics.CUMULATIVE,
+			writes: []write{{"t2", 10}, {"t3", 5}, {"t2", 15}, {"t3", 7}, {"t2", 3}},
+			want:   []int64{10, 15, 20, 22, 25},
+		},
+		{
+			// Sum of the last values.
+			name:   "gauge",
+			kind:   metrics.GAUGE,
+			writes: []write{{"t2", 10}, {"t3", 5}, {"t2", 15}, {"t3", 7}, {"t2", 3}},
+			want:   []int64{10, 15, 20, 22, 10},
+		},
+		{
+			// Sum goes below t3's last value, and is re-computed.
+			name:   "gauge_negative",
+			kind:   metrics.GAUGE,
+			writes: []write{{"t2", -10}, {"t3", 5}, {"t3", 7}, {"t2", 3}},
+			want:   []int64{-10, -5, -3, 10},
+		},
+		{
+			// Only as many series as the budget are folded, t4 is only
+			// counted.
+			name:   "gauge_untracked",
+			kind:   metrics.GAUGE,
+			writes: []write{{"t2", 10}, {"t3", 5}, {"t4", 100}, {"t2", 15}},
+			want:   []int64{10, 15, 15, 20},
+		},
+		{
+			name:   "cumulative_untracked",
+			kind:   metrics.CUMULATIVE,
+			writes: []write{{"t2", 10}, {"t3", 5}, {"t4", 100}, {"t3", 7}},
+			want:   []int64{10, 15, 15, 17},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g, _ := New(2, time.Hour, nil)
+			g.Apply(newEM(test.kind, "t0", 1))
+			g.Apply(newEM(test.kind, "t1", 1))
+
+			var got []int64
+			for _, w := range test.writes {
+				_, overflow := g.Apply(newEM(test.kind, w.dst, w.v))
+				assert.Equal(t, test.kind, overflow[0].Kind)
+				got = append(got, overflow[0].Metric("total").(*metrics.Int).Int64())
+			}
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, int64(len(test.writes)), g.OverflowCount("total"))
+		})
+	}
+}
+
+func TestOverflowNotAggregatable(t *testing.T) {
+	var logBuf bytes.Buffer
+	g, _ := New(2, time.Hour, logger.New(logger.WithWriter(&logBuf)))
+	newEM := func(dst string) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("version", metrics.NewString("v1")).
+			AddLabel("dst", dst)
+		em.Kind = metrics.GAUGE
+		return em
+	}
+	for _, dst := range []string{"t1", "t2", "t3"} {
+		g.Apply(newEM(dst))
+	}
+
+	// Strings can't be summed, only the overflow count is written.
+	_, overflow := g.Apply(newEM("t4"))
+	assert.Len(t, overflow, 1)
+	assert.Equal(t, []string{OverflowCountMetricName}, overflow[0].MetricsKeys())
+	assert.Equal(t, int64(2), g.OverflowCount("version"))
+
+	// Aggregation error is logged once for the overflow series.
+	assert.Equal(t, 1, strings.Count(logBuf.String(), "error aggregating the overflow series"))
+}
+
+func TestOverflowCountKind(t *testing.T) {
+	g, _ := New(1, time.Hour, nil)
+	newEM := func(dst string) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("queue_depth", metrics.NewInt(5)).
+			AddLabel("dst", dst)
+		em.Kind = metrics.GAUGE
+		return em
+	}
+	g.Apply(newEM("t1"))
+
+	// Folded values keep the GAUGE kind, overflow count is CUMULATIVE.
+	_, overflow := g.Apply(newEM("t2"))
+	assert.Len(t, overflow, 2)
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), overflow[0].Kind)
+	assert.Equal(t, metrics.Kind(metrics.CUMULATIVE), overflow[1].Kind)
+	assert.Equal(t, []string{OverflowLabel}, overflow[1].LabelsKeys())
+}
+
+func TestOverflowPerProbe(t *testing.T) {
+	g, _ := New(2, time.Hour, nil)
+	newEM := func(probe, dst string, v int64) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(v)).
+			AddLabel("probe", probe).
+			AddLabel("dst", dst)
+	}
+	g.Apply(newEM("p1", "t0", 1))
+	g.Apply(newEM("p1", "t1", 1))
+
+	// Each probe gets its own overflow series.
+	for _, w := range []struct {
+		probe, dst string
+		v, want    int64
+	}{
+		{"p1", "t2", 10, 10},
+		{"p2", "t2", 5, 5},
+		{"p1", "t3", 3, 13},
+	} {
+		_, overflow := g.Apply(newEM(w.probe, w.dst, w.v))
+		assert.Equal(t, w.probe, overflow[0].Label("probe"))
+		assert.Equal(t, w.want, overflow[0].Metric("total").(*metrics.Int).Int64(), "probe: %s, dst: %s", w.probe, w.dst)
+	}
+	assert.Equal(t, int64(3), g.OverflowCount("total"))
+}
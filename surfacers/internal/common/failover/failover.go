@@ -0,0 +1,156 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package failover implements health based selection of an endpoint from a
+// list of endpoints, for surfacers that publish to remote services. It fails
+// over to the next endpoint on sustained errors, and fails back to the
+// primary endpoint once it recovers.
+package failover
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// Selector selects the endpoint to send requests to. First endpoint is the
+// primary endpoint, rest of the endpoints are used in order if primary
+// endpoint keeps failing.
+type Selector struct {
+	endpoints        []string
+	failureThreshold int
+	failbackInterval time.Duration
+	l                *logger.Logger
+
+	mu                  sync.Mutex
+	active              int
+	consecutiveFailures int
+	// Last time we failed over or tried the primary endpoint.
+	lastPrimaryTry time.Time
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+// New returns a new Selector for the given endpoints. We fail over to the
+// next endpoint after failureThreshold consecutive failures. While on a
+// non-primary endpoint, primary endpoint is retried every failbackInterval.
+func New(endpoints []string, failureThreshold int, failbackInterval time.Duration, l *logger.Logger) (*Selector, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("failover: no endpoints given")
+	}
+	if failureThreshold <= 0 {
+		return nil, fmt.Errorf("failover: failure threshold should be positive, got %d", failureThreshold)
+	}
+	if failbackInterval <= 0 {
+		return nil, fmt.Errorf("failover: failback interval should be positive, got %s", failbackInterval)
+	}
+
+	return &Selector{
+		endpoints:        endpoints,
+		failureThreshold: failureThreshold,
+		failbackInterval: failbackInterval,
+		l:                l,
+		now:              time.Now,
+	}, nil
+}
+
+// Endpoint returns the index and address of the endpoint to use for the next
+// request. Result of the request should be reported back using Report.
+func (s *Selector) Endpoint() (int, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active != 0 {
+		if now := s.now(); now.Sub(s.lastPrimaryTry) >= s.failbackInterval {
+			s.lastPrimaryTry = now
+			return 0, s.endpoints[0]
+		}
+	}
+	return s.active, s.endpoints[s.active]
+}
+
+// Report records the result of a request sent to the endpoint at index i.
+func (s *Selector) Report(i int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Primary endpoint tried while on a failover endpoint.
+	if i == 0 && s.active != 0 {
+		if err == nil {
+			s.l.Infof("Primary endpoint %s has recovered, failing back to it from %s", s.endpoints[0], s.endpoints[s.active])
+			s.active, s.consecutiveFailures = 0, 0
+		}
+		return
+	}
+
+	// Ignore results from endpoints that are not active anymore.
+	if i != s.active {
+		return
+	}
+
+	if err == nil {
+		s.consecutiveFailures = 0
+		return
+	}
+
+	s.consecutiveFailures++
+	if s.consecutiveFailures < s.failureThreshold || len(s.endpoints) == 1 {
+		return
+	}
+
+	next := (s.active + 1) % len(s.endpoints)
+	s.l.Warningf("Endpoint %s failed %d times in a row (last error: %v), failing over to %s", s.endpoints[s.active], s.consecutiveFailures, err, s.endpoints[next])
+	s.active, s.consecutiveFailures = next, 0
+	s.lastPrimaryTry = s.now()
+}
+
+// Active returns the currently active endpoint.
+func (s *Selector) Active() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.endpoints[s.active]
+}
+
+// EventMetrics returns a GAUGE EventMetrics with the
+// surfacer_active_endpoint map metric, which is 1 for the active endpoint
+// and 0 for the others.
+func (s *Selector) EventMetrics(ts time.Time) *metrics.EventMetrics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := metrics.NewMap("endpoint")
+	for i, ep := range s.endpoints {
+		var v int64
+		if i == s.active {
+			v = 1
+		}
+		m.IncKeyBy(ep, v)
+	}
+
+	em := metrics.NewEventMetrics(ts).AddMetric("surfacer_active_endpoint", m)
+	em.Kind = metrics.GAUGE
+	return em
+}
+
+// SetClockForTest sets the function used to get the current time. It's meant
+// to be used only in tests.
+func (s *Selector) SetClockForTest(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
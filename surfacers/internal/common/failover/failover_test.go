@@ -0,0 +1,117 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package failover
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewErrors(t *testing.T) {
+	l := &logger.Logger{}
+	_, err := New(nil, 3, time.Minute, l)
+	assert.Error(t, err, "no endpoints")
+	_, err = New([]string{"a"}, 0, time.Minute, l)
+	assert.Error(t, err, "zero threshold")
+	_, err = New([]string{"a"}, 3, 0, l)
+	assert.Error(t, err, "zero failback interval")
+}
+
+func TestFailoverAndFailback(t *testing.T) {
+	s, err := New([]string{"primary", "secondary"}, 2, time.Minute, &logger.Logger{})
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.SetClockForTest(func() time.Time { return now })
+
+	errFailed := errors.New("failed")
+	send := func(fail map[string]bool) string {
+		i, ep := s.Endpoint()
+		if fail[ep] {
+			s.Report(i, errFailed)
+		} else {
+			s.Report(i, nil)
+		}
+		return ep
+	}
+
+	primaryDown := map[string]bool{"primary": true}
+
+	// A single failure doesn't trigger failover.
+	assert.Equal(t, "primary", send(primaryDown))
+	assert.Equal(t, "primary", send(nil))
+	assert.Equal(t, "primary", send(primaryDown))
+	assert.Equal(t, "primary", s.Active(), "after non-consecutive failures")
+
+	// Sustained failures trigger failover.
+	assert.Equal(t, "primary", send(primaryDown))
+	assert.Equal(t, "secondary", s.Active(), "after consecutive failures")
+	assert.Equal(t, "secondary", send(primaryDown))
+
+	// Primary is retried after the failback interval, but it's still down.
+	now = now.Add(time.Minute)
+	assert.Equal(t, "primary", send(primaryDown))
+	assert.Equal(t, "secondary", s.Active(), "after failed primary retry")
+	assert.Equal(t, "secondary", send(primaryDown), "before failback interval")
+
+	// Primary recovers, we fail back on the next retry.
+	now = now.Add(time.Minute)
+	assert.Equal(t, "primary", send(nil))
+	assert.Equal(t, "primary", s.Active(), "after primary recovery")
+	assert.Equal(t, "primary", send(nil))
+}
+
+func TestFailoverWrapsAround(t *testing.T) {
+	s, err := New([]string{"a", "b", "c"}, 1, time.Hour, &logger.Logger{})
+	require.NoError(t, err)
+
+	for _, want := range []string{"b", "c", "a"} {
+		i, _ := s.Endpoint()
+		s.Report(i, errors.New("failed"))
+		assert.Equal(t, want, s.Active())
+	}
+}
+
+func TestSingleEndpoint(t *testing.T) {
+	s, err := New([]string{"a"}, 1, time.Hour, &logger.Logger{})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		idx, ep := s.Endpoint()
+		assert.Equal(t, "a", ep)
+		s.Report(idx, errors.New("failed"))
+	}
+	assert.Equal(t, "a", s.Active())
+}
+
+func TestEventMetrics(t *testing.T) {
+	s, err := New([]string{"primary", "secondary"}, 1, time.Hour, &logger.Logger{})
+	require.NoError(t, err)
+
+	em := s.EventMetrics(time.Now())
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), em.Kind)
+	assert.Equal(t, "map:endpoint,primary:1,secondary:0", em.Metric("surfacer_active_endpoint").String())
+
+	i, _ := s.Endpoint()
+	s.Report(i, errors.New("failed"))
+	em = s.EventMetrics(time.Now())
+	assert.Equal(t, "map:endpoint,primary:0,secondary:1", em.Metric("surfacer_active_endpoint").String())
+}
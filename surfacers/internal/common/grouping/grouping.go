@@ -0,0 +1,133 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grouping implements coalescing of all EventMetrics for a target,
+// received between two flushes, into a single EventMetrics.
+package grouping
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+type group struct {
+	ts          time.Time
+	kind        metrics.Kind
+	latencyUnit time.Duration
+	metricUnits map[string]string
+	labels      [][2]string
+	metricNames []string
+	metrics     map[string]metrics.Value
+}
+
+// Grouper groups EventMetrics by target. EventMetrics are considered to be
+// for the same target if they have the same kind and the same labels.
+// Keeping labels as part of the key makes sure that grouping is lossless:
+// EventMetrics with additional labels, e.g. per-edge metrics, form their own
+// groups.
+type Grouper struct {
+	mu     sync.Mutex
+	groups map[string]*group
+	// Group keys in the order they were first seen, to keep flush output
+	// deterministic.
+	keys []string
+}
+
+// New returns a new Grouper.
+func New() *Grouper {
+	return &Grouper{
+		groups: make(map[string]*group),
+	}
+}
+
+func groupKey(em *metrics.EventMetrics) string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(int(em.Kind)))
+	for _, k := range em.LabelsKeys() {
+		b.WriteString("," + k + "=" + em.Label(k))
+	}
+	return b.String()
+}
+
+// Add adds the EventMetrics to its target's group. If a metric is already
+// present in the group, e.g. from an earlier probe run, the newer value
+// replaces it.
+func (g *Grouper) Add(em *metrics.EventMetrics) {
+	key := groupKey(em)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	grp := g.groups[key]
+	if grp == nil {
+		grp = &group{
+			kind:    em.Kind,
+			metrics: make(map[string]metrics.Value),
+		}
+		for _, k := range em.LabelsKeys() {
+			grp.labels = append(grp.labels, [2]string{k, em.Label(k)})
+		}
+		g.groups[key] = grp
+		g.keys = append(g.keys, key)
+	}
+
+	if em.Timestamp.After(grp.ts) {
+		grp.ts = em.Timestamp
+	}
+	if em.LatencyUnit != 0 {
+		grp.latencyUnit = em.LatencyUnit
+	}
+	for name, unit := range em.MetricUnits {
+		if grp.metricUnits == nil {
+			grp.metricUnits = make(map[string]string)
+		}
+		grp.metricUnits[name] = unit
+	}
+	for _, name := range em.MetricsKeys() {
+		if _, ok := grp.metrics[name]; !ok {
+			grp.metricNames = append(grp.metricNames, name)
+		}
+		grp.metrics[name] = em.Metric(name)
+	}
+}
+
+// Flush returns one combined EventMetrics per group and resets the groups.
+func (g *Grouper) Flush() []*metrics.EventMetrics {
+	g.mu.Lock()
+	groups, keys := g.groups, g.keys
+	g.groups, g.keys = make(map[string]*group), nil
+	g.mu.Unlock()
+
+	var out []*metrics.EventMetrics
+	for _, key := range keys {
+		grp := groups[key]
+
+		em := metrics.NewEventMetrics(grp.ts)
+		em.Kind = grp.kind
+		em.LatencyUnit = grp.latencyUnit
+		em.MetricUnits = grp.metricUnits
+		for _, name := range grp.metricNames {
+			em.AddMetric(name, grp.metrics[name])
+		}
+		for _, label := range grp.labels {
+			em.AddLabel(label[0], label[1])
+		}
+		out = append(out, em)
+	}
+	return out
+}
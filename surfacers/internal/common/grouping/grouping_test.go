@@ -0,0 +1,79 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func testEM(ts time.Time, dst string, kv ...any) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(ts).
+		AddLabel("probe", "p1").
+		AddLabel("dst", dst)
+	for i := 0; i < len(kv); i += 2 {
+		em.AddMetric(kv[i].(string), metrics.NewInt(int64(kv[i+1].(int))))
+	}
+	return em
+}
+
+func TestGrouper(t *testing.T) {
+	ts := time.Now()
+	g := New()
+
+	g.Add(testEM(ts, "t1", "total", 10, "success", 9))
+	g.Add(testEM(ts, "t2", "total", 5))
+	g.Add(testEM(ts.Add(time.Second), "t1", "total", 11, "timeouts", 1))
+	g.Add(testEM(ts, "t1", "resp_size", 100).AddLabel("code", "200"))
+
+	gaugeEM := testEM(ts, "t1", "ssl_expiry", 3600)
+	gaugeEM.Kind = metrics.GAUGE
+	g.Add(gaugeEM)
+
+	var got []string
+	for _, em := range g.Flush() {
+		got = append(got, em.String())
+	}
+	want := []string{
+		testEM(ts.Add(time.Second), "t1", "total", 11, "success", 9, "timeouts", 1).String(),
+		testEM(ts, "t2", "total", 5).String(),
+		testEM(ts, "t1", "resp_size", 100).AddLabel("code", "200").String(),
+		testEM(ts, "t1", "ssl_expiry", 3600).String(),
+	}
+	assert.Equal(t, want, got)
+
+	assert.Empty(t, g.Flush(), "flush after flush")
+}
+
+func TestGrouperKeepsKind(t *testing.T) {
+	g := New()
+
+	em := testEM(time.Now(), "t1", "total", 1)
+	em.LatencyUnit = time.Millisecond
+	g.Add(em)
+
+	gaugeEM := testEM(time.Now(), "t1", "queue_depth", 5)
+	gaugeEM.Kind = metrics.GAUGE
+	g.Add(gaugeEM)
+
+	out := g.Flush()
+	assert.Len(t, out, 2)
+	assert.Equal(t, metrics.Kind(metrics.CUMULATIVE), out[0].Kind)
+	assert.Equal(t, time.Millisecond, out[0].LatencyUnit)
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), out[1].Kind)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+// bufferSizeRule is a compiled metrics_buffer_size_rule.
+type bufferSizeRule struct {
+	re   *regexp.Regexp
+	size int
+}
+
+func parseBufferSizeRules(configs []*surfacerpb.MetricsBufferSizeRule) ([]*bufferSizeRule, error) {
+	var rules []*bufferSizeRule
+	for _, c := range configs {
+		re, err := regexp.Compile(c.GetMetricNameRegex())
+		if err != nil {
+			return nil, &OptionsError{Field: "metrics_buffer_size_rule.metric_name_regex", Value: c.GetMetricNameRegex(), Err: err}
+		}
+		if c.GetSize() <= 0 {
+			return nil, newOptionsError("metrics_buffer_size_rule.size", strconv.Itoa(int(c.GetSize())), fmt.Sprintf("should be positive (metric_name_regex: %s)", c.GetMetricNameRegex()))
+		}
+		rules = append(rules, &bufferSizeRule{re: re, size: int(c.GetSize())})
+	}
+	return rules, nil
+}
+
+// BufferSizeFor returns the buffer size for the metrics of the given name:
+// the size of the first matching metrics_buffer_size_rule, or
+// MetricsBufferSize if none matches. Lookups are cached by metric name, so
+// it's cheap to call on the hot path. Surfacers enforce it on their
+// EventMetrics buffers through MetricsBufferUsage.
+func (opts *Options) BufferSizeFor(metricName string) int {
+	if opts == nil {
+		return int((&surfacerpb.SurfacerDef{}).GetMetricsBufferSize())
+	}
+	if len(opts.bufferSizeRules) == 0 {
+		return opts.MetricsBufferSize
+	}
+	if size, ok := opts.bufferSizes.Load(metricName); ok {
+		return size.(int)
+	}
+
+	size := opts.MetricsBufferSize
+	for _, r := range opts.bufferSizeRules {
+		if r.re.MatchString(metricName) {
+			size = r.size
+			break
+		}
+	}
+	opts.bufferSizes.Store(metricName, size)
+	return size
+}
+
+// MetricsBufferCapacity returns the capacity for a surfacer's EventMetrics
+// buffer: MetricsBufferSize, or the largest metrics_buffer_size_rule size if
+// that's bigger.
+func (opts *Options) MetricsBufferCapacity() int {
+	size := opts.MetricsBufferSize
+	for _, r := range opts.bufferSizeRules {
+		if r.size > size {
+			size = r.size
+		}
+	}
+	return size
+}
+
+// MetricsBufferUsage keeps track of the EventMetrics in a surfacer's buffer by
+// metric name, so that the EventMetrics of a metric name don't take more than
+// BufferSizeFor of the buffer. An EventMetrics counts towards the usage of
+// each of its metric names.
+type MetricsBufferUsage struct {
+	opts   *Options
+	mu     sync.Mutex
+	queued map[string]int
+}
+
+// NewMetricsBufferUsage returns a new MetricsBufferUsage. It returns nil if
+// there are no metrics_buffer_size_rule, as the buffer's capacity is the
+// only limit in that case; MetricsBufferUsage methods are no-op for nil.
+func (opts *Options) NewMetricsBufferUsage() *MetricsBufferUsage {
+	if opts == nil || len(opts.bufferSizeRules) == 0 {
+		return nil
+	}
+	return &MetricsBufferUsage{opts: opts, queued: make(map[string]int)}
+}
+
+// Reserve reserves the buffer space for the given EventMetrics. It returns an
+// error if the buffer is full for any of its metric names, in which case
+// nothing is reserved.
+func (u *MetricsBufferUsage) Reserve(em *metrics.EventMetrics) error {
+	if u == nil {
+		return nil
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	names := em.MetricsKeys()
+	for _, name := range names {
+		if size := u.opts.BufferSizeFor(name); u.queued[name] >= size {
+			return fmt.Errorf("write channel is full for metric %s (size: %d)", name, size)
+		}
+	}
+	for _, name := range names {
+		u.queued[name]++
+	}
+	return nil
+}
+
+// Release releases the buffer space reserved for the given EventMetrics,
+// once it's taken out of the buffer.
+func (u *MetricsBufferUsage) Release(em *metrics.EventMetrics) {
+	if u == nil {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for _, name := range em.MetricsKeys() {
+		if u.queued[name]--; u.queued[name] <= 0 {
+			delete(u.queued, name)
+		}
+	}
+}
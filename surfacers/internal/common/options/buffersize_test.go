@@ -0,0 +1,178 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testBufferSizeRule(re string, size int64) *configpb.MetricsBufferSizeRule {
+	return &configpb.MetricsBufferSizeRule{
+		MetricNameRegex: proto.String(re),
+		Size:            proto.Int64(size),
+	}
+}
+
+func TestBufferSizeFor(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []*configpb.MetricsBufferSizeRule
+		want  map[string]int
+	}{
+		{
+			name: "no_rules",
+			want: map[string]int{
+				"http_latency": 10000,
+				"uptime":       10000,
+			},
+		},
+		{
+			name: "overlapping_rules_first_match_wins",
+			rules: []*configpb.MetricsBufferSizeRule{
+				testBufferSizeRule("^http_.*latency$", 100000),
+				testBufferSizeRule("latency", 50000),
+				testBufferSizeRule("^uptime", 100),
+			},
+			want: map[string]int{
+				"http_latency":     100000,
+				"http_dns_latency": 100000,
+				"dns_latency":      50000,
+				"uptime":           100,
+				"uptime_msec":      100,
+				"total":            10000,
+			},
+		},
+		{
+			name: "broad_rule_first_shadows_specific",
+			rules: []*configpb.MetricsBufferSizeRule{
+				testBufferSizeRule("latency", 50000),
+				testBufferSizeRule("^http_.*latency$", 100000),
+			},
+			want: map[string]int{
+				"http_latency": 50000,
+				"total":        10000,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := buildOptions(&configpb.SurfacerDef{MetricsBufferSizeRule: test.rules}, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error: %v", err)
+			}
+			// Twice, to go through the cached lookups as well.
+			for i := 0; i < 2; i++ {
+				for name, want := range test.want {
+					assert.Equal(t, want, opts.BufferSizeFor(name), "BufferSizeFor(%s), lookup #%d", name, i)
+				}
+			}
+		})
+	}
+}
+
+func TestBufferSizeForDefault(t *testing.T) {
+	// Options built without the config, e.g. in the surfacer tests.
+	opts := &Options{MetricsBufferSize: 1000}
+	assert.Equal(t, 1000, opts.BufferSizeFor("latency"))
+
+	// nil Options get the metrics_buffer_size default.
+	assert.Equal(t, 10000, (*Options)(nil).BufferSizeFor("latency"))
+
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		MetricsBufferSize:     proto.Int64(500),
+		MetricsBufferSizeRule: []*configpb.MetricsBufferSizeRule{testBufferSizeRule("^latency$", 5000)},
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error: %v", err)
+	}
+	assert.Equal(t, 5000, opts.BufferSizeFor("latency"))
+	assert.Equal(t, 500, opts.BufferSizeFor("latency_p99"))
+}
+
+func TestBufferSizeRuleErrors(t *testing.T) {
+	for name, rule := range map[string]*configpb.MetricsBufferSizeRule{
+		"bad_regex":     testBufferSizeRule("(", 100),
+		"zero_size":     testBufferSizeRule("latency", 0),
+		"negative_size": testBufferSizeRule("latency", -1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := buildOptions(&configpb.SurfacerDef{MetricsBufferSizeRule: []*configpb.MetricsBufferSizeRule{rule}}, true, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func BenchmarkBufferSizeFor(b *testing.B) {
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		MetricsBufferSizeRule: []*configpb.MetricsBufferSizeRule{
+			testBufferSizeRule("^http_.*latency$", 100000),
+			testBufferSizeRule("^uptime", 100),
+		},
+	}, true, nil)
+	if err != nil {
+		b.Fatalf("buildOptions() error: %v", err)
+	}
+	for i := 0; i < b.N; i++ {
+		opts.BufferSizeFor("http_latency")
+	}
+}
+
+func TestMetricsBufferUsage(t *testing.T) {
+	// No rules: buffer capacity is the only limit.
+	opts := &Options{MetricsBufferSize: 1000}
+	assert.Equal(t, 1000, opts.MetricsBufferCapacity())
+	assert.Nil(t, opts.NewMetricsBufferUsage())
+
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		MetricsBufferSize:     proto.Int64(5),
+		MetricsBufferSizeRule: []*configpb.MetricsBufferSizeRule{testBufferSizeRule("^uptime$", 2), testBufferSizeRule("^latency$", 10)},
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error: %v", err)
+	}
+	assert.Equal(t, 10, opts.MetricsBufferCapacity())
+
+	u := opts.NewMetricsBufferUsage()
+	uptimeEM := metrics.NewEventMetrics(time.Now()).AddMetric("uptime", metrics.NewInt(1))
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, u.Reserve(uptimeEM), "reserve #%d", i)
+	}
+	assert.Error(t, u.Reserve(uptimeEM))
+
+	// EventMetrics with uptime can't be reserved either, and nothing is
+	// reserved for its other metrics.
+	bothEM := metrics.NewEventMetrics(time.Now()).AddMetric("latency", metrics.NewFloat(1)).AddMetric("uptime", metrics.NewInt(1))
+	assert.Error(t, u.Reserve(bothEM))
+	assert.Equal(t, map[string]int{"uptime": 2}, u.queued)
+
+	u.Release(uptimeEM)
+	assert.NoError(t, u.Reserve(bothEM))
+	assert.Equal(t, map[string]int{"uptime": 2, "latency": 1}, u.queued)
+
+	u.Release(bothEM)
+	assert.Equal(t, map[string]int{"uptime": 1}, u.queued)
+
+	// nil MetricsBufferUsage is no-op.
+	var nilUsage *MetricsBufferUsage
+	assert.NoError(t, nilUsage.Reserve(uptimeEM))
+	nilUsage.Release(uptimeEM)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"sync"
+
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	filterBundlesMu sync.RWMutex
+	filterBundles   map[string]*surfacerpb.FilterBundle
+)
+
+// SetFilterBundles sets the filter bundles that surfacers can refer to
+// through the filter_bundle option. It replaces the previously set bundles.
+func SetFilterBundles(bundles []*surfacerpb.FilterBundle) error {
+	m := make(map[string]*surfacerpb.FilterBundle)
+	for _, b := range bundles {
+		if b.GetName() == "" {
+			return fmt.Errorf("filter bundle without a name: %v", b)
+		}
+		if m[b.GetName()] != nil {
+			return fmt.Errorf("duplicate filter bundle: %s", b.GetName())
+		}
+		m[b.GetName()] = b
+	}
+
+	filterBundlesMu.Lock()
+	defer filterBundlesMu.Unlock()
+	filterBundles = m
+	return nil
+}
+
+// orRegex returns a regex matching either of the given regexes.
+func orRegex(re1, re2 string) string {
+	if re1 == "" {
+		return re2
+	}
+	if re2 == "" {
+		return re1
+	}
+	return "(?:" + re1 + ")|(?:" + re2 + ")"
+}
+
+// expandFilterBundles returns the config with the filters of the referenced
+// filter bundles merged into its own filters. If no bundle is referenced,
+// config is returned as it is.
+func expandFilterBundles(sdef *surfacerpb.SurfacerDef) (*surfacerpb.SurfacerDef, error) {
+	if len(sdef.GetFilterBundle()) == 0 {
+		return sdef, nil
+	}
+
+	filterBundlesMu.RLock()
+	defer filterBundlesMu.RUnlock()
+
+	sdef = proto.Clone(sdef).(*surfacerpb.SurfacerDef)
+	for _, name := range sdef.GetFilterBundle() {
+		b := filterBundles[name]
+		if b == nil {
+			return nil, newOptionsError("filter_bundle", name, "unknown filter bundle")
+		}
+		sdef.AllowMetricsWithLabel = append(sdef.AllowMetricsWithLabel, b.GetAllowMetricsWithLabel()...)
+		sdef.IgnoreMetricsWithLabel = append(sdef.IgnoreMetricsWithLabel, b.GetIgnoreMetricsWithLabel()...)
+		if re := orRegex(sdef.GetAllowMetricsWithName(), b.GetAllowMetricsWithName()); re != "" {
+			sdef.AllowMetricsWithName = proto.String(re)
+		}
+		if re := orRegex(sdef.GetIgnoreMetricsWithName(), b.GetIgnoreMetricsWithName()); re != "" {
+			sdef.IgnoreMetricsWithName = proto.String(re)
+		}
+	}
+	sdef.FilterBundle = nil
+	return sdef, nil
+}
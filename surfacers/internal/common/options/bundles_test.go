@@ -0,0 +1,161 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func setTestFilterBundles(t *testing.T) {
+	t.Helper()
+
+	err := SetFilterBundles([]*configpb.FilterBundle{
+		{
+			Name: proto.String("no-sysvars"),
+			IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("probe"), Value: proto.String("sysvars")},
+			},
+			IgnoreMetricsWithName: proto.String("^resp-body$"),
+		},
+		{
+			Name: proto.String("prod-only"),
+			AllowMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("env"), Value: proto.String("prod")},
+			},
+			AllowMetricsWithName: proto.String("^(total|success)$"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetFilterBundles() error = %v", err)
+	}
+	t.Cleanup(func() { SetFilterBundles(nil) })
+}
+
+func TestFilterBundles(t *testing.T) {
+	setTestFilterBundles(t)
+
+	ems := map[string]*metrics.EventMetrics{
+		"sysvars": metrics.NewEventMetrics(time.Now()).AddLabel("probe", "sysvars").AddLabel("env", "prod"),
+		"prod":    metrics.NewEventMetrics(time.Now()).AddLabel("probe", "http").AddLabel("env", "prod"),
+		"dev":     metrics.NewEventMetrics(time.Now()).AddLabel("probe", "http").AddLabel("env", "dev"),
+		"staging": metrics.NewEventMetrics(time.Now()).AddLabel("probe", "http").AddLabel("env", "staging"),
+	}
+	names := []string{"total", "success", "latency", "resp-body", "timeouts"}
+
+	tests := []struct {
+		name         string
+		sdef         *configpb.SurfacerDef
+		wantAllowed  map[string]bool
+		wantNames    []bool
+		wantFilters  *configpb.SurfacerDef
+		wantParseErr bool
+	}{
+		{
+			name:        "one_bundle",
+			sdef:        &configpb.SurfacerDef{FilterBundle: []string{"no-sysvars"}},
+			wantAllowed: map[string]bool{"sysvars": false, "prod": true, "dev": true, "staging": true},
+			wantNames:   []bool{true, true, true, false, true},
+			wantFilters: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("probe"), Value: proto.String("sysvars")},
+				},
+				IgnoreMetricsWithName: proto.String("^resp-body$"),
+			},
+		},
+		{
+			name: "bundles_and_own_filters",
+			sdef: &configpb.SurfacerDef{
+				FilterBundle: []string{"no-sysvars", "prod-only"},
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("env"), Value: proto.String("staging")},
+				},
+				AllowMetricsWithName: proto.String("^latency$"),
+			},
+			wantAllowed: map[string]bool{"sysvars": false, "prod": true, "dev": false, "staging": true},
+			wantNames:   []bool{true, true, true, false, false},
+			wantFilters: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("env"), Value: proto.String("staging")},
+					{Key: proto.String("env"), Value: proto.String("prod")},
+				},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("probe"), Value: proto.String("sysvars")},
+				},
+				AllowMetricsWithName:  proto.String("(?:^latency$)|(?:^(total|success)$)"),
+				IgnoreMetricsWithName: proto.String("^resp-body$"),
+			},
+		},
+		{
+			name:         "unknown_bundle",
+			sdef:         &configpb.SurfacerDef{FilterBundle: []string{"no-sysvars", "prod_only"}},
+			wantParseErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if tt.wantParseErr {
+				assert.ErrorContains(t, err, "invalid filter_bundle (prod_only): unknown filter bundle")
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			for name, em := range ems {
+				assert.Equal(t, tt.wantAllowed[name], opts.AllowEventMetrics(em), "EventMetrics: %s", name)
+			}
+			for i, name := range names {
+				assert.Equal(t, tt.wantNames[i], opts.AllowMetric(name), "metric: %s", name)
+			}
+			assert.True(t, proto.Equal(tt.wantFilters, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+			assert.Empty(t, opts.EffectiveConfig().GetFilterBundle(), "filter_bundle in the effective config")
+
+			// Input config should not be modified.
+			assert.Len(t, tt.sdef.GetIgnoreMetricsWithLabel(), 0)
+		})
+	}
+}
+
+func TestFilterBundlesReload(t *testing.T) {
+	setTestFilterBundles(t)
+
+	emSysvars := metrics.NewEventMetrics(time.Now()).AddLabel("probe", "sysvars")
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{})
+	assert.True(t, opts.AllowEventMetrics(emSysvars), "sysvars before update")
+
+	assert.Error(t, opts.Reload(&configpb.SurfacerDef{FilterBundle: []string{"unknown"}}))
+	assert.True(t, opts.AllowEventMetrics(emSysvars), "sysvars after failed update")
+
+	assert.NoError(t, opts.Reload(&configpb.SurfacerDef{FilterBundle: []string{"no-sysvars"}}))
+	assert.False(t, opts.AllowEventMetrics(emSysvars), "sysvars after update")
+}
+
+func TestSetFilterBundlesErrors(t *testing.T) {
+	defer SetFilterBundles(nil)
+
+	assert.Error(t, SetFilterBundles([]*configpb.FilterBundle{{Name: proto.String("")}}), "no name")
+	assert.Error(t, SetFilterBundles([]*configpb.FilterBundle{
+		{Name: proto.String("b1")},
+		{Name: proto.String("b1")},
+	}), "duplicate name")
+}
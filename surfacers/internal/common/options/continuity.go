@@ -0,0 +1,140 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// continuityState is the counter continuity state of a series.
+type continuityState struct {
+	// Last value received from the probe, and last value surfaced.
+	lastRaw, lastSurfaced metrics.Value
+	// Base that's added to the received values, nil if the series hasn't
+	// been restarted.
+	offset   metrics.Value
+	lastSeen time.Time
+}
+
+// counterContinuity carries forward the counter values across probe
+// restarts.
+type counterContinuity struct {
+	maxGap time.Duration
+
+	mu        sync.Mutex
+	series    map[string]*continuityState
+	lastSweep time.Time
+}
+
+func newCounterContinuity(maxGap time.Duration) *counterContinuity {
+	return &counterContinuity{
+		maxGap: maxGap,
+		series: make(map[string]*continuityState),
+	}
+}
+
+// expire forgets the series that haven't been seen for longer than maxGap.
+// To keep it cheap, it goes over the series at most once every maxGap.
+func (cc *counterContinuity) expire(now time.Time) {
+	if now.Sub(cc.lastSweep) < cc.maxGap {
+		return
+	}
+	cc.lastSweep = now
+	for key, st := range cc.series {
+		if now.Sub(st.lastSeen) > cc.maxGap {
+			delete(cc.series, key)
+		}
+	}
+}
+
+// isReset returns true if val is lower than the last value, i.e. the
+// counter has been reset.
+func isReset(val, last metrics.Value) bool {
+	reset, err := val.Clone().SubtractCounter(last)
+	return err == nil && reset
+}
+
+// apply returns the value to surface for the given series.
+func (cc *counterContinuity) apply(key string, val metrics.Value, ts time.Time) metrics.Value {
+	st := cc.series[key]
+	if st == nil {
+		st = &continuityState{}
+		cc.series[key] = st
+	} else if isReset(val, st.lastRaw) {
+		if ts.Sub(st.lastSeen) <= cc.maxGap {
+			// Restart: continue from where we left.
+			st.offset = st.lastSurfaced
+		} else {
+			// Genuine reset: the series has been gone for too long.
+			st.offset = nil
+		}
+	}
+	st.lastRaw, st.lastSeen = val.Clone(), ts
+
+	out := val
+	if st.offset != nil {
+		out = st.offset.Clone()
+		if err := out.Add(val); err != nil {
+			// Incompatible values, e.g. distribution buckets have changed.
+			// Start over from the received value.
+			out, st.offset = val, nil
+		}
+	}
+	st.lastSurfaced = out.Clone()
+	return out
+}
+
+// CounterContinuity returns the EventMetrics with the counter values carried
+// forward across the probe restarts, as per the counter_continuity config.
+// Only CUMULATIVE EventMetrics are considered. Input EventMetrics is not
+// modified; if no value needs adjustment, it's returned as it is.
+func (opts *Options) CounterContinuity(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.counterContinuity == nil || em.Kind != metrics.CUMULATIVE {
+		return em
+	}
+	cc := opts.counterContinuity
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	cc.expire(em.Timestamp)
+
+	metricsKeys := em.MetricsKeys()
+	values := make([]metrics.Value, len(metricsKeys))
+	changed := false
+	for i, name := range metricsKeys {
+		val := em.Metric(name)
+		values[i] = val
+		if _, ok := val.(metrics.String); ok {
+			continue
+		}
+		if values[i] = cc.apply(seriesKey(em, name), val, em.Timestamp); values[i] != val {
+			changed = true
+		}
+	}
+
+	if !changed {
+		return em
+	}
+
+	newEM := emWith(em, nil)
+	for i, name := range metricsKeys {
+		newEM.AddMetric(name, values[i])
+	}
+	return newEM
+}
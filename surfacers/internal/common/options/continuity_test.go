@@ -0,0 +1,113 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCounterContinuity(t *testing.T) {
+	start := time.Now()
+
+	type step struct {
+		dst   string
+		sec   int
+		total int64
+		codes map[string]int64
+	}
+	steps := []step{
+		{sec: 0, total: 10, codes: map[string]int64{"200": 10}},
+		{sec: 10, total: 20, codes: map[string]int64{"200": 18, "500": 2}},
+		// Probe restart.
+		{sec: 20, total: 2, codes: map[string]int64{"200": 2}},
+		{sec: 30, total: 5, codes: map[string]int64{"200": 4, "500": 1}},
+		// Another restart.
+		{sec: 40, total: 1, codes: map[string]int64{"200": 1}},
+		// Series was gone for longer than max gap: genuine reset.
+		{sec: 200, total: 3, codes: map[string]int64{"200": 3}},
+		{sec: 210, total: 4, codes: map[string]int64{"200": 4}},
+	}
+
+	testEM := func(s step, kind metrics.Kind) *metrics.EventMetrics {
+		codes := metrics.NewMap("code")
+		for k, v := range s.codes {
+			codes.IncKeyBy(k, v)
+		}
+		em := metrics.NewEventMetrics(start.Add(time.Duration(s.sec)*time.Second)).
+			AddMetric("total", metrics.NewInt(s.total)).
+			AddMetric("resp_code", codes).
+			AddMetric("status", metrics.NewString("ok")).
+			AddLabel("dst", s.dst)
+		em.Kind = kind
+		return em
+	}
+
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		CounterContinuity: &configpb.CounterContinuity{MaxGapSec: proto.Int32(60)},
+	})
+
+	var gotTotal, gotCodes []string
+	for _, s := range steps {
+		s.dst = "t1"
+		em := testEM(s, metrics.CUMULATIVE)
+		out := opts.CounterContinuity(em)
+		gotTotal = append(gotTotal, out.Metric("total").String())
+		gotCodes = append(gotCodes, out.Metric("resp_code").String())
+		assert.Equal(t, `"ok"`, out.Metric("status").String())
+
+		// Input EventMetrics is never modified.
+		assert.Equal(t, s.total, em.Metric("total").(metrics.NumValue).Int64())
+	}
+	assert.Equal(t, []string{"10", "20", "22", "25", "26", "3", "4"}, gotTotal)
+	assert.Equal(t, []string{
+		"map:code,200:10",
+		"map:code,200:18,500:2",
+		"map:code,200:20,500:2",
+		"map:code,200:22,500:3",
+		"map:code,200:23,500:3",
+		"map:code,200:3",
+		"map:code,200:4",
+	}, gotCodes)
+
+	// Series are tracked independently.
+	em := testEM(step{dst: "t2", sec: 220, total: 1}, metrics.CUMULATIVE)
+	assert.Equal(t, "1", opts.CounterContinuity(em).Metric("total").String())
+
+	// GAUGE metrics are not touched.
+	em = testEM(step{sec: 230, total: 1}, metrics.GAUGE)
+	assert.Same(t, em, opts.CounterContinuity(em))
+
+	// Not configured.
+	em = testEM(steps[0], metrics.CUMULATIVE)
+	assert.Same(t, em, BuildOptionsForTest(&configpb.SurfacerDef{}).CounterContinuity(em))
+}
+
+func TestCounterContinuityExpire(t *testing.T) {
+	cc := newCounterContinuity(time.Minute)
+	start := time.Now()
+
+	cc.apply("s1", metrics.NewInt(1), start)
+	cc.expire(start)
+	cc.apply("s2", metrics.NewInt(1), start.Add(50*time.Second))
+	cc.expire(start.Add(90 * time.Second))
+	assert.Len(t, cc.series, 1)
+	assert.Contains(t, cc.series, "s2")
+}
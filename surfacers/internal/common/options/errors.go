@@ -0,0 +1,50 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OptionsError is the error returned by BuildOptionsFromConfig and Reload,
+// for an invalid config field.
+// Callers can use errors.As to find out the field at fault.
+type OptionsError struct {
+	// Field is the config field at fault, e.g. "allow_metrics_with_name".
+	// Nested fields are dot-separated, e.g. "counter_continuity.max_gap_sec".
+	Field string
+	// Value is the offending value, if there is one.
+	Value string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *OptionsError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("invalid %s: %v", e.Field, e.Err)
+	}
+	return fmt.Sprintf("invalid %s (%s): %v", e.Field, e.Value, e.Err)
+}
+
+func (e *OptionsError) Unwrap() error {
+	return e.Err
+}
+
+// newOptionsError returns an OptionsError for the field, with the error
+// built from the message.
+func newOptionsError(field, value, msg string) *OptionsError {
+	return &OptionsError{Field: field, Value: value, Err: errors.New(msg)}
+}
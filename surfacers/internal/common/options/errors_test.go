@@ -0,0 +1,128 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"errors"
+	"regexp/syntax"
+	"testing"
+
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOptionsError(t *testing.T) {
+	tests := []struct {
+		name      string
+		sdef      *configpb.SurfacerDef
+		wantField string
+		wantValue string
+		wantRegex bool
+	}{
+		{
+			name:      "allow_metrics_with_name",
+			sdef:      &configpb.SurfacerDef{AllowMetricsWithName: proto.String("(")},
+			wantField: "allow_metrics_with_name",
+			wantValue: "(",
+			wantRegex: true,
+		},
+		{
+			name: "disabled_ignore_metrics_with_name",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithName:        proto.String("[a"),
+				IgnoreMetricsWithNameEnabled: proto.Bool(false),
+			},
+			wantField: "ignore_metrics_with_name",
+			wantValue: "[a",
+			wantRegex: true,
+		},
+		{
+			name:      "latency_metric_pattern",
+			sdef:      &configpb.SurfacerDef{LatencyMetricPattern: proto.String("lat(")},
+			wantField: "latency_metric_pattern",
+			wantValue: "lat(",
+			wantRegex: true,
+		},
+		{
+			name: "label_filter_regex",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe"), ValueRegex: proto.String("(")}},
+			},
+			wantField: "ignore_metrics_with_label",
+			wantValue: "(",
+			wantRegex: true,
+		},
+		{
+			name: "label_filter_no_key",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{{Value: proto.String("v1")}},
+			},
+			wantField: "allow_metrics_with_label",
+			wantValue: "v1",
+		},
+		{
+			name: "name_filters_conflict",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("^latency$"),
+				IgnoreMetricsWithName: proto.String("^latency$"),
+			},
+			wantField: "allow_metrics_with_name",
+			wantValue: "^latency$",
+		},
+		{
+			name:      "nested_field",
+			sdef:      &configpb.SurfacerDef{CounterContinuity: &configpb.CounterContinuity{MaxGapSec: proto.Int32(0)}},
+			wantField: "counter_continuity.max_gap_sec",
+			wantValue: "0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := buildOptions(test.sdef, true, nil)
+
+			var oe *OptionsError
+			if !errors.As(err, &oe) {
+				t.Fatalf("buildOptions() error = %v (%T), want *OptionsError", err, err)
+			}
+			assert.Equal(t, test.wantField, oe.Field)
+			assert.Equal(t, test.wantValue, oe.Value)
+			assert.ErrorContains(t, err, test.wantField)
+
+			var reErr *syntax.Error
+			assert.Equal(t, test.wantRegex, errors.As(err, &reErr), "regex error")
+		})
+	}
+}
+
+func TestOptionsErrorReload(t *testing.T) {
+	opts, err := buildOptions(&configpb.SurfacerDef{}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error = %v", err)
+	}
+
+	err = opts.Reload(&configpb.SurfacerDef{AllowMetricsWithName: proto.String("(")})
+	var oe *OptionsError
+	if assert.ErrorAs(t, err, &oe) {
+		assert.Equal(t, "allow_metrics_with_name", oe.Field)
+	}
+}
+
+func TestBuildOptionsForTestPanic(t *testing.T) {
+	assert.PanicsWithValue(t, "Error building surfacer options for tests, field sample_rate: invalid sample_rate (2): should be in (0, 1]", func() {
+		BuildOptionsForTest(&configpb.SurfacerDef{SampleRate: proto.Float64(2)})
+	})
+}
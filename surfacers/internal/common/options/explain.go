@@ -0,0 +1,160 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/metrics"
+)
+
+// filterReason identifies what decided whether an EventMetrics is allowed.
+type filterReason int
+
+const (
+	// Allowed: no ignore label filter matched, and there are no allow label
+	// filters (or precedence is ALLOW_FIRST).
+	reasonNotIgnored filterReason = iota
+	// Allowed: matched the allow label filter(s).
+	reasonAllowFilter
+	// Dropped: not routed to this surfacer through the surfacers label.
+	reasonNotRouted
+	// Dropped: not in the success state of the success filter.
+	reasonSuccessState
+	// Dropped: didn't match any value filter.
+	reasonValueFilters
+	// Dropped: matched an ignore label filter.
+	reasonIgnoreFilter
+	// Dropped: didn't match the allow label filter(s).
+	reasonNoAllowFilter
+)
+
+// filterDecision is the outcome of the EventMetrics evaluation, along with
+// the label filter that decided it, if any.
+type filterDecision struct {
+	allowed bool
+	reason  filterReason
+	filter  *labelFilter
+}
+
+// nameReason identifies what decided whether a metric is allowed by the
+// metric name filters.
+type nameReason int
+
+const (
+	reasonNotIgnoredName nameReason = iota
+	reasonAllowName
+	reasonIgnoreName
+	reasonNoAllowName
+)
+
+// nameDecision is the outcome of the metric name evaluation. re is the
+// deciding name filter's regex, if any, and name is the normalized name.
+type nameDecision struct {
+	allowed bool
+	reason  nameReason
+	re      string
+	name    string
+}
+
+// explain returns the human-readable reason for the decision.
+func (f *Filters) explain(em *metrics.EventMetrics, d filterDecision) string {
+	switch d.reason {
+	case reasonNotRouted:
+		return fmt.Sprintf("not routed to this surfacer (%s), %s label: %s", f.opts.routeName, SurfacersLabel, em.Label(SurfacersLabel))
+	case reasonSuccessState:
+		return fmt.Sprintf("not in the %s state of allow_metrics_with_success_state", f.successFilter.GetState())
+	case reasonValueFilters:
+		return "did not match any allow_metrics_with_value filter"
+	case reasonIgnoreFilter:
+		return "dropped by ignore label filter " + d.filter.String()
+	case reasonNoAllowFilter:
+		if d.filter != nil {
+			return fmt.Sprintf("did not match allow label filter %s, all allow label filters should match (allow_metrics_label_match_mode: ALL)", d.filter)
+		}
+		return "did not match any allow label filter"
+	case reasonAllowFilter:
+		if d.filter != nil {
+			return "matched allow label filter " + d.filter.String()
+		}
+		return "matched all allow label filters"
+	}
+	return "did not match any ignore label filter"
+}
+
+// String returns the human-readable reason for the decision.
+func (d nameDecision) String() string {
+	switch d.reason {
+	case reasonIgnoreName:
+		return fmt.Sprintf("dropped by ignore_metrics_with_name (%s)", d.re)
+	case reasonNoAllowName:
+		return fmt.Sprintf("did not match allow_metrics_with_name (%s)", d.re)
+	case reasonAllowName:
+		return fmt.Sprintf("matched allow_metrics_with_name (%s)", d.re)
+	}
+	return "not dropped by any metric name filter"
+}
+
+// explain returns the human-readable reason for the decision on the given
+// metric name, noting the normalized name if it's different.
+func (d nameDecision) explain(metricName string) string {
+	if d.name != metricName {
+		return fmt.Sprintf("%s (normalized name: %s)", d, d.name)
+	}
+	return d.String()
+}
+
+// ExplainEventMetrics is like AllowEventMetrics, but it also returns the
+// reason for the decision, e.g. the filter that dropped the EventMetrics. If
+// the EventMetrics is allowed, reason also lists its metrics that are dropped
+// by the metric name filters (see ExplainMetric). It uses the same evaluation
+// as AllowEventMetrics, but doesn't count the decision in the filter stats or
+// update any filter state, so it can be used to debug the filters.
+func (opts *Options) ExplainEventMetrics(em *metrics.EventMetrics) (allowed bool, reason string) {
+	f := opts.Filters()
+	if f == nil {
+		return true, "no filters configured"
+	}
+
+	d := f.evalEventMetrics(em, true)
+	reason = f.explain(em, d)
+	if !d.allowed {
+		return false, reason
+	}
+
+	var dropped []string
+	for _, name := range em.MetricsKeys() {
+		if nd := f.evalMetricName(name); !nd.allowed {
+			dropped = append(dropped, name+": "+nd.String())
+		}
+	}
+	if len(dropped) > 0 {
+		reason += "; metrics dropped by the metric name filters: " + strings.Join(dropped, ", ")
+	}
+	return true, reason
+}
+
+// ExplainMetric is like AllowMetric, but it also returns the reason for the
+// decision. It doesn't count the decision in the filter stats.
+func (opts *Options) ExplainMetric(metricName string) (allowed bool, reason string) {
+	f := opts.Filters()
+	if f == nil {
+		return true, "no filters configured"
+	}
+
+	d := f.evalMetricName(metricName)
+	return d.allowed, d.explain(metricName)
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestExplainEventMetrics(t *testing.T) {
+	newEM := func(labels ...string) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(10)).
+			AddMetric("success", metrics.NewInt(9)).
+			AddMetric("resp-code", metrics.NewMap("code"))
+		for i := 0; i < len(labels); i += 2 {
+			em.AddLabel(labels[i], labels[i+1])
+		}
+		return em
+	}
+	labelFilter := func(key, value string) *configpb.LabelFilter {
+		return &configpb.LabelFilter{Key: proto.String(key), Value: proto.String(value)}
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		em          *metrics.EventMetrics
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "no_filters",
+			sdef:        &configpb.SurfacerDef{},
+			em:          newEM("probe", "p1"),
+			wantAllowed: true,
+			wantReason:  "did not match any ignore label filter",
+		},
+		{
+			name: "ignore_filter",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{labelFilter("probe", "p2"), labelFilter("debug", "true")},
+			},
+			em:         newEM("probe", "p1", "debug", "true"),
+			wantReason: "dropped by ignore label filter {key=debug value=true}",
+		},
+		{
+			name: "allow_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{labelFilter("probe", "p2"), labelFilter("probe", "p1")},
+			},
+			em:          newEM("probe", "p1"),
+			wantAllowed: true,
+			wantReason:  "matched allow label filter {key=probe value=p1}",
+		},
+		{
+			name: "no_allow_filter_matched",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{labelFilter("probe", "p2")},
+			},
+			em:         newEM("probe", "p1"),
+			wantReason: "did not match any allow label filter",
+		},
+		{
+			name: "allow_filter_match_all",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:      []*configpb.LabelFilter{labelFilter("probe", "p1"), labelFilter("dst", "d1")},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+			},
+			em:         newEM("probe", "p1", "dst", "d2"),
+			wantReason: "did not match allow label filter {key=dst value=d1}, all allow label filters should match (allow_metrics_label_match_mode: ALL)",
+		},
+		{
+			name: "allow_first",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{labelFilter("debug", "true")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{labelFilter("probe", "p1")},
+				FilterPrecedence:       configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+			},
+			em:          newEM("probe", "p1", "debug", "true"),
+			wantAllowed: true,
+			wantReason:  "matched allow label filter {key=debug value=true}",
+		},
+		{
+			name: "value_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithValue: []*configpb.ValueFilter{{MetricName: proto.String("total"), Op: configpb.ValueFilter_GT.Enum(), Value: proto.Float64(100)}},
+			},
+			em:         newEM("probe", "p1"),
+			wantReason: "did not match any allow_metrics_with_value filter",
+		},
+		{
+			name: "success_state",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithSuccessState: &configpb.SuccessFilter{State: configpb.SuccessFilter_NO_FAILURES.Enum()},
+			},
+			em:         newEM("probe", "p1"),
+			wantReason: "not in the NO_FAILURES state of allow_metrics_with_success_state",
+		},
+		{
+			name: "not_routed",
+			sdef: &configpb.SurfacerDef{
+				Name:                  proto.String("s1"),
+				RouteBySurfacersLabel: proto.Bool(true),
+			},
+			em:         newEM("probe", "p1", SurfacersLabel, "s2,s3"),
+			wantReason: "not routed to this surfacer (s1), surfacers label: s2,s3",
+		},
+		{
+			name: "metric_name_filters",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithName: proto.String("^resp-code$"),
+			},
+			em:          newEM("probe", "p1"),
+			wantAllowed: true,
+			wantReason:  "did not match any ignore label filter; metrics dropped by the metric name filters: resp-code: dropped by ignore_metrics_with_name (^resp-code$)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := buildOptions(test.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			allowed, reason := opts.ExplainEventMetrics(test.em)
+			assert.Equal(t, test.wantAllowed, allowed, "allowed")
+			assert.Equal(t, test.wantReason, reason, "reason")
+
+			// Explain doesn't count the decisions, and it agrees with
+			// AllowEventMetrics.
+			assert.Equal(t, FilterStats{}, opts.Stats())
+			assert.Equal(t, allowed, opts.AllowEventMetrics(test.em), "AllowEventMetrics")
+		})
+	}
+}
+
+func TestExplainEventMetricsNil(t *testing.T) {
+	allowed, reason := (*Options)(nil).ExplainEventMetrics(metrics.NewEventMetrics(time.Now()))
+	assert.True(t, allowed)
+	assert.Equal(t, "no filters configured", reason)
+}
+
+func TestExplainEventMetricsDryRun(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		AllowMetricsWithSuccessState: &configpb.SuccessFilter{State: configpb.SuccessFilter_FAILURES.Enum()},
+	})
+
+	newEM := func(total, success int64) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(total)).
+			AddMetric("success", metrics.NewInt(success)).
+			AddLabel("probe", "p1")
+	}
+
+	assert.True(t, opts.AllowEventMetrics(newEM(10, 9)))
+
+	// No failures since the last EventMetrics. Explaining it repeatedly
+	// shouldn't change the series state.
+	for i := 0; i < 2; i++ {
+		allowed, _ := opts.ExplainEventMetrics(newEM(20, 19))
+		assert.False(t, allowed)
+	}
+	// Delta is still from (10, 9), i.e. 1 failure.
+	assert.True(t, opts.AllowEventMetrics(newEM(20, 18)))
+}
+
+func TestExplainMetric(t *testing.T) {
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		metric      string
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "no_filters",
+			sdef:        &configpb.SurfacerDef{},
+			metric:      "total",
+			wantAllowed: true,
+			wantReason:  "not dropped by any metric name filter",
+		},
+		{
+			name:       "ignored",
+			sdef:       &configpb.SurfacerDef{IgnoreMetricsWithName: proto.String("^total$"), AllowMetricsWithName: proto.String("^(total|success)$")},
+			metric:     "total",
+			wantReason: "dropped by ignore_metrics_with_name (^total$)",
+		},
+		{
+			name:        "allowed",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithName: proto.String("^(total|success)$")},
+			metric:      "success",
+			wantAllowed: true,
+			wantReason:  "matched allow_metrics_with_name (^(total|success)$)",
+		},
+		{
+			name:       "not_allowed",
+			sdef:       &configpb.SurfacerDef{AllowMetricsWithName: proto.String("^(total|success)$")},
+			metric:     "latency",
+			wantReason: "did not match allow_metrics_with_name (^(total|success)$)",
+		},
+		{
+			name: "normalized",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithName:   proto.String("^resp_code$"),
+				MetricNameNormalization: &configpb.MetricNameNormalization{},
+			},
+			metric:     "resp.code",
+			wantReason: "dropped by ignore_metrics_with_name (^resp_code$) (normalized name: resp_code)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(test.sdef)
+
+			allowed, reason := opts.ExplainMetric(test.metric)
+			assert.Equal(t, test.wantAllowed, allowed, "allowed")
+			assert.Equal(t, test.wantReason, reason, "reason")
+
+			assert.Equal(t, allowed, opts.AllowMetric(test.metric), "AllowMetric")
+		})
+	}
+}
+
+func TestDecisionHook(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("debug"), Value: proto.String("true")}},
+		IgnoreMetricsWithName:  proto.String("^resp-code$"),
+	})
+
+	type decision struct {
+		em      *metrics.EventMetrics
+		allowed bool
+		reason  string
+	}
+	var decisions []decision
+	opts.DecisionHook = func(em *metrics.EventMetrics, allowed bool, reason string) {
+		decisions = append(decisions, decision{em, allowed, reason})
+	}
+
+	em1 := metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(10)).AddLabel("probe", "p1")
+	em2 := metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(10)).AddLabel("debug", "true")
+
+	assert.True(t, opts.AllowEventMetrics(em1))
+	assert.False(t, opts.AllowEventMetrics(em2))
+	assert.True(t, opts.AllowMetric("total"))
+	assert.False(t, opts.AllowMetric("resp-code"))
+
+	assert.Equal(t, []decision{
+		{em1, true, "did not match any ignore label filter"},
+		{em2, false, "dropped by ignore label filter {key=debug value=true}"},
+		{nil, true, "metric total: not dropped by any metric name filter"},
+		{nil, false, "metric resp-code: dropped by ignore_metrics_with_name (^resp-code$)"},
+	}, decisions)
+
+	// Decisions are still counted in the stats.
+	assert.Equal(t, FilterStats{Allowed: 1, LabelDropped: 1, NameDropped: 1}, opts.Stats())
+}
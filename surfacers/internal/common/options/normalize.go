@@ -0,0 +1,191 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+type nameRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// nameNormalizer normalizes the metric names using a list of regex
+// replacement rules.
+type nameNormalizer struct {
+	rules  []nameRule
+	export bool
+
+	// Cache of the normalized names, as the same names come up again and
+	// again.
+	cache sync.Map
+}
+
+func newNameNormalizer(c *surfacerpb.MetricNameNormalization) (*nameNormalizer, error) {
+	nn := &nameNormalizer{export: c.GetExportNormalizedNames()}
+
+	if len(c.GetRule()) == 0 {
+		nn.rules = []nameRule{{re: regexp.MustCompile(`\.`), replacement: "_"}}
+		return nn, nil
+	}
+
+	for _, r := range c.GetRule() {
+		re, err := regexp.Compile(r.GetPattern())
+		if err != nil {
+			return nil, &OptionsError{Field: "metric_name_normalization.rule.pattern", Value: r.GetPattern(), Err: err}
+		}
+		nn.rules = append(nn.rules, nameRule{re: re, replacement: r.GetReplacement()})
+	}
+	return nn, nil
+}
+
+// normalize returns the normalized metric name. It returns the name as it is
+// if normalizer is nil.
+func (nn *nameNormalizer) normalize(name string) string {
+	if nn == nil {
+		return name
+	}
+	if v, ok := nn.cache.Load(name); ok {
+		return v.(string)
+	}
+	normalized := name
+	for _, r := range nn.rules {
+		normalized = r.re.ReplaceAllString(normalized, r.replacement)
+	}
+	nn.cache.Store(name, normalized)
+	return normalized
+}
+
+// NormalizeMetricNames returns EventMetrics with the metrics renamed to their
+// normalized names, if export_normalized_names is set. Input EventMetrics is
+// not modified; if no name changes, it's returned as it is.
+func (opts *Options) NormalizeMetricNames(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.nameNormalizer == nil || !opts.nameNormalizer.export {
+		return em
+	}
+
+	metricsKeys := em.MetricsKeys()
+
+	changed := false
+	for _, name := range metricsKeys {
+		if opts.nameNormalizer.normalize(name) != name {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return em
+	}
+
+	newEM := emWith(em, nil)
+	if em.MetricUnits != nil {
+		newEM.MetricUnits = make(map[string]string, len(em.MetricUnits))
+		for name, unit := range em.MetricUnits {
+			newEM.MetricUnits[opts.nameNormalizer.normalize(name)] = unit
+		}
+	}
+	for _, name := range metricsKeys {
+		newEM.AddMetric(opts.nameNormalizer.normalize(name), em.Metric(name))
+	}
+	return newEM
+}
+
+// labelNormalizer normalizes the label values.
+type labelNormalizer struct {
+	// Keys of the labels to normalize, nil for all labels.
+	keys      map[string]bool
+	trimSpace bool
+	lowercase bool
+	rules     []nameRule
+}
+
+func newLabelNormalizer(c *surfacerpb.LabelValueNormalization) (*labelNormalizer, error) {
+	ln := &labelNormalizer{
+		trimSpace: c.GetTrimSpace(),
+		lowercase: c.GetLowercase(),
+	}
+
+	for _, k := range c.GetLabelKey() {
+		if ln.keys == nil {
+			ln.keys = make(map[string]bool)
+		}
+		ln.keys[k] = true
+	}
+
+	for _, r := range c.GetRule() {
+		re, err := regexp.Compile(r.GetPattern())
+		if err != nil {
+			return nil, &OptionsError{Field: "label_value_normalization.rule.pattern", Value: r.GetPattern(), Err: err}
+		}
+		ln.rules = append(ln.rules, nameRule{re: re, replacement: r.GetReplacement()})
+	}
+	return ln, nil
+}
+
+// normalize returns the normalized value of the label. Unlike the metric
+// names, label values are not cached, as their number is not bounded.
+func (ln *labelNormalizer) normalize(key, value string) string {
+	if ln.keys != nil && !ln.keys[key] {
+		return value
+	}
+	if ln.trimSpace {
+		value = strings.TrimSpace(value)
+	}
+	if ln.lowercase {
+		value = strings.ToLower(value)
+	}
+	for _, r := range ln.rules {
+		value = r.re.ReplaceAllString(value, r.replacement)
+	}
+	return value
+}
+
+// NormalizeLabels returns EventMetrics with the label values normalized, if
+// label_value_normalization is configured. It's applied after the filtering,
+// so label filters see the original values. Input EventMetrics is not
+// modified, as it's shared with the other surfacers; if no value changes,
+// it's returned as it is.
+func (opts *Options) NormalizeLabels(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.labelNormalizer == nil {
+		return em
+	}
+
+	labelsKeys := em.LabelsKeys()
+
+	changed := false
+	for _, k := range labelsKeys {
+		if opts.labelNormalizer.normalize(k, em.Label(k)) != em.Label(k) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return em
+	}
+
+	newEM := emWith(em, func(k, v string) (string, string, bool) {
+		return k, opts.labelNormalizer.normalize(k, v), true
+	})
+	for _, name := range em.MetricsKeys() {
+		newEM.AddMetric(name, em.Metric(name))
+	}
+	return newEM
+}
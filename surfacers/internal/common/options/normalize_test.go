@@ -0,0 +1,177 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMetricNameNormalization(t *testing.T) {
+	names := []string{"http.resp_code", "dns.latency", "tcp-connect.latency", "total"}
+
+	tests := []struct {
+		name            string
+		nn              *configpb.MetricNameNormalization
+		wantAllowed     []bool
+		wantLatency     []bool
+		wantNormalized  []string
+		wantExportNames []string
+	}{
+		{
+			name:        "not_configured",
+			wantAllowed: []bool{false, false, false, true},
+			wantLatency: []bool{false, false, false, false},
+		},
+		{
+			name:           "default_rule",
+			nn:             &configpb.MetricNameNormalization{},
+			wantAllowed:    []bool{true, true, false, true},
+			wantLatency:    []bool{false, true, false, false},
+			wantNormalized: []string{"http_resp_code", "dns_latency", "tcp-connect_latency", "total"},
+		},
+		{
+			name: "custom_rules_export",
+			nn: &configpb.MetricNameNormalization{
+				Rule: []*configpb.MetricNameNormalization_Rule{
+					{Pattern: proto.String(`[.-]`), Replacement: proto.String("_")},
+					{Pattern: proto.String(`^tcp_connect_(.*)$`), Replacement: proto.String("tcp_$1")},
+				},
+				ExportNormalizedNames: proto.Bool(true),
+			},
+			wantAllowed:     []bool{true, true, true, true},
+			wantLatency:     []bool{false, true, true, false},
+			wantNormalized:  []string{"http_resp_code", "dns_latency", "tcp_latency", "total"},
+			wantExportNames: []string{"http_resp_code", "dns_latency", "tcp_latency", "total"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{
+				AllowMetricsWithName:    proto.String(`^(http_resp_code|dns_latency|tcp_latency|total)$`),
+				LatencyMetricPattern:    proto.String(`^[a-z]+_latency$`),
+				MetricNameNormalization: test.nn,
+			})
+
+			em := metrics.NewEventMetrics(time.Now())
+			em.MetricUnits = map[string]string{"dns.latency": "ms"}
+			for i, name := range names {
+				assert.Equal(t, test.wantAllowed[i], opts.AllowMetric(name), "AllowMetric(%s)", name)
+				assert.Equal(t, test.wantLatency[i], opts.IsLatencyMetric(name), "IsLatencyMetric(%s)", name)
+				if test.wantNormalized != nil {
+					assert.Equal(t, test.wantNormalized[i], opts.nameNormalizer.normalize(name))
+				}
+				em.AddMetric(name, metrics.NewInt(int64(i)))
+			}
+
+			out := opts.NormalizeMetricNames(em)
+			if test.wantExportNames == nil {
+				assert.Same(t, em, out, "names are not exported normalized")
+				return
+			}
+			assert.Equal(t, test.wantExportNames, out.MetricsKeys())
+			assert.Equal(t, map[string]string{"dns_latency": "ms"}, out.MetricUnits)
+			assert.Equal(t, names, em.MetricsKeys(), "input is not modified")
+		})
+	}
+}
+
+func TestMetricNameNormalizationInvalidRule(t *testing.T) {
+	_, err := buildOptions(&configpb.SurfacerDef{
+		MetricNameNormalization: &configpb.MetricNameNormalization{
+			Rule: []*configpb.MetricNameNormalization_Rule{{Pattern: proto.String("(")}},
+		},
+	}, true, nil)
+	assert.Error(t, err)
+}
+
+func TestNormalizeLabels(t *testing.T) {
+	newEM := func() *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(10)).
+			AddLabel("probe", "Web").
+			AddLabel("region", " US_East ")
+	}
+
+	tests := []struct {
+		name       string
+		ln         *configpb.LabelValueNormalization
+		wantSame   bool
+		wantLabels map[string]string
+	}{
+		{
+			name:     "not_configured",
+			wantSame: true,
+		},
+		{
+			name:       "trim_space_default",
+			ln:         &configpb.LabelValueNormalization{},
+			wantLabels: map[string]string{"probe": "Web", "region": "US_East"},
+		},
+		{
+			name:       "lowercase_and_rules",
+			ln:         &configpb.LabelValueNormalization{Lowercase: proto.Bool(true), Rule: []*configpb.LabelValueNormalization_Rule{{Pattern: proto.String("_"), Replacement: proto.String("-")}}},
+			wantLabels: map[string]string{"probe": "web", "region": "us-east"},
+		},
+		{
+			name:       "only_listed_keys",
+			ln:         &configpb.LabelValueNormalization{LabelKey: []string{"region"}, Lowercase: proto.Bool(true)},
+			wantLabels: map[string]string{"probe": "Web", "region": "us_east"},
+		},
+		{
+			name:     "no_change",
+			ln:       &configpb.LabelValueNormalization{LabelKey: []string{"probe"}},
+			wantSame: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{LabelValueNormalization: test.ln})
+
+			em := newEM()
+			out := opts.NormalizeLabels(em)
+			if test.wantSame {
+				assert.Same(t, em, out)
+				return
+			}
+
+			gotLabels := make(map[string]string)
+			for _, k := range out.LabelsKeys() {
+				gotLabels[k] = out.Label(k)
+			}
+			assert.Equal(t, test.wantLabels, gotLabels)
+			assert.Equal(t, []string{"total"}, out.MetricsKeys())
+
+			// Input is not modified.
+			assert.Equal(t, " US_East ", em.Label("region"))
+		})
+	}
+}
+
+func TestNormalizeLabelsInvalidRule(t *testing.T) {
+	_, err := buildOptions(&configpb.SurfacerDef{
+		LabelValueNormalization: &configpb.LabelValueNormalization{
+			Rule: []*configpb.LabelValueNormalization_Rule{{Pattern: proto.String("(")}},
+		},
+	}, true, nil)
+	assert.Error(t, err)
+}
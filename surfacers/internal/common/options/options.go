@@ -16,60 +16,161 @@
 package options
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
 	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type labelFilter struct {
 	key   string
 	value string
+	// If set, key is matched as a prefix of the label keys.
+	keyPrefix bool
+	// If set, value is matched against this regex, instead of value.
+	valueRe *regexp.Regexp
+	// Original value regex, as configured.
+	valueRegex string
+	// If set, filter's match result is inverted.
+	negate bool
 }
 
+// SurfacersLabel is the label used by probes to route their EventMetrics to
+// specific surfacers, see route_by_surfacers_label.
+const SurfacersLabel = "surfacers"
+
 var defaultLatencyMetricRe = regexp.MustCompile("^(.*_|)latency$")
 
-func (lf *labelFilter) matchEventMetrics(em *metrics.EventMetrics) bool {
-	if lf.key != "" {
-		for _, lKey := range em.LabelsKeys() {
-			if lf.key != lKey {
-				continue
-			}
-			if lf.value == "" {
-				return true
-			}
-			return lf.value == em.Label(lKey)
+// byteMetricRe matches the names of the metrics that are inferred to be in
+// bytes, e.g. "resp_bytes" or "bytes_sent".
+var byteMetricRe = regexp.MustCompile("(^|_)bytes($|_)")
+
+// matchKey returns true if the label key matches the filter's key.
+func (lf *labelFilter) matchKey(lKey string) bool {
+	if lf.keyPrefix {
+		return strings.HasPrefix(lKey, lf.key)
+	}
+	return lf.key == lKey
+}
+
+// matchValue returns true if the label value matches the filter's value or
+// value regex. Filters without either match all values.
+func (lf *labelFilter) matchValue(lValue string) bool {
+	if lf.valueRe != nil {
+		return lf.valueRe.MatchString(lValue)
+	}
+	return lf.value == "" || lf.value == lValue
+}
+
+// matchEventMetrics returns true if the given EventMetrics matches the label
+// filter. Filters on keys in ignoreKeys never match, negated or not. For
+// prefix filters, labels in ignoreKeys are skipped, and filter matches if any
+// of the remaining matched labels has the filter's value (if any).
+func (lf *labelFilter) matchEventMetrics(em *metrics.EventMetrics, ignoreKeys map[string]bool) bool {
+	if lf.key == "" || (!lf.keyPrefix && ignoreKeys[lf.key]) {
+		return false
+	}
+	return lf.matchLabels(em, ignoreKeys) != lf.negate
+}
+
+// matchLabels returns true if any of the EventMetrics labels, other than the
+// ones in ignoreKeys, matches the filter's key and value.
+func (lf *labelFilter) matchLabels(em *metrics.EventMetrics, ignoreKeys map[string]bool) bool {
+	for _, lKey := range em.LabelsKeys() {
+		if !lf.matchKey(lKey) || ignoreKeys[lKey] {
+			continue
+		}
+		if lf.matchValue(em.Label(lKey)) {
+			return true
 		}
 	}
 	return false
 }
 
-func parseMetricsFilter(configs []*surfacerpb.LabelFilter) ([]*labelFilter, error) {
+// parseMetricsFilter parses the label filters configured through the given
+// field, allow_metrics_with_label or ignore_metrics_with_label.
+func parseMetricsFilter(field string, configs []*surfacerpb.LabelFilter) ([]*labelFilter, error) {
 	var filters []*labelFilter
 
 	for _, c := range configs {
 		lf := &labelFilter{
-			key:   c.GetKey(),
-			value: c.GetValue(),
+			key:       c.GetKey(),
+			value:     c.GetValue(),
+			keyPrefix: c.GetKeyPrefix(),
+			negate:    c.GetNegate(),
 		}
 
 		if lf.value != "" && lf.key == "" {
-			return nil, fmt.Errorf("key is required to match against val (%s)", c.GetValue())
+			return nil, newOptionsError(field, c.GetValue(), "key is required to match against value")
+		}
+
+		if lf.negate && lf.key == "" {
+			return nil, newOptionsError(field, "", "key is required for the negated label filters")
+		}
+
+		if c.GetValueRegex() != "" {
+			if lf.key == "" {
+				return nil, newOptionsError(field, c.GetValueRegex(), "key is required to match against value_regex")
+			}
+			if lf.value != "" {
+				return nil, newOptionsError(field, c.GetValueRegex(), fmt.Sprintf("only one of value (%s) and value_regex can be specified", c.GetValue()))
+			}
+			re, err := regexp.Compile("^(?:" + c.GetValueRegex() + ")$")
+			if err != nil {
+				return nil, &OptionsError{Field: field, Value: c.GetValueRegex(), Err: fmt.Errorf("invalid value_regex for label %s: %w", lf.key, err)}
+			}
+			lf.valueRe, lf.valueRegex = re, c.GetValueRegex()
 		}
 
+		if !c.GetEnabled() {
+			continue
+		}
 		filters = append(filters, lf)
 	}
 
 	return filters, nil
 }
 
+// Filters is an immutable set of metrics filters. Filters are updated at
+// runtime by swapping in a new set (see ApplyFilters), so all the decisions
+// for an EventMetrics should be made with the same Filters, obtained once
+// through Options.Filters, for them to be consistent with each other.
+type Filters struct {
+	opts *Options
+
+	allowLabelFilters  []*labelFilter
+	ignoreLabelFilters []*labelFilter
+	// If set, EventMetrics should match all allow label filters.
+	allowLabelMatchAll bool
+	// If set, allow label filters take precedence over the ignore label
+	// filters.
+	allowFirst       bool
+	allowMetricName  *regexp.Regexp
+	ignoreMetricName *regexp.Regexp
+
+	// If set, only EventMetrics matching any of these filters are allowed.
+	valueFilters []*surfacerpb.ValueFilter
+
+	// If set, only EventMetrics in this success state are allowed.
+	successFilter *surfacerpb.SuccessFilter
+}
+
 // Options encapsulates surfacer options common to all surfacers.
 type Options struct {
 	MetricsBufferSize int
@@ -77,72 +178,755 @@ type Options struct {
 	Logger            *logger.Logger
 	HTTPServeMux      *http.ServeMux
 
-	// Metrics filtering
-	allowLabelFilters  []*labelFilter
-	ignoreLabelFilters []*labelFilter
-	allowMetricName    *regexp.Regexp
-	ignoreMetricName   *regexp.Regexp
+	// Metrics filters, swapped as a whole by ApplyFilters.
+	filters atomic.Pointer[Filters]
+
+	// If set, EventMetrics with the "surfacers" label are allowed only if it
+	// lists routeName.
+	routeBySurfacersLabel bool
+	routeName             string
+
+	// successCounts tracks the last success and total values of the
+	// CUMULATIVE series, for the success filter.
+	successCountsMu sync.Mutex
+	successCounts   map[string]successCounts
+
+	// Fraction of the EventMetrics to surface, and the labels the sampling
+	// decision is based on, see ShouldSample. sampleRate is 0 if sampling is
+	// disabled, i.e. sample_rate is 1.
+	sampleRate     float64
+	sampleByLabels []string
+
+	// Label keys that don't participate in filtering, and optionally are
+	// stripped from the exported metrics.
+	ignoreLabelKeys       map[string]bool
+	stripIgnoredLabelKeys bool
+
+	// Label keys whose values are hashed, and the salt for hashing.
+	hashLabelKeys map[string]bool
+	hashLabelSalt string
+
+	// Number of adjacent distribution buckets to merge into one.
+	distBucketMergeFactor int
+
+	// Percentiles of the distributions to export as gauges, nil if
+	// distribution_percentiles is not configured.
+	distPercentiles *surfacerpb.DistributionPercentiles
+
+	// Zero-valued metrics dropping, nil if drop_zero_values is not
+	// configured. nonZeroSeries tracks the series whose last emitted value
+	// was non-zero, to keep transitions to zero.
+	dropZeroValues  *surfacerpb.DropZeroValues
+	nonZeroSeriesMu sync.Mutex
+	nonZeroSeries   map[string]bool
+
+	// Handling of the invalid latency values, nil if invalid_latency is not
+	// configured.
+	invalidLatency *surfacerpb.InvalidLatency
+
+	// Counter continuity across probe restarts, nil if counter_continuity is
+	// not configured.
+	counterContinuity *counterContinuity
 
 	// latencyMetricRe is a regular expression to match latency metrics.
 	latencyMetricRe *regexp.Regexp
+	// If set, no metric is a latency metric.
+	disableLatencyMetrics bool
+
+	// Metric name normalization, nil if metric_name_normalization is not
+	// configured.
+	nameNormalizer *nameNormalizer
+
+	// Label value normalization, nil if label_value_normalization is not
+	// configured.
+	labelNormalizer *labelNormalizer
+
+	// Metric and label key renaming, nil if no relabel_rule is configured.
+	relabeler *relabeler
+
+	// Per metric-name overrides of MetricsBufferSize, see BufferSizeFor.
+	// bufferSizes caches the lookups, keyed by metric name.
+	bufferSizeRules []*bufferSizeRule
+	bufferSizes     sync.Map
 
 	AddFailureMetric bool
 
+	// failureMetricFor restricts failure metric to EventMetrics containing a
+	// metric with matching name.
+	failureMetricFor *regexp.Regexp
+
 	AdditionalLabels [][2]string
+
+	// ResourceAttributes are the resource attributes (resource_attributes),
+	// sorted by key, along with the defaults for the unset ones. It's nil if
+	// resource attributes are not configured.
+	ResourceAttributes [][2]string
+
+	// DecisionHook, if set, is called with every AllowEventMetrics and
+	// AllowMetric decision, along with its reason (see ExplainEventMetrics
+	// and ExplainMetric). em is nil for the AllowMetric decisions. It's meant
+	// for testing complex filter setups, and it should be safe for concurrent
+	// use. Reasons are computed only if the hook is set.
+	DecisionHook func(em *metrics.EventMetrics, allowed bool, reason string)
+
+	// Counts of the filtering decisions, see Stats().
+	allowedCount      atomic.Int64
+	labelDroppedCount atomic.Int64
+	nameDroppedCount  atomic.Int64
+}
+
+// FilterStats are the counts of the filtering decisions made by
+// AllowEventMetrics and AllowMetric.
+type FilterStats struct {
+	// EventMetrics allowed and dropped by AllowEventMetrics. LabelDropped
+	// includes the EventMetrics dropped by the value filters and the
+	// surfacers label routing.
+	Allowed, LabelDropped int64
+
+	// Metrics dropped by AllowMetric, i.e. by the metric name filters.
+	NameDropped int64
+}
+
+// Stats returns the counts of the filtering decisions made so far.
+func (opts *Options) Stats() FilterStats {
+	if opts == nil {
+		return FilterStats{}
+	}
+	return FilterStats{
+		Allowed:      opts.allowedCount.Load(),
+		LabelDropped: opts.labelDroppedCount.Load(),
+		NameDropped:  opts.nameDroppedCount.Load(),
+	}
+}
+
+// Filters returns the current metrics filters. It returns nil for nil
+// options, and nil Filters allow everything.
+func (opts *Options) Filters() *Filters {
+	if opts == nil {
+		return nil
+	}
+	return opts.filters.Load()
 }
 
 // AllowEventMetrics returns whether a certain EventMetrics should be allowed
-// or not.
-// TODO(manugarg): Explore if we can either log or increment some metric when
-// we ignore an EventMetrics.
+// or not, as per the current filters. It's a shorthand for
+// opts.Filters().AllowEventMetrics(em).
 func (opts *Options) AllowEventMetrics(em *metrics.EventMetrics) bool {
-	if opts == nil {
+	return opts.Filters().AllowEventMetrics(em)
+}
+
+// AllowEventMetrics returns whether a certain EventMetrics should be allowed
+// or not. Decisions are counted in the filter stats, see Options.Stats().
+func (f *Filters) AllowEventMetrics(em *metrics.EventMetrics) bool {
+	if f == nil {
 		return true
 	}
 
-	// If we match any ignore filter, return false immediately.
-	for _, ignoreF := range opts.ignoreLabelFilters {
-		if ignoreF.matchEventMetrics(em) {
-			return false
+	opts := f.opts
+	d := f.evalEventMetrics(em, false)
+	if d.allowed {
+		opts.allowedCount.Add(1)
+	} else {
+		opts.labelDroppedCount.Add(1)
+	}
+	if opts.DecisionHook != nil {
+		opts.DecisionHook(em, d.allowed, f.explain(em, d))
+	}
+	return d.allowed
+}
+
+// evalEventMetrics evaluates the EventMetrics against the surfacers label
+// routing, and the success, value and label filters. It's the core of both
+// AllowEventMetrics and ExplainEventMetrics. If dryRun is set, evaluation
+// doesn't update any state, e.g. the success filter's series state.
+func (f *Filters) evalEventMetrics(em *metrics.EventMetrics, dryRun bool) filterDecision {
+	opts := f.opts
+	if opts.routeBySurfacersLabel && !opts.routedHere(em) {
+		return filterDecision{reason: reasonNotRouted}
+	}
+
+	if f.successFilter != nil && !f.matchSuccessFilter(em, !dryRun) {
+		return filterDecision{reason: reasonSuccessState}
+	}
+
+	if len(f.valueFilters) > 0 && !f.matchValueFilters(em) {
+		return filterDecision{reason: reasonValueFilters}
+	}
+
+	// With allow first precedence, EventMetrics matching the allow filters
+	// are allowed, and the rest are subject to the ignore filters only.
+	if f.allowFirst {
+		if matched, allowF := f.matchAllowFilters(em); matched {
+			return filterDecision{allowed: true, reason: reasonAllowFilter, filter: allowF}
 		}
+		if ignoreF := f.matchIgnoreFilters(em); ignoreF != nil {
+			return filterDecision{reason: reasonIgnoreFilter, filter: ignoreF}
+		}
+		return filterDecision{allowed: true, reason: reasonNotIgnored}
+	}
+
+	// If we match any ignore filter, return false immediately.
+	if ignoreF := f.matchIgnoreFilters(em); ignoreF != nil {
+		return filterDecision{reason: reasonIgnoreFilter, filter: ignoreF}
 	}
 
 	// If no allow filters are given, allow everything.
-	if len(opts.allowLabelFilters) == 0 {
-		return true
+	if len(f.allowLabelFilters) == 0 {
+		return filterDecision{allowed: true, reason: reasonNotIgnored}
+	}
+
+	matched, allowF := f.matchAllowFilters(em)
+	if matched {
+		return filterDecision{allowed: true, reason: reasonAllowFilter, filter: allowF}
+	}
+	return filterDecision{reason: reasonNoAllowFilter, filter: allowF}
+}
+
+// matchIgnoreFilters returns the first ignore label filter that the
+// EventMetrics matches, or nil if it doesn't match any.
+func (f *Filters) matchIgnoreFilters(em *metrics.EventMetrics) *labelFilter {
+	for _, ignoreF := range f.ignoreLabelFilters {
+		if ignoreF.matchEventMetrics(em, f.opts.ignoreLabelKeys) {
+			return ignoreF
+		}
+	}
+	return nil
+}
+
+// matchAllowFilters returns true if the EventMetrics matches the allow label
+// filters: any of them by default, or all of them if allowLabelMatchAll is
+// set. It returns false if there are no allow filters. Returned filter is the
+// one that decided: the matching filter by default, or the first filter that
+// didn't match if allowLabelMatchAll is set.
+func (f *Filters) matchAllowFilters(em *metrics.EventMetrics) (bool, *labelFilter) {
+	if len(f.allowLabelFilters) == 0 {
+		return false, nil
+	}
+	for _, allowF := range f.allowLabelFilters {
+		matched := allowF.matchEventMetrics(em, f.opts.ignoreLabelKeys)
+		if matched && !f.allowLabelMatchAll {
+			return true, allowF
+		}
+		if !matched && f.allowLabelMatchAll {
+			return false, allowF
+		}
+	}
+	return f.allowLabelMatchAll, nil
+}
+
+// matchValueFilter returns true if the EventMetrics has a numeric value for
+// the filter's metric, that satisfies the filter's condition.
+func matchValueFilter(vf *surfacerpb.ValueFilter, em *metrics.EventMetrics) bool {
+	v, ok := em.Metric(vf.GetMetricName()).(metrics.NumValue)
+	if !ok {
+		return false
+	}
+	f, want := v.Float64(), vf.GetValue()
+	switch vf.GetOp() {
+	case surfacerpb.ValueFilter_EQ:
+		return f == want
+	case surfacerpb.ValueFilter_NE:
+		return f != want
+	case surfacerpb.ValueFilter_LT:
+		return f < want
+	case surfacerpb.ValueFilter_LE:
+		return f <= want
+	case surfacerpb.ValueFilter_GT:
+		return f > want
+	case surfacerpb.ValueFilter_GE:
+		return f >= want
 	}
+	return false
+}
 
-	// If allow filters are given, allow only if match them.
-	for _, allowF := range opts.allowLabelFilters {
-		if allowF.matchEventMetrics(em) {
+// matchValueFilters returns true if the EventMetrics matches any of the
+// value filters.
+func (f *Filters) matchValueFilters(em *metrics.EventMetrics) bool {
+	for _, vf := range f.valueFilters {
+		if matchValueFilter(vf, em) {
 			return true
 		}
 	}
 	return false
 }
 
-// AllowMetric returns whether a certain Metric should be allowed or not.
+// routedHere returns false if the EventMetrics has the surfacers label and
+// this surfacer is not listed in it.
+func (opts *Options) routedHere(em *metrics.EventMetrics) bool {
+	value := em.Label(SurfacersLabel)
+	if value == "" {
+		return true
+	}
+	for _, name := range strings.Split(value, ",") {
+		if strings.TrimSpace(name) == opts.routeName {
+			return true
+		}
+	}
+	return false
+}
+
+// emWith returns a new EventMetrics with the timestamp, kind, latency unit and
+// metric units of the given EventMetrics, and its labels passed through
+// labelFn: labelFn returns the new label key and value, and false to drop the
+// label. A nil labelFn keeps the labels as they are. Transforms add the
+// metrics to the returned EventMetrics.
+func emWith(em *metrics.EventMetrics, labelFn func(k, v string) (string, string, bool)) *metrics.EventMetrics {
+	newEM := metrics.NewEventMetrics(em.Timestamp)
+	newEM.Kind = em.Kind
+	newEM.LatencyUnit = em.LatencyUnit
+	newEM.MetricUnits = em.MetricUnits
+	for _, k := range em.LabelsKeys() {
+		v := em.Label(k)
+		if labelFn != nil {
+			var keep bool
+			if k, v, keep = labelFn(k, v); !keep {
+				continue
+			}
+		}
+		newEM.AddLabel(k, v)
+	}
+	return newEM
+}
+
+// StripIgnoredLabels returns EventMetrics without the labels listed in
+// ignore_label_keys, if strip_ignored_label_keys is set. Input EventMetrics is
+// not modified; if there is nothing to strip, it's returned as it is.
+func (opts *Options) StripIgnoredLabels(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || !opts.stripIgnoredLabelKeys {
+		return em
+	}
+
+	labelsKeys := em.LabelsKeys()
+
+	found := false
+	for _, k := range labelsKeys {
+		if opts.ignoreLabelKeys[k] {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return em
+	}
+
+	newEM := emWith(em, func(k, v string) (string, string, bool) {
+		return k, v, !opts.ignoreLabelKeys[k]
+	})
+	for _, name := range em.MetricsKeys() {
+		newEM.AddMetric(name, em.Metric(name))
+	}
+	return newEM
+}
+
+// DownsampleDistributions returns EventMetrics with adjacent buckets of the
+// distributions merged, if distribution_bucket_merge_factor is set. Input
+// EventMetrics is not modified; if there is nothing to merge, it's returned
+// as it is.
+func (opts *Options) DownsampleDistributions(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.distBucketMergeFactor < 2 {
+		return em
+	}
+
+	metricsKeys := em.MetricsKeys()
+
+	found := false
+	for _, name := range metricsKeys {
+		if _, ok := em.Metric(name).(*metrics.Distribution); ok {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return em
+	}
+
+	newEM := emWith(em, nil)
+	for _, name := range metricsKeys {
+		val := em.Metric(name)
+		if d, ok := val.(*metrics.Distribution); ok {
+			val = d.MergeBuckets(opts.distBucketMergeFactor)
+		}
+		newEM.AddMetric(name, val)
+	}
+	return newEM
+}
+
+// SplitDistributionPercentiles moves the distributions' percentiles to a
+// separate GAUGE EventMetrics, if distribution_percentiles is configured. It
+// returns the EventMetrics without the distributions (unless
+// keep_distributions is set), or nil if no metric is left in it, and the
+// percentiles EventMetrics, or nil if there are no distributions with
+// samples. Input EventMetrics is not modified; if it has no distributions, it
+// is returned as it is.
+func (opts *Options) SplitDistributionPercentiles(em *metrics.EventMetrics) (*metrics.EventMetrics, *metrics.EventMetrics) {
+	if opts == nil || opts.distPercentiles == nil {
+		return em, nil
+	}
+
+	metricsKeys := em.MetricsKeys()
+
+	found := false
+	for _, name := range metricsKeys {
+		if _, ok := em.Metric(name).(*metrics.Distribution); ok {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return em, nil
+	}
+
+	restEM, percentilesEM := emWith(em, nil), emWith(em, nil)
+	percentilesEM.Kind = metrics.GAUGE
+	percentilesEM.MetricUnits = nil
+
+	for _, name := range metricsKeys {
+		d, ok := em.Metric(name).(*metrics.Distribution)
+		if !ok || opts.distPercentiles.GetKeepDistributions() {
+			restEM.AddMetric(name, em.Metric(name))
+		}
+		if !ok || d.Data().Count == 0 {
+			continue
+		}
+
+		pName := name + "_percentile"
+		m := metrics.NewMapFloat("percentile")
+		for _, p := range opts.distPercentiles.GetPercentile() {
+			m.IncKeyBy("p"+strconv.FormatFloat(p, 'f', -1, 64), d.Percentile(p))
+		}
+		percentilesEM.AddMetric(pName, m)
+		if unit, ok := em.MetricUnits[name]; ok {
+			if percentilesEM.MetricUnits == nil {
+				percentilesEM.MetricUnits = make(map[string]string)
+			}
+			percentilesEM.MetricUnits[pName] = unit
+		}
+	}
+
+	if len(restEM.MetricsKeys()) == 0 {
+		restEM = nil
+	}
+	if len(percentilesEM.MetricsKeys()) == 0 {
+		percentilesEM = nil
+	}
+	return restEM, percentilesEM
+}
+
+// hashLabelValue returns a stable hash of the label value.
+func (opts *Options) hashLabelValue(value string) string {
+	h := sha256.Sum256([]byte(opts.hashLabelSalt + value))
+	return hex.EncodeToString(h[:8])
+}
+
+// HashLabelValues returns EventMetrics with the values of the labels listed
+// in hash_label_values replaced by their hashes. Input EventMetrics is not
+// modified; if there is nothing to hash, it's returned as it is.
+func (opts *Options) HashLabelValues(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || len(opts.hashLabelKeys) == 0 {
+		return em
+	}
+
+	labelsKeys := em.LabelsKeys()
+
+	found := false
+	for _, k := range labelsKeys {
+		if opts.hashLabelKeys[k] {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return em
+	}
+
+	newEM := emWith(em, func(k, v string) (string, string, bool) {
+		if opts.hashLabelKeys[k] {
+			v = opts.hashLabelValue(v)
+		}
+		return k, v, true
+	})
+	for _, name := range em.MetricsKeys() {
+		newEM.AddMetric(name, em.Metric(name))
+	}
+	return newEM
+}
+
+func isZeroValue(val metrics.Value) bool {
+	v, ok := val.(metrics.NumValue)
+	return ok && v.Float64() == 0
+}
+
+func seriesKey(em *metrics.EventMetrics, name string) string {
+	var b strings.Builder
+	b.WriteString(name)
+	for _, k := range em.LabelsKeys() {
+		b.WriteString("," + k + "=" + em.Label(k))
+	}
+	return b.String()
+}
+
+// DropZeroValues returns EventMetrics without the zero-valued metrics, as per
+// the drop_zero_values config. It returns nil if no metric is left. Input
+// EventMetrics is not modified; if there is nothing to drop, it's returned as
+// it is.
+func (opts *Options) DropZeroValues(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.dropZeroValues == nil {
+		return em
+	}
+	if em.Kind == metrics.CUMULATIVE && !opts.dropZeroValues.GetCumulative() {
+		return em
+	}
+	if em.Kind == metrics.GAUGE && !opts.dropZeroValues.GetGauge() {
+		return em
+	}
+
+	keepTransitions := opts.dropZeroValues.GetKeepTransitionsToZero()
+
+	opts.nonZeroSeriesMu.Lock()
+	defer opts.nonZeroSeriesMu.Unlock()
+
+	metricsKeys := em.MetricsKeys()
+	keep := make([]string, 0, len(metricsKeys))
+	for _, name := range metricsKeys {
+		val := em.Metric(name)
+		if !keepTransitions {
+			if !isZeroValue(val) {
+				keep = append(keep, name)
+			}
+			continue
+		}
+
+		key := seriesKey(em, name)
+		if !isZeroValue(val) {
+			opts.nonZeroSeries[key] = true
+			keep = append(keep, name)
+			continue
+		}
+		if opts.nonZeroSeries[key] {
+			delete(opts.nonZeroSeries, key)
+			keep = append(keep, name)
+		}
+	}
+
+	if len(keep) == len(metricsKeys) {
+		return em
+	}
+	if len(keep) == 0 {
+		return nil
+	}
+
+	newEM := emWith(em, nil)
+	for _, name := range keep {
+		newEM.AddMetric(name, em.Metric(name))
+	}
+	return newEM
+}
+
+func isInvalidLatency(val metrics.Value) bool {
+	if val == nil {
+		return true
+	}
+	v, ok := val.(metrics.NumValue)
+	if !ok {
+		return false
+	}
+	f := v.Float64()
+	return math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+// FixInvalidLatency returns EventMetrics with the latency metrics that are
+// missing a value, or have a NaN or infinite value, dropped or defaulted as
+// per the invalid_latency config. It returns nil if no metric is left. Input
+// EventMetrics is not modified; if there is nothing to fix, it's returned as
+// it is.
+func (opts *Options) FixInvalidLatency(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.invalidLatency == nil {
+		return em
+	}
+
+	metricsKeys := em.MetricsKeys()
+
+	found := false
+	for _, name := range metricsKeys {
+		if opts.IsLatencyMetric(name) && isInvalidLatency(em.Metric(name)) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return em
+	}
+
+	newEM := emWith(em, nil)
+
+	kept := 0
+	for _, name := range metricsKeys {
+		val := em.Metric(name)
+		if opts.IsLatencyMetric(name) && isInvalidLatency(val) {
+			if opts.invalidLatency.GetPolicy() == surfacerpb.InvalidLatency_DROP {
+				opts.Logger.Debugf("Dropping invalid latency metric %s, value: %v", name, val)
+				continue
+			}
+			val = metrics.NewFloat(opts.invalidLatency.GetDefaultValue())
+		}
+		newEM.AddMetric(name, val)
+		kept++
+	}
+	if kept == 0 {
+		return nil
+	}
+	return newEM
+}
+
+// AllowMetric returns whether a certain Metric should be allowed or not, as
+// per the current filters. It's a shorthand for
+// opts.Filters().AllowMetric(metricName).
 func (opts *Options) AllowMetric(metricName string) bool {
-	if opts == nil {
+	return opts.Filters().AllowMetric(metricName)
+}
+
+// AllowMetric returns whether a certain Metric should be allowed or not.
+// Dropped metrics are counted in the filter stats, see Options.Stats().
+func (f *Filters) AllowMetric(metricName string) bool {
+	if f == nil {
 		return true
 	}
+	opts := f.opts
+	d := f.evalMetricName(metricName)
+	if !d.allowed {
+		opts.nameDroppedCount.Add(1)
+	}
+	if opts.DecisionHook != nil {
+		opts.DecisionHook(nil, d.allowed, "metric "+metricName+": "+d.explain(metricName))
+	}
+	return d.allowed
+}
+
+// MatchMetricNameFilters is like AllowMetric, but it doesn't count the
+// decision in the filter stats. It's meant for the decisions that don't
+// drop any data, e.g. for the config analysis.
+func (opts *Options) MatchMetricNameFilters(metricName string) bool {
+	return opts.Filters().matchMetricNameFilters(metricName)
+}
+
+func (f *Filters) matchMetricNameFilters(metricName string) bool {
+	if f == nil {
+		return true
+	}
+	return f.evalMetricName(metricName).allowed
+}
+
+// evalMetricName evaluates the (normalized) metric name against the metric
+// name filters. It's the core of both AllowMetric and ExplainMetric.
+func (f *Filters) evalMetricName(metricName string) nameDecision {
+	metricName = f.opts.nameNormalizer.normalize(metricName)
+
+	if f.ignoreMetricName != nil && f.ignoreMetricName.MatchString(metricName) {
+		return nameDecision{reason: reasonIgnoreName, re: f.ignoreMetricName.String(), name: metricName}
+	}
+
+	if f.allowMetricName == nil {
+		return nameDecision{allowed: true, reason: reasonNotIgnoredName, name: metricName}
+	}
+
+	d := nameDecision{reason: reasonNoAllowName, re: f.allowMetricName.String(), name: metricName}
+	if f.allowMetricName.MatchString(metricName) {
+		d.allowed, d.reason = true, reasonAllowName
+	}
+	return d
+}
+
+// FilterDecisions returns the filtering decisions for the given EventMetrics:
+// whether it's allowed by the label filters, and the names of its metrics
+// that are dropped by the metric name filters. It's used by the shadow mode
+// to evaluate filters without writing.
+func (opts *Options) FilterDecisions(em *metrics.EventMetrics) (bool, []string) {
+	f := opts.Filters()
+	if f == nil {
+		return true, nil
+	}
+	if !f.evalEventMetrics(em, false).allowed {
+		return false, nil
+	}
+
+	var dropped []string
+	for _, name := range em.MetricsKeys() {
+		if !f.matchMetricNameFilters(name) {
+			dropped = append(dropped, name)
+		}
+	}
+	return true, dropped
+}
 
-	if opts.ignoreMetricName != nil && opts.ignoreMetricName.MatchString(metricName) {
+// ShouldAddFailureMetric returns whether failure metric should be added to
+// the given EventMetrics, as per the current filters. It's a shorthand for
+// opts.Filters().ShouldAddFailureMetric(em).
+func (opts *Options) ShouldAddFailureMetric(em *metrics.EventMetrics) bool {
+	return opts.Filters().ShouldAddFailureMetric(em)
+}
+
+// ShouldAddFailureMetric returns whether failure metric should be added to
+// the given EventMetrics.
+func (f *Filters) ShouldAddFailureMetric(em *metrics.EventMetrics) bool {
+	if f == nil || !f.opts.AddFailureMetric || !f.matchMetricNameFilters("failure") {
 		return false
 	}
 
-	if opts.allowMetricName == nil {
+	opts := f.opts
+	if opts.failureMetricFor == nil {
 		return true
 	}
 
-	return opts.allowMetricName.MatchString(metricName)
+	for _, name := range em.MetricsKeys() {
+		if opts.failureMetricFor.MatchString(name) {
+			return true
+		}
+	}
+	return false
 }
 
+// IsLatencyMetric returns true if the metric name matches the latency metric
+// pattern. It looks at the name only; use IsLatencyMetricValue if the metric
+// value is available. It always returns false if latency metrics are
+// disabled through disable_latency_metrics.
 func (opts *Options) IsLatencyMetric(metricName string) bool {
-	if opts == nil {
+	if opts != nil && opts.disableLatencyMetrics {
+		return false
+	}
+	if opts == nil || opts.latencyMetricRe == nil {
 		return defaultLatencyMetricRe.MatchString(metricName)
 	}
-	return opts.latencyMetricRe.MatchString(metricName)
+	return opts.latencyMetricRe.MatchString(opts.nameNormalizer.normalize(metricName))
+}
+
+// IsLatencyMetricValue is like IsLatencyMetric, but it also checks the metric
+// value's type: only distributions and float values are latency metrics, so
+// that, for example, a counter named "db_latency" is not treated as one.
+func (opts *Options) IsLatencyMetricValue(metricName string, val metrics.Value) bool {
+	switch val.(type) {
+	case *metrics.Distribution, *metrics.Float:
+		return opts.IsLatencyMetric(metricName)
+	default:
+		return false
+	}
+}
+
+// MetricUnit returns the unit of the given metric, as a UCUM code, e.g. "us"
+// or "By". Unit set in the EventMetrics' MetricUnits takes precedence. For
+// other metrics, unit is inferred: latency metrics (see IsLatencyMetricValue)
+// are in the EventMetrics' latency unit, and metrics with "bytes" in their
+// name are in bytes. It returns an empty string if the unit is not known.
+func (opts *Options) MetricUnit(em *metrics.EventMetrics, metricName string) string {
+	if unit := em.MetricUnits[metricName]; unit != "" {
+		return unit
+	}
+	if opts.IsLatencyMetricValue(metricName, em.Metric(metricName)) {
+		return metrics.LatencyUnitToString(em.LatencyUnit)
+	}
+	if byteMetricRe.MatchString(metricName) {
+		return "By"
+	}
+	return ""
 }
 
 func processAdditionalLabels(envVar string, l *logger.Logger) [][2]string {
@@ -166,7 +950,232 @@ func processAdditionalLabels(envVar string, l *logger.Logger) [][2]string {
 	return labels
 }
 
-// buildOptions builds surfacer options using config.
+// removeDisabledNameFilters returns the config without the metric name
+// filters that are disabled through allow_metrics_with_name_enabled and
+// ignore_metrics_with_name_enabled. Disabled filters are still validated. If
+// no filter is disabled, config is returned as it is.
+func removeDisabledNameFilters(sdef *surfacerpb.SurfacerDef) (*surfacerpb.SurfacerDef, error) {
+	allowDisabled := sdef.GetAllowMetricsWithName() != "" && !sdef.GetAllowMetricsWithNameEnabled()
+	ignoreDisabled := sdef.GetIgnoreMetricsWithName() != "" && !sdef.GetIgnoreMetricsWithNameEnabled()
+	if !allowDisabled && !ignoreDisabled {
+		return sdef, nil
+	}
+
+	sdef = proto.Clone(sdef).(*surfacerpb.SurfacerDef)
+	if allowDisabled {
+		if _, err := regexp.Compile(sdef.GetAllowMetricsWithName()); err != nil {
+			return nil, &OptionsError{Field: "allow_metrics_with_name", Value: sdef.GetAllowMetricsWithName(), Err: err}
+		}
+		sdef.AllowMetricsWithName = nil
+	}
+	if ignoreDisabled {
+		if _, err := regexp.Compile(sdef.GetIgnoreMetricsWithName()); err != nil {
+			return nil, &OptionsError{Field: "ignore_metrics_with_name", Value: sdef.GetIgnoreMetricsWithName(), Err: err}
+		}
+		sdef.IgnoreMetricsWithName = nil
+	}
+	return sdef, nil
+}
+
+// parseFilters parses metrics filters from the given config. Referenced
+// filter bundles are expanded into the filters. Filters are not validated,
+// see validateFilters.
+func (opts *Options) parseFilters(sdef *surfacerpb.SurfacerDef) (*Filters, error) {
+	f := &Filters{
+		opts:          opts,
+		valueFilters:  sdef.GetAllowMetricsWithValue(),
+		successFilter: sdef.GetAllowMetricsWithSuccessState(),
+	}
+
+	sdef, err := removeDisabledNameFilters(sdef)
+	if err != nil {
+		return nil, err
+	}
+
+	sdef, err = expandFilterBundles(sdef)
+	if err != nil {
+		return nil, err
+	}
+
+	f.allowLabelFilters, err = parseMetricsFilter("allow_metrics_with_label", sdef.GetAllowMetricsWithLabel())
+	if err != nil {
+		return nil, err
+	}
+
+	f.ignoreLabelFilters, err = parseMetricsFilter("ignore_metrics_with_label", sdef.GetIgnoreMetricsWithLabel())
+	if err != nil {
+		return nil, err
+	}
+
+	f.allowLabelMatchAll = sdef.GetAllowMetricsLabelMatchMode() == surfacerpb.LabelMatchMode_ALL
+	f.allowFirst = sdef.GetFilterPrecedence() == surfacerpb.FilterPrecedence_ALLOW_FIRST
+
+	if sdef.GetAllowMetricsWithName() != "" {
+		f.allowMetricName, err = regexp.Compile(sdef.GetAllowMetricsWithName())
+		if err != nil {
+			return nil, &OptionsError{Field: "allow_metrics_with_name", Value: sdef.GetAllowMetricsWithName(), Err: err}
+		}
+	}
+
+	if sdef.GetIgnoreMetricsWithName() != "" {
+		f.ignoreMetricName, err = regexp.Compile(sdef.GetIgnoreMetricsWithName())
+		if err != nil {
+			return nil, &OptionsError{Field: "ignore_metrics_with_name", Value: sdef.GetIgnoreMetricsWithName(), Err: err}
+		}
+	}
+
+	return f, nil
+}
+
+// NewFilters builds the metrics filters (allow_metrics_with_label,
+// allow_metrics_label_match_mode, ignore_metrics_with_label,
+// filter_precedence, allow_metrics_with_name, ignore_metrics_with_name, their
+// enabled toggles, filter_bundle, allow_metrics_with_value and
+// allow_metrics_with_success_state) from the given config, and validates
+// them the same way as it's done at the startup. Other fields of the config,
+// e.g. ignore_label_keys, are ignored. Filters are not used until they are
+// applied with ApplyFilters, so callers updating multiple Options can
+// validate the new filters for all of them first.
+func (opts *Options) NewFilters(sdef *surfacerpb.SurfacerDef) (*Filters, error) {
+	f, err := opts.parseFilters(sdef)
+	if err != nil {
+		return nil, err
+	}
+	if err := opts.validateFilters(f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// ApplyFilters swaps in the filters built by NewFilters, for the next
+// filtering decisions. Decisions made with the Filters obtained earlier
+// through Filters() are not affected.
+func (opts *Options) ApplyFilters(f *Filters) {
+	opts.filters.Store(f)
+}
+
+// Reload reloads the metrics filters from the given config (see NewFilters
+// and ApplyFilters). It lets surfacers pick up the new filtering rules, from
+// the next EventMetrics, without a restart. If the new filters are not
+// valid, existing filters are left untouched.
+func (opts *Options) Reload(sdef *surfacerpb.SurfacerDef) error {
+	f, err := opts.NewFilters(sdef)
+	if err != nil {
+		return err
+	}
+	opts.ApplyFilters(f)
+	return nil
+}
+
+// FiltersConfig returns the currently active metrics filters as a
+// SurfacerDef, with only the filter fields set.
+func (opts *Options) FiltersConfig() *surfacerpb.SurfacerDef {
+	f := opts.Filters()
+
+	toConfig := func(filters []*labelFilter) []*surfacerpb.LabelFilter {
+		var out []*surfacerpb.LabelFilter
+		for _, lf := range filters {
+			c := &surfacerpb.LabelFilter{Key: proto.String(lf.key)}
+			if lf.value != "" {
+				c.Value = proto.String(lf.value)
+			}
+			if lf.keyPrefix {
+				c.KeyPrefix = proto.Bool(true)
+			}
+			if lf.valueRegex != "" {
+				c.ValueRegex = proto.String(lf.valueRegex)
+			}
+			if lf.negate {
+				c.Negate = proto.Bool(true)
+			}
+			out = append(out, c)
+		}
+		return out
+	}
+
+	sdef := &surfacerpb.SurfacerDef{
+		AllowMetricsWithLabel:  toConfig(f.allowLabelFilters),
+		IgnoreMetricsWithLabel: toConfig(f.ignoreLabelFilters),
+	}
+	if f.allowLabelMatchAll {
+		sdef.AllowMetricsLabelMatchMode = surfacerpb.LabelMatchMode_ALL.Enum()
+	}
+	if f.allowFirst {
+		sdef.FilterPrecedence = surfacerpb.FilterPrecedence_ALLOW_FIRST.Enum()
+	}
+	if f.allowMetricName != nil {
+		sdef.AllowMetricsWithName = proto.String(f.allowMetricName.String())
+	}
+	if f.ignoreMetricName != nil {
+		sdef.IgnoreMetricsWithName = proto.String(f.ignoreMetricName.String())
+	}
+	for _, vf := range f.valueFilters {
+		sdef.AllowMetricsWithValue = append(sdef.AllowMetricsWithValue, proto.Clone(vf).(*surfacerpb.ValueFilter))
+	}
+	if f.successFilter != nil {
+		sdef.AllowMetricsWithSuccessState = proto.Clone(f.successFilter).(*surfacerpb.SuccessFilter)
+	}
+	return sdef
+}
+
+// setDefaults sets the unset fields of the message, and of its set message
+// fields, that have an explicit default value, to that value.
+func setDefaults(m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch {
+		case fd.IsList() || fd.IsMap():
+			continue
+		case fd.Message() != nil:
+			if m.Has(fd) {
+				setDefaults(m.Mutable(fd).Message())
+			}
+		case fd.HasDefault() && !m.Has(fd) && fd.ContainingOneof() == nil:
+			m.Set(fd, fd.Default())
+		}
+	}
+}
+
+// EffectiveConfig returns the surfacer config with all the settings resolved
+// as they are in use: default values are filled in for the unset fields,
+// filters are the currently active ones (see Reload), and
+// settings derived while building the options, e.g. add_failure_metric,
+// reflect the derived values. Returned config is a copy and can be modified
+// freely.
+func (opts *Options) EffectiveConfig() *surfacerpb.SurfacerDef {
+	sdef := &surfacerpb.SurfacerDef{}
+	if opts.Config != nil {
+		sdef = proto.Clone(opts.Config).(*surfacerpb.SurfacerDef)
+	}
+	setDefaults(sdef.ProtoReflect())
+
+	filters := opts.FiltersConfig()
+	sdef.AllowMetricsWithLabel = filters.AllowMetricsWithLabel
+	sdef.AllowMetricsLabelMatchMode = filters.GetAllowMetricsLabelMatchMode().Enum()
+	sdef.IgnoreMetricsWithLabel = filters.IgnoreMetricsWithLabel
+	sdef.FilterPrecedence = filters.GetFilterPrecedence().Enum()
+	sdef.AllowMetricsWithName = filters.AllowMetricsWithName
+	sdef.IgnoreMetricsWithName = filters.IgnoreMetricsWithName
+	// Filter bundles are already expanded into the filters above.
+	sdef.FilterBundle = nil
+
+	sdef.AllowMetricsWithValue = filters.AllowMetricsWithValue
+	sdef.AllowMetricsWithSuccessState = filters.AllowMetricsWithSuccessState
+
+	sdef.AddFailureMetric = proto.Bool(opts.AddFailureMetric)
+	sdef.StripIgnoredLabelKeys = proto.Bool(opts.stripIgnoredLabelKeys)
+	if opts.latencyMetricRe != nil {
+		sdef.LatencyMetricPattern = proto.String(opts.latencyMetricRe.String())
+	}
+	if opts.disableLatencyMetrics {
+		sdef.DisableLatencyMetrics = proto.Bool(true)
+	}
+	return sdef
+}
+
+// buildOptions builds surfacer options using config. Config validation
+// failures are returned as *OptionsError.
 func buildOptions(sdef *surfacerpb.SurfacerDef, ignoreInit bool, l *logger.Logger) (*Options, error) {
 	opts := &Options{
 		Config:            sdef,
@@ -181,29 +1190,105 @@ func buildOptions(sdef *surfacerpb.SurfacerDef, ignoreInit bool, l *logger.Logge
 	}
 	opts.HTTPServeMux = serveMux
 
-	var err error
-	opts.allowLabelFilters, err = parseMetricsFilter(sdef.GetAllowMetricsWithLabel())
+	filters, err := opts.parseFilters(sdef)
 	if err != nil {
 		return nil, err
 	}
 
-	opts.ignoreLabelFilters, err = parseMetricsFilter(sdef.GetIgnoreMetricsWithLabel())
+	rules, err := parseBufferSizeRules(sdef.GetMetricsBufferSizeRule())
 	if err != nil {
 		return nil, err
 	}
+	opts.bufferSizeRules = rules
 
-	if sdef.GetAllowMetricsWithName() != "" {
-		opts.allowMetricName, err = regexp.Compile(sdef.GetAllowMetricsWithName())
+	if sdef.GetRouteBySurfacersLabel() {
+		opts.routeBySurfacersLabel = true
+		opts.routeName = sdef.GetName()
+		if opts.routeName == "" {
+			opts.routeName = strings.ToLower(sdef.GetType().String())
+		}
+	}
+
+	if err := opts.parseSampling(sdef); err != nil {
+		return nil, err
+	}
+
+	for _, k := range sdef.GetIgnoreLabelKeys() {
+		if opts.ignoreLabelKeys == nil {
+			opts.ignoreLabelKeys = make(map[string]bool)
+		}
+		opts.ignoreLabelKeys[k] = true
+	}
+	opts.stripIgnoredLabelKeys = sdef.GetStripIgnoredLabelKeys() && len(opts.ignoreLabelKeys) > 0
+
+	if err := opts.validateFilters(filters); err != nil {
+		return nil, err
+	}
+	opts.filters.Store(filters)
+
+	for _, k := range sdef.GetHashLabelValues() {
+		if opts.hashLabelKeys == nil {
+			opts.hashLabelKeys = make(map[string]bool)
+		}
+		opts.hashLabelKeys[k] = true
+	}
+	opts.hashLabelSalt = sdef.GetHashLabelValuesSalt()
+
+	if sdef.GetDistributionBucketMergeFactor() < 1 {
+		return nil, newOptionsError("distribution_bucket_merge_factor", strconv.Itoa(int(sdef.GetDistributionBucketMergeFactor())), "should be at least 1")
+	}
+	if sdef.GetDistributionBucketMergeFactor() > 1 {
+		opts.distBucketMergeFactor = int(sdef.GetDistributionBucketMergeFactor())
+	}
+
+	if dp := sdef.GetDistributionPercentiles(); dp != nil {
+		if len(dp.GetPercentile()) == 0 {
+			return nil, newOptionsError("distribution_percentiles", "", "should have at least one percentile")
+		}
+		for _, p := range dp.GetPercentile() {
+			if p <= 0 || p > 100 {
+				return nil, newOptionsError("distribution_percentiles.percentile", fmt.Sprint(p), "should be in (0, 100]")
+			}
+		}
+		opts.distPercentiles = dp
+	}
+
+	if sdef.GetDropZeroValues() != nil {
+		opts.dropZeroValues = sdef.GetDropZeroValues()
+		opts.nonZeroSeries = make(map[string]bool)
+	}
+
+	opts.invalidLatency = sdef.GetInvalidLatency()
+
+	if nn := sdef.GetMetricNameNormalization(); nn != nil {
+		normalizer, err := newNameNormalizer(nn)
 		if err != nil {
 			return nil, err
 		}
+		opts.nameNormalizer = normalizer
 	}
 
-	if sdef.GetIgnoreMetricsWithName() != "" {
-		opts.ignoreMetricName, err = regexp.Compile(sdef.GetIgnoreMetricsWithName())
+	if ln := sdef.GetLabelValueNormalization(); ln != nil {
+		normalizer, err := newLabelNormalizer(ln)
+		if err != nil {
+			return nil, err
+		}
+		opts.labelNormalizer = normalizer
+	}
+
+	if len(sdef.GetRelabelRule()) > 0 {
+		rl, err := newRelabeler(sdef.GetRelabelRule())
 		if err != nil {
 			return nil, err
 		}
+		opts.relabeler = rl
+	}
+
+	if cc := sdef.GetCounterContinuity(); cc != nil {
+		if cc.GetMaxGapSec() <= 0 {
+			return nil, newOptionsError("counter_continuity.max_gap_sec", strconv.Itoa(int(cc.GetMaxGapSec())), "should be positive")
+		}
+		opts.counterContinuity = newCounterContinuity(time.Duration(cc.GetMaxGapSec()) * time.Second)
 	}
 
 	opts.AddFailureMetric = opts.Config.GetAddFailureMetric()
@@ -215,13 +1300,29 @@ func buildOptions(sdef *surfacerpb.SurfacerDef, ignoreInit bool, l *logger.Logge
 		opts.AddFailureMetric = true
 	}
 
-	re, err := regexp.Compile(opts.Config.GetLatencyMetricPattern())
-	if err != nil {
-		return nil, fmt.Errorf("invalid latency_metric_pattern: %s, err: %v", opts.Config.GetLatencyMetricPattern(), err)
+	if sdef.GetAddFailureMetricForMetricsWithName() != "" {
+		re, err := regexp.Compile(sdef.GetAddFailureMetricForMetricsWithName())
+		if err != nil {
+			return nil, &OptionsError{Field: "add_failure_metric_for_metrics_with_name", Value: sdef.GetAddFailureMetricForMetricsWithName(), Err: err}
+		}
+		opts.failureMetricFor = re
+	}
+
+	if sdef.GetDisableLatencyMetrics() {
+		if sdef.LatencyMetricPattern != nil {
+			l.Warningf("latency_metric_pattern (%s) is ignored, as disable_latency_metrics is set", sdef.GetLatencyMetricPattern())
+		}
+		opts.disableLatencyMetrics = true
+	} else {
+		re, err := regexp.Compile(opts.Config.GetLatencyMetricPattern())
+		if err != nil {
+			return nil, &OptionsError{Field: "latency_metric_pattern", Value: opts.Config.GetLatencyMetricPattern(), Err: err}
+		}
+		opts.latencyMetricRe = re
 	}
-	opts.latencyMetricRe = re
 
 	opts.AdditionalLabels = processAdditionalLabels(opts.Config.GetAdditionalLabelsEnvVar(), l)
+	opts.ResourceAttributes = processResourceAttributes(sdef.GetResourceAttributes(), l)
 
 	return opts, nil
 }
@@ -237,6 +1338,10 @@ func BuildOptionsForTest(sdef *surfacerpb.SurfacerDef) *Options {
 
 	opts, err := buildOptions(sdef, ignoreInit, nil)
 	if err != nil {
+		var oe *OptionsError
+		if errors.As(err, &oe) {
+			panic("Error building surfacer options for tests, field " + oe.Field + ": " + err.Error())
+		}
 		panic("Error building surfacer options for tests: " + err.Error())
 	}
 
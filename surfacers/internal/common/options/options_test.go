@@ -15,15 +15,19 @@
 package options
 
 import (
+	"math"
 	"net/http"
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/metrics"
+	promconfigpb "github.com/cloudprober/cloudprober/surfacers/internal/prometheus/proto"
 	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
 	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
 	"github.com/stretchr/testify/assert"
@@ -129,6 +133,133 @@ func TestAllowEventMetrics(t *testing.T) {
 	}
 }
 
+func TestRouteBySurfacersLabel(t *testing.T) {
+	emWithRoute := func(route string) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(1))
+		if route != "" {
+			em.AddLabel(SurfacersLabel, route)
+		}
+		return em
+	}
+
+	tests := []struct {
+		desc  string
+		sdef  *configpb.SurfacerDef
+		route string
+		want  bool
+	}{
+		{
+			desc: "no_label",
+			sdef: &configpb.SurfacerDef{Name: proto.String("prom1"), RouteBySurfacersLabel: proto.Bool(true)},
+			want: true,
+		},
+		{
+			desc:  "listed",
+			sdef:  &configpb.SurfacerDef{Name: proto.String("prom1"), RouteBySurfacersLabel: proto.Bool(true)},
+			route: "file1, prom1",
+			want:  true,
+		},
+		{
+			desc:  "not_listed",
+			sdef:  &configpb.SurfacerDef{Name: proto.String("prom1"), RouteBySurfacersLabel: proto.Bool(true)},
+			route: "file1,prom10",
+		},
+		{
+			desc:  "type_as_name",
+			sdef:  &configpb.SurfacerDef{Type: configpb.Type_PROMETHEUS.Enum(), RouteBySurfacersLabel: proto.Bool(true)},
+			route: "prometheus",
+			want:  true,
+		},
+		{
+			desc:  "not_enabled",
+			sdef:  &configpb.SurfacerDef{Name: proto.String("prom1")},
+			route: "file1",
+			want:  true,
+		},
+		{
+			desc: "label_filters_still_apply",
+			sdef: &configpb.SurfacerDef{
+				Name:                   proto.String("prom1"),
+				RouteBySurfacersLabel:  proto.Bool(true),
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String(SurfacersLabel)}},
+			},
+			route: "prom1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			opts := BuildOptionsForTest(test.sdef)
+			assert.Equal(t, test.want, opts.AllowEventMetrics(emWithRoute(test.route)))
+		})
+	}
+}
+
+func TestAllowMetricsWithValue(t *testing.T) {
+	ems := []*metrics.EventMetrics{
+		metrics.NewEventMetrics(time.Now()).AddMetric("success", metrics.NewInt(0)).AddMetric("total", metrics.NewInt(1)),
+		metrics.NewEventMetrics(time.Now()).AddMetric("success", metrics.NewInt(1)).AddMetric("total", metrics.NewInt(1)),
+		metrics.NewEventMetrics(time.Now()).AddMetric("latency", metrics.NewFloat(250.5)),
+		metrics.NewEventMetrics(time.Now()).AddMetric("success", metrics.NewString("yes")),
+	}
+
+	vf := func(name string, op configpb.ValueFilter_Op, value float64) *configpb.ValueFilter {
+		return &configpb.ValueFilter{MetricName: proto.String(name), Op: op.Enum(), Value: proto.Float64(value)}
+	}
+
+	tests := []struct {
+		desc    string
+		filters []*configpb.ValueFilter
+		labels  []*configpb.LabelFilter
+		want    []bool
+	}{
+		{
+			desc: "no_filters",
+			want: []bool{true, true, true, true},
+		},
+		{
+			desc:    "eq",
+			filters: []*configpb.ValueFilter{{MetricName: proto.String("success"), Value: proto.Float64(0)}},
+			want:    []bool{true, false, false, false},
+		},
+		{
+			desc:    "ne",
+			filters: []*configpb.ValueFilter{vf("success", configpb.ValueFilter_NE, 0)},
+			want:    []bool{false, true, false, false},
+		},
+		{
+			desc:    "lt_le",
+			filters: []*configpb.ValueFilter{vf("latency", configpb.ValueFilter_LT, 250.5), vf("success", configpb.ValueFilter_LE, 0)},
+			want:    []bool{true, false, false, false},
+		},
+		{
+			desc:    "gt_ge_any",
+			filters: []*configpb.ValueFilter{vf("latency", configpb.ValueFilter_GT, 100), vf("success", configpb.ValueFilter_GE, 1)},
+			want:    []bool{false, true, true, false},
+		},
+		{
+			desc:    "with_label_filters",
+			filters: []*configpb.ValueFilter{vf("success", configpb.ValueFilter_GE, 0)},
+			labels:  []*configpb.LabelFilter{{Key: proto.String("probe")}},
+			want:    []bool{false, false, false, false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{
+				AllowMetricsWithValue: test.filters,
+				AllowMetricsWithLabel: test.labels,
+			})
+			var got []bool
+			for _, em := range ems {
+				got = append(got, opts.AllowEventMetrics(em))
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
 func TestAllowMetric(t *testing.T) {
 	tests := []struct {
 		desc        string
@@ -275,6 +406,10 @@ func TestBuildOptions(t *testing.T) {
 				t.Errorf("buildOptions() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			// Filters are covered by the filter tests.
+			if got != nil {
+				tt.want.filters.Store(got.Filters())
+			}
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -298,6 +433,21 @@ func TestOptions_IsLatencyMetric(t *testing.T) {
 			metricName: []string{"latency", "dns_latency", "latency_read"},
 			want:       []bool{false, false, true},
 		},
+		{
+			name:       "disabled",
+			opts:       BuildOptionsForTest(&configpb.SurfacerDef{DisableLatencyMetrics: proto.Bool(true)}),
+			metricName: []string{"latency", "dns_latency", "latency_read", ""},
+			want:       []bool{false, false, false, false},
+		},
+		{
+			name: "disabled_with_pattern",
+			opts: BuildOptionsForTest(&configpb.SurfacerDef{
+				DisableLatencyMetrics: proto.Bool(true),
+				LatencyMetricPattern:  proto.String(""),
+			}),
+			metricName: []string{"latency", "dns_latency", "latency_read", ""},
+			want:       []bool{false, false, false, false},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -308,6 +458,65 @@ func TestOptions_IsLatencyMetric(t *testing.T) {
 	}
 }
 
+func TestOptions_IsLatencyMetricValue(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{})
+	disabled := BuildOptionsForTest(&configpb.SurfacerDef{DisableLatencyMetrics: proto.Bool(true)})
+	dist := metrics.NewDistribution([]float64{1, 10})
+
+	tests := []struct {
+		name       string
+		metricName string
+		val        metrics.Value
+		want       bool
+	}{
+		{"float", "latency", metrics.NewFloat(1.5), true},
+		{"distribution", "dns_latency", dist, true},
+		{"int", "db_latency", metrics.NewInt(10), false},
+		{"string", "latency", metrics.NewString("1.5"), false},
+		{"map", "latency", metrics.NewMapFloat("code"), false},
+		{"nil", "latency", nil, false},
+		{"not_latency_name", "total", metrics.NewFloat(1.5), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, opts.IsLatencyMetricValue(tt.metricName, tt.val))
+			assert.Equal(t, tt.want, (*Options)(nil).IsLatencyMetricValue(tt.metricName, tt.val), "nil options")
+			assert.False(t, disabled.IsLatencyMetricValue(tt.metricName, tt.val), "disable_latency_metrics")
+		})
+	}
+}
+
+func TestMetricUnit(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("latency", metrics.NewFloat(1.5)).
+		AddMetric("db_latency", metrics.NewInt(10))
+	em.LatencyUnit = time.Millisecond
+	em.MetricUnits = map[string]string{"availability": "%", "dns_latency": "s"}
+
+	tests := []struct {
+		metricName string
+		want       string
+	}{
+		{"latency", "ms"},
+		{"db_latency", ""},
+		{"dns_latency", "s"},
+		{"availability", "%"},
+		{"resp_bytes", "By"},
+		{"bytes_sent", "By"},
+		{"bytesize", ""},
+		{"total", ""},
+	}
+	for _, opts := range []*Options{nil, BuildOptionsForTest(&configpb.SurfacerDef{})} {
+		for _, tt := range tests {
+			assert.Equal(t, tt.want, opts.MetricUnit(em, tt.metricName), "metricName: %s", tt.metricName)
+		}
+	}
+
+	// Latency unit defaults to microseconds.
+	em = metrics.NewEventMetrics(time.Now()).AddMetric("latency", metrics.NewFloat(1.5))
+	assert.Equal(t, "us", (*Options)(nil).MetricUnit(em, "latency"))
+}
+
 func Test_processAdditionalLabels(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -364,3 +573,1435 @@ func Test_processAdditionalLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestReloadLabelAndNameFilters(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("sysvars")},
+		},
+	})
+
+	emSysvars := metrics.NewEventMetrics(time.Now()).AddLabel("probe", "sysvars")
+	emHTTP := metrics.NewEventMetrics(time.Now()).AddLabel("probe", "http")
+
+	assert.False(t, opts.AllowEventMetrics(emSysvars), "sysvars before update")
+	assert.True(t, opts.AllowEventMetrics(emHTTP), "http before update")
+	assert.True(t, opts.AllowMetric("latency"), "latency before update")
+
+	newFilters := &configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("http")},
+		},
+		IgnoreMetricsWithName: proto.String("^latency$"),
+	}
+	assert.NoError(t, opts.Reload(newFilters))
+
+	assert.True(t, opts.AllowEventMetrics(emSysvars), "sysvars after update")
+	assert.False(t, opts.AllowEventMetrics(emHTTP), "http after update")
+	assert.False(t, opts.AllowMetric("latency"), "latency after update")
+	assert.True(t, opts.AllowMetric("total"), "total after update")
+	assert.True(t, proto.Equal(newFilters, opts.FiltersConfig()), "got filters: %v", opts.FiltersConfig())
+
+	// Invalid filters should not change anything.
+	for _, badFilters := range []*configpb.SurfacerDef{
+		{
+			AllowMetricsWithLabel: []*configpb.LabelFilter{{Value: proto.String("sysvars")}},
+		},
+		{
+			IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe")}},
+			AllowMetricsWithName:   proto.String("(total"),
+		},
+	} {
+		assert.Error(t, opts.Reload(badFilters))
+		assert.True(t, proto.Equal(newFilters, opts.FiltersConfig()), "got filters: %v", opts.FiltersConfig())
+		assert.False(t, opts.AllowEventMetrics(emHTTP), "http after failed update")
+		assert.False(t, opts.AllowMetric("latency"), "latency after failed update")
+	}
+}
+
+func TestEffectiveConfig(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		sdef := &configpb.SurfacerDef{
+			Type:     configpb.Type_PROMETHEUS.Enum(),
+			Surfacer: &configpb.SurfacerDef_PrometheusSurfacer{PrometheusSurfacer: &promconfigpb.SurfacerConf{}},
+		}
+		opts := BuildOptionsForTest(sdef)
+		got := opts.EffectiveConfig()
+
+		assert.Equal(t, true, got.GetAddFailureMetric())
+		assert.Equal(t, "^(.+_|)latency$", got.GetLatencyMetricPattern())
+		assert.NotNil(t, got.LatencyMetricPattern, "latency_metric_pattern set")
+		assert.Equal(t, int64(10000), *got.MetricsBufferSize)
+		assert.Equal(t, "/metrics", *got.GetPrometheusSurfacer().MetricsUrl)
+		assert.Equal(t, false, *got.StripIgnoredLabelKeys)
+		// Fields without an explicit default are left unset.
+		assert.Nil(t, got.Name)
+
+		// Original config is left untouched.
+		assert.Nil(t, sdef.MetricsBufferSize)
+		assert.Nil(t, sdef.GetPrometheusSurfacer().MetricsUrl)
+	})
+
+	t.Run("derived", func(t *testing.T) {
+		opts := BuildOptionsForTest(&configpb.SurfacerDef{
+			Type:                  configpb.Type_FILE.Enum(),
+			LatencyMetricPattern:  proto.String("^latency_.*"),
+			StripIgnoredLabelKeys: proto.Bool(true),
+			IgnoreMetricsWithName: proto.String("^debug_"),
+		})
+		assert.NoError(t, opts.Reload(&configpb.SurfacerDef{
+			AllowMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe")}},
+		}))
+		got := opts.EffectiveConfig()
+
+		// File surfacer doesn't add failure metric by default.
+		assert.Equal(t, false, *got.AddFailureMetric)
+		assert.Equal(t, "^latency_.*", got.GetLatencyMetricPattern())
+		// Stripping needs ignore_label_keys.
+		assert.Equal(t, false, *got.StripIgnoredLabelKeys)
+		// Filters are the active ones.
+		assert.Nil(t, got.IgnoreMetricsWithName)
+		assert.Len(t, got.GetAllowMetricsWithLabel(), 1)
+		assert.Equal(t, "probe", got.GetAllowMetricsWithLabel()[0].GetKey())
+	})
+}
+
+func TestReload(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("sysvars")},
+		},
+		IgnoreLabelKeys: []string{"request_id"},
+	})
+
+	newEM := func(probe string, success int64) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("success", metrics.NewInt(success)).
+			AddLabel("probe", probe)
+	}
+	assert.False(t, opts.AllowEventMetrics(newEM("sysvars", 1)), "sysvars before reload")
+	assert.True(t, opts.AllowEventMetrics(newEM("http", 0)), "http before reload")
+
+	newConf := &configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("dns")},
+		},
+		AllowMetricsWithValue: []*configpb.ValueFilter{
+			{MetricName: proto.String("success"), Op: configpb.ValueFilter_GT.Enum(), Value: proto.Float64(0)},
+		},
+		IgnoreMetricsWithName: proto.String("^latency$"),
+	}
+	assert.NoError(t, opts.Reload(newConf))
+
+	assert.True(t, opts.AllowEventMetrics(newEM("sysvars", 1)), "sysvars after reload")
+	assert.False(t, opts.AllowEventMetrics(newEM("http", 0)), "http with success=0 after reload")
+	assert.False(t, opts.AllowEventMetrics(newEM("dns", 1)), "dns after reload")
+	assert.False(t, opts.AllowMetric("latency"), "latency after reload")
+	assert.Len(t, opts.EffectiveConfig().GetAllowMetricsWithValue(), 1)
+
+	// Invalid or conflicting filters should not change anything.
+	for _, badConf := range []*configpb.SurfacerDef{
+		{AllowMetricsWithName: proto.String("(total")},
+		{
+			AllowMetricsWithLabel:  []*configpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("http")}},
+			IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("http")}},
+		},
+	} {
+		assert.Error(t, opts.Reload(badConf))
+		assert.False(t, opts.AllowEventMetrics(newEM("dns", 1)), "dns after failed reload")
+		assert.False(t, opts.AllowEventMetrics(newEM("http", 0)), "http after failed reload")
+	}
+
+	// Label filters on the ignored label keys still never match.
+	assert.NoError(t, opts.Reload(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("request_id")}},
+	}))
+	assert.True(t, opts.AllowEventMetrics(newEM("http", 0).AddLabel("request_id", "r1")))
+}
+
+func TestNewFilters(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{})
+	em := metrics.NewEventMetrics(time.Now()).AddLabel("probe", "sysvars")
+
+	_, err := opts.NewFilters(&configpb.SurfacerDef{AllowMetricsWithName: proto.String("(total")})
+	assert.Error(t, err)
+
+	f, err := opts.NewFilters(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe")}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, opts.AllowEventMetrics(em), "before ApplyFilters")
+
+	opts.ApplyFilters(f)
+	assert.False(t, opts.AllowEventMetrics(em), "after ApplyFilters")
+}
+
+func TestFiltersSnapshot(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		IgnoreMetricsWithName: proto.String("^latency$"),
+	})
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(1)).
+		AddMetric("latency", metrics.NewFloat(10)).
+		AddLabel("probe", "p1")
+
+	f := opts.Filters()
+	assert.NoError(t, opts.Reload(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe"), Value: proto.String("p1")}},
+		IgnoreMetricsWithName:  proto.String("^total$"),
+	}))
+
+	// Decisions made with the filters obtained before the reload are not
+	// affected by it.
+	assert.True(t, f.AllowEventMetrics(em), "old filters")
+	assert.True(t, f.AllowMetric("total"), "old filters")
+	assert.False(t, f.AllowMetric("latency"), "old filters")
+
+	assert.False(t, opts.Filters().AllowEventMetrics(em), "new filters")
+	assert.False(t, opts.Filters().AllowMetric("total"), "new filters")
+	assert.True(t, opts.Filters().AllowMetric("latency"), "new filters")
+
+	var nilFilters *Filters
+	assert.True(t, nilFilters.AllowEventMetrics(em))
+	assert.True(t, nilFilters.AllowMetric("latency"))
+}
+
+func TestReloadConcurrent(t *testing.T) {
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{})
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("success", metrics.NewInt(1)).
+		AddLabel("probe", "sysvars")
+
+	confs := []*configpb.SurfacerDef{
+		{},
+		{
+			IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("probe")}},
+			AllowMetricsWithValue:  []*configpb.ValueFilter{{MetricName: proto.String("success"), Value: proto.Float64(0)}},
+			IgnoreMetricsWithName:  proto.String(".*"),
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			assert.NoError(t, opts.Reload(confs[i%2]))
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			opts.AllowEventMetrics(em)
+			opts.AllowMetric("total")
+			opts.EffectiveConfig()
+		}
+	}
+}
+
+func TestShouldAddFailureMetric(t *testing.T) {
+	httpEM := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddMetric("success", metrics.NewInt(18)).
+		AddMetric("resp_code", metrics.NewMap("code"))
+	tcpEM := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddMetric("success", metrics.NewInt(18))
+
+	tests := []struct {
+		name     string
+		sdef     *configpb.SurfacerDef
+		wantHTTP bool
+		wantTCP  bool
+		wantErr  bool
+	}{
+		{
+			name:     "default",
+			sdef:     &configpb.SurfacerDef{},
+			wantHTTP: true,
+			wantTCP:  true,
+		},
+		{
+			name: "disabled",
+			sdef: &configpb.SurfacerDef{
+				AddFailureMetric:                   proto.Bool(false),
+				AddFailureMetricForMetricsWithName: proto.String("^resp_code$"),
+			},
+		},
+		{
+			name: "name_pattern",
+			sdef: &configpb.SurfacerDef{
+				AddFailureMetricForMetricsWithName: proto.String("^resp_code$"),
+			},
+			wantHTTP: true,
+		},
+		{
+			name: "name_pattern_ignored_failure",
+			sdef: &configpb.SurfacerDef{
+				AddFailureMetricForMetricsWithName: proto.String("^resp_code$"),
+				IgnoreMetricsWithName:              proto.String("^failure$"),
+			},
+		},
+		{
+			name: "invalid_pattern",
+			sdef: &configpb.SurfacerDef{
+				AddFailureMetricForMetricsWithName: proto.String("(resp_code"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantHTTP, opts.ShouldAddFailureMetric(httpEM), "http")
+			assert.Equal(t, tt.wantTCP, opts.ShouldAddFailureMetric(tcpEM), "tcp")
+		})
+	}
+}
+
+func TestIgnoreLabelKeys(t *testing.T) {
+	newEM := func(reqID string) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("request_id", reqID)
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed bool
+	}{
+		{
+			name: "ignore_filter_on_ignored_key",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys: []string{"request_id"},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("request_id")},
+				},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "allow_filter_on_ignored_key",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys: []string{"request_id"},
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("request_id"), Value: proto.String("r1")},
+				},
+			},
+			wantAllowed: false,
+		},
+		{
+			name: "other_keys_still_match",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys: []string{"request_id"},
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("request_id"), Value: proto.String("r1")},
+					{Key: proto.String("probe"), Value: proto.String("homepage")},
+				},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "no_ignored_keys",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("request_id")},
+				},
+			},
+			wantAllowed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+			// Result shouldn't depend on the value of the ignored label.
+			for _, reqID := range []string{"r1", "r2"} {
+				assert.Equal(t, tt.wantAllowed, opts.AllowEventMetrics(newEM(reqID)), "request_id=%s", reqID)
+			}
+		})
+	}
+}
+
+func TestKeyPrefixLabelFilter(t *testing.T) {
+	ems := map[string]*metrics.EventMetrics{
+		"pod": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("k8s.namespace", "prod").
+			AddLabel("k8s.pod.name", "web-1"),
+		"node": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("k8s.node.name", "prod"),
+		"no_k8s": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("k8s", "prod"),
+	}
+
+	prefixFilter := func(key, value string) *configpb.LabelFilter {
+		lf := &configpb.LabelFilter{Key: proto.String(key), KeyPrefix: proto.Bool(true)}
+		if value != "" {
+			lf.Value = proto.String(value)
+		}
+		return lf
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed []string
+	}{
+		{
+			name:        "allow_prefix",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.", "")}},
+			wantAllowed: []string{"node", "pod"},
+		},
+		{
+			name:        "allow_prefix_value_on_any_matched_key",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.", "prod")}},
+			wantAllowed: []string{"node", "pod"},
+		},
+		{
+			name:        "allow_prefix_value_only_on_matched_keys",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.pod.", "prod")}},
+			wantAllowed: nil,
+		},
+		{
+			name:        "allow_prefix_value",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.pod.", "web-1")}},
+			wantAllowed: []string{"pod"},
+		},
+		{
+			name:        "ignore_prefix",
+			sdef:        &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.node.", "")}},
+			wantAllowed: []string{"no_k8s", "pod"},
+		},
+		{
+			name:        "exact_key_is_not_a_prefix_match",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("k8s.")}}},
+			wantAllowed: nil,
+		},
+		{
+			name: "ignored_keys_are_skipped",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys:       []string{"k8s.namespace"},
+				AllowMetricsWithLabel: []*configpb.LabelFilter{prefixFilter("k8s.", "prod")},
+			},
+			wantAllowed: []string{"node"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			var got []string
+			for name, em := range ems {
+				if opts.AllowEventMetrics(em) {
+					got = append(got, name)
+				}
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.wantAllowed, got)
+
+			// Prefix filters should survive the round trip through the
+			// filters config.
+			assert.True(t, proto.Equal(&configpb.SurfacerDef{
+				AllowMetricsWithLabel:  tt.sdef.GetAllowMetricsWithLabel(),
+				IgnoreMetricsWithLabel: tt.sdef.GetIgnoreMetricsWithLabel(),
+			}, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+		})
+	}
+}
+
+func TestValueRegexLabelFilter(t *testing.T) {
+	newEM := func(region string) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("region", region)
+	}
+	regions := []string{"us-east-1", "us-west-2", "eu-west-1", "aus-east-1"}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed []string
+		wantErr     bool
+	}{
+		{
+			name: "ignore_regex",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("region"), ValueRegex: proto.String("us-.*")},
+			}},
+			wantAllowed: []string{"eu-west-1", "aus-east-1"},
+		},
+		{
+			name: "allow_regex",
+			sdef: &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("region"), ValueRegex: proto.String(".*-west-[0-9]+")},
+			}},
+			wantAllowed: []string{"us-west-2", "eu-west-1"},
+		},
+		{
+			name: "exact_value_is_not_a_regex",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("region"), Value: proto.String("us-.*")},
+			}},
+			wantAllowed: regions,
+		},
+		{
+			name: "invalid_regex",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("region"), ValueRegex: proto.String("us-(")},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "value_and_regex",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("region"), Value: proto.String("us-east-1"), ValueRegex: proto.String("us-.*")},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "regex_without_key",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{ValueRegex: proto.String("us-.*")},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			var got []string
+			for _, region := range regions {
+				if opts.AllowEventMetrics(newEM(region)) {
+					got = append(got, region)
+				}
+			}
+			assert.Equal(t, tt.wantAllowed, got)
+
+			assert.True(t, proto.Equal(&configpb.SurfacerDef{
+				AllowMetricsWithLabel:  tt.sdef.GetAllowMetricsWithLabel(),
+				IgnoreMetricsWithLabel: tt.sdef.GetIgnoreMetricsWithLabel(),
+			}, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+		})
+	}
+}
+
+func TestNegatedLabelFilter(t *testing.T) {
+	ems := map[string]*metrics.EventMetrics{
+		"sre": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "homepage").
+			AddLabel("team", "sre"),
+		"payments": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "checkout").
+			AddLabel("team", "payments"),
+		"no_team": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "misconfigured"),
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed []string
+		wantErr     bool
+	}{
+		{
+			name: "ignore_without_label",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("team"), Negate: proto.Bool(true)},
+			}},
+			wantAllowed: []string{"payments", "sre"},
+		},
+		{
+			name: "allow_without_label",
+			sdef: &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("team"), Negate: proto.Bool(true)},
+			}},
+			wantAllowed: []string{"no_team"},
+		},
+		{
+			name: "ignore_without_label_value",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("team"), Value: proto.String("sre"), Negate: proto.Bool(true)},
+			}},
+			wantAllowed: []string{"sre"},
+		},
+		{
+			name: "allow_without_label_value_regex",
+			sdef: &configpb.SurfacerDef{AllowMetricsWithLabel: []*configpb.LabelFilter{
+				{Key: proto.String("team"), ValueRegex: proto.String("s.*"), Negate: proto.Bool(true)},
+			}},
+			wantAllowed: []string{"no_team", "payments"},
+		},
+		{
+			name: "negated_filter_on_ignored_key",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys: []string{"team"},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("team"), Negate: proto.Bool(true)},
+				},
+			},
+			wantAllowed: []string{"no_team", "payments", "sre"},
+		},
+		{
+			name: "negate_without_key",
+			sdef: &configpb.SurfacerDef{IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+				{Negate: proto.Bool(true)},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			var got []string
+			for name, em := range ems {
+				if opts.AllowEventMetrics(em) {
+					got = append(got, name)
+				}
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.wantAllowed, got)
+
+			assert.True(t, proto.Equal(&configpb.SurfacerDef{
+				AllowMetricsWithLabel:  tt.sdef.GetAllowMetricsWithLabel(),
+				IgnoreMetricsWithLabel: tt.sdef.GetIgnoreMetricsWithLabel(),
+			}, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+		})
+	}
+}
+
+func TestAllowLabelMatchMode(t *testing.T) {
+	ems := map[string]*metrics.EventMetrics{
+		"prod_frontend": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("env", "prod").
+			AddLabel("tier", "frontend"),
+		"prod_backend": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("env", "prod").
+			AddLabel("tier", "backend"),
+		"prod_no_tier": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("env", "prod"),
+		"no_labels": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)),
+	}
+
+	prodFrontend := []*configpb.LabelFilter{
+		{Key: proto.String("env"), Value: proto.String("prod")},
+		{Key: proto.String("tier"), Value: proto.String("frontend")},
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed []string
+	}{
+		{
+			name:        "any_default",
+			sdef:        &configpb.SurfacerDef{AllowMetricsWithLabel: prodFrontend},
+			wantAllowed: []string{"prod_backend", "prod_frontend", "prod_no_tier"},
+		},
+		{
+			name: "all",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:      prodFrontend,
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+			},
+			wantAllowed: []string{"prod_frontend"},
+		},
+		{
+			name: "all_with_key_only_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("env"), Value: proto.String("prod")},
+					{Key: proto.String("tier")},
+				},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+			},
+			wantAllowed: []string{"prod_backend", "prod_frontend"},
+		},
+		{
+			name: "all_with_negated_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("env"), Value: proto.String("prod")},
+					{Key: proto.String("tier"), Negate: proto.Bool(true)},
+				},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+			},
+			wantAllowed: []string{"prod_no_tier"},
+		},
+		{
+			name: "all_with_ignore_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("env"), Value: proto.String("prod")},
+				},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("tier"), Value: proto.String("backend")},
+				},
+			},
+			wantAllowed: []string{"prod_frontend", "prod_no_tier"},
+		},
+		{
+			name: "all_without_allow_filters",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+			},
+			wantAllowed: []string{"no_labels", "prod_backend", "prod_frontend", "prod_no_tier"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			var got []string
+			for name, em := range ems {
+				if opts.AllowEventMetrics(em) {
+					got = append(got, name)
+				}
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.wantAllowed, got)
+
+			assert.True(t, proto.Equal(&configpb.SurfacerDef{
+				AllowMetricsWithLabel:      tt.sdef.GetAllowMetricsWithLabel(),
+				AllowMetricsLabelMatchMode: tt.sdef.AllowMetricsLabelMatchMode,
+				IgnoreMetricsWithLabel:     tt.sdef.GetIgnoreMetricsWithLabel(),
+			}, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+		})
+	}
+
+	// Match mode is updated along with the filters.
+	opts, err := buildOptions(&configpb.SurfacerDef{AllowMetricsWithLabel: prodFrontend}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error = %v", err)
+	}
+	assert.True(t, opts.AllowEventMetrics(ems["prod_backend"]), "prod_backend before update")
+	assert.NoError(t, opts.Reload(&configpb.SurfacerDef{
+		AllowMetricsWithLabel:      prodFrontend,
+		AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+	}))
+	assert.False(t, opts.AllowEventMetrics(ems["prod_backend"]), "prod_backend after update")
+	assert.Equal(t, configpb.LabelMatchMode_ALL, opts.EffectiveConfig().GetAllowMetricsLabelMatchMode())
+}
+
+func TestFilterPrecedence(t *testing.T) {
+	ems := map[string]*metrics.EventMetrics{
+		"debug": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("debug", "true"),
+		"debug_critical": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("debug", "true").
+			AddLabel("critical", "true"),
+		"critical": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("critical", "true"),
+		"no_labels": metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)),
+	}
+
+	ignoreDebug := []*configpb.LabelFilter{{Key: proto.String("debug"), Value: proto.String("true")}}
+	allowCritical := []*configpb.LabelFilter{{Key: proto.String("critical"), Value: proto.String("true")}}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantAllowed []string
+	}{
+		{
+			name: "ignore_first_default",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: ignoreDebug,
+				AllowMetricsWithLabel:  allowCritical,
+			},
+			wantAllowed: []string{"critical"},
+		},
+		{
+			name: "allow_first",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: ignoreDebug,
+				AllowMetricsWithLabel:  allowCritical,
+				FilterPrecedence:       configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+			},
+			wantAllowed: []string{"critical", "debug_critical", "no_labels"},
+		},
+		{
+			name: "allow_first_match_all",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: ignoreDebug,
+				AllowMetricsWithLabel: []*configpb.LabelFilter{
+					{Key: proto.String("debug"), Value: proto.String("true")},
+					{Key: proto.String("critical"), Value: proto.String("true")},
+				},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+				FilterPrecedence:           configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+			},
+			wantAllowed: []string{"critical", "debug_critical", "no_labels"},
+		},
+		{
+			name: "allow_first_without_allow_filters",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: ignoreDebug,
+				FilterPrecedence:       configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+			},
+			wantAllowed: []string{"critical", "no_labels"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			var got []string
+			for name, em := range ems {
+				if opts.AllowEventMetrics(em) {
+					got = append(got, name)
+				}
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.wantAllowed, got)
+			assert.Equal(t, tt.sdef.GetFilterPrecedence(), opts.EffectiveConfig().GetFilterPrecedence())
+		})
+	}
+
+	// Precedence is updated along with the filters.
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: ignoreDebug,
+		AllowMetricsWithLabel:  allowCritical,
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error = %v", err)
+	}
+	assert.False(t, opts.AllowEventMetrics(ems["debug_critical"]), "debug_critical before update")
+	assert.NoError(t, opts.Reload(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: ignoreDebug,
+		AllowMetricsWithLabel:  allowCritical,
+		FilterPrecedence:       configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+	}))
+	assert.True(t, opts.AllowEventMetrics(ems["debug_critical"]), "debug_critical after update")
+	assert.Equal(t, configpb.FilterPrecedence_ALLOW_FIRST, opts.FiltersConfig().GetFilterPrecedence())
+}
+
+func TestDisabledFilters(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddMetric("validation_failure", metrics.NewInt(1)).
+		AddLabel("probe", "sysvars").
+		AddLabel("env", "staging")
+
+	disabled := proto.Bool(false)
+	sdef := &configpb.SurfacerDef{
+		AllowMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("env"), Value: proto.String("prod"), Enabled: disabled},
+		},
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("sysvars"), Enabled: disabled},
+			{Key: proto.String("env"), Value: proto.String("dev")},
+		},
+		AllowMetricsWithName:         proto.String("^total$"),
+		AllowMetricsWithNameEnabled:  disabled,
+		IgnoreMetricsWithName:        proto.String("^validation_failure$"),
+		IgnoreMetricsWithNameEnabled: disabled,
+	}
+
+	opts, err := buildOptions(sdef, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error = %v", err)
+	}
+	assert.True(t, opts.AllowEventMetrics(em), "disabled label filters")
+	assert.True(t, opts.AllowMetric("validation_failure"), "disabled name filters")
+	assert.Len(t, opts.Filters().ignoreLabelFilters, 1, "enabled ignore filters")
+
+	// Disabled filters remain in the config, but not in the active filters.
+	assert.Len(t, opts.Config.GetIgnoreMetricsWithLabel(), 2)
+	assert.Equal(t, "^total$", opts.Config.GetAllowMetricsWithName())
+	assert.True(t, proto.Equal(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("env"), Value: proto.String("dev")},
+		},
+	}, opts.FiltersConfig()), "FiltersConfig: %v", opts.FiltersConfig())
+
+	// Enabling the filters through an update.
+	enabledSdef := proto.Clone(sdef).(*configpb.SurfacerDef)
+	enabledSdef.AllowMetricsWithLabel[0].Enabled = nil
+	enabledSdef.IgnoreMetricsWithLabel[0].Enabled = nil
+	enabledSdef.AllowMetricsWithNameEnabled = nil
+	enabledSdef.IgnoreMetricsWithNameEnabled = nil
+	assert.NoError(t, opts.Reload(enabledSdef))
+	assert.False(t, opts.AllowEventMetrics(em), "enabled label filters")
+	assert.False(t, opts.AllowMetric("validation_failure"), "enabled name filters")
+
+	// Disabled filters are still validated.
+	for name, sdef := range map[string]*configpb.SurfacerDef{
+		"label_filter": {AllowMetricsWithLabel: []*configpb.LabelFilter{{Value: proto.String("prod"), Enabled: disabled}}},
+		"name_filter":  {IgnoreMetricsWithName: proto.String("("), IgnoreMetricsWithNameEnabled: disabled},
+	} {
+		_, err := buildOptions(sdef, true, nil)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestFilterStats(t *testing.T) {
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("probe"), Value: proto.String("noisy")},
+		},
+		IgnoreMetricsWithName: proto.String("^resp-code$"),
+		AddFailureMetric:      proto.Bool(true),
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error = %v", err)
+	}
+
+	newEM := func(probe string) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddMetric("resp-code", metrics.NewMap("code")).
+			AddLabel("probe", probe)
+	}
+
+	for _, probe := range []string{"homepage", "noisy", "checkout", "noisy", "noisy"} {
+		em := newEM(probe)
+		if !opts.AllowEventMetrics(em) {
+			continue
+		}
+		for _, name := range em.MetricsKeys() {
+			opts.AllowMetric(name)
+		}
+	}
+	assert.Equal(t, FilterStats{Allowed: 2, LabelDropped: 3, NameDropped: 2}, opts.Stats())
+
+	// Decisions that don't drop anything are not counted.
+	opts.FilterDecisions(newEM("noisy"))
+	opts.FilterDecisions(newEM("homepage"))
+	opts.ShouldAddFailureMetric(newEM("homepage"))
+	opts.MatchMetricNameFilters("resp-code")
+	assert.Equal(t, FilterStats{Allowed: 2, LabelDropped: 3, NameDropped: 2}, opts.Stats())
+
+	var nilOpts *Options
+	assert.True(t, nilOpts.AllowEventMetrics(newEM("noisy")))
+	assert.Equal(t, FilterStats{}, nilOpts.Stats())
+}
+
+func TestStripIgnoredLabels(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddLabel("probe", "homepage").
+		AddLabel("request_id", "r1")
+
+	tests := []struct {
+		name       string
+		sdef       *configpb.SurfacerDef
+		wantLabels []string
+	}{
+		{
+			name:       "not_stripped_by_default",
+			sdef:       &configpb.SurfacerDef{IgnoreLabelKeys: []string{"request_id"}},
+			wantLabels: []string{"probe", "request_id"},
+		},
+		{
+			name: "stripped",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys:       []string{"request_id"},
+				StripIgnoredLabelKeys: proto.Bool(true),
+			},
+			wantLabels: []string{"probe"},
+		},
+		{
+			name: "nothing_to_strip",
+			sdef: &configpb.SurfacerDef{
+				IgnoreLabelKeys:       []string{"timestamp"},
+				StripIgnoredLabelKeys: proto.Bool(true),
+			},
+			wantLabels: []string{"probe", "request_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+			got := opts.StripIgnoredLabels(em)
+			assert.Equal(t, tt.wantLabels, got.LabelsKeys())
+			assert.Equal(t, []string{"total"}, got.MetricsKeys())
+			// Input EventMetrics should be untouched.
+			assert.Equal(t, []string{"probe", "request_id"}, em.LabelsKeys())
+		})
+	}
+}
+
+func TestEMWith(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("latency", metrics.NewFloat(1.5)).
+		AddLabel("probe", "p1").
+		AddLabel("dst", "t1").
+		AddLabel("request_id", "42")
+	em.Kind = metrics.GAUGE
+	em.LatencyUnit = time.Millisecond
+	em.MetricUnits = map[string]string{"latency": "ms"}
+
+	for _, test := range []struct {
+		name       string
+		labelFn    func(k, v string) (string, string, bool)
+		wantLabels []string
+	}{
+		{
+			name:       "keep",
+			wantLabels: []string{"probe=p1", "dst=t1", "request_id=42"},
+		},
+		{
+			name: "rename_and_drop",
+			labelFn: func(k, v string) (string, string, bool) {
+				if k == "dst" {
+					return "target", strings.ToUpper(v), true
+				}
+				return k, v, k != "request_id"
+			},
+			wantLabels: []string{"probe=p1", "target=T1"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			newEM := emWith(em, test.labelFn)
+			assert.Equal(t, em.Timestamp, newEM.Timestamp)
+			assert.Equal(t, em.Kind, newEM.Kind)
+			assert.Equal(t, em.LatencyUnit, newEM.LatencyUnit)
+			assert.Equal(t, em.MetricUnits, newEM.MetricUnits)
+			assert.Empty(t, newEM.MetricsKeys(), "metrics are added by the transforms")
+
+			var gotLabels []string
+			for _, k := range newEM.LabelsKeys() {
+				gotLabels = append(gotLabels, k+"="+newEM.Label(k))
+			}
+			assert.Equal(t, test.wantLabels, gotLabels)
+		})
+	}
+}
+
+func TestDownsampleDistributions(t *testing.T) {
+	d := metrics.NewDistribution([]float64{1, 2, 4, 8})
+	for _, s := range []float64{0.5, 1.5, 3, 5, 10} {
+		d.AddSample(s)
+	}
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddMetric("latency", d).
+		AddLabel("probe", "homepage")
+	em.Kind = metrics.GAUGE
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantLatency string
+		wantErr     bool
+	}{
+		{
+			name:        "default",
+			sdef:        &configpb.SurfacerDef{},
+			wantLatency: "dist:sum:20|count:5|lb:-Inf,1,2,4,8|bc:1,1,1,1,1",
+		},
+		{
+			name:        "factor_2",
+			sdef:        &configpb.SurfacerDef{DistributionBucketMergeFactor: proto.Int32(2)},
+			wantLatency: "dist:sum:20|count:5|lb:-Inf,1,4|bc:1,2,2",
+		},
+		{
+			name:        "factor_3",
+			sdef:        &configpb.SurfacerDef{DistributionBucketMergeFactor: proto.Int32(3)},
+			wantLatency: "dist:sum:20|count:5|lb:-Inf,1,8|bc:1,3,1",
+		},
+		{
+			name:    "invalid_factor",
+			sdef:    &configpb.SurfacerDef{DistributionBucketMergeFactor: proto.Int32(0)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(tt.sdef, true, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+			got := opts.DownsampleDistributions(em)
+			assert.Equal(t, tt.wantLatency, got.Metric("latency").String())
+			assert.Equal(t, "20", got.Metric("total").String())
+			assert.Equal(t, "homepage", got.Label("probe"))
+			assert.Equal(t, em.Kind, got.Kind)
+		})
+	}
+
+	// Original EventMetrics is not modified.
+	assert.Equal(t, "dist:sum:20|count:5|lb:-Inf,1,2,4,8|bc:1,1,1,1,1", em.Metric("latency").String())
+
+	// EventMetrics without distributions is returned as it is.
+	noDistEM := metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(20))
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{DistributionBucketMergeFactor: proto.Int32(2)})
+	assert.Same(t, noDistEM, opts.DownsampleDistributions(noDistEM))
+}
+
+func TestDropZeroValues(t *testing.T) {
+	testEM := func(kind metrics.Kind, total, failures int64) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(total)).
+			AddMetric("failures", metrics.NewInt(failures)).
+			AddLabel("dst", "t1")
+		em.Kind = kind
+		return em
+	}
+
+	// Each step writes total and failures, and we record the metrics that
+	// make it through, or "-" if the whole EventMetrics is dropped.
+	steps := [][2]int64{{0, 0}, {5, 1}, {6, 0}, {7, 0}, {0, 0}}
+
+	tests := []struct {
+		name string
+		conf *configpb.DropZeroValues
+		kind metrics.Kind
+		want []string
+	}{
+		{
+			name: "not_configured",
+			kind: metrics.CUMULATIVE,
+			want: []string{
+				"total=0,failures=0",
+				"total=5,failures=1",
+				"total=6,failures=0",
+				"total=7,failures=0",
+				"total=0,failures=0",
+			},
+		},
+		{
+			name: "cumulative_keep_transitions",
+			conf: &configpb.DropZeroValues{},
+			kind: metrics.CUMULATIVE,
+			want: []string{
+				"-",
+				"total=5,failures=1",
+				"total=6,failures=0",
+				"total=7",
+				"total=0",
+			},
+		},
+		{
+			name: "gauge_no_transitions",
+			conf: &configpb.DropZeroValues{KeepTransitionsToZero: proto.Bool(false)},
+			kind: metrics.GAUGE,
+			want: []string{
+				"-",
+				"total=5,failures=1",
+				"total=6",
+				"total=7",
+				"-",
+			},
+		},
+		{
+			name: "gauge_not_enabled",
+			conf: &configpb.DropZeroValues{Gauge: proto.Bool(false)},
+			kind: metrics.GAUGE,
+			want: []string{
+				"total=0,failures=0",
+				"total=5,failures=1",
+				"total=6,failures=0",
+				"total=7,failures=0",
+				"total=0,failures=0",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{DropZeroValues: tt.conf})
+
+			var got []string
+			for _, step := range steps {
+				em := testEM(tt.kind, step[0], step[1])
+				out := opts.DropZeroValues(em)
+				if out == nil {
+					got = append(got, "-")
+					continue
+				}
+				var parts []string
+				for _, name := range out.MetricsKeys() {
+					parts = append(parts, name+"="+out.Metric(name).String())
+				}
+				got = append(got, strings.Join(parts, ","))
+
+				// Input EventMetrics is never modified.
+				assert.Len(t, em.MetricsKeys(), 2)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// Non-numeric values are never dropped.
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{DropZeroValues: &configpb.DropZeroValues{}})
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(0)).
+		AddMetric("status", metrics.NewString("ok"))
+	assert.Equal(t, []string{"status"}, opts.DropZeroValues(em).MetricsKeys())
+}
+
+func TestSplitDistributionPercentiles(t *testing.T) {
+	// 10 samples in each of the buckets [0,10), [10,20), [20,40).
+	d := metrics.NewDistribution([]float64{0, 10, 20, 40})
+	for _, s := range []float64{5, 15, 30} {
+		for i := 0; i < 10; i++ {
+			d.AddSample(s)
+		}
+	}
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(20)).
+		AddMetric("latency", d).
+		AddMetric("empty_latency", metrics.NewDistribution([]float64{0, 10})).
+		AddLabel("probe", "homepage")
+	em.MetricUnits = map[string]string{"latency": "ms"}
+
+	tests := []struct {
+		name           string
+		dp             *configpb.DistributionPercentiles
+		wantSame       bool
+		wantMetrics    []string
+		wantNoRest     bool
+		wantPercentile map[string]float64
+		wantErr        bool
+	}{
+		{
+			name:     "not_configured",
+			wantSame: true,
+		},
+		{
+			name:           "replace_distributions",
+			dp:             &configpb.DistributionPercentiles{Percentile: []float64{50, 90, 99.9}},
+			wantMetrics:    []string{"total"},
+			wantPercentile: map[string]float64{"p50": 15, "p90": 34, "p99.9": 39.94},
+		},
+		{
+			name:           "keep_distributions",
+			dp:             &configpb.DistributionPercentiles{Percentile: []float64{50}, KeepDistributions: proto.Bool(true)},
+			wantMetrics:    []string{"total", "latency", "empty_latency"},
+			wantPercentile: map[string]float64{"p50": 15},
+		},
+		{
+			name:    "no_percentiles",
+			dp:      &configpb.DistributionPercentiles{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_percentile",
+			dp:      &configpb.DistributionPercentiles{Percentile: []float64{50, 0}},
+			wantErr: true,
+		},
+		{
+			name:    "percentile_over_100",
+			dp:      &configpb.DistributionPercentiles{Percentile: []float64{101}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := buildOptions(&configpb.SurfacerDef{DistributionPercentiles: tt.dp}, true, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildOptions() error = %v", err)
+			}
+
+			restEM, percentilesEM := opts.SplitDistributionPercentiles(em)
+			if tt.wantSame {
+				assert.Same(t, em, restEM)
+				assert.Nil(t, percentilesEM)
+				return
+			}
+
+			assert.Equal(t, tt.wantMetrics, restEM.MetricsKeys())
+			assert.Equal(t, em.Kind, restEM.Kind)
+
+			if percentilesEM == nil {
+				t.Fatal("no percentiles EventMetrics")
+			}
+			assert.Equal(t, metrics.Kind(metrics.GAUGE), percentilesEM.Kind)
+			assert.Equal(t, "homepage", percentilesEM.Label("probe"))
+			// Distributions without samples have no percentiles.
+			assert.Equal(t, []string{"latency_percentile"}, percentilesEM.MetricsKeys())
+			assert.Equal(t, map[string]string{"latency_percentile": "ms"}, percentilesEM.MetricUnits)
+
+			m := percentilesEM.Metric("latency_percentile").(*metrics.Map[float64])
+			assert.Equal(t, "percentile", m.MapName)
+			got := make(map[string]float64)
+			for _, k := range m.Keys() {
+				got[k] = m.GetKey(k)
+			}
+			assert.InDeltaMapValues(t, tt.wantPercentile, got, 1e-9)
+
+			// Input is not modified.
+			assert.Equal(t, []string{"total", "latency", "empty_latency"}, em.MetricsKeys())
+		})
+	}
+
+	t.Run("only_distributions", func(t *testing.T) {
+		opts := BuildOptionsForTest(&configpb.SurfacerDef{
+			DistributionPercentiles: &configpb.DistributionPercentiles{Percentile: []float64{50}},
+		})
+		restEM, percentilesEM := opts.SplitDistributionPercentiles(metrics.NewEventMetrics(time.Now()).AddMetric("latency", d))
+		assert.Nil(t, restEM)
+		assert.NotNil(t, percentilesEM)
+	})
+}
+
+func TestHashLabelValues(t *testing.T) {
+	testEM := func(user string) *metrics.EventMetrics {
+		return metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("dst", "t1").
+			AddLabel("user", user)
+	}
+
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{HashLabelValues: []string{"user", "missing"}})
+	em := testEM("alice@example.com")
+	out := opts.HashLabelValues(em)
+	assert.Equal(t, []string{"dst", "user"}, out.LabelsKeys())
+	assert.Equal(t, "t1", out.Label("dst"))
+	assert.Equal(t, "ff8d9819fc0e12bf", out.Label("user"))
+	assert.Equal(t, "alice@example.com", em.Label("user"), "input EventMetrics is not modified")
+	assert.Equal(t, "1", out.Metric("total").String())
+
+	// Same value, same hash; salt changes the hash.
+	assert.Equal(t, out.Label("user"), opts.HashLabelValues(testEM("alice@example.com")).Label("user"))
+	saltedOpts := BuildOptionsForTest(&configpb.SurfacerDef{HashLabelValues: []string{"user"}, HashLabelValuesSalt: proto.String("s1")})
+	assert.NotEqual(t, out.Label("user"), saltedOpts.HashLabelValues(testEM("alice@example.com")).Label("user"))
+
+	// Nothing to hash.
+	em = metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(1)).AddLabel("dst", "t1")
+	assert.Same(t, em, opts.HashLabelValues(em))
+	assert.Same(t, em, BuildOptionsForTest(&configpb.SurfacerDef{}).HashLabelValues(em))
+}
+
+func TestFixInvalidLatency(t *testing.T) {
+	formatEM := func(em *metrics.EventMetrics) string {
+		if em == nil {
+			return "-"
+		}
+		var parts []string
+		for _, name := range em.MetricsKeys() {
+			parts = append(parts, name+"="+em.Metric(name).String())
+		}
+		return strings.Join(parts, ",")
+	}
+
+	inputs := []struct {
+		name string
+		em   *metrics.EventMetrics
+	}{
+		{"valid", metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(2)).AddMetric("latency", metrics.NewFloat(1.5))},
+		{"nan", metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(2)).AddMetric("latency", metrics.NewFloat(math.NaN()))},
+		{"inf", metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(2)).AddMetric("dns_latency", metrics.NewFloat(math.Inf(1)))},
+		{"absent", metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(2)).AddMetric("latency", nil)},
+		{"only_latency", metrics.NewEventMetrics(time.Now()).AddMetric("latency", metrics.NewFloat(math.NaN()))},
+		{"not_latency", metrics.NewEventMetrics(time.Now()).AddMetric("ratio", metrics.NewFloat(math.NaN()))},
+	}
+
+	tests := []struct {
+		name string
+		conf *configpb.InvalidLatency
+		want []string
+	}{
+		{
+			name: "drop",
+			conf: &configpb.InvalidLatency{},
+			want: []string{"total=2,latency=1.500", "total=2", "total=2", "total=2", "-", "ratio=NaN"},
+		},
+		{
+			name: "default",
+			conf: &configpb.InvalidLatency{
+				Policy:       configpb.InvalidLatency_DEFAULT.Enum(),
+				DefaultValue: proto.Float64(-1),
+			},
+			want: []string{"total=2,latency=1.500", "total=2,latency=-1.000", "total=2,dns_latency=-1.000", "total=2,latency=-1.000", "latency=-1.000", "ratio=NaN"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{InvalidLatency: test.conf})
+
+			var got []string
+			for _, in := range inputs {
+				out := opts.FixInvalidLatency(in.em)
+				if in.name == "valid" || in.name == "not_latency" {
+					assert.Same(t, in.em, out, in.name)
+				}
+				got = append(got, formatEM(out))
+			}
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	// Not configured.
+	em := inputs[1].em
+	assert.Same(t, em, BuildOptionsForTest(&configpb.SurfacerDef{}).FixInvalidLatency(em))
+}
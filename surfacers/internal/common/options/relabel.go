@@ -0,0 +1,129 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+type relabelRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// relabeler renames the metrics and the label keys using the relabel rules.
+type relabeler struct {
+	metricRules []relabelRule
+	labelRules  []relabelRule
+
+	// Caches of the renamed metric names and label keys.
+	metricCache sync.Map
+	labelCache  sync.Map
+}
+
+func newRelabeler(rules []*surfacerpb.RelabelRule) (*relabeler, error) {
+	rl := &relabeler{}
+	for _, r := range rules {
+		re, err := regexp.Compile("^(?:" + r.GetSourceRegex() + ")$")
+		if err != nil {
+			return nil, &OptionsError{Field: "relabel_rule.source_regex", Value: r.GetSourceRegex(), Err: err}
+		}
+		if r.GetReplacement() == "" {
+			return nil, newOptionsError("relabel_rule.replacement", "", fmt.Sprintf("empty replacement for source_regex %s", r.GetSourceRegex()))
+		}
+		rule := relabelRule{re: re, replacement: r.GetReplacement()}
+		if r.GetTarget() == surfacerpb.RelabelRule_LABEL_KEY {
+			rl.labelRules = append(rl.labelRules, rule)
+		} else {
+			rl.metricRules = append(rl.metricRules, rule)
+		}
+	}
+	return rl, nil
+}
+
+func rename(name string, rules []relabelRule, cache *sync.Map) string {
+	if len(rules) == 0 {
+		return name
+	}
+	if v, ok := cache.Load(name); ok {
+		return v.(string)
+	}
+	renamed := name
+	for _, r := range rules {
+		if m := r.re.FindStringSubmatchIndex(renamed); m != nil {
+			renamed = string(r.re.ExpandString(nil, r.replacement, renamed, m))
+		}
+	}
+	cache.Store(name, renamed)
+	return renamed
+}
+
+// ApplyRelabel returns EventMetrics with the metrics and the label keys
+// renamed as per the relabel rules. Input EventMetrics is not modified, as
+// it's shared with the other surfacers; if nothing is renamed, it's returned
+// as it is.
+func (opts *Options) ApplyRelabel(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if opts == nil || opts.relabeler == nil {
+		return em
+	}
+	rl := opts.relabeler
+
+	metricName := func(name string) string { return rename(name, rl.metricRules, &rl.metricCache) }
+	labelKey := func(key string) string { return rename(key, rl.labelRules, &rl.labelCache) }
+
+	metricsKeys, labelsKeys := em.MetricsKeys(), em.LabelsKeys()
+
+	changed := false
+	for _, name := range metricsKeys {
+		if metricName(name) != name {
+			changed = true
+			break
+		}
+	}
+	for _, key := range labelsKeys {
+		if changed {
+			break
+		}
+		changed = labelKey(key) != key
+	}
+	if !changed {
+		return em
+	}
+
+	// Existing labels win over the renamed labels with the same key.
+	kept := make(map[string]bool)
+	for _, k := range labelsKeys {
+		kept[k] = labelKey(k) == k
+	}
+	newEM := emWith(em, func(k, v string) (string, string, bool) {
+		newKey := labelKey(k)
+		return newKey, v, newKey == k || !kept[newKey]
+	})
+	if em.MetricUnits != nil {
+		newEM.MetricUnits = make(map[string]string, len(em.MetricUnits))
+		for name, unit := range em.MetricUnits {
+			newEM.MetricUnits[metricName(name)] = unit
+		}
+	}
+	for _, name := range metricsKeys {
+		newEM.AddMetric(metricName(name), em.Metric(name))
+	}
+	return newEM
+}
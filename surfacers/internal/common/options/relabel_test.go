@@ -0,0 +1,135 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testRelabelEM() *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(10)).
+		AddMetric("latency", metrics.NewFloat(1.5)).
+		AddMetric("dns_latency", metrics.NewFloat(0.5)).
+		AddLabel("ptype", "http").
+		AddLabel("probe", "web").
+		AddLabel("dst", "example.com")
+	em.Kind = metrics.GAUGE
+	em.MetricUnits = map[string]string{"latency": "s"}
+	return em
+}
+
+func TestApplyRelabel(t *testing.T) {
+	metricRule := func(re, repl string) *configpb.RelabelRule {
+		return &configpb.RelabelRule{SourceRegex: proto.String(re), Replacement: proto.String(repl)}
+	}
+	labelRule := func(re, repl string) *configpb.RelabelRule {
+		r := metricRule(re, repl)
+		r.Target = configpb.RelabelRule_LABEL_KEY.Enum()
+		return r
+	}
+
+	tests := []struct {
+		name        string
+		rules       []*configpb.RelabelRule
+		wantSame    bool
+		wantMetrics []string
+		wantLabels  map[string]string
+		wantUnits   map[string]string
+	}{
+		{
+			name:     "not_configured",
+			wantSame: true,
+		},
+		{
+			name:     "no_match",
+			rules:    []*configpb.RelabelRule{metricRule("resp_code", "http_resp_code"), labelRule("code", "status")},
+			wantSame: true,
+		},
+		{
+			name:        "rename_metric_full_match",
+			rules:       []*configpb.RelabelRule{metricRule("latency", "http_latency_seconds")},
+			wantMetrics: []string{"total", "http_latency_seconds", "dns_latency"},
+			wantLabels:  map[string]string{"ptype": "http", "probe": "web", "dst": "example.com"},
+			wantUnits:   map[string]string{"http_latency_seconds": "s"},
+		},
+		{
+			name:        "capture_groups",
+			rules:       []*configpb.RelabelRule{metricRule("(?P<kind>[a-z]*)_?latency", "latency_${kind}"), metricRule("(.*)_$", "$1")},
+			wantMetrics: []string{"total", "latency", "latency_dns"},
+			wantLabels:  map[string]string{"ptype": "http", "probe": "web", "dst": "example.com"},
+			wantUnits:   map[string]string{"latency": "s"},
+		},
+		{
+			name:        "rename_label",
+			rules:       []*configpb.RelabelRule{labelRule("d(st)", "target"), labelRule("p(.*)", "probe_$1")},
+			wantMetrics: []string{"total", "latency", "dns_latency"},
+			wantLabels:  map[string]string{"probe_type": "http", "probe_robe": "web", "target": "example.com"},
+			wantUnits:   map[string]string{"latency": "s"},
+		},
+		{
+			name:        "label_collision",
+			rules:       []*configpb.RelabelRule{labelRule("dst", "probe")},
+			wantMetrics: []string{"total", "latency", "dns_latency"},
+			wantLabels:  map[string]string{"ptype": "http", "probe": "web"},
+			wantUnits:   map[string]string{"latency": "s"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := BuildOptionsForTest(&configpb.SurfacerDef{RelabelRule: test.rules})
+
+			em := testRelabelEM()
+			out := opts.ApplyRelabel(em)
+			if test.wantSame {
+				assert.Same(t, em, out)
+				return
+			}
+
+			assert.Equal(t, test.wantMetrics, out.MetricsKeys())
+			gotLabels := make(map[string]string)
+			for _, k := range out.LabelsKeys() {
+				gotLabels[k] = out.Label(k)
+			}
+			assert.Equal(t, test.wantLabels, gotLabels)
+			assert.Equal(t, test.wantUnits, out.MetricUnits)
+			assert.Equal(t, em.Kind, out.Kind)
+
+			// Input is not modified.
+			assert.Equal(t, []string{"total", "latency", "dns_latency"}, em.MetricsKeys())
+			assert.Equal(t, []string{"ptype", "probe", "dst"}, em.LabelsKeys())
+			assert.Equal(t, map[string]string{"latency": "s"}, em.MetricUnits)
+		})
+	}
+}
+
+func TestApplyRelabelInvalidRule(t *testing.T) {
+	for name, r := range map[string]*configpb.RelabelRule{
+		"bad_regex":         {SourceRegex: proto.String("("), Replacement: proto.String("x")},
+		"empty_replacement": {SourceRegex: proto.String("latency")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := buildOptions(&configpb.SurfacerDef{RelabelRule: []*configpb.RelabelRule{r}}, true, nil)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/logger"
+)
+
+// Well-known resource attributes that get a default value.
+const (
+	serviceNameAttr    = "service.name"
+	serviceVersionAttr = "service.version"
+	hostNameAttr       = "host.name"
+)
+
+const defaultServiceName = "cloudprober"
+
+// hostname is a variable so that tests can override it.
+var hostname = os.Hostname
+
+// envResourceAttributes parses the resource attributes from the
+// OTEL_RESOURCE_ATTRIBUTES environment variable, e.g.
+// "environment=prod,team=sre". Values may be percent-encoded.
+func envResourceAttributes(l *logger.Logger) map[string]string {
+	attrs := make(map[string]string)
+	for _, kv := range strings.Split(os.Getenv("OTEL_RESOURCE_ATTRIBUTES"), ",") {
+		if strings.TrimSpace(kv) == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" {
+			l.Warningf("Invalid resource attribute in OTEL_RESOURCE_ATTRIBUTES: %s", kv)
+			continue
+		}
+		if uv, err := url.PathUnescape(v); err == nil {
+			v = uv
+		}
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// processResourceAttributes returns the resource attributes, sorted by key,
+// with the attributes that are not configured taken from the environment.
+// It returns nil if no resource attribute is configured.
+func processResourceAttributes(configured map[string]string, l *logger.Logger) [][2]string {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	attrs := envResourceAttributes(l)
+	if v := os.Getenv("OTEL_SERVICE_NAME"); v != "" {
+		attrs[serviceNameAttr] = v
+	}
+	if attrs[serviceNameAttr] == "" {
+		attrs[serviceNameAttr] = defaultServiceName
+	}
+	if attrs[serviceVersionAttr] == "" && runconfig.Version() != "" {
+		attrs[serviceVersionAttr] = runconfig.Version()
+	}
+	if attrs[hostNameAttr] == "" {
+		if h, err := hostname(); err == nil {
+			attrs[hostNameAttr] = h
+		} else {
+			l.Warningf("Error getting hostname for the %s resource attribute: %v", hostNameAttr, err)
+		}
+	}
+
+	for k, v := range configured {
+		attrs[k] = v
+	}
+
+	var out [][2]string
+	for k, v := range attrs {
+		out = append(out, [2]string{k, v})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"errors"
+	"testing"
+
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessResourceAttributes(t *testing.T) {
+	defer func(f func() (string, error)) { hostname = f }(hostname)
+
+	tests := []struct {
+		name        string
+		configured  map[string]string
+		env         map[string]string
+		hostnameErr bool
+		want        [][2]string
+	}{
+		{
+			name: "not_configured",
+			env:  map[string]string{"OTEL_RESOURCE_ATTRIBUTES": "environment=prod"},
+		},
+		{
+			name:       "defaults",
+			configured: map[string]string{"environment": "prod"},
+			want: [][2]string{
+				{"environment", "prod"},
+				{"host.name", "test-host"},
+				{"service.name", "cloudprober"},
+			},
+		},
+		{
+			name:       "from_env",
+			configured: map[string]string{"team": "sre"},
+			env: map[string]string{
+				"OTEL_RESOURCE_ATTRIBUTES": "environment=staging, region=us%2Deast1,invalid",
+				"OTEL_SERVICE_NAME":        "prober",
+			},
+			want: [][2]string{
+				{"environment", "staging"},
+				{"host.name", "test-host"},
+				{"region", "us-east1"},
+				{"service.name", "prober"},
+				{"team", "sre"},
+			},
+		},
+		{
+			name: "configured_overrides",
+			configured: map[string]string{
+				"environment":     "prod",
+				"host.name":       "prober-1",
+				"service.name":    "edge-prober",
+				"service.version": "v1.2.3",
+			},
+			env: map[string]string{
+				"OTEL_RESOURCE_ATTRIBUTES": "environment=staging",
+				"OTEL_SERVICE_NAME":        "prober",
+			},
+			want: [][2]string{
+				{"environment", "prod"},
+				{"host.name", "prober-1"},
+				{"service.name", "edge-prober"},
+				{"service.version", "v1.2.3"},
+			},
+		},
+		{
+			name:        "hostname_error",
+			configured:  map[string]string{"environment": "prod"},
+			hostnameErr: true,
+			want: [][2]string{
+				{"environment", "prod"},
+				{"service.name", "cloudprober"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "")
+			t.Setenv("OTEL_SERVICE_NAME", "")
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+			hostname = func() (string, error) {
+				if test.hostnameErr {
+					return "", errors.New("no hostname")
+				}
+				return "test-host", nil
+			}
+
+			assert.Equal(t, test.want, processResourceAttributes(test.configured, nil))
+		})
+	}
+}
+
+func TestBuildOptionsResourceAttributes(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "")
+	t.Setenv("OTEL_SERVICE_NAME", "")
+
+	opts := BuildOptionsForTest(&configpb.SurfacerDef{
+		ResourceAttributes: map[string]string{"service.name": "prober", "host.name": "h1"},
+	})
+	assert.Equal(t, [][2]string{{"host.name", "h1"}, {"service.name", "prober"}}, opts.ResourceAttributes)
+
+	assert.Nil(t, BuildOptionsForTest(&configpb.SurfacerDef{}).ResourceAttributes)
+}
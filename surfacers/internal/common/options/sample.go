@@ -0,0 +1,77 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+func (opts *Options) parseSampling(sdef *surfacerpb.SurfacerDef) error {
+	rate := sdef.GetSampleRate()
+	if rate <= 0 || rate > 1 {
+		return newOptionsError("sample_rate", fmt.Sprint(rate), "should be in (0, 1]")
+	}
+	if rate == 1 {
+		if len(sdef.GetSampleByLabel()) > 0 {
+			opts.Logger.Warningf("sample_by_label is set, but sample_rate is 1, nothing will be sampled out")
+		}
+		return nil
+	}
+	opts.sampleRate = rate
+	opts.sampleByLabels = sdef.GetSampleByLabel()
+	return nil
+}
+
+// ShouldSample returns true if the EventMetrics should be surfaced as per
+// the sample_rate config. Decision is deterministic for the sample_by_label
+// label values (all labels by default), so a series is either always kept or
+// always dropped.
+func (opts *Options) ShouldSample(em *metrics.EventMetrics) bool {
+	if opts == nil || opts.sampleRate == 0 {
+		return true
+	}
+
+	keys := opts.sampleByLabels
+	if len(keys) == 0 {
+		keys = em.LabelsKeys()
+	}
+
+	h := fnv.New64a()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(em.Label(k)))
+		h.Write([]byte{0})
+	}
+
+	// Top 53 bits of the hash, as a float in [0, 1).
+	return float64(mix64(h.Sum64())>>11)/(1<<53) < opts.sampleRate
+}
+
+// mix64 is the murmur3 64-bit finalizer. FNV's high bits are not well mixed
+// for similar keys, e.g. target names differing only in a suffix, which would
+// skew the sampled fraction.
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func sampleEM(dst, probe string) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(1)).
+		AddLabel("probe", probe).
+		AddLabel("dst", dst)
+}
+
+func TestShouldSample(t *testing.T) {
+	tests := []struct {
+		name         string
+		rate         *float64
+		byLabel      []string
+		wantFraction float64
+	}{
+		{
+			name:         "default",
+			wantFraction: 1,
+		},
+		{
+			name:         "explicit_1.0",
+			rate:         proto.Float64(1),
+			byLabel:      []string{"dst"},
+			wantFraction: 1,
+		},
+		{
+			name:         "by_dst",
+			rate:         proto.Float64(0.1),
+			byLabel:      []string{"dst"},
+			wantFraction: 0.1,
+		},
+		{
+			name:         "all_labels",
+			rate:         proto.Float64(0.5),
+			wantFraction: 0.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := buildOptions(&configpb.SurfacerDef{
+				SampleRate:    test.rate,
+				SampleByLabel: test.byLabel,
+			}, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error: %v", err)
+			}
+
+			const numTargets = 10000
+			kept := 0
+			for i := 0; i < numTargets; i++ {
+				dst := fmt.Sprintf("target-%d", i)
+				sampled := opts.ShouldSample(sampleEM(dst, "p1"))
+				if sampled {
+					kept++
+				}
+				// Decision doesn't flicker across the EventMetrics of the
+				// same series.
+				assert.Equal(t, sampled, opts.ShouldSample(sampleEM(dst, "p1")), "dst: %s", dst)
+			}
+			assert.InDelta(t, test.wantFraction, float64(kept)/numTargets, 0.02)
+		})
+	}
+}
+
+func TestShouldSampleByLabel(t *testing.T) {
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		SampleRate:    proto.Float64(0.5),
+		SampleByLabel: []string{"dst"},
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error: %v", err)
+	}
+
+	// All series of a target are either kept or dropped, as only dst is used
+	// for the decision.
+	for i := 0; i < 100; i++ {
+		dst := fmt.Sprintf("target-%d", i)
+		want := opts.ShouldSample(sampleEM(dst, "p1"))
+		for _, probe := range []string{"p2", "p3", "p4"} {
+			assert.Equal(t, want, opts.ShouldSample(sampleEM(dst, probe)), "dst: %s, probe: %s", dst, probe)
+		}
+	}
+
+	// Options not built from the config, e.g. in the surfacer tests.
+	assert.True(t, (&Options{}).ShouldSample(sampleEM("target-1", "p1")))
+}
+
+func TestSampleRateErrors(t *testing.T) {
+	for _, rate := range []float64{0, -0.5, 1.5} {
+		t.Run(fmt.Sprint(rate), func(t *testing.T) {
+			_, err := buildOptions(&configpb.SurfacerDef{SampleRate: proto.Float64(rate)}, true, nil)
+			assert.ErrorContains(t, err, "sample_rate")
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+// successCounts are the success and total values of a series.
+type successCounts struct {
+	success, total float64
+}
+
+// matchSuccessFilter returns true if the EventMetrics is in the success state
+// of the success filter. EventMetrics without numeric success and total
+// metrics match only if match_missing is set. If record is false, series'
+// values are not recorded for the next delta.
+func (f *Filters) matchSuccessFilter(em *metrics.EventMetrics, record bool) bool {
+	success, okS := em.Metric("success").(metrics.NumValue)
+	total, okT := em.Metric("total").(metrics.NumValue)
+	if !okS || !okT {
+		return f.successFilter.GetMatchMissing()
+	}
+
+	c := successCounts{success: success.Float64(), total: total.Float64()}
+	if em.Kind == metrics.CUMULATIVE {
+		c = f.opts.successDelta(em, c, record)
+	}
+
+	failures := c.total-c.success > 0
+	switch f.successFilter.GetState() {
+	case surfacerpb.SuccessFilter_FAILURES:
+		return failures
+	case surfacerpb.SuccessFilter_NO_FAILURES:
+		return !failures
+	}
+	return false
+}
+
+// successDelta returns the change in the success and total values since the
+// previous EventMetrics of the same series, and records the new values if
+// record is set. For the first EventMetrics of a series, or after a counter
+// reset, it returns the values as they are.
+func (opts *Options) successDelta(em *metrics.EventMetrics, c successCounts, record bool) successCounts {
+	key := seriesKey(em, "success")
+
+	opts.successCountsMu.Lock()
+	defer opts.successCountsMu.Unlock()
+
+	if opts.successCounts == nil {
+		opts.successCounts = make(map[string]successCounts)
+	}
+	prev, ok := opts.successCounts[key]
+	if record {
+		opts.successCounts[key] = c
+	}
+
+	if !ok || c.total < prev.total || c.success < prev.success {
+		return c
+	}
+	return successCounts{success: c.success - prev.success, total: c.total - prev.total}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func successEM(kind metrics.Kind, dst string, total, success int64) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(total)).
+		AddMetric("success", metrics.NewInt(success)).
+		AddLabel("dst", dst)
+	em.Kind = kind
+	return em
+}
+
+func TestAllowMetricsWithSuccessState(t *testing.T) {
+	type input struct {
+		em   *metrics.EventMetrics
+		want bool
+	}
+
+	tests := []struct {
+		name   string
+		filter *configpb.SuccessFilter
+		inputs []input
+	}{
+		{
+			name:   "failures_cumulative",
+			filter: &configpb.SuccessFilter{},
+			inputs: []input{
+				{em: successEM(metrics.CUMULATIVE, "t1", 10, 10), want: false},
+				{em: successEM(metrics.CUMULATIVE, "t1", 20, 18), want: true},
+				// Old failures don't count.
+				{em: successEM(metrics.CUMULATIVE, "t1", 30, 28), want: false},
+				// No runs since the last export.
+				{em: successEM(metrics.CUMULATIVE, "t1", 30, 28), want: false},
+				// Series are tracked independently.
+				{em: successEM(metrics.CUMULATIVE, "t2", 10, 9), want: true},
+				{em: successEM(metrics.CUMULATIVE, "t2", 20, 19), want: false},
+			},
+		},
+		{
+			name:   "failures_counter_reset",
+			filter: &configpb.SuccessFilter{},
+			inputs: []input{
+				{em: successEM(metrics.CUMULATIVE, "t1", 100, 100), want: false},
+				{em: successEM(metrics.CUMULATIVE, "t1", 5, 4), want: true},
+				{em: successEM(metrics.CUMULATIVE, "t1", 10, 9), want: false},
+			},
+		},
+		{
+			name:   "failures_gauge",
+			filter: &configpb.SuccessFilter{},
+			inputs: []input{
+				{em: successEM(metrics.GAUGE, "t1", 1, 0), want: true},
+				{em: successEM(metrics.GAUGE, "t1", 1, 0), want: true},
+				{em: successEM(metrics.GAUGE, "t1", 1, 1), want: false},
+			},
+		},
+		{
+			name:   "no_failures",
+			filter: &configpb.SuccessFilter{State: configpb.SuccessFilter_NO_FAILURES.Enum()},
+			inputs: []input{
+				{em: successEM(metrics.CUMULATIVE, "t1", 10, 10), want: true},
+				{em: successEM(metrics.CUMULATIVE, "t1", 20, 19), want: false},
+				{em: successEM(metrics.CUMULATIVE, "t1", 30, 29), want: true},
+			},
+		},
+		{
+			name:   "missing_metrics",
+			filter: &configpb.SuccessFilter{},
+			inputs: []input{
+				{em: metrics.NewEventMetrics(time.Now()).AddMetric("uptime", metrics.NewInt(10)), want: false},
+				{em: metrics.NewEventMetrics(time.Now()).AddMetric("success", metrics.NewInt(0)), want: false},
+				{em: metrics.NewEventMetrics(time.Now()).AddMetric("success", metrics.NewString("0")).AddMetric("total", metrics.NewInt(1)), want: false},
+			},
+		},
+		{
+			name:   "missing_metrics_matched",
+			filter: &configpb.SuccessFilter{MatchMissing: proto.Bool(true)},
+			inputs: []input{
+				{em: metrics.NewEventMetrics(time.Now()).AddMetric("uptime", metrics.NewInt(10)), want: true},
+				{em: successEM(metrics.CUMULATIVE, "t1", 10, 10), want: false},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts, err := buildOptions(&configpb.SurfacerDef{AllowMetricsWithSuccessState: test.filter}, true, nil)
+			if err != nil {
+				t.Fatalf("buildOptions() error: %v", err)
+			}
+			for i, in := range test.inputs {
+				assert.Equal(t, in.want, opts.AllowEventMetrics(in.em), "input #%d: %s", i, in.em.String())
+			}
+		})
+	}
+}
+
+func TestAllowMetricsWithSuccessStateAndLabels(t *testing.T) {
+	opts, err := buildOptions(&configpb.SurfacerDef{
+		AllowMetricsWithSuccessState: &configpb.SuccessFilter{},
+		IgnoreMetricsWithLabel: []*configpb.LabelFilter{
+			{Key: proto.String("dst"), Value: proto.String("t2")},
+		},
+	}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error: %v", err)
+	}
+	assert.True(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t1", 1, 0)))
+	assert.False(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t2", 1, 0)))
+}
+
+func TestReloadSuccessState(t *testing.T) {
+	opts, err := buildOptions(&configpb.SurfacerDef{}, true, nil)
+	if err != nil {
+		t.Fatalf("buildOptions() error: %v", err)
+	}
+	assert.True(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t1", 1, 1)))
+
+	sf := &configpb.SuccessFilter{State: configpb.SuccessFilter_FAILURES.Enum()}
+	if err := opts.Reload(&configpb.SurfacerDef{AllowMetricsWithSuccessState: sf}); err != nil {
+		t.Fatalf("Reload() error: %v", err)
+	}
+	assert.False(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t1", 1, 1)))
+	assert.True(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t1", 1, 0)))
+	assert.True(t, proto.Equal(sf, opts.EffectiveConfig().GetAllowMetricsWithSuccessState()))
+
+	if err := opts.Reload(&configpb.SurfacerDef{}); err != nil {
+		t.Fatalf("Reload() error: %v", err)
+	}
+	assert.True(t, opts.AllowEventMetrics(successEM(metrics.GAUGE, "t1", 1, 1)))
+	assert.Nil(t, opts.EffectiveConfig().AllowMetricsWithSuccessState)
+}
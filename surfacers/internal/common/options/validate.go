@@ -0,0 +1,218 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+)
+
+// maxLiteralNames is the maximum number of names expanded from a metric name
+// regex while validating the filters. Regexes matching more names are not
+// checked.
+const maxLiteralNames = 1000
+
+// Markers for the start and end of text anchors, while expanding a regex
+// into the names it matches.
+const (
+	beginMarker = "\x00"
+	endMarker   = "\x01"
+)
+
+func (lf *labelFilter) equal(o *labelFilter) bool {
+	return lf.key == o.key && lf.value == o.value && lf.keyPrefix == o.keyPrefix && lf.valueRegex == o.valueRegex && lf.negate == o.negate
+}
+
+func (lf *labelFilter) String() string {
+	var parts []string
+	if lf.negate {
+		parts = append(parts, "not")
+	}
+	if lf.keyPrefix {
+		parts = append(parts, "key_prefix="+lf.key)
+	} else {
+		parts = append(parts, "key="+lf.key)
+	}
+	if lf.value != "" {
+		parts = append(parts, "value="+lf.value)
+	}
+	if lf.valueRegex != "" {
+		parts = append(parts, "value_regex="+lf.valueRegex)
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// neverMatches returns the reason why the label filter can never match, or
+// an empty string if it can.
+func (opts *Options) neverMatches(lf *labelFilter) string {
+	if lf.key == "" {
+		return "it has no key"
+	}
+	if !lf.keyPrefix && opts.ignoreLabelKeys[lf.key] {
+		return "its key is in ignore_label_keys"
+	}
+	return ""
+}
+
+// validateFilters checks the metrics filters for the configurations that
+// can't be right: it returns an error if the filters can never allow
+// anything, and logs a warning for the filters that have no effect.
+func (opts *Options) validateFilters(f *Filters) error {
+	if err := opts.validateLabelFilters(f); err != nil {
+		return err
+	}
+	return opts.validateNameFilters(f)
+}
+
+func (opts *Options) validateLabelFilters(f *Filters) error {
+	for _, ignoreF := range f.ignoreLabelFilters {
+		if reason := opts.neverMatches(ignoreF); reason != "" {
+			opts.Logger.Warningf("ignore_metrics_with_label filter %s has no effect, %s", ignoreF, reason)
+		}
+	}
+
+	var conflicts []string
+	for _, allowF := range f.allowLabelFilters {
+		if reason := opts.neverMatches(allowF); reason != "" {
+			opts.Logger.Warningf("allow_metrics_with_label filter %s never matches, %s", allowF, reason)
+			continue
+		}
+		for _, ignoreF := range f.ignoreLabelFilters {
+			if !allowF.equal(ignoreF) {
+				continue
+			}
+			// With allow first precedence, allow filters are exceptions to
+			// the ignore filters, so it's the ignore filter that has no
+			// effect.
+			if f.allowFirst {
+				opts.Logger.Warningf("ignore_metrics_with_label filter %s has no effect, it's also an allow_metrics_with_label filter and filter_precedence is ALLOW_FIRST", ignoreF)
+			} else {
+				conflicts = append(conflicts, allowF.String())
+			}
+			break
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if f.allowLabelMatchAll || len(conflicts) == len(f.allowLabelFilters) {
+		return newOptionsError("allow_metrics_with_label", strings.Join(conflicts, ", "), "filters can never allow any EventMetrics, they are also ignore_metrics_with_label filters")
+	}
+	opts.Logger.Warningf("allow_metrics_with_label filters %v never match, they are also ignore_metrics_with_label filters", conflicts)
+	return nil
+}
+
+func (opts *Options) validateNameFilters(f *Filters) error {
+	if f.allowMetricName == nil || f.ignoreMetricName == nil {
+		return nil
+	}
+
+	allowRe, ignoreRe := f.allowMetricName.String(), f.ignoreMetricName.String()
+	if allowRe == ignoreRe {
+		return newOptionsError("allow_metrics_with_name", allowRe, "same as ignore_metrics_with_name, no metrics will be allowed")
+	}
+
+	names := literalNames(allowRe)
+	if names == nil {
+		return nil
+	}
+	var ignored []string
+	for _, name := range names {
+		if f.ignoreMetricName.MatchString(name) {
+			ignored = append(ignored, name)
+		}
+	}
+	if len(ignored) == len(names) {
+		return newOptionsError("allow_metrics_with_name", allowRe, fmt.Sprintf("all allowed metrics are ignored by ignore_metrics_with_name (%s)", ignoreRe))
+	}
+	if len(ignored) > 0 {
+		opts.Logger.Warningf("metrics %v allowed by allow_metrics_with_name (%s) are ignored by ignore_metrics_with_name (%s)", ignored, allowRe, ignoreRe)
+	}
+	return nil
+}
+
+// literalNames returns the names matched by the regex, if it matches only a
+// fixed set of full names, e.g. "^(total|success)$". It returns nil for the
+// other regexes, e.g. unanchored ones.
+func literalNames(re string) []string {
+	r, err := syntax.Parse(re, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	candidates := expandRegex(r.Simplify())
+
+	var names []string
+	for _, c := range candidates {
+		if !strings.HasPrefix(c, beginMarker) || !strings.HasSuffix(c, endMarker) {
+			return nil
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(c, beginMarker), endMarker)
+		if strings.Contains(name, beginMarker) || strings.Contains(name, endMarker) {
+			return nil
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// expandRegex returns the strings matched by the regex, with the text
+// anchors replaced by markers. It returns nil if the regex matches anything
+// other than literals, or too many of them.
+func expandRegex(r *syntax.Regexp) []string {
+	switch r.Op {
+	case syntax.OpEmptyMatch:
+		return []string{""}
+	case syntax.OpBeginText:
+		return []string{beginMarker}
+	case syntax.OpEndText:
+		return []string{endMarker}
+	case syntax.OpLiteral:
+		if r.Flags&syntax.FoldCase != 0 {
+			return nil
+		}
+		return []string{string(r.Rune)}
+	case syntax.OpCapture:
+		return expandRegex(r.Sub[0])
+	case syntax.OpAlternate:
+		var out []string
+		for _, sub := range r.Sub {
+			s := expandRegex(sub)
+			if s == nil || len(out)+len(s) > maxLiteralNames {
+				return nil
+			}
+			out = append(out, s...)
+		}
+		return out
+	case syntax.OpConcat:
+		out := []string{""}
+		for _, sub := range r.Sub {
+			s := expandRegex(sub)
+			if s == nil || len(out)*len(s) > maxLiteralNames {
+				return nil
+			}
+			var next []string
+			for _, prefix := range out {
+				for _, suffix := range s {
+					next = append(next, prefix+suffix)
+				}
+			}
+			out = next
+		}
+		return out
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/logger"
+	configpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateFilters(t *testing.T) {
+	setTestFilterBundles(t)
+
+	lf := func(key, value string) *configpb.LabelFilter {
+		return &configpb.LabelFilter{Key: proto.String(key), Value: proto.String(value)}
+	}
+
+	tests := []struct {
+		name        string
+		sdef        *configpb.SurfacerDef
+		wantErr     bool
+		wantWarning string
+	}{
+		{
+			name: "valid",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{lf("ptype", "http")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{lf("probe", "test")},
+				AllowMetricsWithName:   proto.String("^(total|success|latency)$"),
+				IgnoreMetricsWithName:  proto.String("^dns_"),
+			},
+		},
+		{
+			name: "same_label_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{lf("ptype", "http")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{lf("ptype", "http")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "same_label_filter_any_mode",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{lf("ptype", "http"), lf("ptype", "dns")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{lf("ptype", "http")},
+			},
+			wantWarning: "never match",
+		},
+		{
+			name: "same_label_filter_all_mode",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:      []*configpb.LabelFilter{lf("ptype", "http"), lf("probe", "web")},
+				AllowMetricsLabelMatchMode: configpb.LabelMatchMode_ALL.Enum(),
+				IgnoreMetricsWithLabel:     []*configpb.LabelFilter{lf("ptype", "http")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "same_label_filter_allow_first",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{lf("ptype", "http")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{lf("ptype", "http")},
+				FilterPrecedence:       configpb.FilterPrecedence_ALLOW_FIRST.Enum(),
+			},
+			wantWarning: "has no effect",
+		},
+		{
+			name: "allow_label_filter_ignored_key",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel: []*configpb.LabelFilter{lf("dst", "example.com")},
+				IgnoreLabelKeys:       []string{"dst"},
+			},
+			wantWarning: "never matches",
+		},
+		{
+			name: "ignore_label_filter_ignored_key",
+			sdef: &configpb.SurfacerDef{
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{lf("dst", "example.com")},
+				IgnoreLabelKeys:        []string{"dst"},
+			},
+			wantWarning: "has no effect",
+		},
+		{
+			name: "disabled_conflicting_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithLabel:  []*configpb.LabelFilter{lf("ptype", "http")},
+				IgnoreMetricsWithLabel: []*configpb.LabelFilter{{Key: proto.String("ptype"), Value: proto.String("http"), Enabled: proto.Bool(false)}},
+			},
+		},
+		{
+			name: "same_name_filter",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("^total$"),
+				IgnoreMetricsWithName: proto.String("^total$"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "all_names_ignored",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("^(total|success)$"),
+				IgnoreMetricsWithName: proto.String("^(total|success|latency)$"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "all_names_ignored_bundle",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("^total$"),
+				FilterBundle:          []string{"prod-only"},
+				IgnoreMetricsWithName: proto.String("^(total|success)$"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "some_names_ignored",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("^(total|success)$"),
+				IgnoreMetricsWithName: proto.String("^succ"),
+			},
+			wantWarning: "[success]",
+		},
+		{
+			name: "unanchored_allow_name",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("total"),
+				IgnoreMetricsWithName: proto.String("total"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "unanchored_allow_name_not_checked",
+			sdef: &configpb.SurfacerDef{
+				AllowMetricsWithName:  proto.String("total"),
+				IgnoreMetricsWithName: proto.String("^total$"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			_, err := buildOptions(test.sdef, true, logger.New(logger.WithWriter(&buf)))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if test.wantWarning == "" {
+				assert.Empty(t, buf.String(), "unexpected warnings")
+				return
+			}
+			assert.True(t, strings.Contains(buf.String(), test.wantWarning), "warnings: %s, want: %s", buf.String(), test.wantWarning)
+		})
+	}
+}
+
+func TestLiteralNames(t *testing.T) {
+	for re, want := range map[string][]string{
+		"^total$":                      {"total"},
+		"^(total|success)$":            {"total", "success"},
+		"(?:^total$)|(?:^success$)":    {"total", "success"},
+		"^(dns|http)_(total|success)$": {"dns_total", "dns_success", "http_total", "http_success"},
+		"total":                        nil,
+		"^total":                       nil,
+		"^tot.*$":                      nil,
+		"^(?i)total$":                  nil,
+		"(":                            nil,
+	} {
+		t.Run(re, func(t *testing.T) {
+			assert.ElementsMatch(t, want, literalNames(re))
+		})
+	}
+}
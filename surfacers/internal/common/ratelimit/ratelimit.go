@@ -0,0 +1,135 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit implements a token bucket rate limiter for the
+// EventMetrics written to a surfacer.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"golang.org/x/time/rate"
+)
+
+// ShedMetricName is the name of the metric that counts the EventMetrics
+// dropped by the rate limiter.
+const ShedMetricName = "surfacer_rate_limit_shed"
+
+// Limiter limits the rate of EventMetrics. Depending on the policy,
+// EventMetrics beyond the rate are either shed right away, or buffered and
+// released at the allowed rate. T is the type of the buffered items, e.g.
+// the EventMetrics along with the state to process it with.
+type Limiter[T any] struct {
+	lim *rate.Limiter
+	l   *logger.Logger
+
+	// Queue for the BUFFER policy, nil for the SHED policy.
+	buf chan T
+
+	shed atomic.Int64
+}
+
+// New returns a new Limiter for the given config. It returns nil if config
+// is nil, i.e. there is no limit.
+func New[T any](c *surfacerpb.RateLimit, l *logger.Logger) (*Limiter[T], error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	if c.GetEventsPerSec() <= 0 {
+		return nil, fmt.Errorf("rate_limit: events_per_sec should be positive, got %d", c.GetEventsPerSec())
+	}
+	burst := int(c.GetBurst())
+	if burst == 0 {
+		burst = int(c.GetEventsPerSec())
+	}
+	if burst < 0 {
+		return nil, fmt.Errorf("rate_limit: burst should not be negative, got %d", burst)
+	}
+
+	rl := &Limiter[T]{
+		lim: rate.NewLimiter(rate.Limit(c.GetEventsPerSec()), burst),
+		l:   l,
+	}
+
+	if c.GetPolicy() == surfacerpb.RateLimit_BUFFER {
+		if c.GetBufferSize() <= 0 {
+			return nil, fmt.Errorf("rate_limit: buffer_size should be positive, got %d", c.GetBufferSize())
+		}
+		rl.buf = make(chan T, c.GetBufferSize())
+	}
+
+	return rl, nil
+}
+
+// Admit returns true if EventMetrics can be written right away. If it
+// returns false, EventMetrics item has either been shed, or buffered to be
+// released by Drain.
+func (rl *Limiter[T]) Admit(item T) bool {
+	if rl.buf == nil {
+		if rl.lim.Allow() {
+			return true
+		}
+		rl.shed.Add(1)
+		return false
+	}
+
+	// With the BUFFER policy, all EventMetrics go through the queue to keep
+	// them in order.
+	select {
+	case rl.buf <- item:
+	default:
+		if rl.shed.Add(1) == 1 {
+			rl.l.Warningf("Rate limit buffer is full, dropping EventMetrics. Further drops will be counted in %s.", ShedMetricName)
+		}
+	}
+	return false
+}
+
+// Drain releases the buffered EventMetrics items to the write function at
+// the allowed rate, until the context is canceled. It returns right away for
+// the SHED policy.
+func (rl *Limiter[T]) Drain(ctx context.Context, write func(T)) {
+	if rl.buf == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case item := <-rl.buf:
+			if err := rl.lim.Wait(ctx); err != nil {
+				return
+			}
+			write(item)
+		}
+	}
+}
+
+// Shed returns the number of EventMetrics shed so far.
+func (rl *Limiter[T]) Shed() int64 {
+	return rl.shed.Load()
+}
+
+// EventMetrics returns the EventMetrics with the shed counter.
+func (rl *Limiter[T]) EventMetrics(ts time.Time) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(ts).AddMetric(ShedMetricName, metrics.NewInt(rl.Shed()))
+}
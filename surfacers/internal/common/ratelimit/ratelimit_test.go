@@ -0,0 +1,115 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testEM(i int) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(int64(i)))
+}
+
+func TestNew(t *testing.T) {
+	rl, err := New[*metrics.EventMetrics](nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, rl, "nil config")
+
+	for name, c := range map[string]*surfacerpb.RateLimit{
+		"zero_rate":      {EventsPerSec: proto.Int32(0)},
+		"negative_burst": {EventsPerSec: proto.Int32(10), Burst: proto.Int32(-1)},
+		"zero_buffer": {
+			EventsPerSec: proto.Int32(10),
+			Policy:       surfacerpb.RateLimit_BUFFER.Enum(),
+			BufferSize:   proto.Int32(0),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New[*metrics.EventMetrics](c, &logger.Logger{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestShed(t *testing.T) {
+	rl, err := New[*metrics.EventMetrics](&surfacerpb.RateLimit{
+		EventsPerSec: proto.Int32(1),
+		Burst:        proto.Int32(5),
+	}, &logger.Logger{})
+	require.NoError(t, err)
+
+	admitted := 0
+	for i := 0; i < 20; i++ {
+		if rl.Admit(testEM(i)) {
+			admitted++
+		}
+	}
+
+	// Only the burst is admitted, the rest is shed.
+	assert.Equal(t, 5, admitted)
+	assert.Equal(t, int64(15), rl.Shed())
+	assert.Equal(t, "15", rl.EventMetrics(time.Now()).Metric(ShedMetricName).String())
+
+	// Drain is a no-op for the SHED policy.
+	rl.Drain(context.Background(), func(*metrics.EventMetrics) {
+		t.Errorf("unexpected write from Drain")
+	})
+}
+
+func TestBuffer(t *testing.T) {
+	rl, err := New[*metrics.EventMetrics](&surfacerpb.RateLimit{
+		EventsPerSec: proto.Int32(20),
+		Burst:        proto.Int32(1),
+		Policy:       surfacerpb.RateLimit_BUFFER.Enum(),
+		BufferSize:   proto.Int32(5),
+	}, &logger.Logger{})
+	require.NoError(t, err)
+
+	for i := 0; i < 8; i++ {
+		assert.False(t, rl.Admit(testEM(i)), "EventMetrics should always be buffered")
+	}
+	assert.Equal(t, int64(3), rl.Shed(), "EventMetrics beyond buffer size")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	written := make(chan *metrics.EventMetrics, 10)
+	start := time.Now()
+	go rl.Drain(ctx, func(em *metrics.EventMetrics) { written <- em })
+
+	var got []string
+	for i := 0; i < 5; i++ {
+		select {
+		case em := <-written:
+			got = append(got, em.Metric("total").String())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for buffered EventMetrics")
+		}
+	}
+
+	// Buffered EventMetrics are released in order, at the allowed rate: first
+	// one right away, and then one every 50ms.
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, got)
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package staleness implements a tracker that finds gauge series that have
+// not been refreshed within their configured time window.
+package staleness
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+// scanInterval is the minimum interval between two passes over the tracked
+// series.
+const scanInterval = time.Second
+
+type series struct {
+	// Last EventMetrics for the series, containing only the tracked metric.
+	em       *metrics.EventMetrics
+	lastSeen time.Time
+	ttl      time.Duration
+}
+
+// Tracker keeps track of when each gauge series was last written.
+type Tracker struct {
+	ttls map[string]time.Duration
+	l    *logger.Logger
+
+	mu       sync.Mutex
+	series   map[string]*series
+	lastScan time.Time
+
+	// Used by tests to control time.
+	now func() time.Time
+}
+
+// New returns a new staleness tracker for the given configs. It returns nil
+// if there are no configs.
+func New(configs []*surfacerpb.StaleAfter, l *logger.Logger) (*Tracker, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	t := &Tracker{
+		ttls:   make(map[string]time.Duration),
+		l:      l,
+		series: make(map[string]*series),
+		now:    time.Now,
+	}
+	for _, c := range configs {
+		if c.GetStaleAfterSec() <= 0 {
+			return nil, fmt.Errorf("stale_after: stale_after_sec should be positive for metric %s, got %d", c.GetMetricName(), c.GetStaleAfterSec())
+		}
+		if _, ok := t.ttls[c.GetMetricName()]; ok {
+			return nil, fmt.Errorf("stale_after: duplicate config for metric %s", c.GetMetricName())
+		}
+		t.ttls[c.GetMetricName()] = time.Duration(c.GetStaleAfterSec()) * time.Second
+	}
+	t.lastScan = t.now()
+	return t, nil
+}
+
+func seriesKey(name string, em *metrics.EventMetrics) string {
+	var b strings.Builder
+	b.WriteString(name + "{")
+	for i, k := range em.LabelsKeys() {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k + "=" + em.Label(k))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// Observe records that the gauge metrics in the given EventMetrics have been
+// refreshed. Non-gauge EventMetrics are ignored.
+func (t *Tracker) Observe(em *metrics.EventMetrics) {
+	if t == nil || em.Kind != metrics.GAUGE {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	for _, name := range em.MetricsKeys() {
+		ttl, ok := t.ttls[name]
+		if !ok {
+			continue
+		}
+
+		lastEM := metrics.NewEventMetrics(em.Timestamp)
+		lastEM.Kind = em.Kind
+		lastEM.LatencyUnit = em.LatencyUnit
+		lastEM.MetricUnits = em.MetricUnits
+		for _, k := range em.LabelsKeys() {
+			lastEM.AddLabel(k, em.Label(k))
+		}
+		lastEM.AddMetric(name, em.Metric(name).Clone())
+
+		t.series[seriesKey(name, em)] = &series{em: lastEM, lastSeen: now, ttl: ttl}
+	}
+}
+
+// Expire returns the series that have not been refreshed within their
+// window, and stops tracking them. Each series is returned as the last
+// EventMetrics written for it, with the timestamp set to the current time.
+// To keep the cost low, series are scanned at most once a second.
+func (t *Tracker) Expire() []*metrics.EventMetrics {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	if now.Sub(t.lastScan) < scanInterval {
+		return nil
+	}
+	t.lastScan = now
+
+	var expired []*metrics.EventMetrics
+	for key, s := range t.series {
+		if now.Sub(s.lastSeen) < s.ttl {
+			continue
+		}
+		t.l.Debugf("Series %s not refreshed in %s, expiring it", key, s.ttl)
+		s.em.Timestamp = now
+		expired = append(expired, s.em)
+		delete(t.series, key)
+	}
+	return expired
+}
+
+// ZeroMarker returns a copy of the given EventMetrics with all numerical
+// values set to zero. It's used to signal the end of a series to surfacers
+// that don't keep the series state themselves. It returns nil if there are
+// no numerical values in the EventMetrics.
+func ZeroMarker(em *metrics.EventMetrics) *metrics.EventMetrics {
+	markerEM := metrics.NewEventMetrics(em.Timestamp)
+	markerEM.Kind = em.Kind
+	markerEM.LatencyUnit = em.LatencyUnit
+	markerEM.MetricUnits = em.MetricUnits
+	for _, k := range em.LabelsKeys() {
+		markerEM.AddLabel(k, em.Label(k))
+	}
+
+	found := false
+	for _, name := range em.MetricsKeys() {
+		var zero metrics.Value
+		switch v := em.Metric(name).(type) {
+		case *metrics.Int:
+			zero = metrics.NewInt(0)
+		case *metrics.Float:
+			zero = metrics.NewFloat(0)
+		case *metrics.Map[int64]:
+			m := metrics.NewMap(v.MapName)
+			for _, k := range v.Keys() {
+				m.IncKeyBy(k, 0)
+			}
+			zero = m
+		case *metrics.Map[float64]:
+			m := metrics.NewMapFloat(v.MapName)
+			for _, k := range v.Keys() {
+				m.IncKeyBy(k, 0)
+			}
+			zero = m
+		default:
+			continue
+		}
+		markerEM.AddMetric(name, zero)
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+	return markerEM
+}
+
+// SetClockForTest sets the function used to get the current time. It's meant
+// to be used only in tests.
+func (t *Tracker) SetClockForTest(now func() time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.now = now
+	t.lastScan = now()
+}
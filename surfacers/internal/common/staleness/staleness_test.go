@@ -0,0 +1,178 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staleness
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (fc *fakeClock) now() time.Time {
+	return fc.t
+}
+
+func (fc *fakeClock) advance(d time.Duration) {
+	fc.t = fc.t.Add(d)
+}
+
+func gaugeEM(dst string, val int64) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("queue_depth", metrics.NewInt(val)).
+		AddMetric("other", metrics.NewInt(val)).
+		AddLabel("dst", dst)
+	em.Kind = metrics.GAUGE
+	return em
+}
+
+func testTracker(t *testing.T) (*Tracker, *fakeClock) {
+	t.Helper()
+
+	tr, err := New([]*surfacerpb.StaleAfter{
+		{
+			MetricName:    proto.String("queue_depth"),
+			StaleAfterSec: proto.Int32(60),
+		},
+	}, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	fc := &fakeClock{t: time.Now()}
+	tr.now = fc.now
+	tr.lastScan = fc.now()
+	return tr, fc
+}
+
+func expiredDsts(ems []*metrics.EventMetrics) []string {
+	var dsts []string
+	for _, em := range ems {
+		dsts = append(dsts, em.Label("dst"))
+	}
+	return dsts
+}
+
+func TestExpire(t *testing.T) {
+	tr, fc := testTracker(t)
+
+	tr.Observe(gaugeEM("t1", 5))
+	tr.Observe(gaugeEM("t2", 7))
+
+	fc.advance(30 * time.Second)
+	assert.Empty(t, tr.Expire(), "expired before window")
+
+	// Refresh t1, t2 is not refreshed.
+	tr.Observe(gaugeEM("t1", 6))
+
+	fc.advance(40 * time.Second)
+	expired := tr.Expire()
+	assert.Equal(t, []string{"t2"}, expiredDsts(expired))
+
+	// Only the tracked metric is returned, with the last value.
+	assert.Equal(t, []string{"queue_depth"}, expired[0].MetricsKeys())
+	assert.Equal(t, "7", expired[0].Metric("queue_depth").String())
+	assert.Equal(t, fc.now(), expired[0].Timestamp)
+
+	// Expired series are not returned again.
+	fc.advance(30 * time.Second)
+	assert.Equal(t, []string{"t1"}, expiredDsts(tr.Expire()))
+	fc.advance(120 * time.Second)
+	assert.Empty(t, tr.Expire())
+
+	// Series comes back after being written again.
+	tr.Observe(gaugeEM("t2", 1))
+	fc.advance(60 * time.Second)
+	assert.Equal(t, []string{"t2"}, expiredDsts(tr.Expire()))
+}
+
+func TestExpireScanInterval(t *testing.T) {
+	tr, fc := testTracker(t)
+
+	tr.Observe(gaugeEM("t1", 5))
+	fc.advance(60 * time.Second)
+	assert.Len(t, tr.Expire(), 1)
+
+	tr.Observe(gaugeEM("t1", 5))
+	// Clock moves less than scan interval since the last scan.
+	fc.advance(60*time.Second - time.Millisecond)
+	tr.Expire()
+	fc.advance(500 * time.Millisecond)
+	assert.Empty(t, tr.Expire(), "scan within scan interval")
+	fc.advance(time.Second)
+	assert.Len(t, tr.Expire(), 1)
+}
+
+func TestObserveIgnoresCumulative(t *testing.T) {
+	tr, fc := testTracker(t)
+
+	em := gaugeEM("t1", 5)
+	em.Kind = metrics.CUMULATIVE
+	tr.Observe(em)
+
+	fc.advance(120 * time.Second)
+	assert.Empty(t, tr.Expire())
+}
+
+func TestNilTracker(t *testing.T) {
+	var tr *Tracker
+	tr.Observe(gaugeEM("t1", 5))
+	assert.Nil(t, tr.Expire())
+}
+
+func TestNew(t *testing.T) {
+	tr, err := New(nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, tr)
+
+	_, err = New([]*surfacerpb.StaleAfter{
+		{MetricName: proto.String("m"), StaleAfterSec: proto.Int32(0)},
+	}, nil)
+	assert.Error(t, err, "zero stale_after_sec")
+
+	_, err = New([]*surfacerpb.StaleAfter{
+		{MetricName: proto.String("m"), StaleAfterSec: proto.Int32(10)},
+		{MetricName: proto.String("m"), StaleAfterSec: proto.Int32(20)},
+	}, nil)
+	assert.Error(t, err, "duplicate metric")
+}
+
+func TestZeroMarker(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("i", metrics.NewInt(5)).
+		AddMetric("f", metrics.NewFloat(2.5)).
+		AddMetric("m", metrics.NewMap("code").IncKeyBy("200", 4)).
+		AddMetric("s", metrics.NewString("v1")).
+		AddLabel("dst", "t1")
+	em.Kind = metrics.GAUGE
+
+	marker := ZeroMarker(em)
+	assert.Equal(t, []string{"i", "f", "m"}, marker.MetricsKeys())
+	assert.Equal(t, "0", marker.Metric("i").String())
+	assert.Equal(t, "0.000", marker.Metric("f").String())
+	assert.Equal(t, int64(0), marker.Metric("m").(*metrics.Map[int64]).GetKey("200"))
+	assert.Equal(t, "t1", marker.Label("dst"))
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), marker.Kind)
+
+	assert.Nil(t, ZeroMarker(metrics.NewEventMetrics(time.Now()).AddMetric("s", metrics.NewString("v1"))))
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+// linearExpr represents an expression of the form a*x + b. We parse value
+// transform expressions directly into this form, which makes evaluation
+// cheap and lets us reject non-linear expressions at config time.
+type linearExpr struct {
+	a, b float64
+}
+
+func (e linearExpr) eval(x float64) float64 {
+	return e.a*x + e.b
+}
+
+// isScaling returns true if expression maps 0 to 0, i.e., it's safe to apply
+// it to counters.
+func (e linearExpr) isScaling() bool {
+	return e.b == 0
+}
+
+// exprParser is a small recursive descent parser for value transform
+// expressions:
+//
+//	expr   := term (('+' | '-') term)*
+//	term   := factor (('*' | '/') factor)*
+//	factor := number | 'x' | '(' expr ')' | '-' factor
+type exprParser struct {
+	s   string
+	pos int
+}
+
+func (p *exprParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *exprParser) peek() byte {
+	p.skipSpaces()
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *exprParser) expr() (linearExpr, error) {
+	lhs, err := p.term()
+	if err != nil {
+		return lhs, err
+	}
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return lhs, nil
+		}
+		p.pos++
+		rhs, err := p.term()
+		if err != nil {
+			return lhs, err
+		}
+		if op == '+' {
+			lhs = linearExpr{lhs.a + rhs.a, lhs.b + rhs.b}
+		} else {
+			lhs = linearExpr{lhs.a - rhs.a, lhs.b - rhs.b}
+		}
+	}
+}
+
+func (p *exprParser) term() (linearExpr, error) {
+	lhs, err := p.factor()
+	if err != nil {
+		return lhs, err
+	}
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return lhs, nil
+		}
+		p.pos++
+		rhs, err := p.factor()
+		if err != nil {
+			return lhs, err
+		}
+		switch {
+		case op == '*' && lhs.a != 0 && rhs.a != 0:
+			return lhs, fmt.Errorf("non-linear expression at position %d: x can't be multiplied by itself", p.pos)
+		case op == '*':
+			lhs = linearExpr{lhs.a*rhs.b + rhs.a*lhs.b, lhs.b * rhs.b}
+		case rhs.a != 0:
+			return lhs, fmt.Errorf("non-linear expression at position %d: x can't be in a denominator", p.pos)
+		case rhs.b == 0:
+			return lhs, fmt.Errorf("division by zero at position %d", p.pos)
+		default:
+			lhs = linearExpr{lhs.a / rhs.b, lhs.b / rhs.b}
+		}
+	}
+}
+
+func (p *exprParser) factor() (linearExpr, error) {
+	switch c := p.peek(); {
+	case c == 'x':
+		p.pos++
+		return linearExpr{a: 1}, nil
+	case c == '-':
+		p.pos++
+		f, err := p.factor()
+		return linearExpr{-f.a, -f.b}, err
+	case c == '(':
+		p.pos++
+		e, err := p.expr()
+		if err != nil {
+			return e, err
+		}
+		if p.peek() != ')' {
+			return e, fmt.Errorf("missing ')' at position %d", p.pos)
+		}
+		p.pos++
+		return e, nil
+	case c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		for p.pos < len(p.s) && (p.s[p.pos] == '.' || p.s[p.pos] == 'e' || (p.s[p.pos] >= '0' && p.s[p.pos] <= '9')) {
+			p.pos++
+		}
+		f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+		if err != nil {
+			return linearExpr{}, fmt.Errorf("invalid number %q at position %d", p.s[start:p.pos], start)
+		}
+		return linearExpr{b: f}, nil
+	case c == 0:
+		return linearExpr{}, fmt.Errorf("unexpected end of expression")
+	default:
+		return linearExpr{}, fmt.Errorf("unexpected character %q at position %d", c, p.pos)
+	}
+}
+
+func parseExpr(s string) (linearExpr, error) {
+	p := &exprParser{s: strings.TrimSpace(s)}
+	e, err := p.expr()
+	if err != nil {
+		return e, err
+	}
+	if p.peek() != 0 {
+		return e, fmt.Errorf("unexpected character %q at position %d", p.peek(), p.pos)
+	}
+	return e, nil
+}
+
+// ValueTransformer transforms metric values using linear arithmetic
+// expressions configured per metric name.
+type ValueTransformer struct {
+	exprs map[string]linearExpr
+	l     *logger.Logger
+
+	// Metrics for which we've already logged a warning, to avoid flooding
+	// the logs. This is accessed from the surfacer's write path only, which
+	// is single-threaded for a surfacer.
+	warned map[string]bool
+}
+
+// NewValueTransformer returns a new ValueTransformer for the given configs.
+// It returns nil if there are no configs.
+func NewValueTransformer(configs []*surfacerpb.ValueTransform, l *logger.Logger) (*ValueTransformer, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	vt := &ValueTransformer{
+		exprs:  make(map[string]linearExpr),
+		l:      l,
+		warned: make(map[string]bool),
+	}
+	for _, c := range configs {
+		if _, ok := vt.exprs[c.GetMetricName()]; ok {
+			return nil, fmt.Errorf("value_transform: duplicate transform for metric %s", c.GetMetricName())
+		}
+		e, err := parseExpr(c.GetExpression())
+		if err != nil {
+			return nil, fmt.Errorf("value_transform: invalid expression (%s) for metric %s: %v", c.GetExpression(), c.GetMetricName(), err)
+		}
+		vt.exprs[c.GetMetricName()] = e
+	}
+	return vt, nil
+}
+
+func (vt *ValueTransformer) warnOnce(name, format string, args ...interface{}) {
+	if vt.warned[name] {
+		return
+	}
+	vt.warned[name] = true
+	vt.l.Warningf(format, args...)
+}
+
+func transformMap[T metrics.Number](m *metrics.Map[T], e linearExpr) *metrics.Map[float64] {
+	out := metrics.NewMapFloat(m.MapName)
+	for _, k := range m.Keys() {
+		out.IncKeyBy(k, e.eval(float64(m.GetKey(k))))
+	}
+	return out
+}
+
+func (vt *ValueTransformer) transformValue(name string, val metrics.Value, e linearExpr) metrics.Value {
+	switch v := val.(type) {
+	case metrics.NumValue:
+		return metrics.NewFloat(e.eval(v.Float64()))
+	case *metrics.Map[int64]:
+		return transformMap(v, e)
+	case *metrics.Map[float64]:
+		return transformMap(v, e)
+	default:
+		vt.warnOnce(name, "value_transform: metric %s has unsupported value type %T, not transforming", name, val)
+		return val
+	}
+}
+
+// Apply returns a new EventMetrics with transformed values. If no metric in
+// the given EventMetrics needs transformation, it's returned as it is. Input
+// EventMetrics is never modified as it's shared across surfacers.
+func (vt *ValueTransformer) Apply(em *metrics.EventMetrics) *metrics.EventMetrics {
+	if vt == nil {
+		return em
+	}
+
+	keys := em.MetricsKeys()
+
+	needsTransform := false
+	for _, name := range keys {
+		if _, ok := vt.exprs[name]; ok {
+			needsTransform = true
+			break
+		}
+	}
+	if !needsTransform {
+		return em
+	}
+
+	newEM := metrics.NewEventMetrics(em.Timestamp)
+	newEM.Kind = em.Kind
+	newEM.LatencyUnit = em.LatencyUnit
+	newEM.MetricUnits = em.MetricUnits
+	for _, k := range em.LabelsKeys() {
+		newEM.AddLabel(k, em.Label(k))
+	}
+
+	for _, name := range keys {
+		val := em.Metric(name)
+		e, ok := vt.exprs[name]
+		if !ok {
+			newEM.AddMetric(name, val)
+			continue
+		}
+		if em.Kind == metrics.CUMULATIVE && !e.isScaling() {
+			vt.warnOnce(name, "value_transform: expression for metric %s adds an offset, not applying it to cumulative metrics", name)
+			newEM.AddMetric(name, val)
+			continue
+		}
+		newEM.AddMetric(name, vt.transformValue(name, val, e))
+	}
+	return newEM
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseExpr(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    linearExpr
+		wantErr bool
+	}{
+		{expr: "x", want: linearExpr{a: 1}},
+		{expr: "x / 1048576", want: linearExpr{a: 1.0 / 1048576}},
+		{expr: "x * 1000", want: linearExpr{a: 1000}},
+		{expr: "1000 * x", want: linearExpr{a: 1000}},
+		{expr: "(x - 32) * 5 / 9", want: linearExpr{a: 5.0 / 9, b: -32 * 5.0 / 9}},
+		{expr: "-x + 2.5", want: linearExpr{a: -1, b: 2.5}},
+		{expr: "x * 1e3", want: linearExpr{a: 1000}},
+		{expr: "x * x", wantErr: true},
+		{expr: "1 / x", wantErr: true},
+		{expr: "x / 0", wantErr: true},
+		{expr: "(x * 2", wantErr: true},
+		{expr: "x * 2)", wantErr: true},
+		{expr: "y * 2", wantErr: true},
+		{expr: "", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			got, err := parseExpr(test.expr)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseExpr(%s) error = %v, wantErr %v", test.expr, err, test.wantErr)
+			}
+			if err == nil {
+				assert.InDelta(t, test.want.a, got.a, 1e-12, "a")
+				assert.InDelta(t, test.want.b, got.b, 1e-12, "b")
+			}
+		})
+	}
+}
+
+func TestNewValueTransformer(t *testing.T) {
+	vt, err := NewValueTransformer(nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, vt)
+
+	_, err = NewValueTransformer([]*surfacerpb.ValueTransform{
+		{MetricName: proto.String("bytes"), Expression: proto.String("x / 1024")},
+		{MetricName: proto.String("bytes"), Expression: proto.String("x / 1000")},
+	}, nil)
+	assert.Error(t, err, "duplicate metric")
+
+	_, err = NewValueTransformer([]*surfacerpb.ValueTransform{
+		{MetricName: proto.String("bytes"), Expression: proto.String("x ^ 2")},
+	}, nil)
+	assert.Error(t, err, "invalid expression")
+}
+
+func TestValueTransformerApply(t *testing.T) {
+	vt, err := NewValueTransformer([]*surfacerpb.ValueTransform{
+		{MetricName: proto.String("resp_bytes"), Expression: proto.String("x / 1048576")},
+		{MetricName: proto.String("latency_sec"), Expression: proto.String("x * 1000")},
+		{MetricName: proto.String("temp_f"), Expression: proto.String("(x - 32) * 5 / 9")},
+		{MetricName: proto.String("code_bytes"), Expression: proto.String("x / 1024")},
+	}, nil)
+	assert.NoError(t, err)
+
+	newEM := func(kind metrics.Kind) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(10)).
+			AddMetric("resp_bytes", metrics.NewInt(3145728)).
+			AddMetric("latency_sec", metrics.NewFloat(0.25)).
+			AddMetric("temp_f", metrics.NewFloat(212)).
+			AddMetric("code_bytes", metrics.NewMap("code").IncKeyBy("200", 2048)).
+			AddLabel("probe", "p1")
+		em.Kind = kind
+		return em
+	}
+
+	t.Run("gauge", func(t *testing.T) {
+		em := newEM(metrics.GAUGE)
+		got := vt.Apply(em)
+
+		assert.Equal(t, metrics.Kind(metrics.GAUGE), got.Kind)
+		assert.Equal(t, []string{"total", "resp_bytes", "latency_sec", "temp_f", "code_bytes"}, got.MetricsKeys())
+		assert.Equal(t, "p1", got.Label("probe"))
+		assert.Equal(t, int64(10), got.Metric("total").(metrics.NumValue).Int64())
+		assert.Equal(t, 3.0, got.Metric("resp_bytes").(metrics.NumValue).Float64())
+		assert.Equal(t, 250.0, got.Metric("latency_sec").(metrics.NumValue).Float64())
+		assert.Equal(t, 100.0, got.Metric("temp_f").(metrics.NumValue).Float64())
+		assert.Equal(t, 2.0, got.Metric("code_bytes").(*metrics.Map[float64]).GetKey("200"))
+
+		// Input EventMetrics is not modified.
+		assert.Equal(t, int64(3145728), em.Metric("resp_bytes").(metrics.NumValue).Int64())
+		assert.Equal(t, 212.0, em.Metric("temp_f").(metrics.NumValue).Float64())
+	})
+
+	t.Run("cumulative", func(t *testing.T) {
+		got := vt.Apply(newEM(metrics.CUMULATIVE))
+
+		// Scaling expressions are applied to cumulative metrics.
+		assert.Equal(t, 3.0, got.Metric("resp_bytes").(metrics.NumValue).Float64())
+		assert.Equal(t, 250.0, got.Metric("latency_sec").(metrics.NumValue).Float64())
+		// But expressions with an offset are not.
+		assert.Equal(t, 212.0, got.Metric("temp_f").(metrics.NumValue).Float64())
+	})
+
+	t.Run("no_matching_metrics", func(t *testing.T) {
+		em := metrics.NewEventMetrics(time.Now()).AddMetric("total", metrics.NewInt(10))
+		assert.Same(t, em, vt.Apply(em))
+	})
+
+	t.Run("nil_transformer", func(t *testing.T) {
+		var nilVT *ValueTransformer
+		em := newEM(metrics.GAUGE)
+		assert.Same(t, em, nilVT.Apply(em))
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package workerpool implements a pool of workers that write EventMetrics to
+// a surfacer in parallel.
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+)
+
+// UtilizationMetricName is the name of the metric that reports the fraction
+// of time the workers spent writing.
+const UtilizationMetricName = "surfacer_write_worker_utilization"
+
+// Pool writes EventMetrics using a fixed number of workers. If series order
+// is preserved, each worker has its own queue and EventMetrics are assigned
+// to the workers by their labels; otherwise all workers share a single
+// queue.
+type Pool struct {
+	workers int
+	queues  []chan item
+
+	// Total time spent in writes, across all workers.
+	busy atomic.Int64
+
+	mu         sync.Mutex
+	lastReport time.Time
+	lastBusy   int64
+}
+
+// item is a queued EventMetrics. done is set for the EventMetrics submitted
+// through SubmitBatch, to let it know when they are written.
+type item struct {
+	em   *metrics.EventMetrics
+	done *sync.WaitGroup
+}
+
+// New returns a new Pool for the given config. It returns nil if config is
+// nil, i.e. writes are not parallelized.
+func New(c *surfacerpb.WriteWorkers) (*Pool, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	if c.GetWorkers() <= 0 {
+		return nil, fmt.Errorf("write_workers: workers should be positive, got %d", c.GetWorkers())
+	}
+	if c.GetQueueSize() <= 0 {
+		return nil, fmt.Errorf("write_workers: queue_size should be positive, got %d", c.GetQueueSize())
+	}
+
+	p := &Pool{workers: int(c.GetWorkers())}
+	if c.GetPreserveSeriesOrder() {
+		for i := 0; i < p.workers; i++ {
+			p.queues = append(p.queues, make(chan item, c.GetQueueSize()))
+		}
+	} else {
+		p.queues = []chan item{make(chan item, int(c.GetQueueSize())*p.workers)}
+	}
+
+	return p, nil
+}
+
+// Start starts the workers, which pass the EventMetrics to the write function
+// until the context is canceled.
+func (p *Pool) Start(ctx context.Context, write func(context.Context, *metrics.EventMetrics)) {
+	p.mu.Lock()
+	p.lastReport = time.Now()
+	p.mu.Unlock()
+
+	for i := 0; i < p.workers; i++ {
+		go p.work(ctx, p.queues[i%len(p.queues)], write)
+	}
+}
+
+func (p *Pool) work(ctx context.Context, queue chan item, write func(context.Context, *metrics.EventMetrics)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case it := <-queue:
+			start := time.Now()
+			write(ctx, it.em)
+			p.busy.Add(int64(time.Since(start)))
+			if it.done != nil {
+				it.done.Done()
+			}
+		}
+	}
+}
+
+// queue returns the queue for the EventMetrics. All EventMetrics with the
+// same labels go to the same queue.
+func (p *Pool) queue(em *metrics.EventMetrics) chan item {
+	if len(p.queues) == 1 {
+		return p.queues[0]
+	}
+
+	h := fnv.New32a()
+	for _, k := range em.LabelsKeys() {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(em.Label(k)))
+		h.Write([]byte{0})
+	}
+	return p.queues[h.Sum32()%uint32(len(p.queues))]
+}
+
+// Submit queues the EventMetrics for writing. It blocks if the queue is full,
+// until there is space in the queue or the context is canceled.
+func (p *Pool) Submit(ctx context.Context, em *metrics.EventMetrics) {
+	p.submit(ctx, item{em: em})
+}
+
+func (p *Pool) submit(ctx context.Context, it item) bool {
+	select {
+	case p.queue(it.em) <- it:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// SubmitBatch queues the EventMetrics for writing, and waits until all of
+// them are written, or the context is canceled. EventMetrics submitted
+// concurrently through Submit are not waited for.
+func (p *Pool) SubmitBatch(ctx context.Context, ems []*metrics.EventMetrics) {
+	var wg sync.WaitGroup
+	for _, em := range ems {
+		wg.Add(1)
+		if !p.submit(ctx, item{em: em, done: &wg}) {
+			return
+		}
+	}
+
+	written := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(written)
+	}()
+	select {
+	case <-written:
+	case <-ctx.Done():
+	}
+}
+
+// Utilization returns the fraction of time the workers spent writing since
+// the last call (or since the pool was started). Writes are accounted for
+// when they finish.
+func (p *Pool) Utilization(ts time.Time) float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	busy := p.busy.Load()
+	elapsed := ts.Sub(p.lastReport)
+	busyDelta := busy - p.lastBusy
+	p.lastReport, p.lastBusy = ts, busy
+
+	if elapsed <= 0 {
+		return 0
+	}
+	u := float64(busyDelta) / float64(elapsed*time.Duration(p.workers))
+	if u > 1 {
+		u = 1
+	}
+	return u
+}
+
+// EventMetrics returns the GAUGE EventMetrics with the workers' utilization.
+func (p *Pool) EventMetrics(ts time.Time) *metrics.EventMetrics {
+	em := metrics.NewEventMetrics(ts).AddMetric(UtilizationMetricName, metrics.NewFloat(p.Utilization(ts)))
+	em.Kind = metrics.GAUGE
+	return em
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testEM(dst string, i int) *metrics.EventMetrics {
+	return metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(int64(i))).
+		AddLabel("dst", dst)
+}
+
+func TestNew(t *testing.T) {
+	p, err := New(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, p, "nil config")
+
+	for name, c := range map[string]*surfacerpb.WriteWorkers{
+		"zero_workers": {Workers: proto.Int32(0)},
+		"zero_queue":   {Workers: proto.Int32(2), QueueSize: proto.Int32(0)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(c)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParallelWrites(t *testing.T) {
+	for _, preserveOrder := range []bool{true, false} {
+		t.Run(fmt.Sprintf("preserve_order=%v", preserveOrder), func(t *testing.T) {
+			p, err := New(&surfacerpb.WriteWorkers{
+				Workers:             proto.Int32(4),
+				PreserveSeriesOrder: proto.Bool(preserveOrder),
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// Each write blocks until all 4 writes are in progress, which is
+			// possible only if they are written in parallel.
+			var inflight, maxInflight atomic.Int32
+			var wg sync.WaitGroup
+			release := make(chan struct{})
+			wg.Add(4)
+			p.Start(ctx, func(_ context.Context, em *metrics.EventMetrics) {
+				n := inflight.Add(1)
+				if n > maxInflight.Load() {
+					maxInflight.Store(n)
+				}
+				if n == 4 {
+					close(release)
+				}
+				select {
+				case <-release:
+				case <-time.After(5 * time.Second):
+				}
+				inflight.Add(-1)
+				wg.Done()
+			})
+
+			// Pick the targets such that they land on different workers.
+			seenQueues := make(map[chan item]bool)
+			var dsts []string
+			for i := 0; len(dsts) < 4; i++ {
+				dst := fmt.Sprintf("target-%d", i)
+				if q := p.queue(testEM(dst, 0)); !seenQueues[q] || !preserveOrder {
+					seenQueues[q] = true
+					dsts = append(dsts, dst)
+				}
+			}
+			for i, dst := range dsts {
+				p.Submit(ctx, testEM(dst, i))
+			}
+
+			wg.Wait()
+			assert.Equal(t, int32(4), maxInflight.Load(), "max parallel writes")
+		})
+	}
+}
+
+func TestSeriesOrder(t *testing.T) {
+	p, err := New(&surfacerpb.WriteWorkers{Workers: proto.Int32(8)})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	got := make(map[string][]int64)
+	p.Start(ctx, func(_ context.Context, em *metrics.EventMetrics) {
+		// Vary the write duration to shuffle the writes across workers.
+		time.Sleep(time.Duration(em.Metric("total").(*metrics.Int).Int64()%3) * time.Millisecond)
+		mu.Lock()
+		got[em.Label("dst")] = append(got[em.Label("dst")], em.Metric("total").(*metrics.Int).Int64())
+		mu.Unlock()
+		wg.Done()
+	})
+
+	const numTargets, numWrites = 10, 50
+	wg.Add(numTargets * numWrites)
+	for i := 0; i < numWrites; i++ {
+		for j := 0; j < numTargets; j++ {
+			p.Submit(ctx, testEM(fmt.Sprintf("target-%d", j), i))
+		}
+	}
+	wg.Wait()
+
+	require.Len(t, got, numTargets)
+	for dst, vals := range got {
+		require.Len(t, vals, numWrites, dst)
+		for i, v := range vals {
+			assert.Equal(t, int64(i), v, "%s: write %d out of order", dst, i)
+		}
+	}
+}
+
+func TestSubmitBatch(t *testing.T) {
+	p, err := New(&surfacerpb.WriteWorkers{Workers: proto.Int32(4)})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var written atomic.Int32
+	p.Start(ctx, func(_ context.Context, em *metrics.EventMetrics) {
+		time.Sleep(time.Duration(em.Metric("total").(*metrics.Int).Int64()%5) * time.Millisecond)
+		written.Add(1)
+	})
+
+	var ems []*metrics.EventMetrics
+	for i := 0; i < 20; i++ {
+		ems = append(ems, testEM(fmt.Sprintf("target-%d", i), i))
+	}
+	p.SubmitBatch(ctx, ems)
+	assert.Equal(t, int32(20), written.Load(), "SubmitBatch should return after all writes")
+
+	// Canceled context doesn't block SubmitBatch.
+	cancel()
+	p.SubmitBatch(ctx, ems)
+}
+
+func TestUtilization(t *testing.T) {
+	p, err := New(&surfacerpb.WriteWorkers{Workers: proto.Int32(2)})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	p.Start(ctx, func(_ context.Context, em *metrics.EventMetrics) {
+		time.Sleep(100 * time.Millisecond)
+		wg.Done()
+	})
+	start := time.Now()
+
+	// One write on one of the two workers.
+	wg.Add(1)
+	p.Submit(ctx, testEM("t1", 0))
+	wg.Wait()
+
+	// Report as of 200ms after the start: one worker was busy for 100ms out
+	// of the 2*200ms.
+	em := p.EventMetrics(start.Add(200 * time.Millisecond))
+	assert.True(t, em.Kind == metrics.GAUGE, "utilization is a GAUGE metric")
+	assert.InDelta(t, 0.25, em.Metric(UtilizationMetricName).(*metrics.Float).Float64(), 0.05)
+
+	// Nothing was written since the last report.
+	assert.Equal(t, 0.0, p.Utilization(start.Add(time.Second)))
+}
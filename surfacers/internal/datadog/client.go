@@ -23,6 +23,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/failover"
 )
 
 const defaultServer = "api.datadoghq.com"
@@ -33,6 +35,9 @@ type ddClient struct {
 	server         string
 	c              http.Client
 	useCompression bool
+
+	// Selects the server to publish to, if failover servers are configured.
+	failover *failover.Selector
 }
 
 // ddSeries A metric to submit to Datadog. See:
@@ -81,8 +86,8 @@ func newClient(server, apiKey, appKey string, disableCompression bool) *ddClient
 	return c
 }
 
-func (c *ddClient) newRequest(series []ddSeries) (*http.Request, error) {
-	url := fmt.Sprintf("https://%s/api/v1/series", c.server)
+func (c *ddClient) newRequest(server string, series []ddSeries) (*http.Request, error) {
+	url := fmt.Sprintf("https://%s/api/v1/series", server)
 
 	// JSON encoding of the datadog series.
 	// {
@@ -121,7 +126,18 @@ func (c *ddClient) newRequest(series []ddSeries) (*http.Request, error) {
 }
 
 func (c *ddClient) submitMetrics(ctx context.Context, series []ddSeries) error {
-	req, err := c.newRequest(series)
+	if c.failover == nil {
+		return c.submitMetricsToServer(ctx, c.server, series)
+	}
+
+	i, server := c.failover.Endpoint()
+	err := c.submitMetricsToServer(ctx, server, series)
+	c.failover.Report(i, err)
+	return err
+}
+
+func (c *ddClient) submitMetricsToServer(ctx context.Context, server string, series []ddSeries) error {
+	req, err := c.newRequest(server, series)
 	if err != nil {
 		return nil
 	}
@@ -130,6 +146,7 @@ func (c *ddClient) submitMetrics(ctx context.Context, series []ddSeries) error {
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode >= 300 {
 		b, _ := io.ReadAll(resp.Body)
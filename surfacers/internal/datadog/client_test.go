@@ -16,13 +16,22 @@ package datadog
 
 import (
 	"compress/gzip"
+	"context"
+	"crypto/tls"
 	"encoding/json"
 	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/failover"
 )
 
 func TestNewClient(t *testing.T) {
@@ -179,7 +188,7 @@ func TestNewRequest(t *testing.T) {
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			testClient := newClient("", "test-api-key", "test-app-key", test.disableCompression)
-			req, err := testClient.newRequest(test.ddSeries)
+			req, err := testClient.newRequest(testClient.server, test.ddSeries)
 			if err != nil {
 				t.Fatalf("Unexpected error: %v", err)
 			}
@@ -238,3 +247,93 @@ func TestNewRequest(t *testing.T) {
 		})
 	}
 }
+
+// testDDServer is a stub datadog server that can be made to fail.
+type testDDServer struct {
+	*httptest.Server
+	fail     atomic.Bool
+	requests atomic.Int64
+}
+
+func newTestDDServer(t *testing.T) *testDDServer {
+	t.Helper()
+	ts := &testDDServer{}
+	ts.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts.requests.Add(1)
+		if ts.fail.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func (ts *testDDServer) host() string {
+	return strings.TrimPrefix(ts.URL, "https://")
+}
+
+func TestSubmitMetricsFailover(t *testing.T) {
+	primary, secondary := newTestDDServer(t), newTestDDServer(t)
+
+	c := newClient(primary.host(), "test-api-key", "test-app-key", false)
+	c.c.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+
+	sel, err := failover.New([]string{primary.host(), secondary.host()}, 2, time.Minute, &logger.Logger{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	now := time.Now()
+	sel.SetClockForTest(func() time.Time { return now })
+	c.failover = sel
+
+	series := []ddSeries{{Metric: "cloudprober.total", Points: [][]float64{{float64(now.Unix()), 1}}}}
+	submit := func() error {
+		return c.submitMetrics(context.Background(), series)
+	}
+
+	if err := submit(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Primary fails, we fail over to secondary after 2 failures.
+	primary.fail.Store(true)
+	for i := 0; i < 2; i++ {
+		if err := submit(); err == nil {
+			t.Errorf("Expected error while primary is failing")
+		}
+	}
+	if sel.Active() != secondary.host() {
+		t.Fatalf("Active server after primary failures: %s, want: %s", sel.Active(), secondary.host())
+	}
+	for i := 0; i < 3; i++ {
+		if err := submit(); err != nil {
+			t.Errorf("Unexpected error after failover: %v", err)
+		}
+	}
+	if got := primary.requests.Load(); got != 3 {
+		t.Errorf("Requests to primary: %d, want: 3", got)
+	}
+	if got := secondary.requests.Load(); got != 3 {
+		t.Errorf("Requests to secondary: %d, want: 3", got)
+	}
+
+	// Primary recovers, we fail back once failback interval has passed.
+	primary.fail.Store(false)
+	if err := submit(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if sel.Active() != secondary.host() {
+		t.Errorf("Active server before failback interval: %s, want: %s", sel.Active(), secondary.host())
+	}
+
+	now = now.Add(time.Minute)
+	if err := submit(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if sel.Active() != primary.host() {
+		t.Errorf("Active server after failback: %s, want: %s", sel.Active(), primary.host())
+	}
+	if got := primary.requests.Load(); got != 4 {
+		t.Errorf("Requests to primary: %d, want: 4", got)
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/failover"
 	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
 	configpb "github.com/cloudprober/cloudprober/surfacers/internal/datadog/proto"
 	"google.golang.org/protobuf/proto"
@@ -80,6 +81,15 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		ddSeriesCache: make([]ddSeries, 0, config.GetMetricsBatchSize()),
 	}
 
+	if len(config.GetFailoverServer()) > 0 {
+		servers := append([]string{dd.client.server}, config.GetFailoverServer()...)
+		sel, err := failover.New(servers, int(config.GetFailoverThreshold()), time.Duration(config.GetFailbackIntervalSec())*time.Second, l)
+		if err != nil {
+			return nil, err
+		}
+		dd.client.failover = sel
+	}
+
 	go dd.receiveMetricsFromEvent(ctx)
 
 	dd.l.Info("Initialised Datadog surfacer")
@@ -157,6 +167,14 @@ func (dd *DDSurfacer) addMetricsAndPublish(ctx context.Context, publishTimer *ti
 }
 
 func (dd *DDSurfacer) publishMetrics(ctx context.Context) {
+	// Export the active server along with the other metrics.
+	if dd.client.failover != nil {
+		em := dd.client.failover.EventMetrics(time.Now())
+		for _, k := range em.MetricsKeys() {
+			dd.ddSeriesCache = append(dd.ddSeriesCache, recordMapValue(dd, em.Metric(k).(*metrics.Map[int64]), nil, k, em)...)
+		}
+	}
+
 	if err := dd.client.submitMetrics(ctx, dd.ddSeriesCache); err != nil {
 		dd.l.Errorf("Failed to publish %d series to datadog: %v", len(dd.ddSeriesCache), err)
 	}
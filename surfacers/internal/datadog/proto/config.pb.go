@@ -49,13 +49,27 @@ type SurfacerConf struct {
 	// Disable gzip compression of metric payload, when sending metrics to Datadog.
 	// Compression is enabled by default.
 	DisableCompression *bool `protobuf:"varint,7,opt,name=disable_compression,json=disableCompression" json:"disable_compression,omitempty"`
+	// Additional Datadog servers to fail over to, in order of preference. If
+	// publishing to the active server fails failover_threshold times in a row,
+	// surfacer switches to the next server. While on a failover server, the
+	// primary server (server above) is retried every failback_interval_sec,
+	// and surfacer switches back to it once it succeeds. Active server is
+	// exported as the surfacer_active_endpoint metric.
+	FailoverServer []string `protobuf:"bytes,8,rep,name=failover_server,json=failoverServer" json:"failover_server,omitempty"`
+	// Number of consecutive publishing failures before failing over to the
+	// next server.
+	FailoverThreshold *int32 `protobuf:"varint,9,opt,name=failover_threshold,json=failoverThreshold,def=3" json:"failover_threshold,omitempty"`
+	// How often to retry the primary server while on a failover server.
+	FailbackIntervalSec *int32 `protobuf:"varint,10,opt,name=failback_interval_sec,json=failbackIntervalSec,def=300" json:"failback_interval_sec,omitempty"`
 }
 
 // Default values for SurfacerConf fields.
 const (
-	Default_SurfacerConf_Prefix           = string("cloudprober")
-	Default_SurfacerConf_MetricsBatchSize = int32(1000)
-	Default_SurfacerConf_BatchTimerSec    = int32(30)
+	Default_SurfacerConf_Prefix              = string("cloudprober")
+	Default_SurfacerConf_MetricsBatchSize    = int32(1000)
+	Default_SurfacerConf_BatchTimerSec       = int32(30)
+	Default_SurfacerConf_FailoverThreshold   = int32(3)
+	Default_SurfacerConf_FailbackIntervalSec = int32(300)
 )
 
 func (x *SurfacerConf) Reset() {
@@ -139,6 +153,27 @@ func (x *SurfacerConf) GetDisableCompression() bool {
 	return false
 }
 
+func (x *SurfacerConf) GetFailoverServer() []string {
+	if x != nil {
+		return x.FailoverServer
+	}
+	return nil
+}
+
+func (x *SurfacerConf) GetFailoverThreshold() int32 {
+	if x != nil && x.FailoverThreshold != nil {
+		return *x.FailoverThreshold
+	}
+	return Default_SurfacerConf_FailoverThreshold
+}
+
+func (x *SurfacerConf) GetFailbackIntervalSec() int32 {
+	if x != nil && x.FailbackIntervalSec != nil {
+		return *x.FailbackIntervalSec
+	}
+	return Default_SurfacerConf_FailbackIntervalSec
+}
+
 var File_github_com_cloudprober_cloudprober_surfacers_internal_datadog_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_surfacers_internal_datadog_proto_config_proto_rawDesc = []byte{
@@ -149,7 +184,7 @@ var file_github_com_cloudprober_cloudprober_surfacers_internal_datadog_proto_con
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x1c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
 	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x64, 0x6f, 0x67,
-	0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x22, 0xa2, 0x03, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e,
 	0x66, 0x12, 0x23, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x3a, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x52, 0x06,
 	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
@@ -166,11 +201,20 @@ var file_github_com_cloudprober_cloudprober_surfacers_internal_datadog_proto_con
 	0x12, 0x2f, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
 	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64,
 	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
-	0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x64,
-	0x6f, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x12, 0x66, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x33, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x37, 0x0a, 0x15,
+	0x66, 0x61, 0x69, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x33, 0x30, 0x30,
+	0x52, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x64, 0x6f, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -40,8 +40,9 @@ type Surfacer struct {
 	c    *configpb.SurfacerConf
 	opts *options.Options
 
-	// Channel for incoming data.
+	// Channel for incoming data, and its usage by metric name.
 	inChan         chan *metrics.EventMetrics
+	inChanUsage    *options.MetricsBufferUsage
 	processInputWg sync.WaitGroup
 
 	// Output file for serializing to
@@ -67,6 +68,8 @@ func (s *Surfacer) processInput(ctx context.Context) {
 			if !ok {
 				return
 			}
+			s.inChanUsage.Release(em)
+
 			var emStr strings.Builder
 			emStr.WriteString(s.c.GetPrefix())
 			emStr.WriteByte(' ')
@@ -91,7 +94,8 @@ func (s *Surfacer) processInput(ctx context.Context) {
 }
 
 func (s *Surfacer) init(ctx context.Context, id int64) error {
-	s.inChan = make(chan *metrics.EventMetrics, s.opts.MetricsBufferSize)
+	s.inChan = make(chan *metrics.EventMetrics, s.opts.MetricsBufferCapacity())
+	s.inChanUsage = s.opts.NewMetricsBufferUsage()
 	s.id = id
 
 	// File handle for the output file
@@ -138,9 +142,14 @@ func (s *Surfacer) close() {
 // goroutine that actually writes data to a file ((usually set as a GCE
 // instance's serial port).
 func (s *Surfacer) Write(ctx context.Context, em *metrics.EventMetrics) {
+	if err := s.inChanUsage.Reserve(em); err != nil {
+		s.l.Errorf("Surfacer's %v, dropping new data.", err)
+		return
+	}
 	select {
 	case s.inChan <- em:
 	default:
+		s.inChanUsage.Release(em)
 		s.l.Errorf("Surfacer's write channel is full, dropping new data.")
 	}
 }
@@ -29,12 +29,15 @@ import (
 	"time"
 
 	"github.com/kylelemons/godebug/pretty"
+	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
 	"github.com/cloudprober/cloudprober/surfacers/internal/common/compress"
 	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
 	configpb "github.com/cloudprober/cloudprober/surfacers/internal/file/proto"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
 )
 
 func TestWrite(t *testing.T) {
@@ -109,3 +112,31 @@ func testWrite(t *testing.T, compressionEnabled bool) {
 		}
 	}
 }
+
+func TestWriteBufferSizeRule(t *testing.T) {
+	s := &Surfacer{
+		c: &configpb.SurfacerConf{},
+		opts: options.BuildOptionsForTest(&surfacerpb.SurfacerDef{
+			MetricsBufferSize: proto.Int64(4),
+			MetricsBufferSizeRule: []*surfacerpb.MetricsBufferSizeRule{
+				{MetricNameRegex: proto.String("^uptime$"), Size: proto.Int64(1)},
+			},
+		}),
+		l: &logger.Logger{},
+	}
+	// Don't start the input processing, so that the writes stay buffered.
+	s.inChan = make(chan *metrics.EventMetrics, s.opts.MetricsBufferCapacity())
+	s.inChanUsage = s.opts.NewMetricsBufferUsage()
+
+	for i := 0; i < 5; i++ {
+		s.Write(context.Background(), metrics.NewEventMetrics(time.Now()).AddMetric("latency", metrics.NewFloat(1)))
+		s.Write(context.Background(), metrics.NewEventMetrics(time.Now()).AddMetric("uptime", metrics.NewInt(1)))
+	}
+
+	// Only one uptime EventMetrics, rest of the buffer goes to latency.
+	counts := make(map[string]int)
+	for len(s.inChan) > 0 {
+		counts[(<-s.inChan).MetricsKeys()[0]]++
+	}
+	assert.Equal(t, map[string]int{"latency": 3, "uptime": 1}, counts)
+}
@@ -58,6 +58,9 @@ type OtelSurfacer struct {
 	scopeMetrics map[string]*metricdata.ScopeMetrics
 
 	startTime time.Time
+
+	// Resource that the metrics are exported for.
+	resource *resource.Resource
 }
 
 func getExporter(ctx context.Context, config *configpb.SurfacerConf, l *logger.Logger) (metric.Exporter, error) {
@@ -170,7 +173,12 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 	exportInterval := time.Second * time.Duration(config.GetExportIntervalSec())
 	r := metric.NewPeriodicReader(exp, metric.WithProducer(os), metric.WithInterval(exportInterval))
 
+	// Surfacer's own resource attributes come last, to take precedence over
+	// the common ones.
 	var attrKVs []attribute.KeyValue
+	for _, attr := range opts.ResourceAttributes {
+		attrKVs = append(attrKVs, attribute.String(attr[0], attr[1]))
+	}
 	for _, attr := range config.GetResourceAttribute() {
 		attrKVs = append(attrKVs, attribute.String(attr.GetKey(), attr.GetValue()))
 	}
@@ -178,6 +186,7 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource: %v", err)
 	}
+	os.resource = res
 
 	// This step registers the reader and pipelines behind the scene.
 	// manugarg: This step seems kind of unnecessary right now but it's
@@ -287,10 +296,9 @@ func numberData[T int64 | float64](kind metrics.Kind, v T, attrs attribute.Set,
 func (os *OtelSurfacer) convertMetric(em *metrics.EventMetrics, metricName string) (metricdata.Metrics, error) {
 	baseAttrs := otelAttributes(em)
 
-	unit := "1"
-	if os.opts.IsLatencyMetric(metricName) {
-		unit = metrics.LatencyUnitToString(em.LatencyUnit)
-		os.l.Debugf("Latency metric (%s) unit: %s", metricName, unit)
+	unit := os.opts.MetricUnit(em, metricName)
+	if unit == "" {
+		unit = "1"
 	}
 
 	otelmetrics := func(data metricdata.Aggregation) metricdata.Metrics {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
 	configpb "github.com/cloudprober/cloudprober/surfacers/internal/otel/proto"
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/otel/attribute"
@@ -226,3 +227,60 @@ func TestGetExporterType(t *testing.T) {
 		})
 	}
 }
+
+func TestOtelSurfacerMetricUnits(t *testing.T) {
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("latency", metrics.NewFloat(9.2)).
+		AddMetric("resp_bytes", metrics.NewInt(512)).
+		AddMetric("availability", metrics.NewFloat(99.5)).
+		AddMetric("total", metrics.NewInt(2)).
+		AddLabel("probe", "p3")
+	em.LatencyUnit = time.Second
+	em.MetricUnits = map[string]string{"availability": "%"}
+
+	os := &OtelSurfacer{
+		startTime:    time.Now(),
+		scopeMetrics: make(map[string]*metricdata.ScopeMetrics),
+	}
+	os.Write(context.Background(), em)
+
+	gotUnits := make(map[string]string)
+	for _, m := range os.scopeMetrics["probe.p3"].Metrics {
+		gotUnits[m.Name] = m.Unit
+	}
+	assert.Equal(t, map[string]string{
+		"cloudprober_latency":      "s",
+		"cloudprober_resp_bytes":   "By",
+		"cloudprober_availability": "%",
+		"cloudprober_total":        "1",
+	}, gotUnits)
+}
+
+func TestOtelSurfacerResourceAttributes(t *testing.T) {
+	opts := &options.Options{
+		ResourceAttributes: [][2]string{
+			{"environment", "prod"},
+			{"service.name", "cloudprober"},
+		},
+	}
+	config := &configpb.SurfacerConf{
+		ResourceAttribute: []*configpb.SurfacerConf_Attribute{
+			{Key: proto.String("service.name"), Value: proto.String("edge-prober")},
+		},
+	}
+
+	os, err := New(context.Background(), config, opts, nil)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	for k, want := range map[string]string{
+		"environment": "prod",
+		// Surfacer's own resource attribute takes precedence.
+		"service.name": "edge-prober",
+	} {
+		v, ok := os.resource.Set().Value(attribute.Key(k))
+		assert.True(t, ok, "resource attribute %s not found", k)
+		assert.Equal(t, want, v.AsString(), "resource attribute %s", k)
+	}
+}
@@ -86,6 +86,7 @@ var (
 
 type promMetric struct {
 	typ      string
+	unit     string
 	data     map[string]*dataPoint
 	dataKeys []string // To keep data keys ordered
 }
@@ -115,6 +116,7 @@ type PromSurfacer struct {
 	opts        *options.Options
 	prefix      string                     // Metrics prefix, e.g. "cloudprober_"
 	emChan      chan *metrics.EventMetrics // Buffered channel to store incoming EventMetrics
+	deleteChan  chan *metrics.EventMetrics // Buffered channel to store series to delete
 	metrics     map[string]*promMetric     // Metric name to promMetric mapping
 	metricNames []string                   // Metric names, to keep names ordered.
 	queryChan   chan *httpWriter           // Query channel
@@ -127,6 +129,11 @@ type PromSurfacer struct {
 	// Regexes for metric and label names.
 	metricNameRe *regexp.Regexp
 	labelNameRe  *regexp.Regexp
+
+	// Data key of the target_info metric, carrying the common resource
+	// attributes (resource_attributes) as labels. Empty if there are no
+	// resource attributes.
+	targetInfoKey string
 }
 
 // New returns a prometheus surfacer based on the config provided. It sets up a
@@ -140,6 +147,7 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		c:            config,
 		opts:         opts,
 		emChan:       make(chan *metrics.EventMetrics, config.GetMetricsBufferSize()),
+		deleteChan:   make(chan *metrics.EventMetrics, config.GetMetricsBufferSize()),
 		queryChan:    make(chan *httpWriter, queriesQueueSize),
 		metrics:      make(map[string]*promMetric),
 		metricNameRe: regexp.MustCompile(ValidMetricNameRegex),
@@ -156,6 +164,8 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		ps.prefix = ps.c.GetMetricsPrefix()
 	}
 
+	ps.targetInfoKey = ps.targetInfo(opts.ResourceAttributes)
+
 	if ps.c.GetIncludeTimestamp() {
 		ps.dataWriter = func(w io.Writer, pm *promMetric, k string) {
 			fmt.Fprintf(w, "%s %s %d\n", k, pm.data[k].value, pm.data[k].timestamp)
@@ -180,6 +190,8 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 				return
 			case em := <-ps.emChan:
 				ps.record(em)
+			case em := <-ps.deleteChan:
+				ps.deleteSeries(em)
 			case hw := <-ps.queryChan:
 				ps.writeData(hw.w)
 				close(hw.doneChan)
@@ -212,6 +224,16 @@ func (ps *PromSurfacer) Write(_ context.Context, em *metrics.EventMetrics) {
 	}
 }
 
+// DeleteSeries queues the series corresponding to the given EventMetrics for
+// deletion, so that they are not exposed anymore.
+func (ps *PromSurfacer) DeleteSeries(_ context.Context, em *metrics.EventMetrics) {
+	select {
+	case ps.deleteChan <- em:
+	default:
+		ps.l.Errorf("PromSurfacer's delete channel is full, not deleting stale series.")
+	}
+}
+
 func promType(em *metrics.EventMetrics) string {
 	switch em.Kind {
 	case metrics.CUMULATIVE:
@@ -319,14 +341,18 @@ func dataKey(metricName string, labels []string) string {
 	return metricName + "{" + strings.Join(labels, ",") + "}"
 }
 
-func recordMap[T int64 | float64](ps *PromSurfacer, m *metrics.Map[T], em *metrics.EventMetrics, pMetricName string, labels []string) {
+// dataPointFunc is called for each data point generated from an
+// EventMetrics.
+type dataPointFunc func(metricName, key, value, typ string)
+
+func mapDataPoints[T int64 | float64](ps *PromSurfacer, m *metrics.Map[T], pMetricName string, labels []string, fn dataPointFunc) {
 	labelName := ps.checkLabelName(m.MapName)
 	if labelName == "" {
 		return
 	}
 	for _, k := range m.Keys() {
 		key := dataKey(pMetricName, append(labels, labelName+"=\""+k+"\""))
-		ps.recordMetric(pMetricName, key, metrics.MapValueToString(m.GetKey(k)), em, "")
+		fn(pMetricName, key, metrics.MapValueToString(m.GetKey(k)), "")
 	}
 }
 
@@ -349,6 +375,27 @@ func recordMap[T int64 | float64](ps *PromSurfacer, m *metrics.Map[T], em *metri
 //
 //	version{val=cloudprober-20170608-RC00} 1
 func (ps *PromSurfacer) record(em *metrics.EventMetrics) {
+	ps.forEachDataPoint(em, func(metricName, key, value, typ string) {
+		ps.recordMetric(metricName, key, value, em, typ)
+	})
+
+	if !ps.c.GetEmitUnits() {
+		return
+	}
+	for _, metricName := range em.MetricsKeys() {
+		pm := ps.metrics[ps.promMetricName(metricName)]
+		if pm == nil {
+			continue
+		}
+		if unit := ps.opts.MetricUnit(em, metricName); unit != "" {
+			pm.unit = unit
+		}
+	}
+}
+
+// forEachDataPoint converts the given EventMetrics into prometheus data
+// points, and calls fn for each of them.
+func (ps *PromSurfacer) forEachDataPoint(em *metrics.EventMetrics, fn dataPointFunc) {
 	var labels []string
 	for _, k := range em.LabelsKeys() {
 		if labelName := ps.checkLabelName(k); labelName != "" {
@@ -369,15 +416,15 @@ func (ps *PromSurfacer) record(em *metrics.EventMetrics) {
 
 		switch v := val.(type) {
 		case *metrics.Map[int64]:
-			recordMap(ps, v, em, pMetricName, labels)
+			mapDataPoints(ps, v, pMetricName, labels, fn)
 		case *metrics.Map[float64]:
-			recordMap(ps, v, em, pMetricName, labels)
+			mapDataPoints(ps, v, pMetricName, labels, fn)
 		// Distribution values get expanded into metrics with extra label "le".
 		case *metrics.Distribution:
 			d := v.Data()
 			var val int64
-			ps.recordMetric(pMetricName, dataKey(pMetricName+"_sum", labels), strconv.FormatFloat(d.Sum, 'f', -1, 64), em, histogram)
-			ps.recordMetric(pMetricName, dataKey(pMetricName+"_count", labels), strconv.FormatInt(d.Count, 10), em, histogram)
+			fn(pMetricName, dataKey(pMetricName+"_sum", labels), strconv.FormatFloat(d.Sum, 'f', -1, 64), histogram)
+			fn(pMetricName, dataKey(pMetricName+"_count", labels), strconv.FormatInt(d.Count, 10), histogram)
 			for i := range d.LowerBounds {
 				val += d.BucketCounts[i]
 				var lb string
@@ -387,30 +434,68 @@ func (ps *PromSurfacer) record(em *metrics.EventMetrics) {
 					lb = strconv.FormatFloat(d.LowerBounds[i+1], 'f', -1, 64)
 				}
 				labelsWithBucket := append(labels, "le=\""+lb+"\"")
-				ps.recordMetric(pMetricName, dataKey(pMetricName+"_bucket", labelsWithBucket), strconv.FormatInt(val, 10), em, histogram)
+				fn(pMetricName, dataKey(pMetricName+"_bucket", labelsWithBucket), strconv.FormatInt(val, 10), histogram)
 			}
 		case metrics.String:
 			newLabels := append(labels, "val="+val.String())
-			ps.recordMetric(pMetricName, dataKey(pMetricName, newLabels), "1", em, "")
+			fn(pMetricName, dataKey(pMetricName, newLabels), "1", "")
 
 		// All other value types, mostly numerical types.
 		default:
-			ps.recordMetric(pMetricName, dataKey(pMetricName, labels), val.String(), em, "")
+			fn(pMetricName, dataKey(pMetricName, labels), val.String(), "")
 		}
 	}
 }
 
+// targetInfo returns the data key of the target_info metric for the resource
+// attributes, following the OpenMetrics convention for exposing the resource
+// attributes. Attribute keys are converted to label names by replacing "."
+// with "_".
+func (ps *PromSurfacer) targetInfo(attrs [][2]string) string {
+	var labels []string
+	for _, attr := range attrs {
+		labelName := ps.checkLabelName(strings.ReplaceAll(attr[0], ".", "_"))
+		if labelName == "" {
+			continue
+		}
+		labels = append(labels, labelName+"=\""+attr[1]+"\"")
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+	return dataKey("target_info", labels)
+}
+
 // writeData writes metrics data on w io.Writer
 func (ps *PromSurfacer) writeData(w io.Writer) {
+	if ps.targetInfoKey != "" {
+		fmt.Fprintf(w, "# TYPE target_info gauge\n%s 1\n", ps.targetInfoKey)
+	}
 	for _, name := range ps.metricNames {
 		pm := ps.metrics[name]
 		fmt.Fprintf(w, "# TYPE %s %s\n", name, pm.typ)
+		if pm.unit != "" {
+			fmt.Fprintf(w, "# UNIT %s %s\n", name, pm.unit)
+		}
 		for _, k := range pm.dataKeys {
 			ps.dataWriter(w, pm, k)
 		}
 	}
 }
 
+// deleteSeries deletes the data points corresponding to the given
+// EventMetrics.
+func (ps *PromSurfacer) deleteSeries(em *metrics.EventMetrics) {
+	ps.forEachDataPoint(em, func(metricName, key, _, _ string) {
+		pm := ps.metrics[metricName]
+		if pm == nil || pm.data[key] == nil {
+			return
+		}
+		delete(pm.data, key)
+		pm.dataKeys = deleteFromSlice(pm.dataKeys, key)
+	})
+}
+
 // deleteExpiredMetrics clears the metric expired in PromSurfacer.
 // Note from manugarg: We can possibly optimize this by recording expired
 // keys while serving the metrics, and deleting them based on the timer.
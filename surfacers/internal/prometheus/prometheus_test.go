@@ -251,6 +251,45 @@ func TestScrapeOutputNoTimestamp(t *testing.T) {
 	}
 }
 
+func TestScrapeOutputUnits(t *testing.T) {
+	newEM := func() *metrics.EventMetrics {
+		latencyVal := metrics.NewDistribution([]float64{1, 4})
+		latencyVal.AddSample(0.5)
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("sent", metrics.NewInt(32)).
+			AddMetric("resp_bytes", metrics.NewInt(1024)).
+			AddMetric("availability", metrics.NewFloat(99.5)).
+			AddMetric("latency", latencyVal).
+			AddLabel("ptype", "http")
+		em.LatencyUnit = time.Millisecond
+		em.MetricUnits = map[string]string{"availability": "%"}
+		return em
+	}
+
+	for _, emitUnits := range []bool{false, true} {
+		t.Run(fmt.Sprintf("emit_units=%v", emitUnits), func(t *testing.T) {
+			ps := testPromSurfacerNoErr(t, &configpb.SurfacerConf{EmitUnits: proto.Bool(emitUnits)})
+			ps.record(newEM())
+			var b bytes.Buffer
+			ps.writeData(&b)
+			data := b.String()
+
+			if !emitUnits {
+				assert.NotContains(t, data, "# UNIT")
+				return
+			}
+			for _, d := range []string{
+				"# TYPE resp_bytes counter\n# UNIT resp_bytes By\n",
+				"# TYPE availability counter\n# UNIT availability %\n",
+				"# TYPE latency histogram\n# UNIT latency ms\n",
+			} {
+				assert.Contains(t, data, d)
+			}
+			assert.NotContains(t, data, "# UNIT sent")
+		})
+	}
+}
+
 func TestScrapeOutputWithExpiredTimeMetrics(t *testing.T) {
 	ps := testPromSurfacerNoErr(t, &configpb.SurfacerConf{IncludeTimestamp: proto.Bool(true)})
 
@@ -299,6 +338,54 @@ func TestScrapeOutputWithExpiredTimeMetrics(t *testing.T) {
 	}
 }
 
+func TestDeleteSeries(t *testing.T) {
+	ps := testPromSurfacerNoErr(t, &configpb.SurfacerConf{IncludeTimestamp: proto.Bool(false)})
+
+	newEM := func(dst string) *metrics.EventMetrics {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("queue_depth", metrics.NewInt(5)).
+			AddMetric("resp_code", metrics.NewMap("code").IncKeyBy("200", 3)).
+			AddLabel("dst", dst)
+		em.Kind = metrics.GAUGE
+		return em
+	}
+	ps.record(newEM("t1"))
+	ps.record(newEM("t2"))
+
+	// Delete only the queue_depth series for t1.
+	staleEM := metrics.NewEventMetrics(time.Now()).
+		AddMetric("queue_depth", metrics.NewInt(5)).
+		AddLabel("dst", "t1")
+	ps.deleteSeries(staleEM)
+
+	var b bytes.Buffer
+	ps.writeData(&b)
+	assert.Equal(t, strings.Join([]string{
+		"# TYPE queue_depth gauge",
+		"queue_depth{dst=\"t2\"} 5",
+		"# TYPE resp_code gauge",
+		"resp_code{dst=\"t1\",code=\"200\"} 3",
+		"resp_code{dst=\"t2\",code=\"200\"} 3",
+	}, "\n")+"\n", b.String())
+
+	// Map series are deleted for all keys, and deleting unknown series is
+	// a no-op.
+	staleEM = metrics.NewEventMetrics(time.Now()).
+		AddMetric("resp_code", metrics.NewMap("code").IncKeyBy("200", 3)).
+		AddLabel("dst", "t2")
+	ps.deleteSeries(staleEM)
+	ps.deleteSeries(metrics.NewEventMetrics(time.Now()).AddMetric("unknown", metrics.NewInt(1)))
+
+	b.Reset()
+	ps.writeData(&b)
+	assert.Equal(t, strings.Join([]string{
+		"# TYPE queue_depth gauge",
+		"queue_depth{dst=\"t2\"} 5",
+		"# TYPE resp_code gauge",
+		"resp_code{dst=\"t1\",code=\"200\"} 3",
+	}, "\n")+"\n", b.String())
+}
+
 func TestMetricsPrefix(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -371,3 +458,39 @@ func TestMetricsPrefix(t *testing.T) {
 	}
 	verify(t, ps, expectedMetrics)
 }
+
+func TestScrapeOutputTargetInfo(t *testing.T) {
+	c := &configpb.SurfacerConf{
+		MetricsUrl:       proto.String(fmt.Sprintf("/metrics_%d", rand.Int())),
+		IncludeTimestamp: proto.Bool(false),
+	}
+	opts := &options.Options{
+		HTTPServeMux: http.NewServeMux(),
+		ResourceAttributes: [][2]string{
+			{"environment", "prod"},
+			{"service.name", "cloudprober"},
+		},
+	}
+	ps, err := New(context.Background(), c, opts, nil)
+	if err != nil {
+		t.Fatal("Error while initializing prometheus surfacer", err)
+	}
+	ps.record(metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(3)).
+		AddLabel("dst", "t1"))
+
+	var b bytes.Buffer
+	ps.writeData(&b)
+	assert.Equal(t, strings.Join([]string{
+		"# TYPE target_info gauge",
+		"target_info{environment=\"prod\",service_name=\"cloudprober\"} 1",
+		"# TYPE total counter",
+		"total{dst=\"t1\"} 3",
+	}, "\n")+"\n", b.String())
+
+	// No target_info without resource attributes.
+	b.Reset()
+	ps = testPromSurfacerNoErr(t, nil)
+	ps.writeData(&b)
+	assert.NotContains(t, b.String(), "target_info")
+}
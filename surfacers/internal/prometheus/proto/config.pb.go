@@ -46,6 +46,11 @@ type SurfacerConf struct {
 	// As it's typically useful to set this across the deployment, this field can
 	// also be set through the command line flag --prometheus_metrics_prefix.
 	MetricsPrefix *string `protobuf:"bytes,4,opt,name=metrics_prefix,json=metricsPrefix" json:"metrics_prefix,omitempty"`
+	// Whether to export metric units, as "# UNIT" lines. Units come from the
+	// EventMetrics, or are inferred for latency metrics (from the probe's
+	// latency unit) and byte metrics (names containing "bytes"). Units use UCUM
+	// codes, e.g. "ms", "s", "By".
+	EmitUnits *bool `protobuf:"varint,5,opt,name=emit_units,json=emitUnits,def=0" json:"emit_units,omitempty"`
 }
 
 // Default values for SurfacerConf fields.
@@ -53,6 +58,7 @@ const (
 	Default_SurfacerConf_MetricsBufferSize = int64(10000)
 	Default_SurfacerConf_IncludeTimestamp  = bool(true)
 	Default_SurfacerConf_MetricsUrl        = string("/metrics")
+	Default_SurfacerConf_EmitUnits         = bool(false)
 )
 
 func (x *SurfacerConf) Reset() {
@@ -115,6 +121,13 @@ func (x *SurfacerConf) GetMetricsPrefix() string {
 	return ""
 }
 
+func (x *SurfacerConf) GetEmitUnits() bool {
+	if x != nil && x.EmitUnits != nil {
+		return *x.EmitUnits
+	}
+	return Default_SurfacerConf_EmitUnits
+}
+
 var File_github_com_cloudprober_cloudprober_surfacers_internal_prometheus_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_surfacers_internal_prometheus_proto_config_proto_rawDesc = []byte{
@@ -125,7 +138,7 @@ var file_github_com_cloudprober_cloudprober_surfacers_internal_prometheus_proto_
 	0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
 	0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
-	0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61,
+	0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x72, 0x66, 0x61,
 	0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x35, 0x0a, 0x13, 0x6d, 0x65, 0x74, 0x72, 0x69,
 	0x63, 0x73, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x03, 0x3a, 0x05, 0x31, 0x30, 0x30, 0x30, 0x30, 0x52, 0x11, 0x6d, 0x65, 0x74,
@@ -138,11 +151,14 @@ var file_github_com_cloudprober_cloudprober_surfacers_internal_prometheus_proto_
 	0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
 	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x72, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
-	0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x66, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x0a, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09,
+	0x65, 0x6d, 0x69, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -62,8 +62,9 @@ type Surfacer struct {
 	c    *configpb.SurfacerConf
 	opts *options.Options
 
-	// Channel for incoming data.
+	// Channel for incoming data, and its usage by metric name.
 	inChan            chan *metrics.EventMetrics
+	inChanUsage       *options.MetricsBufferUsage
 	publishResultChan chan *pubsub.PublishResult
 
 	topic      *pubsub.Topic
@@ -106,6 +107,8 @@ func (s *Surfacer) processInput(ctx context.Context) {
 			if !ok {
 				return
 			}
+			s.inChanUsage.Release(em)
+
 			if s.c.GetCompressionEnabled() {
 				s.compressionBuffer.WriteLineToBuffer(em.String())
 			} else {
@@ -116,7 +119,8 @@ func (s *Surfacer) processInput(ctx context.Context) {
 }
 
 func (s *Surfacer) init(ctx context.Context) error {
-	s.inChan = make(chan *metrics.EventMetrics, s.opts.MetricsBufferSize)
+	s.inChan = make(chan *metrics.EventMetrics, s.opts.MetricsBufferCapacity())
+	s.inChanUsage = s.opts.NewMetricsBufferUsage()
 
 	// We use start timestamp in millisecond as the incarnation id.
 	s.starttime = strconv.FormatInt(time.Now().UnixNano()/(1000*1000), 10)
@@ -204,10 +208,15 @@ func (s *Surfacer) close() {
 // Write queues the incoming data into a channel. This channel is watched by a
 // goroutine that actually publishes it to a pubsub topic.
 func (s *Surfacer) Write(ctx context.Context, em *metrics.EventMetrics) {
+	if err := s.inChanUsage.Reserve(em); err != nil {
+		s.l.Errorf("Surfacer's %v, dropping new data.", err)
+		return
+	}
 	select {
 	case s.inChan <- em:
 	default:
-		s.l.Errorf("Surfacer's write channel (capacity: %d) is full, dropping new data.", s.opts.MetricsBufferSize)
+		s.inChanUsage.Release(em)
+		s.l.Errorf("Surfacer's write channel (capacity: %d) is full, dropping new data.", cap(s.inChan))
 	}
 }
 
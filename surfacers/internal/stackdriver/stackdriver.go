@@ -69,6 +69,10 @@ type SDSurfacer struct {
 	projectName string
 	resource    *monitoring.MonitoredResource
 
+	// Common resource attributes (resource_attributes), added as metric
+	// labels, as arbitrary labels can't be added to the monitored resources.
+	resourceLabels [][2]string
+
 	// Time when stackdriver module was initialized. This is used as start time
 	// for cumulative metrics.
 	startTime time.Time
@@ -99,6 +103,10 @@ func New(ctx context.Context, config *configpb.SurfacerConf, opts *options.Optio
 		l:            l,
 	}
 
+	for _, attr := range opts.ResourceAttributes {
+		s.resourceLabels = append(s.resourceLabels, [2]string{strings.ReplaceAll(attr[0], ".", "_"), attr[1]})
+	}
+
 	if s.c.GetAllowedMetricsRegex() != "" {
 		l.Warning("allowed_metrics_regex is now deprecated. Please use the common surfacer options: allow_metrics, ignore_metrics.")
 		r, err := regexp.Compile(s.c.GetAllowedMetricsRegex())
@@ -380,6 +388,14 @@ func (s *SDSurfacer) baseMetric(em *metrics.EventMetrics) (*baseMetric, string)
 		sortedLabels = append(sortedLabels, k+"="+labels[k])
 	}
 
+	// Metrics' own labels take precedence over the resource labels.
+	for _, rl := range s.resourceLabels {
+		if _, ok := labels[rl[0]]; !ok {
+			labels[rl[0]] = rl[1]
+			sortedLabels = append(sortedLabels, rl[0]+"="+rl[1])
+		}
+	}
+
 	metricPrefix := ""
 	if ptype != "" && usePType {
 		metricPrefix += ptype + "/"
@@ -450,7 +466,7 @@ func (s *SDSurfacer) recordEventMetrics(em *metrics.EventMetrics) (ts []*monitor
 		bm := baseM.Clone()
 		bm.name = name
 
-		if s.opts.IsLatencyMetric(k) {
+		if s.opts.IsLatencyMetricValue(k, em.Metric(k)) {
 			bm.unit = metrics.LatencyUnitToString(em.LatencyUnit)
 		}
 
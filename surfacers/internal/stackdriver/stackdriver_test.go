@@ -158,6 +158,13 @@ func TestTimeSeries(t *testing.T) {
 			tsValue:     []float64{1.176},
 			tsUnit:      []string{"ms"},
 		},
+		{
+			description: "int-metric-latency-name",
+			metricName:  "db_latency",
+			metricValue: metrics.NewInt(12),
+			latencyUnit: time.Millisecond,
+			tsValue:     []float64{12},
+		},
 		{
 			description:   "string-value",
 			metricName:    "version",
@@ -291,3 +298,33 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceLabels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := &options.Options{
+		ResourceAttributes: [][2]string{
+			{"environment", "prod"},
+			{"service.name", "cloudprober"},
+		},
+	}
+	s, err := New(ctx, &configpb.SurfacerConf{MetricsPrefix: configpb.SurfacerConf_NONE.Enum()}, opts, http.DefaultClient, nil)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(3)).
+		AddLabel("dst", "t1").
+		AddLabel("environment", "staging")
+
+	bm, _ := s.baseMetric(em)
+	// EventMetrics' own labels take precedence.
+	assert.Equal(t, map[string]string{
+		"dst":          "t1",
+		"environment":  "staging",
+		"service_name": "cloudprober",
+	}, bm.labels)
+	assert.Equal(t, "dst=t1,environment=staging,service_name=cloudprober", bm.cacheKey)
+}
@@ -117,6 +117,424 @@ func (Type) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{0}
 }
 
+// LabelMatchMode controls how multiple label filters are combined.
+type LabelMatchMode int32
+
+const (
+	// EventMetrics should match any of the filters.
+	LabelMatchMode_ANY LabelMatchMode = 0
+	// EventMetrics should match all of the filters.
+	LabelMatchMode_ALL LabelMatchMode = 1
+)
+
+// Enum value maps for LabelMatchMode.
+var (
+	LabelMatchMode_name = map[int32]string{
+		0: "ANY",
+		1: "ALL",
+	}
+	LabelMatchMode_value = map[string]int32{
+		"ANY": 0,
+		"ALL": 1,
+	}
+)
+
+func (x LabelMatchMode) Enum() *LabelMatchMode {
+	p := new(LabelMatchMode)
+	*p = x
+	return p
+}
+
+func (x LabelMatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LabelMatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[1].Descriptor()
+}
+
+func (LabelMatchMode) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[1]
+}
+
+func (x LabelMatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *LabelMatchMode) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = LabelMatchMode(num)
+	return nil
+}
+
+// Deprecated: Use LabelMatchMode.Descriptor instead.
+func (LabelMatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+// FilterPrecedence controls which label filters win when EventMetrics match
+// both the allow and the ignore label filters. See
+// SurfacerDef.filter_precedence for details.
+type FilterPrecedence int32
+
+const (
+	FilterPrecedence_IGNORE_FIRST FilterPrecedence = 0
+	FilterPrecedence_ALLOW_FIRST  FilterPrecedence = 1
+)
+
+// Enum value maps for FilterPrecedence.
+var (
+	FilterPrecedence_name = map[int32]string{
+		0: "IGNORE_FIRST",
+		1: "ALLOW_FIRST",
+	}
+	FilterPrecedence_value = map[string]int32{
+		"IGNORE_FIRST": 0,
+		"ALLOW_FIRST":  1,
+	}
+)
+
+func (x FilterPrecedence) Enum() *FilterPrecedence {
+	p := new(FilterPrecedence)
+	*p = x
+	return p
+}
+
+func (x FilterPrecedence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterPrecedence) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[2].Descriptor()
+}
+
+func (FilterPrecedence) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[2]
+}
+
+func (x FilterPrecedence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FilterPrecedence) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FilterPrecedence(num)
+	return nil
+}
+
+// Deprecated: Use FilterPrecedence.Descriptor instead.
+func (FilterPrecedence) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+type ValueFilter_Op int32
+
+const (
+	ValueFilter_EQ ValueFilter_Op = 0
+	ValueFilter_NE ValueFilter_Op = 1
+	ValueFilter_LT ValueFilter_Op = 2
+	ValueFilter_LE ValueFilter_Op = 3
+	ValueFilter_GT ValueFilter_Op = 4
+	ValueFilter_GE ValueFilter_Op = 5
+)
+
+// Enum value maps for ValueFilter_Op.
+var (
+	ValueFilter_Op_name = map[int32]string{
+		0: "EQ",
+		1: "NE",
+		2: "LT",
+		3: "LE",
+		4: "GT",
+		5: "GE",
+	}
+	ValueFilter_Op_value = map[string]int32{
+		"EQ": 0,
+		"NE": 1,
+		"LT": 2,
+		"LE": 3,
+		"GT": 4,
+		"GE": 5,
+	}
+)
+
+func (x ValueFilter_Op) Enum() *ValueFilter_Op {
+	p := new(ValueFilter_Op)
+	*p = x
+	return p
+}
+
+func (x ValueFilter_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValueFilter_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[3].Descriptor()
+}
+
+func (ValueFilter_Op) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[3]
+}
+
+func (x ValueFilter_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ValueFilter_Op) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ValueFilter_Op(num)
+	return nil
+}
+
+// Deprecated: Use ValueFilter_Op.Descriptor instead.
+func (ValueFilter_Op) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type SuccessFilter_State int32
+
+const (
+	// Some probes failed, i.e. success < total.
+	SuccessFilter_FAILURES SuccessFilter_State = 0
+	// No probe failed, i.e. success == total.
+	SuccessFilter_NO_FAILURES SuccessFilter_State = 1
+)
+
+// Enum value maps for SuccessFilter_State.
+var (
+	SuccessFilter_State_name = map[int32]string{
+		0: "FAILURES",
+		1: "NO_FAILURES",
+	}
+	SuccessFilter_State_value = map[string]int32{
+		"FAILURES":    0,
+		"NO_FAILURES": 1,
+	}
+)
+
+func (x SuccessFilter_State) Enum() *SuccessFilter_State {
+	p := new(SuccessFilter_State)
+	*p = x
+	return p
+}
+
+func (x SuccessFilter_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuccessFilter_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[4].Descriptor()
+}
+
+func (SuccessFilter_State) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[4]
+}
+
+func (x SuccessFilter_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *SuccessFilter_State) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = SuccessFilter_State(num)
+	return nil
+}
+
+// Deprecated: Use SuccessFilter_State.Descriptor instead.
+func (SuccessFilter_State) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type RateLimit_Policy int32
+
+const (
+	// EventMetrics beyond the rate limit are dropped.
+	RateLimit_SHED RateLimit_Policy = 0
+	// EventMetrics beyond the rate limit are queued and written as tokens
+	// become available. EventMetrics that don't fit in the queue are dropped.
+	RateLimit_BUFFER RateLimit_Policy = 1
+)
+
+// Enum value maps for RateLimit_Policy.
+var (
+	RateLimit_Policy_name = map[int32]string{
+		0: "SHED",
+		1: "BUFFER",
+	}
+	RateLimit_Policy_value = map[string]int32{
+		"SHED":   0,
+		"BUFFER": 1,
+	}
+)
+
+func (x RateLimit_Policy) Enum() *RateLimit_Policy {
+	p := new(RateLimit_Policy)
+	*p = x
+	return p
+}
+
+func (x RateLimit_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateLimit_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[5].Descriptor()
+}
+
+func (RateLimit_Policy) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[5]
+}
+
+func (x RateLimit_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *RateLimit_Policy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = RateLimit_Policy(num)
+	return nil
+}
+
+// Deprecated: Use RateLimit_Policy.Descriptor instead.
+func (RateLimit_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type RelabelRule_Target int32
+
+const (
+	RelabelRule_METRIC_NAME RelabelRule_Target = 0
+	RelabelRule_LABEL_KEY   RelabelRule_Target = 1
+)
+
+// Enum value maps for RelabelRule_Target.
+var (
+	RelabelRule_Target_name = map[int32]string{
+		0: "METRIC_NAME",
+		1: "LABEL_KEY",
+	}
+	RelabelRule_Target_value = map[string]int32{
+		"METRIC_NAME": 0,
+		"LABEL_KEY":   1,
+	}
+)
+
+func (x RelabelRule_Target) Enum() *RelabelRule_Target {
+	p := new(RelabelRule_Target)
+	*p = x
+	return p
+}
+
+func (x RelabelRule_Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelabelRule_Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[6].Descriptor()
+}
+
+func (RelabelRule_Target) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[6]
+}
+
+func (x RelabelRule_Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *RelabelRule_Target) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = RelabelRule_Target(num)
+	return nil
+}
+
+// Deprecated: Use RelabelRule_Target.Descriptor instead.
+func (RelabelRule_Target) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type InvalidLatency_Policy int32
+
+const (
+	// Drop the invalid latency metric. If no metrics are left in the
+	// EventMetrics, it's dropped as well.
+	InvalidLatency_DROP InvalidLatency_Policy = 0
+	// Replace the invalid value with default_value.
+	InvalidLatency_DEFAULT InvalidLatency_Policy = 1
+)
+
+// Enum value maps for InvalidLatency_Policy.
+var (
+	InvalidLatency_Policy_name = map[int32]string{
+		0: "DROP",
+		1: "DEFAULT",
+	}
+	InvalidLatency_Policy_value = map[string]int32{
+		"DROP":    0,
+		"DEFAULT": 1,
+	}
+)
+
+func (x InvalidLatency_Policy) Enum() *InvalidLatency_Policy {
+	p := new(InvalidLatency_Policy)
+	*p = x
+	return p
+}
+
+func (x InvalidLatency_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvalidLatency_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[7].Descriptor()
+}
+
+func (InvalidLatency_Policy) Type() protoreflect.EnumType {
+	return &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes[7]
+}
+
+func (x InvalidLatency_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *InvalidLatency_Policy) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = InvalidLatency_Policy(num)
+	return nil
+}
+
+// Deprecated: Use InvalidLatency_Policy.Descriptor instead.
+func (InvalidLatency_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type LabelFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -124,8 +542,40 @@ type LabelFilter struct {
 
 	Key   *string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Value *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	// If set, key is matched as a prefix of the label keys, e.g. key "k8s."
+	// matches labels "k8s.pod.name" and "k8s.namespace". If value is also set,
+	// it's compared only with the values of the matched labels, and filter
+	// matches if any of them has that value.
+	KeyPrefix *bool `protobuf:"varint,3,opt,name=key_prefix,json=keyPrefix" json:"key_prefix,omitempty"`
+	// Regex to match the label value against, instead of an exact value, e.g.
+	// "us-.*". Regex should match the whole value. It can't be used together
+	// with value.
+	ValueRegex *string `protobuf:"bytes,4,opt,name=value_regex,json=valueRegex" json:"value_regex,omitempty"`
+	// If set, filter matches the EventMetrics that don't match the rest of the
+	// filter, i.e. the ones that don't have the label, or, if value (or
+	// value_regex) is also specified, the ones that either don't have the
+	// label or have it with a different value. For example, to drop the
+	// EventMetrics without a "team" label:
+	//
+	//	ignore_metrics_with_label {
+	//	  key: "team"
+	//	  negate: true
+	//	}
+	//
+	// Labels in ignore_label_keys don't participate in negated filters either;
+	// a negated filter on such a key never matches.
+	Negate *bool `protobuf:"varint,5,opt,name=negate" json:"negate,omitempty"`
+	// Disabled filters stay in the config, but they are not applied. This is
+	// useful to roll out filters in stages, or to turn them off temporarily.
+	// Disabled filters are still validated.
+	Enabled *bool `protobuf:"varint,6,opt,name=enabled,def=1" json:"enabled,omitempty"`
 }
 
+// Default values for LabelFilter fields.
+const (
+	Default_LabelFilter_Enabled = bool(true)
+)
+
 func (x *LabelFilter) Reset() {
 	*x = LabelFilter{}
 	if protoimpl.UnsafeEnabled {
@@ -150,24 +600,1123 @@ func (x *LabelFilter) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelFilter.ProtoReflect.Descriptor instead.
+func (*LabelFilter) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LabelFilter) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *LabelFilter) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *LabelFilter) GetKeyPrefix() bool {
+	if x != nil && x.KeyPrefix != nil {
+		return *x.KeyPrefix
+	}
+	return false
+}
+
+func (x *LabelFilter) GetValueRegex() string {
+	if x != nil && x.ValueRegex != nil {
+		return *x.ValueRegex
+	}
+	return ""
+}
+
+func (x *LabelFilter) GetNegate() bool {
+	if x != nil && x.Negate != nil {
+		return *x.Negate
+	}
+	return false
+}
+
+func (x *LabelFilter) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return Default_LabelFilter_Enabled
+}
+
+// ValueFilter matches EventMetrics based on the value of a metric, e.g.
+// success == 0. EventMetrics without the metric, or with a non-numeric value
+// for it, don't match.
+type ValueFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MetricName *string         `protobuf:"bytes,1,req,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	Op         *ValueFilter_Op `protobuf:"varint,2,opt,name=op,enum=cloudprober.surfacer.ValueFilter_Op,def=0" json:"op,omitempty"`
+	Value      *float64        `protobuf:"fixed64,3,req,name=value" json:"value,omitempty"`
+}
+
+// Default values for ValueFilter fields.
+const (
+	Default_ValueFilter_Op = ValueFilter_EQ
+)
+
+func (x *ValueFilter) Reset() {
+	*x = ValueFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueFilter) ProtoMessage() {}
+
+func (x *ValueFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueFilter.ProtoReflect.Descriptor instead.
+func (*ValueFilter) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValueFilter) GetMetricName() string {
+	if x != nil && x.MetricName != nil {
+		return *x.MetricName
+	}
+	return ""
+}
+
+func (x *ValueFilter) GetOp() ValueFilter_Op {
+	if x != nil && x.Op != nil {
+		return *x.Op
+	}
+	return Default_ValueFilter_Op
+}
+
+func (x *ValueFilter) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+// SuccessFilter matches EventMetrics based on the probe's success state, i.e.
+// the relationship between the "success" and "total" metrics. For CUMULATIVE
+// EventMetrics, the state is determined from the change since the previous
+// EventMetrics of the same series (same labels), so that an old failure
+// doesn't match forever; the first EventMetrics of a series is evaluated
+// as it is.
+type SuccessFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *SuccessFilter_State `protobuf:"varint,1,opt,name=state,enum=cloudprober.surfacer.SuccessFilter_State,def=0" json:"state,omitempty"`
+	// Whether to match the EventMetrics that don't have numeric "success" and
+	// "total" metrics, e.g. the system variables.
+	MatchMissing *bool `protobuf:"varint,2,opt,name=match_missing,json=matchMissing,def=0" json:"match_missing,omitempty"`
+}
+
+// Default values for SuccessFilter fields.
+const (
+	Default_SuccessFilter_State        = SuccessFilter_FAILURES
+	Default_SuccessFilter_MatchMissing = bool(false)
+)
+
+func (x *SuccessFilter) Reset() {
+	*x = SuccessFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuccessFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuccessFilter) ProtoMessage() {}
+
+func (x *SuccessFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessFilter.ProtoReflect.Descriptor instead.
+func (*SuccessFilter) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SuccessFilter) GetState() SuccessFilter_State {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return Default_SuccessFilter_State
+}
+
+func (x *SuccessFilter) GetMatchMissing() bool {
+	if x != nil && x.MatchMissing != nil {
+		return *x.MatchMissing
+	}
+	return Default_SuccessFilter_MatchMissing
+}
+
+// ValueTransform transforms the value of a metric using an arithmetic
+// expression, e.g. to convert bytes to megabytes or seconds to milliseconds.
+type ValueTransform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric to transform.
+	MetricName *string `protobuf:"bytes,1,req,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	// Arithmetic expression using "x" for the metric value, e.g. "x / 1048576"
+	// or "x * 1000". Supported operators are +, -, *, / and parentheses.
+	// Expression should be linear in x, i.e., x can't be multiplied by itself
+	// or appear in a denominator.
+	//
+	// For CUMULATIVE metrics (default for most probes, unless export_as_gauge
+	// is set), only scaling expressions (ones that map 0 to 0, e.g. "x * 1000")
+	// are applied, as adding an offset to counters breaks rate computations in
+	// the monitoring systems.
+	//
+	// Transformed values are always exported as float. Distribution values are
+	// not supported.
+	Expression *string `protobuf:"bytes,2,req,name=expression" json:"expression,omitempty"`
+}
+
+func (x *ValueTransform) Reset() {
+	*x = ValueTransform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValueTransform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueTransform) ProtoMessage() {}
+
+func (x *ValueTransform) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueTransform.ProtoReflect.Descriptor instead.
+func (*ValueTransform) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ValueTransform) GetMetricName() string {
+	if x != nil && x.MetricName != nil {
+		return *x.MetricName
+	}
+	return ""
+}
+
+func (x *ValueTransform) GetExpression() string {
+	if x != nil && x.Expression != nil {
+		return *x.Expression
+	}
+	return ""
+}
+
+type StaleAfter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the metric.
+	MetricName *string `protobuf:"bytes,1,req,name=metric_name,json=metricName" json:"metric_name,omitempty"`
+	// Series of the metric that are not refreshed within this window are
+	// considered stale. Pull surfacers (e.g. prometheus) stop exposing stale
+	// series, while push surfacers get a final zero value for them.
+	StaleAfterSec *int32 `protobuf:"varint,2,req,name=stale_after_sec,json=staleAfterSec" json:"stale_after_sec,omitempty"`
+}
+
+func (x *StaleAfter) Reset() {
+	*x = StaleAfter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaleAfter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleAfter) ProtoMessage() {}
+
+func (x *StaleAfter) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleAfter.ProtoReflect.Descriptor instead.
+func (*StaleAfter) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StaleAfter) GetMetricName() string {
+	if x != nil && x.MetricName != nil {
+		return *x.MetricName
+	}
+	return ""
+}
+
+func (x *StaleAfter) GetStaleAfterSec() int32 {
+	if x != nil && x.StaleAfterSec != nil {
+		return *x.StaleAfterSec
+	}
+	return 0
+}
+
+// RateLimit caps the rate at which EventMetrics are written to a surfacer,
+// using a token bucket.
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Max EventMetrics per second.
+	EventsPerSec *int32 `protobuf:"varint,1,req,name=events_per_sec,json=eventsPerSec" json:"events_per_sec,omitempty"`
+	// Token bucket size, i.e. the max number of EventMetrics that can be
+	// written in a burst. Default is events_per_sec.
+	Burst  *int32            `protobuf:"varint,2,opt,name=burst" json:"burst,omitempty"`
+	Policy *RateLimit_Policy `protobuf:"varint,3,opt,name=policy,enum=cloudprober.surfacer.RateLimit_Policy,def=0" json:"policy,omitempty"`
+	// Queue size for the BUFFER policy.
+	BufferSize *int32 `protobuf:"varint,4,opt,name=buffer_size,json=bufferSize,def=10000" json:"buffer_size,omitempty"`
+}
+
+// Default values for RateLimit fields.
+const (
+	Default_RateLimit_Policy     = RateLimit_SHED
+	Default_RateLimit_BufferSize = int32(10000)
+)
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateLimit) GetEventsPerSec() int32 {
+	if x != nil && x.EventsPerSec != nil {
+		return *x.EventsPerSec
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int32 {
+	if x != nil && x.Burst != nil {
+		return *x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimit) GetPolicy() RateLimit_Policy {
+	if x != nil && x.Policy != nil {
+		return *x.Policy
+	}
+	return Default_RateLimit_Policy
+}
+
+func (x *RateLimit) GetBufferSize() int32 {
+	if x != nil && x.BufferSize != nil {
+		return *x.BufferSize
+	}
+	return Default_RateLimit_BufferSize
+}
+
+// DropZeroValues configures dropping of the zero-valued metrics, per metric
+// kind. Only numeric values (counters and gauges) are considered.
+type DropZeroValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Drop zero values of CUMULATIVE metrics.
+	Cumulative *bool `protobuf:"varint,1,opt,name=cumulative,def=1" json:"cumulative,omitempty"`
+	// Drop zero values of GAUGE metrics.
+	Gauge *bool `protobuf:"varint,2,opt,name=gauge,def=1" json:"gauge,omitempty"`
+	// Emit the first zero after a non-zero value, so that transitions to zero
+	// (e.g. a counter reset or a gauge going idle) are still visible in the
+	// backend. Subsequent zeros are dropped.
+	KeepTransitionsToZero *bool `protobuf:"varint,3,opt,name=keep_transitions_to_zero,json=keepTransitionsToZero,def=1" json:"keep_transitions_to_zero,omitempty"`
+}
+
+// Default values for DropZeroValues fields.
+const (
+	Default_DropZeroValues_Cumulative            = bool(true)
+	Default_DropZeroValues_Gauge                 = bool(true)
+	Default_DropZeroValues_KeepTransitionsToZero = bool(true)
+)
+
+func (x *DropZeroValues) Reset() {
+	*x = DropZeroValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropZeroValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropZeroValues) ProtoMessage() {}
+
+func (x *DropZeroValues) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropZeroValues.ProtoReflect.Descriptor instead.
+func (*DropZeroValues) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DropZeroValues) GetCumulative() bool {
+	if x != nil && x.Cumulative != nil {
+		return *x.Cumulative
+	}
+	return Default_DropZeroValues_Cumulative
+}
+
+func (x *DropZeroValues) GetGauge() bool {
+	if x != nil && x.Gauge != nil {
+		return *x.Gauge
+	}
+	return Default_DropZeroValues_Gauge
+}
+
+func (x *DropZeroValues) GetKeepTransitionsToZero() bool {
+	if x != nil && x.KeepTransitionsToZero != nil {
+		return *x.KeepTransitionsToZero
+	}
+	return Default_DropZeroValues_KeepTransitionsToZero
+}
+
+// CounterContinuity configures carrying forward of the counter values across
+// probe restarts. See SurfacerDef.counter_continuity for details.
+type CounterContinuity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A decrease in a counter is treated as a probe restart only if the series
+	// was last surfaced within this duration. If the series has been missing
+	// for longer, the decrease is treated as a genuine reset: carried forward
+	// value is discarded and the new value is surfaced as it is. Series not
+	// seen for this long are also forgotten.
+	MaxGapSec *int32 `protobuf:"varint,1,opt,name=max_gap_sec,json=maxGapSec,def=600" json:"max_gap_sec,omitempty"`
+}
+
+// Default values for CounterContinuity fields.
+const (
+	Default_CounterContinuity_MaxGapSec = int32(600)
+)
+
+func (x *CounterContinuity) Reset() {
+	*x = CounterContinuity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CounterContinuity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterContinuity) ProtoMessage() {}
+
+func (x *CounterContinuity) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterContinuity.ProtoReflect.Descriptor instead.
+func (*CounterContinuity) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CounterContinuity) GetMaxGapSec() int32 {
+	if x != nil && x.MaxGapSec != nil {
+		return *x.MaxGapSec
+	}
+	return Default_CounterContinuity_MaxGapSec
+}
+
+// MetricNameNormalization configures the normalization of the metric names,
+// e.g. to use the same separator for the metrics coming from different
+// probes. See SurfacerDef.metric_name_normalization for details.
+type MetricNameNormalization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rules are applied to the metric name in the given order. If no rule is
+	// specified, "." is replaced with "_". Rules should be idempotent, i.e.
+	// applying them to a normalized name should not change it further.
+	Rule []*MetricNameNormalization_Rule `protobuf:"bytes,1,rep,name=rule" json:"rule,omitempty"`
+	// By default, normalized names are used only for the filtering decisions,
+	// and metrics are exported with their original names. If set, metrics are
+	// exported with the normalized names.
+	ExportNormalizedNames *bool `protobuf:"varint,2,opt,name=export_normalized_names,json=exportNormalizedNames,def=0" json:"export_normalized_names,omitempty"`
+}
+
+// Default values for MetricNameNormalization fields.
+const (
+	Default_MetricNameNormalization_ExportNormalizedNames = bool(false)
+)
+
+func (x *MetricNameNormalization) Reset() {
+	*x = MetricNameNormalization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricNameNormalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricNameNormalization) ProtoMessage() {}
+
+func (x *MetricNameNormalization) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricNameNormalization.ProtoReflect.Descriptor instead.
+func (*MetricNameNormalization) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MetricNameNormalization) GetRule() []*MetricNameNormalization_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *MetricNameNormalization) GetExportNormalizedNames() bool {
+	if x != nil && x.ExportNormalizedNames != nil {
+		return *x.ExportNormalizedNames
+	}
+	return Default_MetricNameNormalization_ExportNormalizedNames
+}
+
+// MetricsBufferSizeRule overrides metrics_buffer_size for the metrics whose
+// name matches the regex. See SurfacerDef.metrics_buffer_size_rule for
+// details.
+type MetricsBufferSizeRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MetricNameRegex *string `protobuf:"bytes,1,req,name=metric_name_regex,json=metricNameRegex" json:"metric_name_regex,omitempty"`
+	Size            *int64  `protobuf:"varint,2,req,name=size" json:"size,omitempty"`
+}
+
+func (x *MetricsBufferSizeRule) Reset() {
+	*x = MetricsBufferSizeRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsBufferSizeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsBufferSizeRule) ProtoMessage() {}
+
+func (x *MetricsBufferSizeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsBufferSizeRule.ProtoReflect.Descriptor instead.
+func (*MetricsBufferSizeRule) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MetricsBufferSizeRule) GetMetricNameRegex() string {
+	if x != nil && x.MetricNameRegex != nil {
+		return *x.MetricNameRegex
+	}
+	return ""
+}
+
+func (x *MetricsBufferSizeRule) GetSize() int64 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+// DistributionPercentiles configures exporting the percentiles of the
+// distribution metrics as gauges. See SurfacerDef.distribution_percentiles
+// for details.
+type DistributionPercentiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentiles to export, each in (0, 100], e.g. 50, 90, 99.
+	Percentile []float64 `protobuf:"fixed64,1,rep,name=percentile" json:"percentile,omitempty"`
+	// By default, distributions are replaced by their percentiles. If set,
+	// distributions are exported as well.
+	KeepDistributions *bool `protobuf:"varint,2,opt,name=keep_distributions,json=keepDistributions,def=0" json:"keep_distributions,omitempty"`
+}
+
+// Default values for DistributionPercentiles fields.
+const (
+	Default_DistributionPercentiles_KeepDistributions = bool(false)
+)
+
+func (x *DistributionPercentiles) Reset() {
+	*x = DistributionPercentiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DistributionPercentiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistributionPercentiles) ProtoMessage() {}
+
+func (x *DistributionPercentiles) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistributionPercentiles.ProtoReflect.Descriptor instead.
+func (*DistributionPercentiles) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DistributionPercentiles) GetPercentile() []float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return nil
+}
+
+func (x *DistributionPercentiles) GetKeepDistributions() bool {
+	if x != nil && x.KeepDistributions != nil {
+		return *x.KeepDistributions
+	}
+	return Default_DistributionPercentiles_KeepDistributions
+}
+
+// LabelValueNormalization configures the normalization of the label values,
+// e.g. to avoid inconsistently formatted values (" US-East " vs "us-east")
+// fragmenting the time series. See SurfacerDef.label_value_normalization
+// for details.
+type LabelValueNormalization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keys of the labels to normalize. If not specified, all labels are
+	// normalized.
+	LabelKey []string `protobuf:"bytes,1,rep,name=label_key,json=labelKey" json:"label_key,omitempty"`
+	// Trim the leading and trailing whitespace.
+	TrimSpace *bool `protobuf:"varint,2,opt,name=trim_space,json=trimSpace,def=1" json:"trim_space,omitempty"`
+	// Convert to lowercase.
+	Lowercase *bool `protobuf:"varint,3,opt,name=lowercase,def=0" json:"lowercase,omitempty"`
+	// Substitution rules, applied in the given order, after trimming and
+	// lowercasing.
+	Rule []*LabelValueNormalization_Rule `protobuf:"bytes,4,rep,name=rule" json:"rule,omitempty"`
+}
+
+// Default values for LabelValueNormalization fields.
+const (
+	Default_LabelValueNormalization_TrimSpace = bool(true)
+	Default_LabelValueNormalization_Lowercase = bool(false)
+)
+
+func (x *LabelValueNormalization) Reset() {
+	*x = LabelValueNormalization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelValueNormalization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelValueNormalization) ProtoMessage() {}
+
+func (x *LabelValueNormalization) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelValueNormalization.ProtoReflect.Descriptor instead.
+func (*LabelValueNormalization) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LabelValueNormalization) GetLabelKey() []string {
+	if x != nil {
+		return x.LabelKey
+	}
+	return nil
+}
+
+func (x *LabelValueNormalization) GetTrimSpace() bool {
+	if x != nil && x.TrimSpace != nil {
+		return *x.TrimSpace
+	}
+	return Default_LabelValueNormalization_TrimSpace
+}
+
+func (x *LabelValueNormalization) GetLowercase() bool {
+	if x != nil && x.Lowercase != nil {
+		return *x.Lowercase
+	}
+	return Default_LabelValueNormalization_Lowercase
+}
+
+func (x *LabelValueNormalization) GetRule() []*LabelValueNormalization_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// RelabelRule renames a metric or a label key before it's surfaced. See
+// SurfacerDef.relabel_rule for details.
+type RelabelRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What to rename: metric names or label keys.
+	Target *RelabelRule_Target `protobuf:"varint,1,opt,name=target,enum=cloudprober.surfacer.RelabelRule_Target,def=0" json:"target,omitempty"`
+	// Regex to match the metric name or the label key against. It has to
+	// match the whole name, e.g. "latency" doesn't match "dns_latency".
+	SourceRegex *string `protobuf:"bytes,2,req,name=source_regex,json=sourceRegex" json:"source_regex,omitempty"`
+	// New name. It can refer to the regex's capturing groups using $1, $2 or
+	// ${name} for the named groups.
+	Replacement *string `protobuf:"bytes,3,req,name=replacement" json:"replacement,omitempty"`
+}
+
+// Default values for RelabelRule fields.
+const (
+	Default_RelabelRule_Target = RelabelRule_METRIC_NAME
+)
+
+func (x *RelabelRule) Reset() {
+	*x = RelabelRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelabelRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelabelRule) ProtoMessage() {}
+
+func (x *RelabelRule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelabelRule.ProtoReflect.Descriptor instead.
+func (*RelabelRule) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RelabelRule) GetTarget() RelabelRule_Target {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return Default_RelabelRule_Target
+}
+
+func (x *RelabelRule) GetSourceRegex() string {
+	if x != nil && x.SourceRegex != nil {
+		return *x.SourceRegex
+	}
+	return ""
+}
+
+func (x *RelabelRule) GetReplacement() string {
+	if x != nil && x.Replacement != nil {
+		return *x.Replacement
+	}
+	return ""
+}
+
+// WriteWorkers configures a pool of workers that write EventMetrics to the
+// surfacer in parallel. See SurfacerDef.write_workers for details.
+type WriteWorkers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of workers.
+	Workers *int32 `protobuf:"varint,1,req,name=workers" json:"workers,omitempty"`
+	// Queue size per worker. Once a worker's queue is full, writes for that
+	// worker block until it catches up.
+	QueueSize *int32 `protobuf:"varint,2,opt,name=queue_size,json=queueSize,def=1000" json:"queue_size,omitempty"`
+	// If enabled, EventMetrics of a series, i.e. with the same labels, are
+	// always written by the same worker, and hence in order. If disabled,
+	// EventMetrics are written by whichever worker is free, which spreads the
+	// load better but can reorder the writes of a series.
+	PreserveSeriesOrder *bool `protobuf:"varint,3,opt,name=preserve_series_order,json=preserveSeriesOrder,def=1" json:"preserve_series_order,omitempty"`
+}
+
+// Default values for WriteWorkers fields.
+const (
+	Default_WriteWorkers_QueueSize           = int32(1000)
+	Default_WriteWorkers_PreserveSeriesOrder = bool(true)
+)
+
+func (x *WriteWorkers) Reset() {
+	*x = WriteWorkers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteWorkers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteWorkers) ProtoMessage() {}
+
+func (x *WriteWorkers) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteWorkers.ProtoReflect.Descriptor instead.
+func (*WriteWorkers) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WriteWorkers) GetWorkers() int32 {
+	if x != nil && x.Workers != nil {
+		return *x.Workers
+	}
+	return 0
+}
+
+func (x *WriteWorkers) GetQueueSize() int32 {
+	if x != nil && x.QueueSize != nil {
+		return *x.QueueSize
+	}
+	return Default_WriteWorkers_QueueSize
+}
+
+func (x *WriteWorkers) GetPreserveSeriesOrder() bool {
+	if x != nil && x.PreserveSeriesOrder != nil {
+		return *x.PreserveSeriesOrder
+	}
+	return Default_WriteWorkers_PreserveSeriesOrder
+}
+
+// InvalidLatency configures the handling of the latency metrics (as
+// determined by latency_metric_pattern) that are missing a value, or whose
+// value is NaN or infinite. Such values can't be represented by most
+// monitoring systems. Only numeric values are checked; distributions are
+// left as they are.
+type InvalidLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *InvalidLatency_Policy `protobuf:"varint,1,opt,name=policy,enum=cloudprober.surfacer.InvalidLatency_Policy,def=0" json:"policy,omitempty"`
+	// Value used for the DEFAULT policy.
+	DefaultValue *float64 `protobuf:"fixed64,2,opt,name=default_value,json=defaultValue,def=0" json:"default_value,omitempty"`
+}
+
+// Default values for InvalidLatency fields.
+const (
+	Default_InvalidLatency_Policy       = InvalidLatency_DROP
+	Default_InvalidLatency_DefaultValue = float64(0)
+)
+
+func (x *InvalidLatency) Reset() {
+	*x = InvalidLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidLatency) ProtoMessage() {}
+
+func (x *InvalidLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidLatency.ProtoReflect.Descriptor instead.
+func (*InvalidLatency) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InvalidLatency) GetPolicy() InvalidLatency_Policy {
+	if x != nil && x.Policy != nil {
+		return *x.Policy
+	}
+	return Default_InvalidLatency_Policy
+}
+
+func (x *InvalidLatency) GetDefaultValue() float64 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return Default_InvalidLatency_DefaultValue
+}
+
+// FilterBundle is a named set of metrics filters, defined once at the top
+// level of the config (surfacer_filter_bundle), and referenced by the
+// surfacers through filter_bundle. Fields have the same meaning as the
+// corresponding SurfacerDef fields.
+type FilterBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                   *string        `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AllowMetricsWithLabel  []*LabelFilter `protobuf:"bytes,2,rep,name=allow_metrics_with_label,json=allowMetricsWithLabel" json:"allow_metrics_with_label,omitempty"`
+	IgnoreMetricsWithLabel []*LabelFilter `protobuf:"bytes,3,rep,name=ignore_metrics_with_label,json=ignoreMetricsWithLabel" json:"ignore_metrics_with_label,omitempty"`
+	AllowMetricsWithName   *string        `protobuf:"bytes,4,opt,name=allow_metrics_with_name,json=allowMetricsWithName" json:"allow_metrics_with_name,omitempty"`
+	IgnoreMetricsWithName  *string        `protobuf:"bytes,5,opt,name=ignore_metrics_with_name,json=ignoreMetricsWithName" json:"ignore_metrics_with_name,omitempty"`
+}
+
+func (x *FilterBundle) Reset() {
+	*x = FilterBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterBundle) ProtoMessage() {}
+
+func (x *FilterBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterBundle.ProtoReflect.Descriptor instead.
+func (*FilterBundle) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FilterBundle) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
 }
 
-// Deprecated: Use LabelFilter.ProtoReflect.Descriptor instead.
-func (*LabelFilter) Descriptor() ([]byte, []int) {
-	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{0}
+func (x *FilterBundle) GetAllowMetricsWithLabel() []*LabelFilter {
+	if x != nil {
+		return x.AllowMetricsWithLabel
+	}
+	return nil
 }
 
-func (x *LabelFilter) GetKey() string {
-	if x != nil && x.Key != nil {
-		return *x.Key
+func (x *FilterBundle) GetIgnoreMetricsWithLabel() []*LabelFilter {
+	if x != nil {
+		return x.IgnoreMetricsWithLabel
+	}
+	return nil
+}
+
+func (x *FilterBundle) GetAllowMetricsWithName() string {
+	if x != nil && x.AllowMetricsWithName != nil {
+		return *x.AllowMetricsWithName
 	}
 	return ""
 }
 
-func (x *LabelFilter) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *FilterBundle) GetIgnoreMetricsWithName() string {
+	if x != nil && x.IgnoreMetricsWithName != nil {
+		return *x.IgnoreMetricsWithName
 	}
 	return ""
 }
@@ -190,7 +1739,29 @@ type SurfacerDef struct {
 	// slow for some reason, e.g. slow writes to a remote file.
 	// Note: Only file and pubsub surfacer supports this option right now.
 	MetricsBufferSize *int64 `protobuf:"varint,3,opt,name=metrics_buffer_size,json=metricsBufferSize,def=10000" json:"metrics_buffer_size,omitempty"`
-	// If specified, only allow metrics that match any of these label filters.
+	// Per metric-name overrides of metrics_buffer_size, e.g. to give
+	// high-cardinality latency metrics a bigger share of the buffer than sparse
+	// uptime metrics. Rules are evaluated in order and the first rule whose
+	// regex matches the metric name wins. Metrics that don't match any rule use
+	// metrics_buffer_size. Surfacer's EventMetrics buffer is sized to the
+	// largest of the sizes, and new EventMetrics are dropped once the buffer
+	// holds a metric name's size worth of EventMetrics with that metric name.
+	// EventMetrics count towards each of their metric names.
+	// Note: Like metrics_buffer_size, only file and pubsub surfacer supports
+	// this option right now.
+	// Example:
+	//
+	//	metrics_buffer_size_rule {
+	//	  metric_name_regex: "^http_.*latency$"
+	//	  size: 100000
+	//	}
+	//	metrics_buffer_size_rule {
+	//	  metric_name_regex: "^uptime"
+	//	  size: 100
+	//	}
+	MetricsBufferSizeRule []*MetricsBufferSizeRule `protobuf:"bytes,86,rep,name=metrics_buffer_size_rule,json=metricsBufferSizeRule" json:"metrics_buffer_size_rule,omitempty"`
+	// If specified, only allow metrics that match any of these label filters
+	// (or all of them, see allow_metrics_label_match_mode).
 	// Example:
 	//
 	//	allow_metrics_with_label {
@@ -198,8 +1769,17 @@ type SurfacerDef struct {
 	//	  value: "check_homepage",
 	//	}
 	AllowMetricsWithLabel []*LabelFilter `protobuf:"bytes,4,rep,name=allow_metrics_with_label,json=allowMetricsWithLabel" json:"allow_metrics_with_label,omitempty"`
+	// How allow_metrics_with_label filters are combined. By default (ANY),
+	// metrics matching any of the filters are allowed. With ALL, metrics
+	// should match every filter, e.g. to allow only the metrics that have both
+	// env=prod and tier=frontend labels:
+	//
+	//	allow_metrics_label_match_mode: ALL
+	//	allow_metrics_with_label { key: "env" value: "prod" }
+	//	allow_metrics_with_label { key: "tier" value: "frontend" }
+	AllowMetricsLabelMatchMode *LabelMatchMode `protobuf:"varint,77,opt,name=allow_metrics_label_match_mode,json=allowMetricsLabelMatchMode,enum=cloudprober.surfacer.LabelMatchMode,def=0" json:"allow_metrics_label_match_mode,omitempty"`
 	// Ignore metrics that match any of these label filters. Ignore has precedence
-	// over allow filters.
+	// over allow filters, unless filter_precedence is ALLOW_FIRST.
 	// Example:
 	//
 	//	ignore_metrics_with_label {
@@ -207,6 +1787,75 @@ type SurfacerDef struct {
 	//	  value: "sysvars",
 	//	}
 	IgnoreMetricsWithLabel []*LabelFilter `protobuf:"bytes,5,rep,name=ignore_metrics_with_label,json=ignoreMetricsWithLabel" json:"ignore_metrics_with_label,omitempty"`
+	// Precedence between the allow and the ignore label filters. By default
+	// (IGNORE_FIRST), EventMetrics matching an ignore filter are dropped, and
+	// if allow filters are given, only the EventMetrics matching them are
+	// allowed. With ALLOW_FIRST, allow filters carve out exceptions from the
+	// ignore filters instead: EventMetrics matching the allow filters are
+	// allowed even if they match an ignore filter, and the other EventMetrics
+	// are allowed unless they match an ignore filter. For example, to drop
+	// the debug metrics, except for the critical ones:
+	//
+	//	filter_precedence: ALLOW_FIRST
+	//	ignore_metrics_with_label { key: "debug" value: "true" }
+	//	allow_metrics_with_label { key: "critical" value: "true" }
+	//
+	// Metric name filters are not affected.
+	FilterPrecedence *FilterPrecedence `protobuf:"varint,81,opt,name=filter_precedence,json=filterPrecedence,enum=cloudprober.surfacer.FilterPrecedence,def=0" json:"filter_precedence,omitempty"`
+	// If set to true, EventMetrics carrying the "surfacers" label are sent to
+	// this surfacer only if its name is listed in the label's value, a comma
+	// separated list of surfacer names. EventMetrics without the label are not
+	// affected. This lets probes pick their surfacers through
+	// additional_label, e.g.:
+	//
+	//	additional_label {
+	//	  key: "surfacers"
+	//	  value: "prometheus,file"
+	//	}
+	//
+	// If surfacer name is not set, the lowercase surfacer type is used. To
+	// keep the routing label out of the exported metrics, add it to
+	// ignore_label_keys and set strip_ignored_label_keys.
+	RouteBySurfacersLabel *bool `protobuf:"varint,69,opt,name=route_by_surfacers_label,json=routeBySurfacersLabel" json:"route_by_surfacers_label,omitempty"`
+	// If specified, only allow EventMetrics that match any of these value
+	// filters. This can be used to route EventMetrics based on their values,
+	// e.g. failures to an alerting surfacer and successes to a storage
+	// surfacer. Filters are evaluated on the EventMetrics as produced by the
+	// probes, where most of the metrics are cumulative, and before the failure
+	// metric is added. Label filters, if any, are applied as well.
+	// Example:
+	//
+	//	allow_metrics_with_value {
+	//	  metric_name: "success"
+	//	  op: EQ
+	//	  value: 0
+	//	}
+	AllowMetricsWithValue []*ValueFilter `protobuf:"bytes,72,rep,name=allow_metrics_with_value,json=allowMetricsWithValue" json:"allow_metrics_with_value,omitempty"`
+	// If specified, only allow EventMetrics in the given success state, e.g.
+	// to store only the metrics of the failing probes in one surfacer, and all
+	// the metrics in another. Like value filters, it's evaluated on the
+	// EventMetrics as produced by the probes, and label filters, if any, are
+	// applied as well.
+	// Example:
+	//
+	//	allow_metrics_with_success_state {
+	//	  state: FAILURES
+	//	}
+	AllowMetricsWithSuccessState *SuccessFilter `protobuf:"bytes,87,opt,name=allow_metrics_with_success_state,json=allowMetricsWithSuccessState" json:"allow_metrics_with_success_state,omitempty"`
+	// Fraction of the EventMetrics to surface, in (0, 1], to reduce the load on
+	// the surfacer, e.g. under high cardinality. Sampling decision is based on
+	// the hash of the sample_by_label label values, so that a series is either
+	// always kept or always dropped. Sampling is applied after the filters.
+	// Default, 1.0, surfaces all the EventMetrics.
+	SampleRate *float64 `protobuf:"fixed64,88,opt,name=sample_rate,json=sampleRate,def=1" json:"sample_rate,omitempty"`
+	// Labels whose values the sampling decision is based on, e.g. "dst" to keep
+	// or drop all the series of a target together. EventMetrics missing a label
+	// are sampled as if its value was empty. By default, all labels are used.
+	// Example:
+	//
+	//	sample_rate: 0.1
+	//	sample_by_label: "dst"
+	SampleByLabel []string `protobuf:"bytes,89,rep,name=sample_by_label,json=sampleByLabel" json:"sample_by_label,omitempty"`
 	// Allow and ignore metrics based on their names. You can specify regexes
 	// here. Ignore has precendence over allow.
 	// Examples:
@@ -220,9 +1869,45 @@ type SurfacerDef struct {
 	// this option.
 	AllowMetricsWithName  *string `protobuf:"bytes,6,opt,name=allow_metrics_with_name,json=allowMetricsWithName" json:"allow_metrics_with_name,omitempty"`
 	IgnoreMetricsWithName *string `protobuf:"bytes,7,opt,name=ignore_metrics_with_name,json=ignoreMetricsWithName" json:"ignore_metrics_with_name,omitempty"`
+	// Set these to false to keep the above metric name filters in the config
+	// without applying them, e.g. for a staged rollout. Name filters coming
+	// from the filter bundles are not affected.
+	AllowMetricsWithNameEnabled  *bool `protobuf:"varint,82,opt,name=allow_metrics_with_name_enabled,json=allowMetricsWithNameEnabled,def=1" json:"allow_metrics_with_name_enabled,omitempty"`
+	IgnoreMetricsWithNameEnabled *bool `protobuf:"varint,83,opt,name=ignore_metrics_with_name_enabled,json=ignoreMetricsWithNameEnabled,def=1" json:"ignore_metrics_with_name_enabled,omitempty"`
+	// Label keys that should not participate in any filtering decision, e.g.
+	// volatile labels like timestamps or request IDs. Label filters
+	// (allow_metrics_with_label and ignore_metrics_with_label) never match on
+	// these keys.
+	// Example:
+	//
+	//	ignore_label_keys: "request_id"
+	IgnoreLabelKeys []string `protobuf:"bytes,57,rep,name=ignore_label_keys,json=ignoreLabelKeys" json:"ignore_label_keys,omitempty"`
+	// If set to true, labels listed in ignore_label_keys are also removed from
+	// the metrics exported by this surfacer.
+	StripIgnoredLabelKeys *bool `protobuf:"varint,58,opt,name=strip_ignored_label_keys,json=stripIgnoredLabelKeys" json:"strip_ignored_label_keys,omitempty"`
+	// Label keys whose values are replaced with a stable hash before surfacing,
+	// e.g. labels that contain PII like email addresses. Hashing keeps the
+	// cardinality of the labels, while keeping the raw values out of this
+	// surfacer. Other surfacers are not affected. Filtering
+	// (allow_metrics_with_label etc) is done on the raw values.
+	// Hash is the first 16 hex digits of the SHA-256 of the salt and the value.
+	// Example:
+	//
+	//	hash_label_values: "user"
+	//	hash_label_values_salt: "s3cr3t"
+	HashLabelValues []string `protobuf:"bytes,67,rep,name=hash_label_values,json=hashLabelValues" json:"hash_label_values,omitempty"`
+	// Salt for hash_label_values. Without a salt, hashes of predictable values
+	// (e.g. known user IDs) can be reversed by hashing the candidates.
+	HashLabelValuesSalt *string `protobuf:"bytes,68,opt,name=hash_label_values_salt,json=hashLabelValuesSalt" json:"hash_label_values_salt,omitempty"`
 	// Whether to add failure metric or not. This option is enabled by default
 	// for all surfacers except FILE and PUBSUB.
 	AddFailureMetric *bool `protobuf:"varint,8,opt,name=add_failure_metric,json=addFailureMetric" json:"add_failure_metric,omitempty"`
+	// If set, failure metric is added only to the EventMetrics that contain a
+	// metric with name matching this regex, e.g. "^resp_code$" to add failure
+	// metric only for HTTP probes. Failure metric is also subject to the
+	// allow_metrics_with_name and ignore_metrics_with_name filters. This option
+	// has no effect if failure metric is disabled.
+	AddFailureMetricForMetricsWithName *string `protobuf:"bytes,55,opt,name=add_failure_metric_for_metrics_with_name,json=addFailureMetricForMetricsWithName" json:"add_failure_metric_for_metrics_with_name,omitempty"`
 	// If set to true, cloudprober will export all metrics as gauge metrics. Note
 	// that cloudprober inherently generates only cumulative metrics. To create
 	// gauge metrics from cumulative metrics, we keep a copy of the old metrics
@@ -234,6 +1919,11 @@ type SurfacerDef struct {
 	// Latency metric name pattern, used to identify latency metrics, and add
 	// EventMetric's LatencyUnit to it.
 	LatencyMetricPattern *string `protobuf:"bytes,51,opt,name=latency_metric_pattern,json=latencyMetricPattern,def=^(.+_|)latency$" json:"latency_metric_pattern,omitempty"`
+	// If set to true, no metric is treated as a latency metric, regardless of
+	// its name, and latency_metric_pattern is ignored. It affects all the
+	// options that act on the latency metrics, e.g. invalid_latency, and the
+	// surfacers that handle the latency metrics specially.
+	DisableLatencyMetrics *bool `protobuf:"varint,90,opt,name=disable_latency_metrics,json=disableLatencyMetrics" json:"disable_latency_metrics,omitempty"`
 	// Environment variable containing additional labels to be added to all
 	// metrics exported by this surfacer.
 	// e.g. "CLOUDPROBER_ADDITIONAL_LABELS=env=prod,app=identity-service"
@@ -241,6 +1931,264 @@ type SurfacerDef struct {
 	// Note: These additional labels have no effect if metrics already have the
 	// same label.
 	AdditionalLabelsEnvVar *string `protobuf:"bytes,52,opt,name=additional_labels_env_var,json=additionalLabelsEnvVar,def=CLOUDPROBER_ADDITIONAL_LABELS" json:"additional_labels_env_var,omitempty"`
+	// Resource attributes identifying this cloudprober instance, e.g.
+	// service.name, service.version and environment. If set, attributes are
+	// added to the surfacer's resource concept:
+	//   - OTEL: resource attributes (surfacer's own resource_attribute takes
+	//     precedence).
+	//   - STACKDRIVER: metric labels, with "." in keys replaced by "_".
+	//     Metrics' own labels take precedence.
+	//   - PROMETHEUS: labels of a target_info metric, with "." in keys
+	//     replaced by "_".
+	//
+	// Attributes that are not set here are taken from the
+	// OTEL_RESOURCE_ATTRIBUTES environment variable, if present. service.name
+	// defaults to OTEL_SERVICE_NAME, or "cloudprober", service.version to the
+	// cloudprober version, and host.name to the hostname.
+	// Example:
+	//
+	//	resource_attributes {
+	//	  key: "environment"
+	//	  value: "prod"
+	//	}
+	ResourceAttributes map[string]string `protobuf:"bytes,91,rep,name=resource_attributes,json=resourceAttributes" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Maximum number of distinct series (unique label sets) allowed per metric
+	// name. Once a metric goes over this budget, data for new series is folded
+	// into an overflow series with the label overflow="true", one for each
+	// probe: overflow series keep only the "ptype" and "probe" labels.
+	// Overflow series' value is the sum of the folded series' values; for
+	// counters, it's the sum of their increments, so that it never goes
+	// backwards. To keep the memory bounded, only as many series as the budget
+	// are folded into the overflow series; writes for the series beyond that
+	// are only counted. Number of folded writes is exported as the CUMULATIVE
+	// surfacer_series_overflow metric, with the same labels as the overflow
+	// series and the metric name ("metric" label). Overflow state is reset
+	// every series_budget_reset_interval_sec. This is a guard to
+	// protect backends from cardinality explosions, e.g. due to a misconfigured
+	// target discovery. Default is 0, i.e. no limit.
+	MaxSeriesPerMetric *int32 `protobuf:"varint,53,opt,name=max_series_per_metric,json=maxSeriesPerMetric" json:"max_series_per_metric,omitempty"`
+	// How often to reset the series budget tracking, in seconds. It should be
+	// positive if max_series_per_metric is set.
+	SeriesBudgetResetIntervalSec *int32 `protobuf:"varint,54,opt,name=series_budget_reset_interval_sec,json=seriesBudgetResetIntervalSec,def=3600" json:"series_budget_reset_interval_sec,omitempty"`
+	// Metric value transformations. These are applied in the common write
+	// path, after export_as_gauge conversion and before the metrics are handed
+	// over to the surfacer. Only one transform per metric_name is allowed.
+	ValueTransform []*ValueTransform `protobuf:"bytes,56,rep,name=value_transform,json=valueTransform" json:"value_transform,omitempty"`
+	// Per-metric staleness windows. Gauge series that are not refreshed within
+	// the window are expired. This applies only to GAUGE metrics, i.e. metrics
+	// that are either generated as gauges or exported using export_as_gauge.
+	// Example:
+	//
+	//	stale_after {
+	//	  metric_name: "queue_depth"
+	//	  stale_after_sec: 300
+	//	}
+	StaleAfter []*StaleAfter `protobuf:"bytes,59,rep,name=stale_after,json=staleAfter" json:"stale_after,omitempty"`
+	// Merge every distribution_bucket_merge_factor adjacent buckets of
+	// distributions into one bucket, before surfacing. This is useful for
+	// backends that charge per bucket or can't handle high resolution
+	// histograms. The underflow bucket, i.e. the one below the first lower
+	// bound, is not merged. This affects only this surfacer; other surfacers
+	// still get the original resolution. Default is 1, i.e. no merging.
+	DistributionBucketMergeFactor *int32 `protobuf:"varint,60,opt,name=distribution_bucket_merge_factor,json=distributionBucketMergeFactor,def=1" json:"distribution_bucket_merge_factor,omitempty"`
+	// If configured, percentiles of the distribution metrics are exported as
+	// gauges, for the backends without histogram support. For a distribution
+	// metric <name>, percentiles are exported in a separate GAUGE EventMetrics,
+	// with the same labels, as a map metric <name>_percentile, keyed by the
+	// "percentile" label ("p50", "p99.9", etc). Percentiles are computed from
+	// the bucket counts, after the bucket merging and the export_as_gauge
+	// conversion, so they are approximate, and they cover all the samples
+	// since the start, unless export_as_gauge is set.
+	// Example:
+	//
+	//	distribution_percentiles {
+	//	  percentile: [50, 90, 99]
+	//	}
+	DistributionPercentiles *DistributionPercentiles `protobuf:"bytes,85,opt,name=distribution_percentiles,json=distributionPercentiles" json:"distribution_percentiles,omitempty"`
+	// If enabled, all EventMetrics for a target are coalesced into a single
+	// record before they are handed over to the surfacer. EventMetrics are
+	// collected for group_flush_interval_msec, and EventMetrics with the same
+	// labels and kind are combined, newer values replacing the older ones.
+	// EventMetrics with additional labels (e.g. per-code or per-edge metrics)
+	// are combined separately, so that no labels are lost. This is useful for
+	// backends that prefer one record per target.
+	GroupByTarget          *bool  `protobuf:"varint,61,opt,name=group_by_target,json=groupByTarget" json:"group_by_target,omitempty"`
+	GroupFlushIntervalMsec *int32 `protobuf:"varint,62,opt,name=group_flush_interval_msec,json=groupFlushIntervalMsec,def=10000" json:"group_flush_interval_msec,omitempty"`
+	// Rate limit for the surfacer, to protect the downstream systems. This is
+	// applied right after the filters (allow_metrics_with_label etc), so that
+	// dropped EventMetrics don't affect the stateful transformations like
+	// export_as_gauge. Number of dropped EventMetrics is exported as the
+	// surfacer_rate_limit_shed metric, to the same surfacer.
+	RateLimit *RateLimit `protobuf:"bytes,63,opt,name=rate_limit,json=rateLimit" json:"rate_limit,omitempty"`
+	// Drop metrics whose value is exactly zero, to avoid cluttering the
+	// backends with endless zero-valued series. EventMetrics that have no
+	// metrics left after dropping are not written at all.
+	// Example:
+	//
+	//	drop_zero_values {
+	//	  gauge: false  # Drop only zero-valued counters.
+	//	}
+	DropZeroValues *DropZeroValues `protobuf:"bytes,64,opt,name=drop_zero_values,json=dropZeroValues" json:"drop_zero_values,omitempty"`
+	// In shadow mode, the surfacer runs the filtering decisions
+	// (allow_metrics_with_label, ignore_metrics_with_name etc) for all
+	// EventMetrics and logs them, but never writes anything. This is useful to
+	// validate a filter config before enabling it; EventMetrics and metrics
+	// that would be dropped are logged, and a summary of the decisions is
+	// logged every minute. Note that the surfacer is still initialized.
+	ShadowMode *bool `protobuf:"varint,65,opt,name=shadow_mode,json=shadowMode" json:"shadow_mode,omitempty"`
+	// Carry forward the counter values across probe restarts, so that the
+	// downstream rate() calculations don't see a reset. When a CUMULATIVE
+	// metric's value goes down, e.g. because the probe was restarted after a
+	// config reload, the last surfaced value is used as the base for the new
+	// values of that series. See CounterContinuity for how genuine resets are
+	// told apart from restarts.
+	// Example:
+	//
+	//	counter_continuity {
+	//	  max_gap_sec: 300
+	//	}
+	CounterContinuity *CounterContinuity `protobuf:"bytes,66,opt,name=counter_continuity,json=counterContinuity" json:"counter_continuity,omitempty"`
+	// If set, the surfacer writes a surfacer_heartbeat metric every
+	// heartbeat_interval_sec, independent of the probes. The metric is a
+	// counter of the heartbeats written so far, with a "surfacer" label set to
+	// the surfacer's name. It can be used to detect a stalled metrics pipeline
+	// even when no probe data is flowing. Like other metrics, heartbeats go
+	// through the metrics filters and get the additional labels. They are not
+	// written in the shadow mode.
+	HeartbeatIntervalSec *int32 `protobuf:"varint,70,opt,name=heartbeat_interval_sec,json=heartbeatIntervalSec" json:"heartbeat_interval_sec,omitempty"`
+	// If configured, latency metrics with missing, NaN or infinite values are
+	// dropped or replaced with a default value, as per the policy. By default,
+	// such values are passed to the surfacer as they are.
+	// Example:
+	//
+	//	invalid_latency {
+	//	  policy: DEFAULT
+	//	  default_value: 0
+	//	}
+	InvalidLatency *InvalidLatency `protobuf:"bytes,71,opt,name=invalid_latency,json=invalidLatency" json:"invalid_latency,omitempty"`
+	// If configured, metric names are normalized before they are matched
+	// against the metric name filters (allow_metrics_with_name and
+	// ignore_metrics_with_name) and latency_metric_pattern, so that filters
+	// don't have to account for varying separators.
+	// If export_normalized_names is set, metrics are also renamed, right
+	// after the failure metric is added; other options referring to the metric
+	// names (e.g. value_transform) should then use the normalized names.
+	// Example:
+	//
+	//	metric_name_normalization {
+	//	  rule {
+	//	    pattern: "[.-]"
+	//	    replacement: "_"
+	//	  }
+	//	}
+	MetricNameNormalization *MetricNameNormalization `protobuf:"bytes,73,opt,name=metric_name_normalization,json=metricNameNormalization" json:"metric_name_normalization,omitempty"`
+	// If configured, label values are normalized before the EventMetrics is
+	// surfaced. Normalization happens after the filtering, so label filters
+	// (allow_metrics_with_label and ignore_metrics_with_label) see the
+	// original values, and before hash_label_values, so that the same
+	// normalized value always gets the same hash.
+	// Example:
+	//
+	//	label_value_normalization {
+	//	  label_key: "region"
+	//	  lowercase: true
+	//	  rule {
+	//	    pattern: "_"
+	//	    replacement: "-"
+	//	  }
+	//	}
+	LabelValueNormalization *LabelValueNormalization `protobuf:"bytes,84,opt,name=label_value_normalization,json=labelValueNormalization" json:"label_value_normalization,omitempty"`
+	// By default, EventMetrics are written to the surfacer one at a time, by
+	// the goroutine that processes them, so a surfacer that writes
+	// synchronously and is slow (e.g. a user defined surfacer that calls a
+	// remote API) holds up the writes behind it. If configured, writes are
+	// handed over to a pool of workers instead. Only the final write is
+	// parallelized; filtering and transformations still run in order.
+	// Workers' utilization, i.e. the fraction of time they spend writing, is
+	// exported as the surfacer_write_worker_utilization gauge, to the same
+	// surfacer.
+	// Example:
+	//
+	//	write_workers {
+	//	  workers: 4
+	//	}
+	WriteWorkers *WriteWorkers `protobuf:"bytes,74,opt,name=write_workers,json=writeWorkers" json:"write_workers,omitempty"`
+	// If set, surfacer writes a schema manifest, i.e. the metric names and
+	// label keys it expects to export, to this file at startup. It's useful
+	// for the backends that need the tables to be created ahead of time, e.g.
+	// BigQuery or ClickHouse. Manifest is a JSON object, e.g.:
+	//
+	//	{
+	//	  "surfacer": "bq",
+	//	  "metrics": [
+	//	    {"name": "latency", "labels": ["dst", "probe", "ptype"]},
+	//	    ...
+	//	  ]
+	//	}
+	//
+	// Manifest is built from the probes' default metrics (total, success,
+	// latency, validation_failure and the probe type's common metrics) and the
+	// surfacer's own settings (metric name normalization, stripped labels,
+	// additional labels, metric name filters, relabel rules). Metrics that
+	// depend on the probe responses, e.g. external probe's payload metrics, are
+	// not listed.
+	SchemaManifestFile *string `protobuf:"bytes,75,opt,name=schema_manifest_file,json=schemaManifestFile" json:"schema_manifest_file,omitempty"`
+	// If set, the counts of the surfacer's filtering decisions are exported
+	// every filter_stats_interval_sec: surfacer_filter_allowed, the number of
+	// EventMetrics that passed the label filters, and surfacer_filter_dropped,
+	// the number of dropped EventMetrics (filter="label", includes the value
+	// filters and the surfacers label routing) and dropped metrics
+	// (filter="name"). Both are counters with a "surfacer" label set to the
+	// surfacer's name. They are exported as cloudprober's own metrics, along
+	// with the system variables (ptype="sysvars", probe="sysvars"), i.e. they
+	// are written to all the surfacers, through their filters, so that drops
+	// can be alerted on even if a surfacer drops everything.
+	FilterStatsIntervalSec *int32 `protobuf:"varint,76,opt,name=filter_stats_interval_sec,json=filterStatsIntervalSec" json:"filter_stats_interval_sec,omitempty"`
+	// Filter bundles to apply, by name. Bundles' label filters are added to
+	// the surfacer's own allow_metrics_with_label and
+	// ignore_metrics_with_label filters. Metric name regexes are OR'ed with the
+	// surfacer's own, and with each other, i.e. a metric name allowed (or
+	// ignored) by any of them is allowed (or ignored). Referring to an unknown
+	// bundle is a config error.
+	// Example:
+	//
+	//	filter_bundle: "no-sysvars"
+	FilterBundle []string `protobuf:"bytes,78,rep,name=filter_bundle,json=filterBundle" json:"filter_bundle,omitempty"`
+	// If set, surfacer's metrics filters can be replaced at runtime by a POST
+	// request to /surfacers/filters on cloudprober's HTTP server. It's
+	// disabled by default, as anyone who can reach the HTTP server would be
+	// able to change what's surfaced. Current filters can always be viewed
+	// with a GET request.
+	AllowFiltersUpdate *bool `protobuf:"varint,92,opt,name=allow_filters_update,json=allowFiltersUpdate" json:"allow_filters_update,omitempty"`
+	// Rules to rename metrics and label keys, e.g. to match the names that a
+	// backend expects. Rules are applied in the given order, each one to the
+	// output of the previous ones. Renaming happens after all other
+	// processing, just before the additional labels are added, so the other
+	// options (filters, value_transform, etc) refer to the original names.
+	// If a label is renamed to an existing label's key, the existing label is
+	// kept.
+	// Example:
+	//
+	//	relabel_rule {
+	//	  source_regex: "latency"
+	//	  replacement: "http_latency_seconds"
+	//	}
+	//	relabel_rule {
+	//	  target: LABEL_KEY
+	//	  source_regex: "dst"
+	//	  replacement: "target"
+	//	}
+	RelabelRule []*RelabelRule `protobuf:"bytes,79,rep,name=relabel_rule,json=relabelRule" json:"relabel_rule,omitempty"`
+	// If set, along with group_by_target, the surfacer writes an end-of-batch
+	// marker after each group flush, so that batch consumers (e.g. the ones
+	// reading the file or pubsub output) know that a batch is complete. The
+	// marker is an EventMetrics with a surfacer_end_of_batch metric, set to
+	// the number of EventMetrics in the batch, and the labels
+	// marker="end_of_batch" and surfacer=<surfacer's name>. It's written even
+	// for the empty batches, and it doesn't go through the filters. Markers
+	// are not written in the shadow mode. If write_workers is configured, the
+	// marker is written after the workers have written the whole batch.
+	EndOfBatchMarker *bool `protobuf:"varint,80,opt,name=end_of_batch_marker,json=endOfBatchMarker" json:"end_of_batch_marker,omitempty"`
 	// Matching surfacer specific configuration (one for each type in the above
 	// enum)
 	//
@@ -261,15 +2209,23 @@ type SurfacerDef struct {
 
 // Default values for SurfacerDef fields.
 const (
-	Default_SurfacerDef_MetricsBufferSize      = int64(10000)
-	Default_SurfacerDef_LatencyMetricPattern   = string("^(.+_|)latency$")
-	Default_SurfacerDef_AdditionalLabelsEnvVar = string("CLOUDPROBER_ADDITIONAL_LABELS")
+	Default_SurfacerDef_MetricsBufferSize             = int64(10000)
+	Default_SurfacerDef_AllowMetricsLabelMatchMode    = LabelMatchMode_ANY
+	Default_SurfacerDef_FilterPrecedence              = FilterPrecedence_IGNORE_FIRST
+	Default_SurfacerDef_SampleRate                    = float64(1)
+	Default_SurfacerDef_AllowMetricsWithNameEnabled   = bool(true)
+	Default_SurfacerDef_IgnoreMetricsWithNameEnabled  = bool(true)
+	Default_SurfacerDef_LatencyMetricPattern          = string("^(.+_|)latency$")
+	Default_SurfacerDef_AdditionalLabelsEnvVar        = string("CLOUDPROBER_ADDITIONAL_LABELS")
+	Default_SurfacerDef_SeriesBudgetResetIntervalSec  = int32(3600)
+	Default_SurfacerDef_DistributionBucketMergeFactor = int32(1)
+	Default_SurfacerDef_GroupFlushIntervalMsec        = int32(10000)
 )
 
 func (x *SurfacerDef) Reset() {
 	*x = SurfacerDef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1]
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -282,7 +2238,7 @@ func (x *SurfacerDef) String() string {
 func (*SurfacerDef) ProtoMessage() {}
 
 func (x *SurfacerDef) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1]
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +2251,7 @@ func (x *SurfacerDef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SurfacerDef.ProtoReflect.Descriptor instead.
 func (*SurfacerDef) Descriptor() ([]byte, []int) {
-	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{1}
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SurfacerDef) GetName() string {
@@ -319,6 +2275,13 @@ func (x *SurfacerDef) GetMetricsBufferSize() int64 {
 	return Default_SurfacerDef_MetricsBufferSize
 }
 
+func (x *SurfacerDef) GetMetricsBufferSizeRule() []*MetricsBufferSizeRule {
+	if x != nil {
+		return x.MetricsBufferSizeRule
+	}
+	return nil
+}
+
 func (x *SurfacerDef) GetAllowMetricsWithLabel() []*LabelFilter {
 	if x != nil {
 		return x.AllowMetricsWithLabel
@@ -326,6 +2289,13 @@ func (x *SurfacerDef) GetAllowMetricsWithLabel() []*LabelFilter {
 	return nil
 }
 
+func (x *SurfacerDef) GetAllowMetricsLabelMatchMode() LabelMatchMode {
+	if x != nil && x.AllowMetricsLabelMatchMode != nil {
+		return *x.AllowMetricsLabelMatchMode
+	}
+	return Default_SurfacerDef_AllowMetricsLabelMatchMode
+}
+
 func (x *SurfacerDef) GetIgnoreMetricsWithLabel() []*LabelFilter {
 	if x != nil {
 		return x.IgnoreMetricsWithLabel
@@ -333,6 +2303,48 @@ func (x *SurfacerDef) GetIgnoreMetricsWithLabel() []*LabelFilter {
 	return nil
 }
 
+func (x *SurfacerDef) GetFilterPrecedence() FilterPrecedence {
+	if x != nil && x.FilterPrecedence != nil {
+		return *x.FilterPrecedence
+	}
+	return Default_SurfacerDef_FilterPrecedence
+}
+
+func (x *SurfacerDef) GetRouteBySurfacersLabel() bool {
+	if x != nil && x.RouteBySurfacersLabel != nil {
+		return *x.RouteBySurfacersLabel
+	}
+	return false
+}
+
+func (x *SurfacerDef) GetAllowMetricsWithValue() []*ValueFilter {
+	if x != nil {
+		return x.AllowMetricsWithValue
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetAllowMetricsWithSuccessState() *SuccessFilter {
+	if x != nil {
+		return x.AllowMetricsWithSuccessState
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetSampleRate() float64 {
+	if x != nil && x.SampleRate != nil {
+		return *x.SampleRate
+	}
+	return Default_SurfacerDef_SampleRate
+}
+
+func (x *SurfacerDef) GetSampleByLabel() []string {
+	if x != nil {
+		return x.SampleByLabel
+	}
+	return nil
+}
+
 func (x *SurfacerDef) GetAllowMetricsWithName() string {
 	if x != nil && x.AllowMetricsWithName != nil {
 		return *x.AllowMetricsWithName
@@ -347,6 +2359,48 @@ func (x *SurfacerDef) GetIgnoreMetricsWithName() string {
 	return ""
 }
 
+func (x *SurfacerDef) GetAllowMetricsWithNameEnabled() bool {
+	if x != nil && x.AllowMetricsWithNameEnabled != nil {
+		return *x.AllowMetricsWithNameEnabled
+	}
+	return Default_SurfacerDef_AllowMetricsWithNameEnabled
+}
+
+func (x *SurfacerDef) GetIgnoreMetricsWithNameEnabled() bool {
+	if x != nil && x.IgnoreMetricsWithNameEnabled != nil {
+		return *x.IgnoreMetricsWithNameEnabled
+	}
+	return Default_SurfacerDef_IgnoreMetricsWithNameEnabled
+}
+
+func (x *SurfacerDef) GetIgnoreLabelKeys() []string {
+	if x != nil {
+		return x.IgnoreLabelKeys
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetStripIgnoredLabelKeys() bool {
+	if x != nil && x.StripIgnoredLabelKeys != nil {
+		return *x.StripIgnoredLabelKeys
+	}
+	return false
+}
+
+func (x *SurfacerDef) GetHashLabelValues() []string {
+	if x != nil {
+		return x.HashLabelValues
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetHashLabelValuesSalt() string {
+	if x != nil && x.HashLabelValuesSalt != nil {
+		return *x.HashLabelValuesSalt
+	}
+	return ""
+}
+
 func (x *SurfacerDef) GetAddFailureMetric() bool {
 	if x != nil && x.AddFailureMetric != nil {
 		return *x.AddFailureMetric
@@ -354,6 +2408,13 @@ func (x *SurfacerDef) GetAddFailureMetric() bool {
 	return false
 }
 
+func (x *SurfacerDef) GetAddFailureMetricForMetricsWithName() string {
+	if x != nil && x.AddFailureMetricForMetricsWithName != nil {
+		return *x.AddFailureMetricForMetricsWithName
+	}
+	return ""
+}
+
 func (x *SurfacerDef) GetExportAsGauge() bool {
 	if x != nil && x.ExportAsGauge != nil {
 		return *x.ExportAsGauge
@@ -368,11 +2429,186 @@ func (x *SurfacerDef) GetLatencyMetricPattern() string {
 	return Default_SurfacerDef_LatencyMetricPattern
 }
 
+func (x *SurfacerDef) GetDisableLatencyMetrics() bool {
+	if x != nil && x.DisableLatencyMetrics != nil {
+		return *x.DisableLatencyMetrics
+	}
+	return false
+}
+
 func (x *SurfacerDef) GetAdditionalLabelsEnvVar() string {
 	if x != nil && x.AdditionalLabelsEnvVar != nil {
 		return *x.AdditionalLabelsEnvVar
 	}
-	return Default_SurfacerDef_AdditionalLabelsEnvVar
+	return Default_SurfacerDef_AdditionalLabelsEnvVar
+}
+
+func (x *SurfacerDef) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetMaxSeriesPerMetric() int32 {
+	if x != nil && x.MaxSeriesPerMetric != nil {
+		return *x.MaxSeriesPerMetric
+	}
+	return 0
+}
+
+func (x *SurfacerDef) GetSeriesBudgetResetIntervalSec() int32 {
+	if x != nil && x.SeriesBudgetResetIntervalSec != nil {
+		return *x.SeriesBudgetResetIntervalSec
+	}
+	return Default_SurfacerDef_SeriesBudgetResetIntervalSec
+}
+
+func (x *SurfacerDef) GetValueTransform() []*ValueTransform {
+	if x != nil {
+		return x.ValueTransform
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetStaleAfter() []*StaleAfter {
+	if x != nil {
+		return x.StaleAfter
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetDistributionBucketMergeFactor() int32 {
+	if x != nil && x.DistributionBucketMergeFactor != nil {
+		return *x.DistributionBucketMergeFactor
+	}
+	return Default_SurfacerDef_DistributionBucketMergeFactor
+}
+
+func (x *SurfacerDef) GetDistributionPercentiles() *DistributionPercentiles {
+	if x != nil {
+		return x.DistributionPercentiles
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetGroupByTarget() bool {
+	if x != nil && x.GroupByTarget != nil {
+		return *x.GroupByTarget
+	}
+	return false
+}
+
+func (x *SurfacerDef) GetGroupFlushIntervalMsec() int32 {
+	if x != nil && x.GroupFlushIntervalMsec != nil {
+		return *x.GroupFlushIntervalMsec
+	}
+	return Default_SurfacerDef_GroupFlushIntervalMsec
+}
+
+func (x *SurfacerDef) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetDropZeroValues() *DropZeroValues {
+	if x != nil {
+		return x.DropZeroValues
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetShadowMode() bool {
+	if x != nil && x.ShadowMode != nil {
+		return *x.ShadowMode
+	}
+	return false
+}
+
+func (x *SurfacerDef) GetCounterContinuity() *CounterContinuity {
+	if x != nil {
+		return x.CounterContinuity
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetHeartbeatIntervalSec() int32 {
+	if x != nil && x.HeartbeatIntervalSec != nil {
+		return *x.HeartbeatIntervalSec
+	}
+	return 0
+}
+
+func (x *SurfacerDef) GetInvalidLatency() *InvalidLatency {
+	if x != nil {
+		return x.InvalidLatency
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetMetricNameNormalization() *MetricNameNormalization {
+	if x != nil {
+		return x.MetricNameNormalization
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetLabelValueNormalization() *LabelValueNormalization {
+	if x != nil {
+		return x.LabelValueNormalization
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetWriteWorkers() *WriteWorkers {
+	if x != nil {
+		return x.WriteWorkers
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetSchemaManifestFile() string {
+	if x != nil && x.SchemaManifestFile != nil {
+		return *x.SchemaManifestFile
+	}
+	return ""
+}
+
+func (x *SurfacerDef) GetFilterStatsIntervalSec() int32 {
+	if x != nil && x.FilterStatsIntervalSec != nil {
+		return *x.FilterStatsIntervalSec
+	}
+	return 0
+}
+
+func (x *SurfacerDef) GetFilterBundle() []string {
+	if x != nil {
+		return x.FilterBundle
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetAllowFiltersUpdate() bool {
+	if x != nil && x.AllowFiltersUpdate != nil {
+		return *x.AllowFiltersUpdate
+	}
+	return false
+}
+
+func (x *SurfacerDef) GetRelabelRule() []*RelabelRule {
+	if x != nil {
+		return x.RelabelRule
+	}
+	return nil
+}
+
+func (x *SurfacerDef) GetEndOfBatchMarker() bool {
+	if x != nil && x.EndOfBatchMarker != nil {
+		return *x.EndOfBatchMarker
+	}
+	return false
 }
 
 func (m *SurfacerDef) GetSurfacer() isSurfacerDef_Surfacer {
@@ -516,6 +2752,122 @@ func (*SurfacerDef_BigquerySurfacer) isSurfacerDef_Surfacer() {}
 
 func (*SurfacerDef_OtelSurfacer) isSurfacerDef_Surfacer() {}
 
+type MetricNameNormalization_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Regex to match in the metric names.
+	Pattern *string `protobuf:"bytes,1,req,name=pattern" json:"pattern,omitempty"`
+	// Replacement for the matches. It can refer to the regex's capturing
+	// groups using $1, $2 etc.
+	Replacement *string `protobuf:"bytes,2,opt,name=replacement" json:"replacement,omitempty"`
+}
+
+func (x *MetricNameNormalization_Rule) Reset() {
+	*x = MetricNameNormalization_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricNameNormalization_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricNameNormalization_Rule) ProtoMessage() {}
+
+func (x *MetricNameNormalization_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricNameNormalization_Rule.ProtoReflect.Descriptor instead.
+func (*MetricNameNormalization_Rule) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *MetricNameNormalization_Rule) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *MetricNameNormalization_Rule) GetReplacement() string {
+	if x != nil && x.Replacement != nil {
+		return *x.Replacement
+	}
+	return ""
+}
+
+type LabelValueNormalization_Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Regex to match in the label values.
+	Pattern *string `protobuf:"bytes,1,req,name=pattern" json:"pattern,omitempty"`
+	// Replacement for the matches. It can refer to the regex's capturing
+	// groups using $1, $2 etc.
+	Replacement *string `protobuf:"bytes,2,opt,name=replacement" json:"replacement,omitempty"`
+}
+
+func (x *LabelValueNormalization_Rule) Reset() {
+	*x = LabelValueNormalization_Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelValueNormalization_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelValueNormalization_Rule) ProtoMessage() {}
+
+func (x *LabelValueNormalization_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelValueNormalization_Rule.ProtoReflect.Descriptor instead.
+func (*LabelValueNormalization_Rule) Descriptor() ([]byte, []int) {
+	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *LabelValueNormalization_Rule) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
+func (x *LabelValueNormalization_Rule) GetReplacement() string {
+	if x != nil && x.Replacement != nil {
+		return *x.Replacement
+	}
+	return ""
+}
+
 var File_github_com_cloudprober_cloudprober_surfacers_proto_config_proto protoreflect.FileDescriptor
 
 var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDesc = []byte{
@@ -576,125 +2928,475 @@ var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDesc
 	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x2f,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x62, 0x69, 0x67, 0x71, 0x75, 0x65, 0x72,
 	0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xca, 0x0c, 0x0a, 0x0b,
-	0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x3a, 0x02, 0x45, 0x51, 0x52, 0x02, 0x6f, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x06, 0x0a, 0x02, 0x45,
+	0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4c,
+	0x54, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x47,
+	0x54, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x45, 0x10, 0x05, 0x22, 0xae, 0x01, 0x0a, 0x0d,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x08, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x53, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a,
+	0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x22, 0x26, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
+	0x4f, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x01, 0x22, 0x51, 0x0a, 0x0e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x55, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75,
+	0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x12, 0x44, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0x53, 0x48, 0x45, 0x44, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x05, 0x31, 0x30, 0x30,
+	0x30, 0x30, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x1e,
+	0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x48, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x01, 0x22, 0x91,
+	0x01, 0x0a, 0x0e, 0x44, 0x72, 0x6f, 0x70, 0x5a, 0x65, 0x72, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x67, 0x61, 0x75, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x05, 0x67, 0x61,
+	0x75, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x18, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x15, 0x6b, 0x65, 0x65,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x5a, 0x65,
+	0x72, 0x6f, 0x22, 0x38, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x61, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x03, 0x36, 0x30,
+	0x30, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x47, 0x61, 0x70, 0x53, 0x65, 0x63, 0x22, 0xe4, 0x01, 0x0a,
+	0x17, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x3d, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x15, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x1a,
+	0x42, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x6f, 0x0a, 0x17,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x3a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x11, 0x6b, 0x65, 0x65, 0x70,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8c, 0x02,
+	0x0a, 0x17, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65,
+	0x52, 0x09, 0x74, 0x72, 0x69, 0x6d, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x09, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x05,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x1a, 0x42, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xcb, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x3a, 0x0b, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x28, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c,
+	0x41, 0x42, 0x45, 0x4c, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x04, 0x31, 0x30, 0x30, 0x30, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x15, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x3a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x26, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x01, 0x30, 0x52, 0x0c, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x06, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01, 0x22, 0xcc, 0x02, 0x0a, 0x0c,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x5a, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x5c, 0x0a, 0x19,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x16, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x23, 0x0a, 0x0b, 0x53,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35,
+	0x0a, 0x13, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x3a, 0x05, 0x31, 0x30, 0x30,
+	0x30, 0x30, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x64, 0x0a, 0x18, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x56, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x15, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x35, 0x0a, 0x13, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x3a, 0x05, 0x31, 0x30,
-	0x30, 0x30, 0x30, 0x52, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5a, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x15, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x5c, 0x0a, 0x19, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
-	0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x16, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x12, 0x35, 0x0a, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57,
-	0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x64,
-	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x26,
-	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x73, 0x5f, 0x67, 0x61, 0x75, 0x67,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41,
-	0x73, 0x47, 0x61, 0x75, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
-	0x18, 0x33, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0f, 0x5e, 0x28, 0x2e, 0x2b, 0x5f, 0x7c, 0x29, 0x6c,
-	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x24, 0x52, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x58, 0x0a,
-	0x19, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09,
-	0x3a, 0x1d, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x52, 0x5f, 0x41, 0x44,
-	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x53, 0x52,
-	0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x12, 0x60, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x65,
-	0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
-	0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75,
-	0x73, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x14, 0x73, 0x74, 0x61,
-	0x63, 0x6b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x73,
-	0x74, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x13, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x4e,
-	0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
-	0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00,
-	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x5a,
-	0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x6d, 0x0a, 0x1e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x3a, 0x03, 0x41, 0x4e, 0x59, 0x52, 0x1a, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x5c, 0x0a, 0x19, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
 	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
-	0x2e, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72,
-	0x65, 0x73, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0f, 0x70, 0x75,
-	0x62, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75,
-	0x62, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00,
-	0x52, 0x0e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
-	0x12, 0x60, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
-	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x16, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x61, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x70,
+	0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x0c, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x5f,
+	0x46, 0x49, 0x52, 0x53, 0x54, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x62, 0x79, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x45, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x42, 0x79, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x5a, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x48, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72,
+	0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x6b, 0x0a, 0x20,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x57, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x1c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x58, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x01,
+	0x31, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x59, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x79,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x74,
+	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x1f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x52, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04,
+	0x74, 0x72, 0x75, 0x65, 0x52, 0x1b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x4c, 0x0a, 0x20, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x53, 0x20, 0x01, 0x28, 0x08, 0x3a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0x52, 0x1c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x39, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x73,
+	0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x73,
+	0x74, 0x72, 0x69, 0x70, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x43, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x68, 0x61, 0x73, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x44, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x68, 0x61, 0x73, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x64, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x54, 0x0a, 0x28, 0x61, 0x64, 0x64, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x22, 0x61, 0x64, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x61, 0x73, 0x5f, 0x67, 0x61, 0x75, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x47, 0x61, 0x75, 0x67,
+	0x65, 0x12, 0x45, 0x0a, 0x16, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x09, 0x3a, 0x0f, 0x5e, 0x28, 0x2e, 0x2b, 0x5f, 0x7c, 0x29, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x24, 0x52, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x58, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x09, 0x3a, 0x1d, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x52,
+	0x5f, 0x41, 0x44, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x42, 0x45,
+	0x4c, 0x53, 0x52, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x12, 0x6a, 0x0a, 0x13, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x5b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x53,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x44, 0x65, 0x66, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18,
+	0x35, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x4c, 0x0a, 0x20, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x36, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x04, 0x33, 0x36, 0x30, 0x30, 0x52, 0x1c, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x4d, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x38, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x41, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x3b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x20, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x05, 0x3a, 0x01, 0x31, 0x52, 0x1d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x68, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x55, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x17, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x19, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x6d, 0x73, 0x65, 0x63, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x05, 0x31, 0x30, 0x30, 0x30,
+	0x30, 0x52, 0x16, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0a, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
 	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x72, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2e,
-	0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x12,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x77, 0x61, 0x74, 0x63, 0x68, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x72, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x64, 0x6f, 0x67, 0x5f, 0x73, 0x75,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63,
+	0x61, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09,
+	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x64, 0x72, 0x6f,
+	0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x40, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x5a,
+	0x65, 0x72, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x5a,
+	0x65, 0x72, 0x6f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x56, 0x0a, 0x12, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79,
+	0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69, 0x74, 0x79, 0x52,
+	0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x69,
+	0x74, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x46, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x14, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12, 0x4d, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x47, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x69, 0x0a, 0x19, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x19, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x54, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a,
+	0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x4a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x4b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x4e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x5c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x4f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x2d, 0x0a, 0x13, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x50, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65,
+	0x6e, 0x64, 0x4f, 0x66, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x60, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x73, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63,
 	0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x64, 0x6f, 0x67, 0x2e, 0x53, 0x75, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61,
-	0x64, 0x6f, 0x67, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x14, 0x70,
-	0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x75, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f,
-	0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
-	0x12, 0x5a, 0x0a, 0x11, 0x62, 0x69, 0x67, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x75, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c,
+	0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x2e, 0x53,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x12, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x72, 0x12, 0x63, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48,
+	0x00, 0x52, 0x13, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x53, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x72, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x75,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72,
+	0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65,
+	0x73, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00,
+	0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x67, 0x72, 0x65, 0x73, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x72, 0x12, 0x54, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c,
 	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x72, 0x2e, 0x62, 0x69, 0x67, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x75, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x10, 0x62, 0x69, 0x67, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0d,
-	0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
-	0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x6f, 0x74, 0x65, 0x6c, 0x2e,
-	0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0c,
-	0x6f, 0x74, 0x65, 0x6c, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2a, 0xad, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
-	0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
-	0x54, 0x41, 0x43, 0x4b, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52,
-	0x45, 0x53, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x53, 0x55, 0x42, 0x10, 0x05,
-	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x57, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06,
-	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x41, 0x54, 0x41, 0x44, 0x4f, 0x47, 0x10, 0x07, 0x12, 0x0f, 0x0a,
-	0x0b, 0x50, 0x52, 0x4f, 0x42, 0x45, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x08, 0x12, 0x0c,
-	0x0a, 0x08, 0x42, 0x49, 0x47, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04,
-	0x4f, 0x54, 0x45, 0x4c, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44,
-	0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x63, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
-	0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73,
-	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x65, 0x72, 0x2e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62,
+	0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x77, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x10, 0x64, 0x61,
+	0x74, 0x61, 0x64, 0x6f, 0x67, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62,
+	0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x64, 0x6f, 0x67, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x64, 0x6f, 0x67, 0x53, 0x75, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e,
+	0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x11, 0x62, 0x69, 0x67, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2e, 0x62, 0x69, 0x67, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x48, 0x00, 0x52, 0x10, 0x62, 0x69, 0x67, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0d, 0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x73, 0x75, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2e, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x72, 0x2e, 0x6f, 0x74, 0x65, 0x6c, 0x2e, 0x53, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x74, 0x65, 0x6c, 0x53, 0x75, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x72, 0x1a, 0x45, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x73,
+	0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x72, 0x2a, 0xad, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52,
+	0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54,
+	0x41, 0x43, 0x4b, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x49, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45,
+	0x53, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x53, 0x55, 0x42, 0x10, 0x05, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x57, 0x41, 0x54, 0x43, 0x48, 0x10, 0x06, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x41, 0x54, 0x41, 0x44, 0x4f, 0x47, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x52, 0x4f, 0x42, 0x45, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x08, 0x12, 0x0c, 0x0a,
+	0x08, 0x42, 0x49, 0x47, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x4f,
+	0x54, 0x45, 0x4c, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45,
+	0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x63, 0x2a, 0x22, 0x0a, 0x0e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x2a, 0x35, 0x0a, 0x10, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x65, 0x63, 0x65, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54,
+	0x10, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x72, 0x2f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x72, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -709,42 +3411,93 @@ func file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDes
 	return file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDescData
 }
 
-var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_goTypes = []any{
-	(Type)(0),                   // 0: cloudprober.surfacer.Type
-	(*LabelFilter)(nil),         // 1: cloudprober.surfacer.LabelFilter
-	(*SurfacerDef)(nil),         // 2: cloudprober.surfacer.SurfacerDef
-	(*proto.SurfacerConf)(nil),  // 3: cloudprober.surfacer.prometheus.SurfacerConf
-	(*proto1.SurfacerConf)(nil), // 4: cloudprober.surfacer.stackdriver.SurfacerConf
-	(*proto2.SurfacerConf)(nil), // 5: cloudprober.surfacer.file.SurfacerConf
-	(*proto3.SurfacerConf)(nil), // 6: cloudprober.surfacer.postgres.SurfacerConf
-	(*proto4.SurfacerConf)(nil), // 7: cloudprober.surfacer.pubsub.SurfacerConf
-	(*proto5.SurfacerConf)(nil), // 8: cloudprober.surfacer.cloudwatch.SurfacerConf
-	(*proto6.SurfacerConf)(nil), // 9: cloudprober.surfacer.datadog.SurfacerConf
-	(*proto7.SurfacerConf)(nil), // 10: cloudprober.surfacer.probestatus.SurfacerConf
-	(*proto8.SurfacerConf)(nil), // 11: cloudprober.surfacer.bigquery.SurfacerConf
-	(*proto9.SurfacerConf)(nil), // 12: cloudprober.surfacer.otel.SurfacerConf
+	(Type)(0),                            // 0: cloudprober.surfacer.Type
+	(LabelMatchMode)(0),                  // 1: cloudprober.surfacer.LabelMatchMode
+	(FilterPrecedence)(0),                // 2: cloudprober.surfacer.FilterPrecedence
+	(ValueFilter_Op)(0),                  // 3: cloudprober.surfacer.ValueFilter.Op
+	(SuccessFilter_State)(0),             // 4: cloudprober.surfacer.SuccessFilter.State
+	(RateLimit_Policy)(0),                // 5: cloudprober.surfacer.RateLimit.Policy
+	(RelabelRule_Target)(0),              // 6: cloudprober.surfacer.RelabelRule.Target
+	(InvalidLatency_Policy)(0),           // 7: cloudprober.surfacer.InvalidLatency.Policy
+	(*LabelFilter)(nil),                  // 8: cloudprober.surfacer.LabelFilter
+	(*ValueFilter)(nil),                  // 9: cloudprober.surfacer.ValueFilter
+	(*SuccessFilter)(nil),                // 10: cloudprober.surfacer.SuccessFilter
+	(*ValueTransform)(nil),               // 11: cloudprober.surfacer.ValueTransform
+	(*StaleAfter)(nil),                   // 12: cloudprober.surfacer.StaleAfter
+	(*RateLimit)(nil),                    // 13: cloudprober.surfacer.RateLimit
+	(*DropZeroValues)(nil),               // 14: cloudprober.surfacer.DropZeroValues
+	(*CounterContinuity)(nil),            // 15: cloudprober.surfacer.CounterContinuity
+	(*MetricNameNormalization)(nil),      // 16: cloudprober.surfacer.MetricNameNormalization
+	(*MetricsBufferSizeRule)(nil),        // 17: cloudprober.surfacer.MetricsBufferSizeRule
+	(*DistributionPercentiles)(nil),      // 18: cloudprober.surfacer.DistributionPercentiles
+	(*LabelValueNormalization)(nil),      // 19: cloudprober.surfacer.LabelValueNormalization
+	(*RelabelRule)(nil),                  // 20: cloudprober.surfacer.RelabelRule
+	(*WriteWorkers)(nil),                 // 21: cloudprober.surfacer.WriteWorkers
+	(*InvalidLatency)(nil),               // 22: cloudprober.surfacer.InvalidLatency
+	(*FilterBundle)(nil),                 // 23: cloudprober.surfacer.FilterBundle
+	(*SurfacerDef)(nil),                  // 24: cloudprober.surfacer.SurfacerDef
+	(*MetricNameNormalization_Rule)(nil), // 25: cloudprober.surfacer.MetricNameNormalization.Rule
+	(*LabelValueNormalization_Rule)(nil), // 26: cloudprober.surfacer.LabelValueNormalization.Rule
+	nil,                                  // 27: cloudprober.surfacer.SurfacerDef.ResourceAttributesEntry
+	(*proto.SurfacerConf)(nil),           // 28: cloudprober.surfacer.prometheus.SurfacerConf
+	(*proto1.SurfacerConf)(nil),          // 29: cloudprober.surfacer.stackdriver.SurfacerConf
+	(*proto2.SurfacerConf)(nil),          // 30: cloudprober.surfacer.file.SurfacerConf
+	(*proto3.SurfacerConf)(nil),          // 31: cloudprober.surfacer.postgres.SurfacerConf
+	(*proto4.SurfacerConf)(nil),          // 32: cloudprober.surfacer.pubsub.SurfacerConf
+	(*proto5.SurfacerConf)(nil),          // 33: cloudprober.surfacer.cloudwatch.SurfacerConf
+	(*proto6.SurfacerConf)(nil),          // 34: cloudprober.surfacer.datadog.SurfacerConf
+	(*proto7.SurfacerConf)(nil),          // 35: cloudprober.surfacer.probestatus.SurfacerConf
+	(*proto8.SurfacerConf)(nil),          // 36: cloudprober.surfacer.bigquery.SurfacerConf
+	(*proto9.SurfacerConf)(nil),          // 37: cloudprober.surfacer.otel.SurfacerConf
 }
 var file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_depIdxs = []int32{
-	0,  // 0: cloudprober.surfacer.SurfacerDef.type:type_name -> cloudprober.surfacer.Type
-	1,  // 1: cloudprober.surfacer.SurfacerDef.allow_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
-	1,  // 2: cloudprober.surfacer.SurfacerDef.ignore_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
-	3,  // 3: cloudprober.surfacer.SurfacerDef.prometheus_surfacer:type_name -> cloudprober.surfacer.prometheus.SurfacerConf
-	4,  // 4: cloudprober.surfacer.SurfacerDef.stackdriver_surfacer:type_name -> cloudprober.surfacer.stackdriver.SurfacerConf
-	5,  // 5: cloudprober.surfacer.SurfacerDef.file_surfacer:type_name -> cloudprober.surfacer.file.SurfacerConf
-	6,  // 6: cloudprober.surfacer.SurfacerDef.postgres_surfacer:type_name -> cloudprober.surfacer.postgres.SurfacerConf
-	7,  // 7: cloudprober.surfacer.SurfacerDef.pubsub_surfacer:type_name -> cloudprober.surfacer.pubsub.SurfacerConf
-	8,  // 8: cloudprober.surfacer.SurfacerDef.cloudwatch_surfacer:type_name -> cloudprober.surfacer.cloudwatch.SurfacerConf
-	9,  // 9: cloudprober.surfacer.SurfacerDef.datadog_surfacer:type_name -> cloudprober.surfacer.datadog.SurfacerConf
-	10, // 10: cloudprober.surfacer.SurfacerDef.probestatus_surfacer:type_name -> cloudprober.surfacer.probestatus.SurfacerConf
-	11, // 11: cloudprober.surfacer.SurfacerDef.bigquery_surfacer:type_name -> cloudprober.surfacer.bigquery.SurfacerConf
-	12, // 12: cloudprober.surfacer.SurfacerDef.otel_surfacer:type_name -> cloudprober.surfacer.otel.SurfacerConf
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	3,  // 0: cloudprober.surfacer.ValueFilter.op:type_name -> cloudprober.surfacer.ValueFilter.Op
+	4,  // 1: cloudprober.surfacer.SuccessFilter.state:type_name -> cloudprober.surfacer.SuccessFilter.State
+	5,  // 2: cloudprober.surfacer.RateLimit.policy:type_name -> cloudprober.surfacer.RateLimit.Policy
+	25, // 3: cloudprober.surfacer.MetricNameNormalization.rule:type_name -> cloudprober.surfacer.MetricNameNormalization.Rule
+	26, // 4: cloudprober.surfacer.LabelValueNormalization.rule:type_name -> cloudprober.surfacer.LabelValueNormalization.Rule
+	6,  // 5: cloudprober.surfacer.RelabelRule.target:type_name -> cloudprober.surfacer.RelabelRule.Target
+	7,  // 6: cloudprober.surfacer.InvalidLatency.policy:type_name -> cloudprober.surfacer.InvalidLatency.Policy
+	8,  // 7: cloudprober.surfacer.FilterBundle.allow_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
+	8,  // 8: cloudprober.surfacer.FilterBundle.ignore_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
+	0,  // 9: cloudprober.surfacer.SurfacerDef.type:type_name -> cloudprober.surfacer.Type
+	17, // 10: cloudprober.surfacer.SurfacerDef.metrics_buffer_size_rule:type_name -> cloudprober.surfacer.MetricsBufferSizeRule
+	8,  // 11: cloudprober.surfacer.SurfacerDef.allow_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
+	1,  // 12: cloudprober.surfacer.SurfacerDef.allow_metrics_label_match_mode:type_name -> cloudprober.surfacer.LabelMatchMode
+	8,  // 13: cloudprober.surfacer.SurfacerDef.ignore_metrics_with_label:type_name -> cloudprober.surfacer.LabelFilter
+	2,  // 14: cloudprober.surfacer.SurfacerDef.filter_precedence:type_name -> cloudprober.surfacer.FilterPrecedence
+	9,  // 15: cloudprober.surfacer.SurfacerDef.allow_metrics_with_value:type_name -> cloudprober.surfacer.ValueFilter
+	10, // 16: cloudprober.surfacer.SurfacerDef.allow_metrics_with_success_state:type_name -> cloudprober.surfacer.SuccessFilter
+	27, // 17: cloudprober.surfacer.SurfacerDef.resource_attributes:type_name -> cloudprober.surfacer.SurfacerDef.ResourceAttributesEntry
+	11, // 18: cloudprober.surfacer.SurfacerDef.value_transform:type_name -> cloudprober.surfacer.ValueTransform
+	12, // 19: cloudprober.surfacer.SurfacerDef.stale_after:type_name -> cloudprober.surfacer.StaleAfter
+	18, // 20: cloudprober.surfacer.SurfacerDef.distribution_percentiles:type_name -> cloudprober.surfacer.DistributionPercentiles
+	13, // 21: cloudprober.surfacer.SurfacerDef.rate_limit:type_name -> cloudprober.surfacer.RateLimit
+	14, // 22: cloudprober.surfacer.SurfacerDef.drop_zero_values:type_name -> cloudprober.surfacer.DropZeroValues
+	15, // 23: cloudprober.surfacer.SurfacerDef.counter_continuity:type_name -> cloudprober.surfacer.CounterContinuity
+	22, // 24: cloudprober.surfacer.SurfacerDef.invalid_latency:type_name -> cloudprober.surfacer.InvalidLatency
+	16, // 25: cloudprober.surfacer.SurfacerDef.metric_name_normalization:type_name -> cloudprober.surfacer.MetricNameNormalization
+	19, // 26: cloudprober.surfacer.SurfacerDef.label_value_normalization:type_name -> cloudprober.surfacer.LabelValueNormalization
+	21, // 27: cloudprober.surfacer.SurfacerDef.write_workers:type_name -> cloudprober.surfacer.WriteWorkers
+	20, // 28: cloudprober.surfacer.SurfacerDef.relabel_rule:type_name -> cloudprober.surfacer.RelabelRule
+	28, // 29: cloudprober.surfacer.SurfacerDef.prometheus_surfacer:type_name -> cloudprober.surfacer.prometheus.SurfacerConf
+	29, // 30: cloudprober.surfacer.SurfacerDef.stackdriver_surfacer:type_name -> cloudprober.surfacer.stackdriver.SurfacerConf
+	30, // 31: cloudprober.surfacer.SurfacerDef.file_surfacer:type_name -> cloudprober.surfacer.file.SurfacerConf
+	31, // 32: cloudprober.surfacer.SurfacerDef.postgres_surfacer:type_name -> cloudprober.surfacer.postgres.SurfacerConf
+	32, // 33: cloudprober.surfacer.SurfacerDef.pubsub_surfacer:type_name -> cloudprober.surfacer.pubsub.SurfacerConf
+	33, // 34: cloudprober.surfacer.SurfacerDef.cloudwatch_surfacer:type_name -> cloudprober.surfacer.cloudwatch.SurfacerConf
+	34, // 35: cloudprober.surfacer.SurfacerDef.datadog_surfacer:type_name -> cloudprober.surfacer.datadog.SurfacerConf
+	35, // 36: cloudprober.surfacer.SurfacerDef.probestatus_surfacer:type_name -> cloudprober.surfacer.probestatus.SurfacerConf
+	36, // 37: cloudprober.surfacer.SurfacerDef.bigquery_surfacer:type_name -> cloudprober.surfacer.bigquery.SurfacerConf
+	37, // 38: cloudprober.surfacer.SurfacerDef.otel_surfacer:type_name -> cloudprober.surfacer.otel.SurfacerConf
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_init() }
@@ -766,6 +3519,186 @@ func file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_init()
 			}
 		}
 		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SuccessFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ValueTransform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*StaleAfter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DropZeroValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CounterContinuity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricNameNormalization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricsBufferSizeRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DistributionPercentiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*LabelValueNormalization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*RelabelRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteWorkers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*InvalidLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*FilterBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*SurfacerDef); i {
 			case 0:
 				return &v.state
@@ -777,8 +3710,32 @@ func file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_init()
 				return nil
 			}
 		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricNameNormalization_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*LabelValueNormalization_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[1].OneofWrappers = []any{
+	file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_msgTypes[16].OneofWrappers = []any{
 		(*SurfacerDef_PrometheusSurfacer)(nil),
 		(*SurfacerDef_StackdriverSurfacer)(nil),
 		(*SurfacerDef_FileSurfacer)(nil),
@@ -795,8 +3752,8 @@ func file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_init()
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_cloudprober_cloudprober_surfacers_proto_config_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      8,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
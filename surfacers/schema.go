@@ -0,0 +1,148 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/transform"
+)
+
+// SchemaManifest describes the metrics a surfacer expects to export. See
+// schema_manifest_file in surfacers/proto/config.proto.
+type SchemaManifest struct {
+	Surfacer string          `json:"surfacer"`
+	Metrics  []*SchemaMetric `json:"metrics"`
+}
+
+// SchemaMetric is a metric in the schema manifest, with all the label keys
+// it's exported with.
+type SchemaMetric struct {
+	Name   string   `json:"name"`
+	Labels []string `json:"labels"`
+}
+
+// schemaManifest builds the schema manifest for the given EventMetrics,
+// applying the surfacer's metric names and labels processing. Label filters
+// are not applied, as they usually depend on the label values which are known
+// only at runtime.
+func (sw *surfacerWrapper) schemaManifest(name string, ems []*metrics.EventMetrics) *SchemaManifest {
+	labels := make(map[string]map[string]bool)
+
+	for _, em := range ems {
+		// EventMetrics are shared between the surfacers, and are modified
+		// below.
+		em = em.Clone()
+		if sw.opts.ShouldAddFailureMetric(em) {
+			if err := transform.AddFailureMetric(em); err != nil {
+				sw.opts.Logger.Warning(err.Error())
+			}
+		}
+		em = sw.opts.NormalizeMetricNames(em)
+		em = sw.opts.StripIgnoredLabels(em)
+
+		// Relabeling is applied after the filters, to the allowed metrics
+		// only.
+		allowedEM := metrics.NewEventMetrics(em.Timestamp)
+		for _, k := range em.LabelsKeys() {
+			allowedEM.AddLabel(k, em.Label(k))
+		}
+		for _, metricName := range em.MetricsKeys() {
+			if sw.opts.MatchMetricNameFilters(metricName) {
+				allowedEM.AddMetric(metricName, em.Metric(metricName))
+			}
+		}
+		em = sw.opts.ApplyRelabel(allowedEM)
+
+		for _, metricName := range em.MetricsKeys() {
+			if labels[metricName] == nil {
+				labels[metricName] = make(map[string]bool)
+			}
+			for _, k := range em.LabelsKeys() {
+				labels[metricName][k] = true
+			}
+			for _, label := range sw.opts.AdditionalLabels {
+				labels[metricName][label[0]] = true
+			}
+			switch m := em.Metric(metricName).(type) {
+			case *metrics.Map[int64]:
+				labels[metricName][m.MapName] = true
+			case *metrics.Map[float64]:
+				labels[metricName][m.MapName] = true
+			}
+		}
+	}
+
+	sm := &SchemaManifest{Surfacer: name, Metrics: []*SchemaMetric{}}
+	for metricName, keys := range labels {
+		m := &SchemaMetric{Name: metricName}
+		for k := range keys {
+			m.Labels = append(m.Labels, k)
+		}
+		sort.Strings(m.Labels)
+		sm.Metrics = append(sm.Metrics, m)
+	}
+	sort.Slice(sm.Metrics, func(i, j int) bool { return sm.Metrics[i].Name < sm.Metrics[j].Name })
+	return sm
+}
+
+// schemaManifestSurfacer returns the surfacer wrapper for the given surfacer
+// if it has schema_manifest_file configured, nil otherwise.
+func schemaManifestSurfacer(si *SurfacerInfo) *surfacerWrapper {
+	sw, ok := si.Surfacer.(*surfacerWrapper)
+	if !ok || sw.opts == nil || sw.opts.Config.GetSchemaManifestFile() == "" {
+		return nil
+	}
+	return sw
+}
+
+// SchemaManifestsEnabled returns true if any of the given surfacers has
+// schema_manifest_file configured.
+func SchemaManifestsEnabled(surfacers []*SurfacerInfo) bool {
+	for _, si := range surfacers {
+		if schemaManifestSurfacer(si) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteSchemaManifests writes the schema manifests for the surfacers that
+// have schema_manifest_file configured. It's called at startup, once the
+// surfacers and the probes are initialized, with the EventMetrics that the
+// probes describe for the schema (label values are not used).
+func WriteSchemaManifests(surfacers []*SurfacerInfo, ems []*metrics.EventMetrics) error {
+	for _, si := range surfacers {
+		sw := schemaManifestSurfacer(si)
+		if sw == nil {
+			continue
+		}
+
+		fileName := sw.opts.Config.GetSchemaManifestFile()
+		b, err := json.MarshalIndent(sw.schemaManifest(surfacerName(si), ems), "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling schema manifest for surfacer %s: %v", surfacerName(si), err)
+		}
+		if err := os.WriteFile(fileName, append(b, '\n'), 0644); err != nil {
+			return fmt.Errorf("error writing schema manifest for surfacer %s to %s: %v", surfacerName(si), fileName, err)
+		}
+		sw.opts.Logger.Infof("Wrote schema manifest to: %s", fileName)
+	}
+	return nil
+}
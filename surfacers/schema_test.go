@@ -0,0 +1,124 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package surfacers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/metrics"
+	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWriteSchemaManifests(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+	t.Setenv("TEST_SCHEMA_LABELS", "env=prod")
+
+	Register("schema", &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)})
+
+	manifestFile := filepath.Join(t.TempDir(), "schema.json")
+	sis, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("schema"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			SchemaManifestFile:     proto.String(manifestFile),
+			AdditionalLabelsEnvVar: proto.String("TEST_SCHEMA_LABELS"),
+			IgnoreLabelKeys:        []string{"request_id"},
+			StripIgnoredLabelKeys:  proto.Bool(true),
+			IgnoreMetricsWithName:  proto.String("^timeouts$"),
+			AddFailureMetric:       proto.Bool(true),
+			RelabelRule: []*surfacerpb.RelabelRule{
+				{SourceRegex: proto.String("latency"), Replacement: proto.String("http_latency_seconds")},
+				{Target: surfacerpb.RelabelRule_LABEL_KEY.Enum(), SourceRegex: proto.String("dst"), Replacement: proto.String("target")},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// EventMetrics as described by the probes, see probes.ProbeInfo.
+	webLabels := func(em *metrics.EventMetrics) *metrics.EventMetrics {
+		return em.AddLabel("ptype", "http").AddLabel("probe", "web").AddLabel("dst", "").
+			AddLabel("team", "").AddLabel("request_id", "")
+	}
+	webEM := webLabels(metrics.NewEventMetrics(time.Time{}).
+		AddMetric("total", metrics.NewInt(0)).
+		AddMetric("success", metrics.NewInt(0)).
+		AddMetric("latency", metrics.NewFloat(0)).
+		AddMetric("timeouts", metrics.NewInt(0)).
+		AddMetric("resp-code", metrics.NewMap("code")).
+		AddMetric("validation_failure", metrics.NewMap("validator")))
+	webGaugeEM := webLabels(metrics.NewEventMetrics(time.Time{}).
+		AddMetric("ssl_earliest_cert_expiry_sec", metrics.NewInt(0)))
+	webGaugeEM.Kind = metrics.GAUGE
+	dnsEM := metrics.NewEventMetrics(time.Time{}).
+		AddMetric("total", metrics.NewInt(0)).
+		AddMetric("success", metrics.NewInt(0)).
+		AddMetric("latency_dist", metrics.NewFloat(0)).
+		AddMetric("timeouts", metrics.NewInt(0)).
+		AddLabel("ptype", "dns").AddLabel("probe", "dns").AddLabel("dst", "")
+
+	ems := []*metrics.EventMetrics{webEM, webGaugeEM, dnsEM}
+	require.NoError(t, WriteSchemaManifests(sis, ems))
+
+	// Shared EventMetrics are not modified.
+	assert.Nil(t, webEM.Metric("failure"), "failure metric added to the shared EventMetrics")
+
+	b, err := os.ReadFile(manifestFile)
+	require.NoError(t, err)
+	sm := &SchemaManifest{}
+	require.NoError(t, json.Unmarshal(b, sm))
+
+	got := make(map[string][]string)
+	for _, m := range sm.Metrics {
+		got[m.Name] = m.Labels
+	}
+
+	common := []string{"env", "probe", "ptype", "target"}
+	// Label keys are merged across the EventMetrics, e.g. team for total.
+	web := []string{"env", "probe", "ptype", "target", "team"}
+	assert.Equal(t, "schema", sm.Surfacer)
+	assert.Equal(t, map[string][]string{
+		"total":                        web,
+		"success":                      web,
+		"failure":                      web,
+		"http_latency_seconds":         web,
+		"latency_dist":                 common,
+		"resp-code":                    []string{"code", "env", "probe", "ptype", "target", "team"},
+		"ssl_earliest_cert_expiry_sec": web,
+		"validation_failure":           []string{"env", "probe", "ptype", "target", "team", "validator"},
+	}, got)
+}
+
+func TestWriteSchemaManifestsNotConfigured(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	sis, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{Type: surfacerpb.Type_FILE.Enum()},
+	})
+	require.NoError(t, err)
+	assert.False(t, SchemaManifestsEnabled(sis))
+
+	// Nothing to write, and no error.
+	assert.NoError(t, WriteSchemaManifests(sis, []*metrics.EventMetrics{metrics.NewEventMetrics(time.Time{}).AddMetric("total", metrics.NewInt(0))}))
+}
@@ -30,13 +30,21 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/cloudprober/cloudprober/config/runconfig"
 	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
 	"github.com/cloudprober/cloudprober/surfacers/internal/bigquery"
 	"github.com/cloudprober/cloudprober/surfacers/internal/cloudwatch"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/cardinality"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/grouping"
 	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/ratelimit"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/staleness"
 	"github.com/cloudprober/cloudprober/surfacers/internal/common/transform"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/workerpool"
 	"github.com/cloudprober/cloudprober/surfacers/internal/datadog"
 	"github.com/cloudprober/cloudprober/surfacers/internal/file"
 	"github.com/cloudprober/cloudprober/surfacers/internal/otel"
@@ -46,6 +54,8 @@ import (
 	"github.com/cloudprober/cloudprober/surfacers/internal/pubsub"
 	"github.com/cloudprober/cloudprober/surfacers/internal/stackdriver"
 	"github.com/cloudprober/cloudprober/web/formatutils"
+	"github.com/cloudprober/cloudprober/web/webutils"
+	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 
 	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
@@ -113,23 +123,165 @@ type Surfacer interface {
 	Write(ctx context.Context, em *metrics.EventMetrics)
 }
 
+// seriesDeleter is implemented by the surfacers that keep the last value of
+// each series and expose it until it's overwritten, e.g. prometheus. Stale
+// series are deleted from these surfacers, instead of being written a final
+// zero value.
+type seriesDeleter interface {
+	DeleteSeries(ctx context.Context, em *metrics.EventMetrics)
+}
+
 type surfacerWrapper struct {
 	Surfacer
 	opts    *options.Options
 	lvCache map[string]*metrics.EventMetrics
+
+	cardinalityGuard *cardinality.Guard
+	valueTransformer *transform.ValueTransformer
+	staleTracker     *staleness.Tracker
+	// Grouper is nil if group_by_target is not enabled.
+	grouper *grouping.Grouper
+	// Name to write the end-of-batch markers with, empty if
+	// end_of_batch_marker is not enabled.
+	endOfBatchName string
+	// Rate limiter is nil if rate_limit is not configured.
+	rateLimiter *ratelimit.Limiter[pendingEM]
+	// Shadow stats are nil if shadow_mode is not enabled.
+	shadow *shadowStats
+	// Write pool is nil if write_workers is not configured.
+	writePool *workerpool.Pool
+}
+
+// pendingEM is an EventMetrics buffered by the rate limiter, along with the
+// filters it was allowed by, for the rest of its filtering decisions.
+type pendingEM struct {
+	em      *metrics.EventMetrics
+	filters *options.Filters
+}
+
+// rateLimitStatsInterval is the interval at which rate limiter's shed counter
+// is written to the surfacer.
+const rateLimitStatsInterval = 30 * time.Second
+
+// endOfBatchMetricName is the name of the end-of-batch marker's metric.
+const endOfBatchMetricName = "surfacer_end_of_batch"
+
+// writeWorkerStatsInterval is the interval at which write workers'
+// utilization is written to the surfacer.
+const writeWorkerStatsInterval = 30 * time.Second
+
+// shadowStatsInterval is the interval at which the shadow mode's summary is
+// logged.
+const shadowStatsInterval = time.Minute
+
+// shadowStats keeps the counts of the filtering decisions made in the shadow
+// mode.
+type shadowStats struct {
+	l *logger.Logger
+
+	total, droppedEMs, droppedMetrics atomic.Int64
+}
+
+// observe runs the filtering decisions for the EventMetrics and logs the
+// ones that would drop data.
+func (ss *shadowStats) observe(opts *options.Options, em *metrics.EventMetrics) {
+	ss.total.Add(1)
+
+	allowed, droppedMetrics := opts.FilterDecisions(em)
+	if !allowed {
+		ss.droppedEMs.Add(1)
+		ss.l.Infof("Shadow mode: would drop EventMetrics: %s", em.String())
+		return
+	}
+	if len(droppedMetrics) > 0 {
+		ss.droppedMetrics.Add(int64(len(droppedMetrics)))
+		ss.l.Infof("Shadow mode: would drop metrics %v from EventMetrics: %s", droppedMetrics, em.String())
+		return
+	}
+	ss.l.Debugf("Shadow mode: would write EventMetrics: %s", em.String())
+}
+
+// logLoop logs the summary of the filtering decisions every
+// shadowStatsInterval, until the context is canceled.
+func (ss *shadowStats) logLoop(ctx context.Context) {
+	ticker := time.NewTicker(shadowStatsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ss.l.Infof("Shadow mode: EventMetrics seen: %d, would drop EventMetrics: %d, would drop metrics: %d", ss.total.Load(), ss.droppedEMs.Load(), ss.droppedMetrics.Load())
+		}
+	}
+}
+
+// expireStaleSeries runs the eviction pass for the series that have not been
+// refreshed within their stale_after window.
+func (sw *surfacerWrapper) expireStaleSeries(ctx context.Context) {
+	for _, em := range sw.staleTracker.Expire() {
+		if d, ok := sw.Surfacer.(seriesDeleter); ok {
+			d.DeleteSeries(ctx, em)
+			continue
+		}
+		if markerEM := staleness.ZeroMarker(em); markerEM != nil {
+			sw.write(ctx, markerEM)
+		}
+	}
 }
 
 func (sw *surfacerWrapper) Write(ctx context.Context, em *metrics.EventMetrics) {
-	if !sw.opts.AllowEventMetrics(em) {
+	// In shadow mode, nothing is written to the surfacer, including the
+	// stale series markers.
+	if sw.shadow != nil {
+		sw.shadow.observe(sw.opts, em)
+		return
+	}
+
+	// Run the eviction pass before filtering, so that it's not blocked by
+	// filters.
+	sw.expireStaleSeries(ctx)
+
+	// Surfacers buffer EventMetrics in their Write method, so filtering here
+	// makes sure that filtered out EventMetrics never occupy buffer space.
+	// Filters are fetched once, so that the rest of the filtering decisions
+	// agree with the label filters even if filters are updated meanwhile.
+	f := sw.opts.Filters()
+	if !f.AllowEventMetrics(em) {
+		return
+	}
+
+	if !sw.opts.ShouldSample(em) {
 		return
 	}
 
-	if sw.opts.AddFailureMetric {
+	if sw.rateLimiter != nil && !sw.rateLimiter.Admit(pendingEM{em: em, filters: f}) {
+		return
+	}
+
+	sw.process(ctx, em, f)
+}
+
+// process runs the EventMetrics through the transformations and writes it to
+// the surfacer.
+func (sw *surfacerWrapper) process(ctx context.Context, em *metrics.EventMetrics, f *options.Filters) {
+	if f.ShouldAddFailureMetric(em) {
 		if err := transform.AddFailureMetric(em); err != nil {
 			sw.opts.Logger.Warning(err.Error())
 		}
 	}
 
+	em = sw.opts.NormalizeMetricNames(em)
+	em = sw.opts.StripIgnoredLabels(em)
+	em = sw.opts.NormalizeLabels(em)
+	em = sw.opts.HashLabelValues(em)
+	em = sw.opts.DownsampleDistributions(em)
+	if em = sw.opts.FixInvalidLatency(em); em == nil {
+		return
+	}
+	em = sw.opts.CounterContinuity(em)
+
 	if sw.opts.Config.GetExportAsGauge() && em.Kind == metrics.CUMULATIVE {
 		newEM, err := transform.CumulativeToGauge(em, sw.lvCache, sw.opts.Logger)
 		if err != nil {
@@ -139,14 +291,151 @@ func (sw *surfacerWrapper) Write(ctx context.Context, em *metrics.EventMetrics)
 		em = newEM
 	}
 
+	em = sw.valueTransformer.Apply(em)
+
+	if em = sw.opts.DropZeroValues(em); em == nil {
+		return
+	}
+
+	em, percentilesEM := sw.opts.SplitDistributionPercentiles(em)
+	for _, outEM := range []*metrics.EventMetrics{em, percentilesEM} {
+		if outEM != nil {
+			sw.emit(ctx, outEM)
+		}
+	}
+}
+
+// emit renames the metrics, adds the additional labels and writes the
+// EventMetrics to the surfacer, through the cardinality guard and the
+// grouper.
+func (sw *surfacerWrapper) emit(ctx context.Context, em *metrics.EventMetrics) {
+	em = sw.opts.ApplyRelabel(em)
+
 	// Apply additional labels
 	for _, label := range sw.opts.AdditionalLabels {
 		em.AddLabel(label[0], label[1])
 	}
 
+	keptEM, overflowEMs := sw.cardinalityGuard.Apply(em)
+	for _, outEM := range append([]*metrics.EventMetrics{keptEM}, overflowEMs...) {
+		if outEM != nil {
+			sw.staleTracker.Observe(outEM)
+			if sw.grouper != nil {
+				sw.grouper.Add(outEM)
+				continue
+			}
+			sw.write(ctx, outEM)
+		}
+	}
+}
+
+// writeGroups writes the grouped EventMetrics to the surfacer, followed by
+// the end-of-batch marker if it's enabled. With the write workers, marker is
+// written after the workers have written the whole batch.
+func (sw *surfacerWrapper) writeGroups(ctx context.Context) {
+	ems := sw.grouper.Flush()
+	if sw.endOfBatchName == "" {
+		for _, em := range ems {
+			sw.write(ctx, em)
+		}
+		return
+	}
+
+	if sw.writePool != nil {
+		sw.writePool.SubmitBatch(ctx, ems)
+	} else {
+		for _, em := range ems {
+			sw.Surfacer.Write(ctx, em)
+		}
+	}
+	if ctx.Err() == nil {
+		sw.Surfacer.Write(ctx, metrics.NewEventMetrics(time.Now()).
+			AddMetric(endOfBatchMetricName, metrics.NewInt(int64(len(ems)))).
+			AddLabel("marker", "end_of_batch").
+			AddLabel("surfacer", sw.endOfBatchName))
+	}
+}
+
+// write writes the EventMetrics to the surfacer, through the write workers if
+// they are configured.
+func (sw *surfacerWrapper) write(ctx context.Context, em *metrics.EventMetrics) {
+	if sw.writePool != nil {
+		sw.writePool.Submit(ctx, em)
+		return
+	}
 	sw.Surfacer.Write(ctx, em)
 }
 
+// writeInternal writes the EventMetrics generated by the wrapper itself, e.g.
+// the write workers' utilization. These EventMetrics go through the filters
+// and get the additional labels like other EventMetrics, and are written
+// through the write workers if they are configured. Nothing is written in the
+// shadow mode.
+func (sw *surfacerWrapper) writeInternal(ctx context.Context, em *metrics.EventMetrics) {
+	if sw.shadow != nil || !sw.opts.AllowEventMetrics(em) {
+		return
+	}
+	for _, label := range sw.opts.AdditionalLabels {
+		em.AddLabel(label[0], label[1])
+	}
+	sw.write(ctx, em)
+}
+
+// writeWorkersLoop starts the write workers, and writes their utilization
+// every interval (see writeInternal), until the context is canceled.
+func (sw *surfacerWrapper) writeWorkersLoop(ctx context.Context, interval time.Duration) {
+	sw.writePool.Start(ctx, sw.Surfacer.Write)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			sw.writeInternal(ctx, sw.writePool.EventMetrics(ts))
+		}
+	}
+}
+
+// rateLimitLoop releases the EventMetrics buffered by the rate limiter, and
+// writes the rate limiter's shed counter every interval (see writeInternal),
+// until the context is canceled.
+func (sw *surfacerWrapper) rateLimitLoop(ctx context.Context, interval time.Duration) {
+	go sw.rateLimiter.Drain(ctx, func(p pendingEM) {
+		sw.process(ctx, p.em, p.filters)
+	})
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ts := <-ticker.C:
+			sw.writeInternal(ctx, sw.rateLimiter.EventMetrics(ts))
+		}
+	}
+}
+
+// flushGroupsLoop writes the grouped EventMetrics every flush interval, until
+// the context is canceled.
+func (sw *surfacerWrapper) flushGroupsLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sw.writeGroups(ctx)
+		}
+	}
+}
+
 // SurfacerInfo encapsulates a Surfacer and related info.
 type SurfacerInfo struct {
 	Surfacer
@@ -197,6 +486,48 @@ func initSurfacer(ctx context.Context, s *surfacerpb.SurfacerDef, sType surfacer
 	if err != nil {
 		return nil, err
 	}
+	l.Debugf("Effective surfacer config: %s", prototext.MarshalOptions{}.Format(opts.EffectiveConfig()))
+
+	valueTransformer, err := transform.NewValueTransformer(s.GetValueTransform(), l)
+	if err != nil {
+		return nil, err
+	}
+
+	staleTracker, err := staleness.New(s.GetStaleAfter(), l)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter, err := ratelimit.New[pendingEM](s.GetRateLimit(), l)
+	if err != nil {
+		return nil, err
+	}
+
+	writePool, err := workerpool.New(s.GetWriteWorkers())
+	if err != nil {
+		return nil, err
+	}
+
+	cardinalityGuard, err := cardinality.New(int(s.GetMaxSeriesPerMetric()), time.Duration(s.GetSeriesBudgetResetIntervalSec())*time.Second, l)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.GetHeartbeatIntervalSec() < 0 {
+		return nil, fmt.Errorf("invalid heartbeat_interval_sec: %d", s.GetHeartbeatIntervalSec())
+	}
+
+	if s.GetFilterStatsIntervalSec() < 0 {
+		return nil, fmt.Errorf("invalid filter_stats_interval_sec: %d", s.GetFilterStatsIntervalSec())
+	}
+
+	if s.GetGroupByTarget() && s.GetGroupFlushIntervalMsec() <= 0 {
+		return nil, fmt.Errorf("group_flush_interval_msec should be positive, got %d", s.GetGroupFlushIntervalMsec())
+	}
+
+	if s.GetEndOfBatchMarker() && !s.GetGroupByTarget() {
+		return nil, fmt.Errorf("end_of_batch_marker requires group_by_target")
+	}
 
 	var surfacer Surfacer
 
@@ -232,11 +563,45 @@ func initSurfacer(ctx context.Context, s *surfacerpb.SurfacerDef, sType surfacer
 		return nil, fmt.Errorf("unknown surfacer type: %s", s.GetType())
 	}
 
-	return &surfacerWrapper{
+	sw := &surfacerWrapper{
 		Surfacer: surfacer,
 		opts:     opts,
 		lvCache:  make(map[string]*metrics.EventMetrics),
-	}, err
+
+		cardinalityGuard: cardinalityGuard,
+		valueTransformer: valueTransformer,
+		staleTracker:     staleTracker,
+		rateLimiter:      rateLimiter,
+		writePool:        writePool,
+	}
+
+	if s.GetShadowMode() && err == nil {
+		l.Warningf("Surfacer is in shadow mode, metrics will not be written.")
+		sw.shadow = &shadowStats{l: l}
+		go sw.shadow.logLoop(ctx)
+	}
+
+	if s.GetGroupByTarget() && err == nil {
+		sw.grouper = grouping.New()
+		if s.GetEndOfBatchMarker() && !s.GetShadowMode() {
+			sw.endOfBatchName = logName
+		}
+		go sw.flushGroupsLoop(ctx, time.Duration(s.GetGroupFlushIntervalMsec())*time.Millisecond)
+	}
+
+	if rateLimiter != nil && err == nil {
+		go sw.rateLimitLoop(ctx, rateLimitStatsInterval)
+	}
+
+	if writePool != nil && err == nil {
+		go sw.writeWorkersLoop(ctx, writeWorkerStatsInterval)
+	}
+
+	if s.GetHeartbeatIntervalSec() > 0 && err == nil {
+		go sw.heartbeatLoop(ctx, logName, time.Duration(s.GetHeartbeatIntervalSec())*time.Second)
+	}
+
+	return sw, err
 }
 
 // Init initializes the surfacers from the config protobufs and returns them as
@@ -293,9 +658,22 @@ func Init(ctx context.Context, sDefs []*surfacerpb.SurfacerDef) ([]*SurfacerInfo
 			})
 		}
 	}
+
+	if srvMux := runconfig.DefaultHTTPServeMux(); srvMux != nil && !webutils.IsHandled(srvMux, FiltersURL) {
+		fh := newFiltersHandler(result)
+		srvMux.Handle(FiltersURL, fh)
+		srvMux.HandleFunc(ConfigURL, fh.serveConfig)
+	}
+
 	return result, nil
 }
 
+// SetFilterBundles sets the filter bundles that surfacers can refer to
+// through the "filter_bundle" option. It should be called before Init.
+func SetFilterBundles(bundles []*surfacerpb.FilterBundle) error {
+	return options.SetFilterBundles(bundles)
+}
+
 // Register allows you to register a user defined surfacer with cloudprober.
 // Example usage:
 //
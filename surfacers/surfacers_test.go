@@ -15,16 +15,26 @@
 package surfacers
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cloudprober/cloudprober/config/runconfig"
+	"github.com/cloudprober/cloudprober/logger"
 	"github.com/cloudprober/cloudprober/metrics"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/options"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/ratelimit"
+	"github.com/cloudprober/cloudprober/surfacers/internal/common/workerpool"
 	surfacerpb "github.com/cloudprober/cloudprober/surfacers/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -173,6 +183,61 @@ func TestUserDefinedAndFiltering(t *testing.T) {
 	}
 }
 
+// bufferedTestSurfacer buffers EventMetrics like the built-in surfacers and
+// drops them if buffer is full.
+type bufferedTestSurfacer struct {
+	buf     chan *metrics.EventMetrics
+	dropped int
+}
+
+func (bs *bufferedTestSurfacer) Write(ctx context.Context, em *metrics.EventMetrics) {
+	select {
+	case bs.buf <- em:
+	default:
+		bs.dropped++
+	}
+}
+
+func TestFilteringBeforeBuffering(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 2)}
+	Register("buffered", bs)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("buffered"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+				{
+					Key:   proto.String("probe"),
+					Value: proto.String("sysvars"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+	s := si[0].Surfacer
+
+	// Filtered out EventMetrics should not take up any buffer space.
+	for i := 0; i < 10; i++ {
+		s.Write(context.Background(), testEventMetrics[1])
+	}
+	assert.Equal(t, 0, len(bs.buf), "buffered EMs after filtered writes")
+	assert.Equal(t, 0, bs.dropped, "dropped EMs after filtered writes")
+
+	for i := 0; i < 3; i++ {
+		s.Write(context.Background(), testEventMetrics[0])
+	}
+	assert.Equal(t, 2, len(bs.buf), "buffered EMs")
+	assert.Equal(t, 1, bs.dropped, "dropped EMs")
+	for len(bs.buf) > 0 {
+		assert.Equal(t, "google_homepage", (<-bs.buf).Label("probe"))
+	}
+}
+
 func TestFailureMetric(t *testing.T) {
 	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
 
@@ -292,3 +357,1179 @@ func TestAdditionalLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestCardinalityGuard(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1 := &testSurfacer{}
+	Register("s1", ts1)
+
+	configs := []*surfacerpb.SurfacerDef{
+		{
+			Name:               proto.String("s1"),
+			Type:               surfacerpb.Type_USER_DEFINED.Enum(),
+			MaxSeriesPerMetric: proto.Int32(2),
+		},
+	}
+
+	si, err := Init(context.Background(), configs)
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for _, dst := range []string{"t1", "t2", "t3", "t4"} {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(20)).
+			AddLabel("probe", "p1").
+			AddLabel("dst", dst)
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	// Overflow series keep the probe label, and the overflow counts are in
+	// their own EventMetrics.
+	var got []string
+	for _, em := range ts1.received {
+		got = append(got, fmt.Sprintf("%v:%v", em.LabelsKeys(), em.MetricsKeys()))
+	}
+	overflowTotal := "[probe overflow]:[total]"
+	overflowCount := "[probe overflow]:[surfacer_series_overflow]"
+	assert.Equal(t, []string{"[probe dst]:[total]", "[probe dst]:[total]", overflowTotal, overflowCount, overflowTotal, overflowCount}, got)
+
+	_, err = Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                         proto.String("s1"),
+			Type:                         surfacerpb.Type_USER_DEFINED.Enum(),
+			MaxSeriesPerMetric:           proto.Int32(2),
+			SeriesBudgetResetIntervalSec: proto.Int32(0),
+		},
+	})
+	assert.Error(t, err, "zero series_budget_reset_interval_sec")
+}
+
+func TestValueTransform(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1, ts2 := &testSurfacer{}, &testSurfacer{}
+	Register("s1", ts1)
+	Register("s2", ts2)
+
+	configs := []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s1"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			ValueTransform: []*surfacerpb.ValueTransform{
+				{
+					MetricName: proto.String("resp_bytes"),
+					Expression: proto.String("x / 1024"),
+				},
+			},
+		},
+		{
+			Name: proto.String("s2"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	}
+
+	si, err := Init(context.Background(), configs)
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	em := metrics.NewEventMetrics(time.Now()).
+		AddMetric("resp_bytes", metrics.NewInt(2048)).
+		AddLabel("probe", "p1")
+	for _, s := range si {
+		s.Surfacer.Write(context.Background(), em)
+	}
+
+	// Only s1 sees the transformed value.
+	assert.Equal(t, "2.000", ts1.received[0].Metric("resp_bytes").String())
+	assert.Equal(t, "2048", ts2.received[0].Metric("resp_bytes").String())
+
+	_, err = Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s1"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			ValueTransform: []*surfacerpb.ValueTransform{
+				{
+					MetricName: proto.String("resp_bytes"),
+					Expression: proto.String("x * x"),
+				},
+			},
+		},
+	})
+	assert.Error(t, err, "non-linear expression")
+}
+
+type testDeleterSurfacer struct {
+	testSurfacer
+	deleted []*metrics.EventMetrics
+}
+
+func (ts *testDeleterSurfacer) DeleteSeries(ctx context.Context, em *metrics.EventMetrics) {
+	ts.deleted = append(ts.deleted, em)
+}
+
+func TestStaleAfter(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	pushS, pullS := &testSurfacer{}, &testDeleterSurfacer{}
+	Register("push", pushS)
+	Register("pull", pullS)
+
+	staleAfter := []*surfacerpb.StaleAfter{
+		{
+			MetricName:    proto.String("queue_depth"),
+			StaleAfterSec: proto.Int32(60),
+		},
+	}
+	configs := []*surfacerpb.SurfacerDef{
+		{
+			Name:       proto.String("push"),
+			Type:       surfacerpb.Type_USER_DEFINED.Enum(),
+			StaleAfter: staleAfter,
+		},
+		{
+			Name:       proto.String("pull"),
+			Type:       surfacerpb.Type_USER_DEFINED.Enum(),
+			StaleAfter: staleAfter,
+		},
+	}
+
+	si, err := Init(context.Background(), configs)
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	now := time.Now()
+	for _, s := range si {
+		if tr := s.Surfacer.(*surfacerWrapper).staleTracker; tr != nil {
+			tr.SetClockForTest(func() time.Time { return now })
+		}
+	}
+
+	write := func(dst string) {
+		em := metrics.NewEventMetrics(now).
+			AddMetric("queue_depth", metrics.NewInt(5)).
+			AddLabel("dst", dst)
+		em.Kind = metrics.GAUGE
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	write("t1")
+	write("t2")
+	now = now.Add(30 * time.Second)
+	write("t2")
+
+	// t1 expires 60s after its last write, while t2 keeps getting refreshed.
+	now = now.Add(31 * time.Second)
+	write("t2")
+
+	assert.Len(t, pullS.deleted, 1)
+	assert.Equal(t, "t1", pullS.deleted[0].Label("dst"))
+	assert.Len(t, pullS.received, 4, "pull surfacer shouldn't get zero marker")
+
+	assert.Len(t, pushS.received, 5)
+	marker := pushS.received[3]
+	assert.Equal(t, "t1", marker.Label("dst"))
+	assert.Equal(t, "0", marker.Metric("queue_depth").String())
+	assert.Equal(t, now, marker.Timestamp)
+
+	_, err = Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("push"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			StaleAfter: []*surfacerpb.StaleAfter{
+				{MetricName: proto.String("queue_depth"), StaleAfterSec: proto.Int32(-1)},
+			},
+		},
+	})
+	assert.Error(t, err, "negative stale_after_sec")
+}
+
+func TestGroupByTarget(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	groupedS, ungroupedS := &testSurfacer{}, &testSurfacer{}
+	Register("grouped", groupedS)
+	Register("ungrouped", ungroupedS)
+
+	configs := []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("grouped"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			GroupByTarget:          proto.Bool(true),
+			GroupFlushIntervalMsec: proto.Int32(3600000),
+		},
+		{
+			Name: proto.String("ungrouped"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, configs)
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	ts := time.Now()
+	for _, dst := range []string{"t1", "t2"} {
+		for _, m := range []string{"total", "success"} {
+			em := metrics.NewEventMetrics(ts).
+				AddMetric(m, metrics.NewInt(10)).
+				AddLabel("probe", "p1").
+				AddLabel("dst", dst)
+			for _, s := range si {
+				s.Surfacer.Write(ctx, em)
+			}
+		}
+	}
+
+	// Ungrouped is the default, EventMetrics are written as they come.
+	assert.Len(t, ungroupedS.received, 4)
+	assert.Nil(t, si[1].Surfacer.(*surfacerWrapper).grouper)
+
+	// Grouped surfacer gets nothing until flush.
+	assert.Len(t, groupedS.received, 0)
+	si[0].Surfacer.(*surfacerWrapper).writeGroups(ctx)
+
+	var got []string
+	for _, em := range groupedS.received {
+		got = append(got, em.Label("dst")+":"+em.Metric("total").String()+","+em.Metric("success").String())
+	}
+	assert.Equal(t, []string{"t1:10,10", "t2:10,10"}, got)
+
+	_, err = Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("grouped"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			GroupByTarget:          proto.Bool(true),
+			GroupFlushIntervalMsec: proto.Int32(0),
+		},
+	})
+	assert.Error(t, err, "zero group_flush_interval_msec")
+}
+
+func TestEndOfBatchMarker(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	markedS, unmarkedS := &testSurfacer{}, &testSurfacer{}
+	Register("marked", markedS)
+	Register("unmarked", unmarkedS)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("marked"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			GroupByTarget:          proto.Bool(true),
+			GroupFlushIntervalMsec: proto.Int32(3600000),
+			EndOfBatchMarker:       proto.Bool(true),
+		},
+		{
+			Name:                   proto.String("unmarked"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			GroupByTarget:          proto.Bool(true),
+			GroupFlushIntervalMsec: proto.Int32(3600000),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	isMarker := func(em *metrics.EventMetrics) bool {
+		return em.Label("marker") == "end_of_batch"
+	}
+
+	writeAndFlush := func(dsts ...string) {
+		for _, dst := range dsts {
+			em := metrics.NewEventMetrics(time.Now()).
+				AddMetric("total", metrics.NewInt(10)).
+				AddLabel("probe", "p1").
+				AddLabel("dst", dst)
+			for _, s := range si {
+				s.Surfacer.Write(ctx, em)
+			}
+		}
+		// Required surfacers are added at the end, flush ours only.
+		for _, s := range si[:2] {
+			s.Surfacer.(*surfacerWrapper).writeGroups(ctx)
+		}
+	}
+
+	// Two flushes, the second one with an empty batch.
+	writeAndFlush("t1", "t2")
+	writeAndFlush()
+
+	var got []string
+	for _, em := range markedS.received {
+		if isMarker(em) {
+			got = append(got, "marker:"+em.Label("surfacer")+":"+em.Metric(endOfBatchMetricName).String())
+			continue
+		}
+		got = append(got, em.Label("dst"))
+	}
+	assert.Equal(t, []string{"t1", "t2", "marker:marked:2", "marker:marked:0"}, got)
+
+	for _, em := range unmarkedS.received {
+		assert.False(t, isMarker(em), "unexpected marker: %s", em.String())
+	}
+	assert.Len(t, unmarkedS.received, 2)
+
+	_, err = Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:             proto.String("marked"),
+			Type:             surfacerpb.Type_USER_DEFINED.Enum(),
+			EndOfBatchMarker: proto.Bool(true),
+		},
+	})
+	assert.Error(t, err, "end_of_batch_marker without group_by_target")
+}
+
+// orderedSurfacer records the EventMetrics in the order their writes
+// finish. Writes take a few milliseconds, varying by target, to shuffle them
+// across the write workers.
+type orderedSurfacer struct {
+	mu       sync.Mutex
+	received []*metrics.EventMetrics
+}
+
+func (s *orderedSurfacer) Write(ctx context.Context, em *metrics.EventMetrics) {
+	if em.Label("marker") == "" {
+		time.Sleep(time.Duration(len(em.Label("dst"))%4) * time.Millisecond)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.received = append(s.received, em)
+}
+
+func TestEndOfBatchMarkerWriteWorkers(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	s := &orderedSurfacer{}
+	Register("marked", s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("marked"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			GroupByTarget:          proto.Bool(true),
+			GroupFlushIntervalMsec: proto.Int32(3600000),
+			EndOfBatchMarker:       proto.Bool(true),
+			WriteWorkers:           &surfacerpb.WriteWorkers{Workers: proto.Int32(4)},
+		},
+	})
+	require.NoError(t, err)
+	sw := si[0].Surfacer.(*surfacerWrapper)
+
+	const numBatches, batchSize = 5, 10
+	for b := 0; b < numBatches; b++ {
+		for i := 0; i < batchSize; i++ {
+			sw.Write(ctx, metrics.NewEventMetrics(time.Now()).
+				AddMetric("total", metrics.NewInt(int64(b))).
+				AddLabel("probe", "p1").
+				AddLabel("dst", strings.Repeat("t", i+1)))
+		}
+		sw.writeGroups(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	require.Len(t, s.received, numBatches*(batchSize+1))
+	for b := 0; b < numBatches; b++ {
+		batch := s.received[b*(batchSize+1) : (b+1)*(batchSize+1)]
+		for _, em := range batch[:batchSize] {
+			assert.Equal(t, int64(b), em.Metric("total").(*metrics.Int).Int64(), "batch %d: unexpected EventMetrics: %s", b, em.String())
+		}
+		marker := batch[batchSize]
+		assert.Equal(t, "end_of_batch", marker.Label("marker"), "batch %d: last write should be the marker", b)
+		assert.Equal(t, "10", marker.Metric(endOfBatchMetricName).String())
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1 := &testSurfacer{}
+	Register("s1", ts1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s1"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			RateLimit: &surfacerpb.RateLimit{
+				EventsPerSec: proto.Int32(1),
+				Burst:        proto.Int32(3),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(int64(i))).
+			AddLabel("dst", "t1")
+		si[0].Surfacer.Write(ctx, em)
+	}
+
+	assert.Len(t, ts1.received, 3, "writes should be capped at burst")
+	rl := si[0].Surfacer.(*surfacerWrapper).rateLimiter
+	assert.Equal(t, int64(7), rl.Shed())
+
+	_, err = Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:      proto.String("s1"),
+			Type:      surfacerpb.Type_USER_DEFINED.Enum(),
+			RateLimit: &surfacerpb.RateLimit{EventsPerSec: proto.Int32(0)},
+		},
+	})
+	assert.Error(t, err, "zero events_per_sec")
+}
+
+func TestFiltersUpdateRateLimitBuffer(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)}
+	Register("s-buffer", bs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:             proto.String("s-buffer"),
+			Type:             surfacerpb.Type_USER_DEFINED.Enum(),
+			AddFailureMetric: proto.Bool(true),
+			RateLimit: &surfacerpb.RateLimit{
+				EventsPerSec: proto.Int32(10),
+				Burst:        proto.Int32(1),
+				Policy:       surfacerpb.RateLimit_BUFFER.Enum(),
+				BufferSize:   proto.Int32(10),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		si[0].Surfacer.Write(ctx, metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(int64(i+2))).
+			AddMetric("success", metrics.NewInt(int64(i+1))).
+			AddLabel("probe", "p1"))
+	}
+
+	// Update the filters while the second EventMetrics is still buffered in
+	// the rate limiter. It should still be processed with the filters it was
+	// allowed with, which allow the failure metric.
+	sw := si[0].Surfacer.(*surfacerWrapper)
+	require.NoError(t, sw.opts.Reload(&surfacerpb.SurfacerDef{
+		IgnoreMetricsWithName: proto.String("^failure$"),
+	}))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case em := <-bs.buf:
+			assert.Equal(t, []string{"total", "success", "failure"}, em.MetricsKeys(), "EventMetrics %d", i)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for EventMetrics %d", i)
+		}
+	}
+}
+
+func TestRateLimitStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		sdef      *surfacerpb.SurfacerDef
+		shadow    bool
+		wantWrite bool
+	}{
+		{
+			name:      "default",
+			sdef:      &surfacerpb.SurfacerDef{},
+			wantWrite: true,
+		},
+		{
+			name:   "shadow",
+			sdef:   &surfacerpb.SurfacerDef{},
+			shadow: true,
+		},
+		{
+			name: "filtered",
+			sdef: &surfacerpb.SurfacerDef{
+				AllowMetricsWithLabel: []*surfacerpb.LabelFilter{
+					{
+						Key:   proto.String("probe"),
+						Value: proto.String("p1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			rl, err := ratelimit.New[pendingEM](&surfacerpb.RateLimit{EventsPerSec: proto.Int32(1)}, nil)
+			require.NoError(t, err)
+
+			bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)}
+			sw := &surfacerWrapper{
+				Surfacer:    bs,
+				opts:        options.BuildOptionsForTest(test.sdef),
+				rateLimiter: rl,
+			}
+			sw.opts.AdditionalLabels = [][2]string{{"env", "prod"}}
+			if test.shadow {
+				sw.shadow = &shadowStats{}
+			}
+			go sw.rateLimitLoop(ctx, 10*time.Millisecond)
+
+			time.Sleep(100 * time.Millisecond)
+			if !test.wantWrite {
+				assert.Len(t, bs.buf, 0, "rate limiter stats")
+				return
+			}
+			require.NotEmpty(t, bs.buf)
+			em := <-bs.buf
+			assert.NotNil(t, em.Metric(ratelimit.ShedMetricName), "rate limiter stats: %s", em.String())
+			assert.Equal(t, "prod", em.Label("env"), "additional label")
+		})
+	}
+}
+
+// slowSurfacer takes 100ms to write each EventMetrics.
+type slowSurfacer struct {
+	written atomic.Int32
+}
+
+func (ss *slowSurfacer) Write(ctx context.Context, em *metrics.EventMetrics) {
+	time.Sleep(100 * time.Millisecond)
+	ss.written.Add(1)
+}
+
+func TestWriteWorkers(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ss := &slowSurfacer{}
+	Register("slow", ss)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("slow"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			WriteWorkers: &surfacerpb.WriteWorkers{
+				Workers:             proto.Int32(4),
+				PreserveSeriesOrder: proto.Bool(false),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(int64(i))).
+			AddLabel("dst", fmt.Sprintf("t%d", i))
+		si[0].Surfacer.Write(ctx, em)
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond, "writes should not block")
+
+	assert.Eventually(t, func() bool { return ss.written.Load() == 4 }, 300*time.Millisecond, 10*time.Millisecond, "writes should run in parallel")
+
+	_, err = Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:         proto.String("slow"),
+			Type:         surfacerpb.Type_USER_DEFINED.Enum(),
+			WriteWorkers: &surfacerpb.WriteWorkers{Workers: proto.Int32(0)},
+		},
+	})
+	assert.Error(t, err, "zero workers")
+}
+
+func TestWriteWorkersStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		sdef      *surfacerpb.SurfacerDef
+		shadow    bool
+		wantWrite bool
+	}{
+		{
+			name:      "default",
+			sdef:      &surfacerpb.SurfacerDef{},
+			wantWrite: true,
+		},
+		{
+			name:   "shadow_mode",
+			sdef:   &surfacerpb.SurfacerDef{},
+			shadow: true,
+		},
+		{
+			name: "filtered",
+			sdef: &surfacerpb.SurfacerDef{
+				AllowMetricsWithLabel: []*surfacerpb.LabelFilter{
+					{
+						Key:   proto.String("probe"),
+						Value: proto.String("p1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pool, err := workerpool.New(&surfacerpb.WriteWorkers{Workers: proto.Int32(2)})
+			require.NoError(t, err)
+
+			s := &orderedSurfacer{}
+			sw := &surfacerWrapper{
+				Surfacer:  s,
+				opts:      options.BuildOptionsForTest(test.sdef),
+				writePool: pool,
+			}
+			sw.opts.AdditionalLabels = [][2]string{{"env", "prod"}}
+			if test.shadow {
+				sw.shadow = &shadowStats{}
+			}
+			go sw.writeWorkersLoop(ctx, 10*time.Millisecond)
+
+			time.Sleep(100 * time.Millisecond)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if !test.wantWrite {
+				assert.Empty(t, s.received, "write workers stats")
+				return
+			}
+			require.NotEmpty(t, s.received)
+			em := s.received[0]
+			assert.NotNil(t, em.Metric(workerpool.UtilizationMetricName), "write workers stats: %s", em.String())
+			assert.Equal(t, "prod", em.Label("env"), "additional label")
+		})
+	}
+}
+
+func TestShadowMode(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1 := &testSurfacer{}
+	Register("s1", ts1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:       proto.String("s1"),
+			Type:       surfacerpb.Type_USER_DEFINED.Enum(),
+			ShadowMode: proto.Bool(true),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+				{
+					Key:   proto.String("probe"),
+					Value: proto.String("sysvars"),
+				},
+			},
+			IgnoreMetricsWithName: proto.String("^timeouts$"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	ss := si[0].Surfacer.(*surfacerWrapper).shadow
+	ss.l = logger.New(logger.WithWriter(&buf))
+
+	ems := []*metrics.EventMetrics{
+		metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(10)).
+			AddLabel("probe", "p1"),
+		metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(10)).
+			AddMetric("timeouts", metrics.NewInt(2)).
+			AddLabel("probe", "p2"),
+		metrics.NewEventMetrics(time.Now()).
+			AddMetric("uptime", metrics.NewInt(60)).
+			AddLabel("probe", "sysvars"),
+	}
+	for _, em := range ems {
+		si[0].Surfacer.Write(ctx, em)
+	}
+
+	assert.Empty(t, ts1.received, "no writes in shadow mode")
+	assert.Equal(t, int64(3), ss.total.Load())
+	assert.Equal(t, int64(1), ss.droppedEMs.Load())
+	assert.Equal(t, int64(1), ss.droppedMetrics.Load())
+
+	logs := buf.String()
+	assert.Contains(t, logs, "would drop metrics [timeouts]")
+	assert.Contains(t, logs, "would drop EventMetrics")
+	assert.Contains(t, logs, "probe=sysvars")
+	assert.NotContains(t, logs, "probe=p1", "allowed EventMetrics are logged only at debug level")
+}
+
+func TestHashLabelValues(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1, ts2 := &testSurfacer{}, &testSurfacer{}
+	Register("s1", ts1)
+	Register("s2", ts2)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:            proto.String("s1"),
+			Type:            surfacerpb.Type_USER_DEFINED.Enum(),
+			HashLabelValues: []string{"user"},
+		},
+		{
+			Name: proto.String("s2"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for _, user := range []string{"alice@example.com", "bob@example.com", "alice@example.com"} {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("probe", "p1").
+			AddLabel("user", user)
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	var hashed, raw []string
+	for _, em := range ts1.received {
+		assert.Equal(t, "p1", em.Label("probe"))
+		hashed = append(hashed, em.Label("user"))
+	}
+	for _, em := range ts2.received {
+		raw = append(raw, em.Label("user"))
+	}
+
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com", "alice@example.com"}, raw, "other surfacers get raw values")
+	require.Len(t, hashed, 3)
+	assert.Len(t, hashed[0], 16)
+	assert.NotContains(t, hashed[0], "alice")
+	assert.NotEqual(t, hashed[0], hashed[1])
+	assert.Equal(t, hashed[0], hashed[2], "hash is stable")
+}
+
+func TestNormalizeLabels(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1, ts2 := &testSurfacer{}, &testSurfacer{}
+	Register("s1", ts1)
+	Register("s2", ts2)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s1"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			LabelValueNormalization: &surfacerpb.LabelValueNormalization{
+				Lowercase: proto.Bool(true),
+			},
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+				{Key: proto.String("region"), Value: proto.String("us-east")},
+			},
+			HashLabelValues: []string{"region"},
+		},
+		{
+			Name: proto.String("s2"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for _, region := range []string{" US-East ", "US-EAST", "us-east"} {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("region", region)
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	var raw []string
+	for _, em := range ts2.received {
+		raw = append(raw, em.Label("region"))
+	}
+	assert.Equal(t, []string{" US-East ", "US-EAST", "us-east"}, raw, "other surfacers get raw values")
+
+	// Filters see the original values, so only "us-east" is dropped. Values
+	// are normalized before hashing, so the other two get the same hash.
+	require.Len(t, ts1.received, 2)
+	assert.Equal(t, ts1.received[0].Label("region"), ts1.received[1].Label("region"))
+	assert.NotEqual(t, "us-east", ts1.received[0].Label("region"))
+}
+
+func TestDistributionPercentiles(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts := &testSurfacer{}
+	Register("s1", ts)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s1"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			DistributionPercentiles: &surfacerpb.DistributionPercentiles{
+				Percentile: []float64{50, 99},
+			},
+			// Percentiles go through the rest of the processing too.
+			RelabelRule: []*surfacerpb.RelabelRule{
+				{SourceRegex: proto.String("latency_percentile"), Replacement: proto.String("latency_ms")},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	d := metrics.NewDistribution([]float64{0, 10, 20})
+	for _, s := range []float64{5, 5, 15, 15} {
+		d.AddSample(s)
+	}
+	si[0].Surfacer.Write(context.Background(), metrics.NewEventMetrics(time.Now()).
+		AddMetric("total", metrics.NewInt(4)).
+		AddMetric("latency", d).
+		AddLabel("probe", "p1"))
+
+	require.Len(t, ts.received, 2)
+	assert.Equal(t, []string{"total"}, ts.received[0].MetricsKeys())
+
+	pEM := ts.received[1]
+	assert.Equal(t, metrics.Kind(metrics.GAUGE), pEM.Kind)
+	assert.Equal(t, "p1", pEM.Label("probe"))
+	m := pEM.Metric("latency_ms").(*metrics.Map[float64])
+	assert.Equal(t, []string{"p50", "p99"}, m.Keys())
+	assert.InDelta(t, 10, m.GetKey("p50"), 1e-9)
+	assert.InDelta(t, 19.8, m.GetKey("p99"), 1e-9)
+}
+
+func TestRouteBySurfacersLabel(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts1, ts2, ts3 := &testSurfacer{}, &testSurfacer{}, &testSurfacer{}
+	Register("s1", ts1)
+	Register("s2", ts2)
+	Register("s3", ts3)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                  proto.String("s1"),
+			Type:                  surfacerpb.Type_USER_DEFINED.Enum(),
+			RouteBySurfacersLabel: proto.Bool(true),
+		},
+		{
+			Name:                  proto.String("s2"),
+			Type:                  surfacerpb.Type_USER_DEFINED.Enum(),
+			RouteBySurfacersLabel: proto.Bool(true),
+		},
+		{
+			// Doesn't route by the label, gets everything.
+			Name: proto.String("s3"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for _, probe := range []string{"p_s1", "p_s2", "p_both", "p_none"} {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("probe", probe)
+		switch probe {
+		case "p_s1":
+			em.AddLabel("surfacers", "s1")
+		case "p_s2":
+			em.AddLabel("surfacers", "s2")
+		case "p_both":
+			em.AddLabel("surfacers", "s1,s2")
+		}
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	probes := func(ts *testSurfacer) []string {
+		var out []string
+		for _, em := range ts.received {
+			out = append(out, em.Label("probe"))
+		}
+		return out
+	}
+	assert.Equal(t, []string{"p_s1", "p_both", "p_none"}, probes(ts1))
+	assert.Equal(t, []string{"p_s2", "p_both", "p_none"}, probes(ts2))
+	assert.Equal(t, []string{"p_s1", "p_s2", "p_both", "p_none"}, probes(ts3))
+}
+
+func TestAllowMetricsWithValue(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	alerting, storage := &testSurfacer{}, &testSurfacer{}
+	Register("s-alerting", alerting)
+	Register("s-storage", storage)
+
+	successFilter := func(op surfacerpb.ValueFilter_Op) []*surfacerpb.ValueFilter {
+		return []*surfacerpb.ValueFilter{{MetricName: proto.String("success"), Op: op.Enum(), Value: proto.Float64(0)}}
+	}
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:                  proto.String("s-alerting"),
+			Type:                  surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowMetricsWithValue: successFilter(surfacerpb.ValueFilter_EQ),
+		},
+		{
+			Name:                  proto.String("s-storage"),
+			Type:                  surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowMetricsWithValue: successFilter(surfacerpb.ValueFilter_GT),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	for _, probe := range []string{"p_fail", "p_ok", "p_no_success"} {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("probe", probe)
+		switch probe {
+		case "p_fail":
+			em.AddMetric("success", metrics.NewInt(0))
+		case "p_ok":
+			em.AddMetric("success", metrics.NewInt(1))
+		}
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	probes := func(ts *testSurfacer) []string {
+		var out []string
+		for _, em := range ts.received {
+			out = append(out, em.Label("probe"))
+		}
+		return out
+	}
+	assert.Equal(t, []string{"p_fail"}, probes(alerting))
+	assert.Equal(t, []string{"p_ok"}, probes(storage))
+}
+
+func TestAllowMetricsWithSuccessState(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	failures, all := &testSurfacer{}, &testSurfacer{}
+	Register("s-failures", failures)
+	Register("s-all", all)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("s-failures"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			AllowMetricsWithSuccessState: &surfacerpb.SuccessFilter{
+				State: surfacerpb.SuccessFilter_FAILURES.Enum(),
+			},
+		},
+		{
+			Name: proto.String("s-all"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	// Cumulative total and success for each probe, across exports. p1 fails
+	// only between the first and the second exports.
+	runs := []struct {
+		probe          string
+		total, success int64
+	}{
+		{"p1", 10, 10},
+		{"p2", 10, 10},
+		{"p1", 20, 19},
+		{"p2", 20, 20},
+		{"p1", 30, 29},
+		{"p2", 30, 30},
+	}
+	for _, r := range runs {
+		em := metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(r.total)).
+			AddMetric("success", metrics.NewInt(r.success)).
+			AddLabel("probe", r.probe)
+		for _, s := range si {
+			s.Surfacer.Write(context.Background(), em)
+		}
+	}
+	// Metrics without success and total, e.g. system variables.
+	for _, s := range si {
+		s.Surfacer.Write(context.Background(), metrics.NewEventMetrics(time.Now()).AddMetric("uptime", metrics.NewInt(100)))
+	}
+
+	received := func(ts *testSurfacer) []string {
+		var out []string
+		for _, em := range ts.received {
+			if em.Metric("total") == nil {
+				out = append(out, "no_total")
+				continue
+			}
+			out = append(out, fmt.Sprintf("%s:%s", em.Label("probe"), em.Metric("total").String()))
+		}
+		return out
+	}
+	assert.Equal(t, []string{"p1:20"}, received(failures))
+	assert.Equal(t, []string{"p1:10", "p2:10", "p1:20", "p2:20", "p1:30", "p2:30", "no_total"}, received(all))
+}
+
+func TestSampleRate(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	ts := &testSurfacer{}
+	Register("s-sampled", ts)
+
+	si, err := Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:          proto.String("s-sampled"),
+			Type:          surfacerpb.Type_USER_DEFINED.Enum(),
+			SampleRate:    proto.Float64(0.5),
+			SampleByLabel: []string{"dst"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected initialization error: %v", err)
+	}
+
+	const numTargets, numRuns = 100, 3
+	for run := 0; run < numRuns; run++ {
+		for i := 0; i < numTargets; i++ {
+			em := metrics.NewEventMetrics(time.Now()).
+				AddMetric("total", metrics.NewInt(int64(run))).
+				AddLabel("probe", fmt.Sprintf("p%d", run)).
+				AddLabel("dst", fmt.Sprintf("target-%d", i))
+			si[0].Surfacer.Write(context.Background(), em)
+		}
+	}
+
+	// A target's series are either all kept or all dropped.
+	perTarget := make(map[string]int)
+	for _, em := range ts.received {
+		perTarget[em.Label("dst")]++
+	}
+	for dst, n := range perTarget {
+		assert.Equal(t, numRuns, n, "EventMetrics received for %s", dst)
+	}
+	assert.Greater(t, len(perTarget), 0)
+	assert.Less(t, len(perTarget), numTargets)
+
+	_, err = Init(context.Background(), []*surfacerpb.SurfacerDef{
+		{
+			Name:       proto.String("s-sampled"),
+			Type:       surfacerpb.Type_USER_DEFINED.Enum(),
+			SampleRate: proto.Float64(0),
+		},
+	})
+	assert.ErrorContains(t, err, "sample_rate")
+}
+
+func TestFilterStats(t *testing.T) {
+	runconfig.SetDefaultHTTPServeMux(http.NewServeMux())
+
+	bs := &bufferedTestSurfacer{buf: make(chan *metrics.EventMetrics, 10)}
+	Register("filter-stats", bs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	si, err := Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name: proto.String("filter-stats"),
+			Type: surfacerpb.Type_USER_DEFINED.Enum(),
+			IgnoreMetricsWithLabel: []*surfacerpb.LabelFilter{
+				{Key: proto.String("probe"), Value: proto.String("noisy")},
+			},
+			FilterStatsIntervalSec: proto.Int32(1),
+		},
+		{
+			Name:                   proto.String("filter-stats"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			FilterStatsIntervalSec: proto.Int32(1),
+			ShadowMode:             proto.Bool(true),
+		},
+	})
+	require.NoError(t, err)
+
+	for _, probe := range []string{"homepage", "noisy", "noisy"} {
+		si[0].Surfacer.Write(ctx, metrics.NewEventMetrics(time.Now()).
+			AddMetric("total", metrics.NewInt(1)).
+			AddLabel("probe", probe))
+	}
+	assert.Len(t, bs.buf, 1, "written EventMetrics")
+
+	// Stats go to the internal metrics channel, not to the surfacer itself.
+	dataChan := make(chan *metrics.EventMetrics, 10)
+	go si[0].Surfacer.(*surfacerWrapper).filterStatsLoop(ctx, "filter-stats", 10*time.Millisecond, dataChan)
+
+	var em *metrics.EventMetrics
+	select {
+	case em = <-dataChan:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the filter stats")
+	}
+	assert.Equal(t, "filter-stats", em.Label("surfacer"))
+	assert.Equal(t, "sysvars", em.Label("probe"))
+	assert.Equal(t, int64(1), em.Metric(filterAllowedMetricName).(*metrics.Int).Int64())
+	dropped := em.Metric(filterDroppedMetricName).(*metrics.Map[int64])
+	assert.Equal(t, int64(2), dropped.GetKey("label"))
+	assert.Equal(t, int64(0), dropped.GetKey("name"))
+	assert.Len(t, bs.buf, 1, "written EventMetrics after the filter stats")
+
+	// StartFilterStats skips the surfacers in shadow mode.
+	dataChan = make(chan *metrics.EventMetrics, 10)
+	StartFilterStats(ctx, si[:2], dataChan)
+	select {
+	case em = <-dataChan:
+		assert.Equal(t, "filter-stats", em.Label("surfacer"))
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for the filter stats")
+	}
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, dataChan, 0, "shadow mode surfacer's filter stats")
+
+	_, err = Init(ctx, []*surfacerpb.SurfacerDef{
+		{
+			Name:                   proto.String("filter-stats"),
+			Type:                   surfacerpb.Type_USER_DEFINED.Enum(),
+			FilterStatsIntervalSec: proto.Int32(-1),
+		},
+	})
+	assert.Error(t, err, "negative filter_stats_interval_sec")
+}
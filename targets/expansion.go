@@ -0,0 +1,95 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/cloudprober/cloudprober/logger"
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	targetspb "github.com/cloudprober/cloudprober/targets/proto"
+)
+
+// maxExpansionCount is the maximum number of targets a target can be expanded
+// into.
+const maxExpansionCount = 1000
+
+// expander expands a target into multiple targets using a name template.
+type expander struct {
+	tmpl       *template.Template
+	start      int
+	count      int
+	indexLabel string
+	l          *logger.Logger
+}
+
+type expansionData struct {
+	Name   string
+	Index  int
+	Labels map[string]string
+}
+
+func newExpander(c *targetspb.TargetsExpansion, l *logger.Logger) (*expander, error) {
+	if c.GetCount() <= 0 || c.GetCount() > maxExpansionCount {
+		return nil, fmt.Errorf("invalid expansion count: %d, should be > 0 and <= %d", c.GetCount(), maxExpansionCount)
+	}
+
+	tmpl, err := template.New("expansion").Option("missingkey=error").Parse(c.GetNameTemplate())
+	if err != nil {
+		return nil, fmt.Errorf("invalid expansion name_template (%s): %v", c.GetNameTemplate(), err)
+	}
+
+	return &expander{
+		tmpl:       tmpl,
+		start:      int(c.GetStartIndex()),
+		count:      int(c.GetCount()),
+		indexLabel: c.GetIndexLabel(),
+		l:          l,
+	}, nil
+}
+
+// expand returns the expanded endpoints for the given endpoint. Expanded
+// endpoints keep the original endpoint's port and labels, but not its IP
+// address, as they are expected to resolve to different addresses; they are
+// resolved using DNS (see targets.Resolve).
+func (e *expander) expand(ep endpoint.Endpoint) []endpoint.Endpoint {
+	result := make([]endpoint.Endpoint, 0, e.count)
+
+	for i := e.start; i < e.start+e.count; i++ {
+		var b strings.Builder
+		if err := e.tmpl.Execute(&b, &expansionData{Name: ep.Name, Index: i, Labels: ep.Labels}); err != nil {
+			e.l.Warningf("Error expanding target %s for index %d: %v", ep.Name, i, err)
+			continue
+		}
+
+		labels := make(map[string]string, len(ep.Labels)+1)
+		for k, v := range ep.Labels {
+			labels[k] = v
+		}
+		labels[e.indexLabel] = strconv.Itoa(i)
+
+		result = append(result, endpoint.Endpoint{
+			Name:        b.String(),
+			Labels:      labels,
+			LastUpdated: ep.LastUpdated,
+			Port:        ep.Port,
+		})
+	}
+
+	return result
+}
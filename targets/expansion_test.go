@@ -0,0 +1,187 @@
+// Copyright 2026 The Cloudprober Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targets
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/cloudprober/cloudprober/targets/endpoint"
+	targetspb "github.com/cloudprober/cloudprober/targets/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNewExpander(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    *targetspb.TargetsExpansion
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			conf: &targetspb.TargetsExpansion{
+				NameTemplate: proto.String("shard-{{.Index}}.{{.Name}}"),
+				Count:        proto.Int32(2),
+			},
+		},
+		{
+			name: "zero_count",
+			conf: &targetspb.TargetsExpansion{
+				NameTemplate: proto.String("shard-{{.Index}}.{{.Name}}"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "count_too_large",
+			conf: &targetspb.TargetsExpansion{
+				NameTemplate: proto.String("shard-{{.Index}}.{{.Name}}"),
+				Count:        proto.Int32(maxExpansionCount + 1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad_template",
+			conf: &targetspb.TargetsExpansion{
+				NameTemplate: proto.String("shard-{{.Index"),
+				Count:        proto.Int32(2),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newExpander(tt.conf, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newExpander() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	e, err := newExpander(&targetspb.TargetsExpansion{
+		NameTemplate: proto.String("{{.Labels.prefix}}-{{.Index}}.{{.Name}}"),
+		Count:        proto.Int32(3),
+		StartIndex:   proto.Int32(1),
+	}, nil)
+	assert.NoError(t, err)
+
+	ep := endpoint.Endpoint{
+		Name:   "db.example.com",
+		Port:   5432,
+		IP:     net.ParseIP("10.1.1.1"),
+		Labels: map[string]string{"prefix": "shard"},
+	}
+
+	got := e.expand(ep)
+	assert.Equal(t, []endpoint.Endpoint{
+		{
+			Name:   "shard-1.db.example.com",
+			Port:   5432,
+			Labels: map[string]string{"prefix": "shard", "expansion_index": "1"},
+		},
+		{
+			Name:   "shard-2.db.example.com",
+			Port:   5432,
+			Labels: map[string]string{"prefix": "shard", "expansion_index": "2"},
+		},
+		{
+			Name:   "shard-3.db.example.com",
+			Port:   5432,
+			Labels: map[string]string{"prefix": "shard", "expansion_index": "3"},
+		},
+	}, got)
+
+	// Original endpoint's labels are not modified.
+	assert.Equal(t, map[string]string{"prefix": "shard"}, ep.Labels)
+}
+
+func TestListWithExpansion(t *testing.T) {
+	targetsDef := &targetspb.TargetsDef{
+		Type: &targetspb.TargetsDef_HostNames{
+			HostNames: "db1.example.com,web.example.com",
+		},
+		Regex: proto.String("db.*"),
+		Expansion: &targetspb.TargetsExpansion{
+			NameTemplate: proto.String("shard-{{.Index}}.{{.Name}}"),
+			Count:        proto.Int32(4),
+			IndexLabel:   proto.String("shard"),
+		},
+	}
+
+	tgts, err := New(targetsDef, nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	eps := tgts.ListEndpoints()
+	assert.Equal(t, []string{
+		"shard-0.db1.example.com",
+		"shard-1.db1.example.com",
+		"shard-2.db1.example.com",
+		"shard-3.db1.example.com",
+	}, endpoint.NamesFromEndpoints(eps))
+
+	for i, ep := range eps {
+		assert.Equal(t, map[string]string{"shard": strconv.Itoa(i)}, ep.Labels)
+	}
+}
+
+// testLister lists and resolves a fixed set of endpoints, like the RDS or file
+// targets do.
+type testLister struct {
+	eps []endpoint.Endpoint
+}
+
+func (tl *testLister) ListEndpoints() []endpoint.Endpoint {
+	return tl.eps
+}
+
+func (tl *testLister) Resolve(name string, ipVer int) (net.IP, error) {
+	for _, ep := range tl.eps {
+		if ep.Name == name {
+			return ep.IP, nil
+		}
+	}
+	return nil, fmt.Errorf("no IP address for the resource: %s", name)
+}
+
+func TestResolveWithExpansion(t *testing.T) {
+	SetSharedTargets("expansion-test", &testLister{
+		eps: []endpoint.Endpoint{{Name: "db", IP: net.ParseIP("10.1.1.1")}},
+	})
+
+	tgts, err := New(&targetspb.TargetsDef{
+		Type: &targetspb.TargetsDef_SharedTargets{SharedTargets: "expansion-test"},
+		Expansion: &targetspb.TargetsExpansion{
+			NameTemplate: proto.String("127.0.0.{{.Index}}"),
+			Count:        proto.Int32(2),
+			StartIndex:   proto.Int32(1),
+		},
+	}, nil, nil, nil, nil)
+	assert.NoError(t, err)
+
+	eps := tgts.ListEndpoints()
+	assert.Equal(t, []string{"127.0.0.1", "127.0.0.2"}, endpoint.NamesFromEndpoints(eps))
+
+	// Expanded targets are not known to the shared targets' resolver, they
+	// are resolved using DNS.
+	for _, ep := range eps {
+		ip, err := ep.Resolve(4, tgts)
+		assert.NoError(t, err, "resolving %s", ep.Name)
+		assert.Equal(t, ep.Name, ip.String())
+	}
+}
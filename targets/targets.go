@@ -121,9 +121,11 @@ func (d *dummy) Resolve(name string, ipVer int) (net.IP, error) {
 type targets struct {
 	lister          endpoint.Lister
 	resolver        endpoint.Resolver
+	dnsResolver     endpoint.Resolver // Used for the expanded targets.
 	staticEndpoints []endpoint.Endpoint
 	re              *regexp.Regexp
 	ldLister        endpoint.Lister
+	expander        *expander
 	l               *logger.Logger
 	resolverIP      string // Used for testing
 }
@@ -131,7 +133,13 @@ type targets struct {
 // Resolve either resolves a target using the core resolver, or returns an error
 // if no core resolver was provided. Currently all target types provide a
 // resolver.
+//
+// If expansion is configured, targets are resolved using DNS, as the expanded
+// targets are new host names that the core resolver doesn't know about.
 func (t *targets) Resolve(name string, ipVer int) (net.IP, error) {
+	if t.expander != nil {
+		return t.dnsResolver.Resolve(name, ipVer)
+	}
 	if t.resolver == nil {
 		return nil, errors.New("no Resolver provided by this target type")
 	}
@@ -178,7 +186,8 @@ func (t *targets) includeInResult(ep endpoint.Endpoint, ldMap map[string]endpoin
 // consists of a name and associated metadata like port and target labels.
 //
 // It gets the list of targets from the configured targets type, filters them
-// by the configured regex, excludes lame ducks and returns the resultant list.
+// by the configured regex, excludes lame ducks, expands them if expansion is
+// configured, and returns the resultant list.
 //
 // This method should be concurrency safe as it doesn't modify any shared
 // variables and doesn't rely on multiple accesses to same variable being
@@ -209,6 +218,14 @@ func (t *targets) ListEndpoints() []endpoint.Endpoint {
 		list = result
 	}
 
+	if t.expander != nil {
+		var result []endpoint.Endpoint
+		for _, ep := range list {
+			result = append(result, t.expander.expand(ep)...)
+		}
+		list = result
+	}
+
 	return list
 }
 
@@ -219,9 +236,10 @@ func baseTargets(targetsDef *targetspb.TargetsDef, ldLister endpoint.Lister, l *
 		l = &logger.Logger{}
 	}
 	tgts := &targets{
-		l:        l,
-		resolver: globalResolver,
-		ldLister: ldLister,
+		l:           l,
+		resolver:    globalResolver,
+		dnsResolver: globalResolver,
+		ldLister:    ldLister,
 	}
 
 	eps, err := endpoint.FromProtoMessage(targetsDef.GetEndpoint())
@@ -241,6 +259,12 @@ func baseTargets(targetsDef *targetspb.TargetsDef, ldLister endpoint.Lister, l *
 		}
 	}
 
+	if targetsDef.GetExpansion() != nil {
+		if tgts.expander, err = newExpander(targetsDef.GetExpansion(), l); err != nil {
+			return nil, err
+		}
+	}
+
 	return tgts, nil
 }
 
@@ -331,7 +355,7 @@ func New(targetsDef *targetspb.TargetsDef, ldLister endpoint.Lister, globalOpts
 		}
 		t.resolverIP = ip
 	}
-	t.resolver = resolver
+	t.resolver, t.dnsResolver = resolver, resolver
 	switch targetsDef.Type.(type) {
 	case *targetspb.TargetsDef_HostNames:
 		st, err := staticTargets(targetsDef.GetHostNames())